@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/brimdata/zed/lake/index"
+	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/nano"
@@ -36,10 +37,11 @@ type VersionResponse struct {
 }
 
 type PoolPostRequest struct {
-	Name       string       `json:"name"`
-	Layout     order.Layout `json:"layout"`
-	SeekStride int          `json:"seek_stride"`
-	Thresh     int64        `json:"thresh"`
+	Name       string          `json:"name"`
+	Layout     order.Layout    `json:"layout"`
+	Partition  pools.Partition `json:"partition"`
+	SeekStride int             `json:"seek_stride"`
+	Thresh     int64           `json:"thresh"`
 }
 
 type PoolPutRequest struct {
@@ -51,10 +53,29 @@ type BranchPostRequest struct {
 	Commit string `json:"commit"`
 }
 
+type TagPostRequest struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit"`
+}
+
 type BranchMergeRequest struct {
 	At string `json:"at"`
 }
 
+type RebaseRequest struct {
+	Onto string `json:"onto"`
+}
+
+type GCRequest struct {
+	Grace  *nano.Duration `json:"grace,omitempty"`
+	DryRun bool           `json:"dry_run"`
+}
+
+type GCResponse struct {
+	Objects int   `json:"objects"`
+	Bytes   int64 `json:"bytes"`
+}
+
 type CompactRequest struct {
 	ObjectIDs []ksuid.KSUID `zed:"object_ids"`
 }
@@ -64,6 +85,11 @@ type DeleteRequest struct {
 	Where     string   `zed:"where"`
 }
 
+type UpdateRequest struct {
+	Where     string `zed:"where"`
+	Transform string `zed:"transform"`
+}
+
 type CommitMessage struct {
 	Author string `zed:"author"`
 	Body   string `zed:"body"`
@@ -112,13 +138,33 @@ type EventBranch struct {
 	Branch string      `zed:"branch"`
 }
 
+type EventTag struct {
+	PoolID ksuid.KSUID `zed:"pool_id"`
+	Tag    string      `zed:"tag"`
+}
+
 type QueryRequest struct {
 	Query string              `json:"query"`
 	Head  lakeparse.Commitish `json:"head"`
+	// Explain requests that a QueryExplain precede the final QueryStats.
+	Explain bool `json:"explain,omitempty"`
+}
+
+type LintRequest struct {
+	Query string              `json:"query"`
+	Head  lakeparse.Commitish `json:"head"`
+	// Types are the ZSON types of the values input to a query that does
+	// not begin with a from operator.
+	Types []string `json:"types,omitempty"`
+}
+
+type LintResponse struct {
+	Warnings []string `json:"warnings"`
 }
 
 type QueryChannelSet struct {
-	ChannelID int `json:"channel_id" zed:"channel_id"`
+	ChannelID int    `json:"channel_id" zed:"channel_id"`
+	Name      string `json:"name,omitempty" zed:"name,omitempty"`
 }
 
 type QueryChannelEnd struct {
@@ -135,6 +181,13 @@ type QueryStats struct {
 	zbuf.Progress
 }
 
+// QueryExplain is sent before the final QueryStats of a query whose
+// QueryRequest has Explain set.  Text is the query's optimized DAG annotated
+// with the runtime statistics of its operators.
+type QueryExplain struct {
+	Text string `json:"text" zed:"text"`
+}
+
 type QueryWarning struct {
 	Warning string `json:"warning" zed:"warning"`
 }
@@ -20,8 +20,10 @@ import (
 	"github.com/brimdata/zed/lake"
 	"github.com/brimdata/zed/lake/branches"
 	"github.com/brimdata/zed/lake/index"
+	"github.com/brimdata/zed/lake/tags"
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/runtime/exec"
+	"github.com/brimdata/zed/runtime/op"
 	"github.com/brimdata/zed/zio/zngio"
 	"github.com/brimdata/zed/zson"
 	"github.com/segmentio/ksuid"
@@ -42,6 +44,10 @@ var (
 	ErrBranchNotFound = errors.New("branch not found")
 	// ErrBranchExists is returned when the specified the branch already exists.
 	ErrBranchExists = errors.New("branch exists")
+	// ErrTagNotFound is returned when the specified tag does not exist.
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagExists is returned when the specified the tag already exists.
+	ErrTagExists = errors.New("tag exists")
 )
 
 type Connection struct {
@@ -271,6 +277,29 @@ func (c *Connection) CreateBranch(ctx context.Context, poolID ksuid.KSUID, paylo
 	return branch, err
 }
 
+func (c *Connection) CreateTag(ctx context.Context, poolID ksuid.KSUID, payload api.TagPostRequest) (tags.Config, error) {
+	req := c.NewRequest(ctx, http.MethodPost, path.Join("/pool", poolID.String(), "tag"), payload)
+	var tag tags.Config
+	err := c.doAndUnmarshal(req, &tag)
+	if errIsStatus(err, http.StatusConflict) {
+		err = ErrTagExists
+	}
+	return tag, err
+}
+
+func (c *Connection) RemoveTag(ctx context.Context, poolID ksuid.KSUID, tagName string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("pool", poolID.String(), "tag", tagName), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		if errIsStatus(err, http.StatusNotFound) {
+			return ErrTagNotFound
+		}
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", parentBranch, "merge", childBranch)
 	req := c.NewRequest(ctx, http.MethodPost, path, nil)
@@ -282,6 +311,23 @@ func (c *Connection) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childB
 	return commit, err
 }
 
+// Diff returns the changes that merging the commit referred to by to into
+// the commit referred to by from would make.  See lake.Pool.Diff.
+//
+// As for Connection.Do, if the returned error is nil, the user is expected to
+// call Response.Body.Close.
+func (c *Connection) Diff(ctx context.Context, poolID ksuid.KSUID, from, to string, values bool) (*Response, error) {
+	query := url.Values{}
+	query.Set("from", from)
+	query.Set("to", to)
+	if values {
+		query.Set("values", "T")
+	}
+	path := urlPath("pool", poolID.String(), "diff") + "?" + query.Encode()
+	req := c.NewRequest(ctx, http.MethodGet, path, nil)
+	return c.Do(req)
+}
+
 func (c *Connection) Revert(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName, "revert", commitID.String())
 	req := c.NewRequest(ctx, http.MethodPost, path, nil)
@@ -293,6 +339,33 @@ func (c *Connection) Revert(ctx context.Context, poolID ksuid.KSUID, branchName
 	return commit, err
 }
 
+func (c *Connection) CherryPick(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (api.CommitResponse, error) {
+	path := urlPath("pool", poolID.String(), "branch", branchName, "cherrypick", commitID.String())
+	req := c.NewRequest(ctx, http.MethodPost, path, nil)
+	if err := encodeCommitMessage(req, message); err != nil {
+		return api.CommitResponse{}, err
+	}
+	var commit api.CommitResponse
+	err := c.doAndUnmarshal(req, &commit)
+	return commit, err
+}
+
+func (c *Connection) GC(ctx context.Context, poolID ksuid.KSUID, request api.GCRequest) (api.GCResponse, error) {
+	path := urlPath("pool", poolID.String(), "gc")
+	req := c.NewRequest(ctx, http.MethodPost, path, request)
+	var res api.GCResponse
+	err := c.doAndUnmarshal(req, &res)
+	return res, err
+}
+
+func (c *Connection) Rebase(ctx context.Context, poolID ksuid.KSUID, branchName, onto string) (api.CommitResponse, error) {
+	path := urlPath("pool", poolID.String(), "branch", branchName, "rebase")
+	req := c.NewRequest(ctx, http.MethodPost, path, api.RebaseRequest{Onto: onto})
+	var commit api.CommitResponse
+	err := c.doAndUnmarshal(req, &commit)
+	return commit, err
+}
+
 // Query assembles a query from src and filenames and runs it.
 //
 // As for Connection.Do, if the returned error is nil, the user is expected to
@@ -302,7 +375,7 @@ func (c *Connection) Query(ctx context.Context, head *lakeparse.Commitish, src s
 	if err != nil {
 		return nil, err
 	}
-	body := api.QueryRequest{Query: src}
+	body := api.QueryRequest{Query: src, Explain: op.ExplainRequested(ctx)}
 	if head != nil {
 		body.Head = *head
 	}
@@ -319,6 +392,20 @@ func (c *Connection) Query(ctx context.Context, head *lakeparse.Commitish, src s
 	return res, err
 }
 
+// Lint returns warnings about likely mistakes in the query src revealed by
+// the types of the values it scans.  types are the ZSON types of the values
+// input to a query that does not begin with a from operator.
+func (c *Connection) Lint(ctx context.Context, head *lakeparse.Commitish, src string, types []string) (api.LintResponse, error) {
+	body := api.LintRequest{Query: src, Types: types}
+	if head != nil {
+		body.Head = *head
+	}
+	req := c.NewRequest(ctx, http.MethodPost, "/query/lint", body)
+	var res api.LintResponse
+	err := c.doAndUnmarshal(req, &res)
+	return res, err
+}
+
 func (c *Connection) Compact(ctx context.Context, poolID ksuid.KSUID, branchName string, objects []ksuid.KSUID, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName, "compact")
 	req := c.NewRequest(ctx, http.MethodPost, path, api.CompactRequest{ObjectIDs: objects})
@@ -333,7 +420,20 @@ func (c *Connection) Compact(ctx context.Context, poolID ksuid.KSUID, branchName
 // Load loads data from r.  contentType is a media type for r or the empty
 // string, in which case the server will attempt to detect r's format.
 func (c *Connection) Load(ctx context.Context, poolID ksuid.KSUID, branchName, contentType string, r io.Reader, message api.CommitMessage) (api.CommitResponse, error) {
+	return c.load(ctx, poolID, branchName, "", contentType, r, message)
+}
+
+// Upsert is like Load but replaces the values in the branch whose value of
+// key equals that of a loaded value.
+func (c *Connection) Upsert(ctx context.Context, poolID ksuid.KSUID, branchName, key, contentType string, r io.Reader, message api.CommitMessage) (api.CommitResponse, error) {
+	return c.load(ctx, poolID, branchName, key, contentType, r, message)
+}
+
+func (c *Connection) load(ctx context.Context, poolID ksuid.KSUID, branchName, upsertKey, contentType string, r io.Reader, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName)
+	if upsertKey != "" {
+		path += "?" + url.Values{"upsert_key": {upsertKey}}.Encode()
+	}
 	req := c.NewRequest(ctx, http.MethodPost, path, r)
 	req.Header.Set("Content-Type", contentType)
 	if err := encodeCommitMessage(req, message); err != nil {
@@ -421,6 +521,20 @@ func (c *Connection) delete(ctx context.Context, poolID ksuid.KSUID, branchName
 	return commit, err
 }
 
+func (c *Connection) Update(ctx context.Context, poolID ksuid.KSUID, branchName, where, transform string, message api.CommitMessage) (api.CommitResponse, error) {
+	path := urlPath("pool", poolID.String(), "branch", branchName, "update")
+	req := c.NewRequest(ctx, http.MethodPost, path, api.UpdateRequest{
+		Where:     where,
+		Transform: transform,
+	})
+	if err := encodeCommitMessage(req, message); err != nil {
+		return api.CommitResponse{}, err
+	}
+	var commit api.CommitResponse
+	err := c.doAndUnmarshal(req, &commit)
+	return commit, err
+}
+
 func (c *Connection) SubscribeEvents(ctx context.Context) (*EventsClient, error) {
 	req := c.NewRequest(ctx, http.MethodGet, "/events", nil)
 	req.Header.Set("Accept", api.MediaTypeZSON)
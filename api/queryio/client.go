@@ -54,9 +54,11 @@ func (q *Query) Read() (*zed.Value, error) {
 func controlToError(ctrl interface{}) error {
 	switch ctrl := ctrl.(type) {
 	case *api.QueryChannelSet:
-		return &zbuf.Control{Message: zbuf.SetChannel(ctrl.ChannelID)}
+		return &zbuf.Control{Message: zbuf.SetChannel{ID: ctrl.ChannelID, Name: ctrl.Name}}
 	case *api.QueryChannelEnd:
 		return &zbuf.Control{Message: zbuf.EndChannel(ctrl.ChannelID)}
+	case *api.QueryExplain:
+		return &zbuf.Control{Message: zbuf.Explanation(ctrl.Text)}
 	case *api.QueryStats:
 		return &zbuf.Control{Message: zbuf.Progress(ctrl.Progress)}
 	case *api.QueryError:
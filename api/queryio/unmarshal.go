@@ -13,6 +13,7 @@ func init() {
 		api.QueryChannelSet{},
 		api.QueryChannelEnd{},
 		api.QueryError{},
+		api.QueryExplain{},
 		api.QueryStats{},
 		api.QueryWarning{},
 	)
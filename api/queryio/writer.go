@@ -56,10 +56,10 @@ func NewWriter(w io.WriteCloser, format string, flusher http.Flusher, ctrl bool)
 	return d, err
 }
 
-func (w *Writer) WriteBatch(cid int, batch zbuf.Batch) error {
+func (w *Writer) WriteBatch(cid int, name string, batch zbuf.Batch) error {
 	if w.cid != cid {
 		w.cid = cid
-		if err := w.WriteControl(api.QueryChannelSet{ChannelID: cid}); err != nil {
+		if err := w.WriteControl(api.QueryChannelSet{ChannelID: cid, Name: name}); err != nil {
 			return err
 		}
 	}
@@ -80,6 +80,12 @@ func (w *Writer) WriteProgress(stats zbuf.Progress) error {
 	return w.WriteControl(v)
 }
 
+// WriteExplanation writes the explanation of a query returned by
+// runtime.Query.Explain.
+func (w *Writer) WriteExplanation(text string) error {
+	return w.WriteControl(api.QueryExplain{Text: text})
+}
+
 func (w *Writer) WriteError(err error) {
 	w.WriteControl(api.QueryError{Error: err.Error()})
 }
@@ -6,7 +6,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/brimdata/zed/cli/auto"
 	"github.com/brimdata/zed/pkg/storage"
@@ -26,6 +28,8 @@ type Flags struct {
 	split         string
 	splitSize     auto.Bytes
 	outputFile    string
+	named         map[string]string
+	namedOpened   bool
 	forceBinary   bool
 	jsonShortcut  bool
 	zsonShortcut  bool
@@ -62,6 +66,34 @@ func (f *Flags) setFlags(fs *flag.FlagSet) {
 		"if >0 and -split is set, split into files at least this big rather than by data type")
 	fs.BoolVar(&f.unbuffered, "unbuffered", false, "disable output buffering")
 	fs.StringVar(&f.outputFile, "o", "", "write data to output file")
+	fs.Var(namedFlag{f}, "output", "write the query output named name to file, given as name=file (may be repeated)")
+}
+
+var nameRE = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// namedFlag implements the -output flag, which may be given any number of
+// times with name=path for the named outputs of a query.
+type namedFlag struct {
+	f *Flags
+}
+
+func (n namedFlag) String() string {
+	return ""
+}
+
+func (n namedFlag) Set(s string) error {
+	name, path, ok := strings.Cut(s, "=")
+	if !ok || !nameRE.MatchString(name) || path == "" {
+		return fmt.Errorf("%q is not of the form name=file", s)
+	}
+	if _, ok := n.f.named[name]; ok {
+		return fmt.Errorf("output %q given more than once", name)
+	}
+	if n.f.named == nil {
+		n.f.named = make(map[string]string)
+	}
+	n.f.named[name] = path
+	return nil
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
@@ -126,6 +158,9 @@ func (f *Flags) FileName() string {
 }
 
 func (f *Flags) Open(ctx context.Context, engine storage.Engine) (zio.WriteCloser, error) {
+	if len(f.named) != 0 && !f.namedOpened {
+		return nil, errors.New("named outputs (-output name=file) are not supported by this command")
+	}
 	if f.split != "" {
 		dir, err := storage.ParseURI(f.split)
 		if err != nil {
@@ -149,3 +184,53 @@ func (f *Flags) Open(ctx context.Context, engine storage.Engine) (zio.WriteClose
 	}
 	return w, nil
 }
+
+// OpenNamed opens a writer for each named output given as -output name=file.
+// The format of each writer is derived from the extension of its file name
+// if the extension is recognized and is otherwise the format of the main output.
+// OpenNamed must be called before Open if named outputs are supported.
+func (f *Flags) OpenNamed(ctx context.Context, engine storage.Engine) (map[string]zio.WriteCloser, error) {
+	f.namedOpened = true
+	writers := make(map[string]zio.WriteCloser)
+	for name, path := range f.named {
+		opts := f.WriterOpts
+		if format := formatOfPath(path); format != "" {
+			opts.Format = format
+		}
+		w, err := emitter.NewFileFromPath(ctx, engine, path, f.unbuffered, opts)
+		if err != nil {
+			for _, w := range writers {
+				w.Close()
+			}
+			return nil, fmt.Errorf("output %q: %w", name, err)
+		}
+		writers[name] = w
+	}
+	return writers, nil
+}
+
+// CloseNamed closes the writers returned by OpenNamed.
+func CloseNamed(writers map[string]zio.WriteCloser) error {
+	var err error
+	for _, w := range writers {
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+var formats = []string{"csv", "json", "parquet", "table", "text", "vng", "zeek", "zjson", "zng", "zson"}
+
+func formatOfPath(path string) string {
+	ext := filepath.Ext(path)
+	if ext == "" {
+		return ""
+	}
+	for _, format := range formats {
+		if zio.Extension(format) == ext {
+			return format
+		}
+	}
+	return ""
+}
@@ -17,11 +17,13 @@ import (
 type Flags struct {
 	Verbose  bool
 	Stats    bool
+	Explain  bool
 	Includes Includes
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&f.Stats, "s", false, "display search stats on stderr")
+	fs.BoolVar(&f.Explain, "explain", false, "run query discarding its output and display its optimized DAG annotated with runtime statistics")
 	fs.Var(&f.Includes, "I", "source file containing Zed query text (may be used multiple times)")
 }
 
@@ -95,3 +97,11 @@ func (f *Flags) PrintStats(stats zbuf.Progress) {
 		fmt.Fprintln(os.Stderr, out)
 	}
 }
+
+// PrintExplain displays the explanation of a query run with the -explain
+// flag on stdout.
+func (f *Flags) PrintExplain(e zbuf.Explainer) {
+	if f.Explain {
+		fmt.Println(e.Explain())
+	}
+}
@@ -1,6 +1,7 @@
 package zq
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
@@ -11,9 +12,11 @@ import (
 	"github.com/brimdata/zed/cli/queryflags"
 	"github.com/brimdata/zed/cli/runtimeflags"
 	"github.com/brimdata/zed/compiler"
+	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/pkg/charm"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime"
+	"github.com/brimdata/zed/runtime/op"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zfmt"
 	"github.com/brimdata/zed/zio"
@@ -149,20 +152,51 @@ func (c *Command) Run(args []string) error {
 		}
 	}
 	defer zio.CloseReaders(readers)
+	if c.queryFlags.Explain {
+		return c.explain(ctx, zctx, local, flowgraph, readers)
+	}
+	named, err := c.outputFlags.OpenNamed(ctx, local)
+	if err != nil {
+		return err
+	}
 	writer, err := c.outputFlags.Open(ctx, local)
 	if err != nil {
+		outputflags.CloseNamed(named)
 		return err
 	}
 	comp := compiler.NewFileSystemCompiler(local)
 	query, err := runtime.CompileQuery(ctx, zctx, comp, flowgraph, readers)
 	if err != nil {
+		outputflags.CloseNamed(named)
 		return err
 	}
 	defer query.Pull(true)
-	err = zbuf.CopyPuller(writer, query)
+	if len(named) == 0 {
+		err = zbuf.CopyPuller(writer, query)
+	} else {
+		err = query.CopyChannels(writer, named)
+	}
 	if closeErr := writer.Close(); err == nil {
 		err = closeErr
 	}
+	if closeErr := outputflags.CloseNamed(named); err == nil {
+		err = closeErr
+	}
 	c.queryFlags.PrintStats(query.Progress())
 	return err
 }
+
+func (c *Command) explain(ctx context.Context, zctx *zed.Context, engine storage.Engine, flowgraph ast.Op, readers []zio.Reader) error {
+	comp := compiler.NewFileSystemCompiler(engine)
+	query, err := runtime.CompileQuery(op.WithExplain(ctx), zctx, comp, flowgraph, readers)
+	if err != nil {
+		return err
+	}
+	defer query.Pull(true)
+	if err := zbuf.CopyPuller(zio.Discard, query); err != nil {
+		return err
+	}
+	c.queryFlags.PrintExplain(query)
+	c.queryFlags.PrintStats(query.Progress())
+	return nil
+}
@@ -0,0 +1,92 @@
+package cherrypick
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/zed/cli/commitflags"
+	"github.com/brimdata/zed/cli/lakeflags"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "cherry-pick",
+	Usage: "cherry-pick commit",
+	Short: "apply the changes in a commit to the current branch",
+	Long: `
+The cherry-pick command applies the changes made by a single commit,
+typically on another branch, to the tip of the current branch in a new commit.
+Unless a message is given with -message, the new commit's message notes the
+ID and message of the original commit.  Unless metadata is given with -meta,
+the new commit carries the metadata of the original commit.  With -keepauthor,
+the new commit is credited to the author of the original commit instead of
+the user given by -user.
+
+If the current branch no longer contains an object the commit deletes, or
+already contains an object the commit adds, the conflict is reported as an
+error and no commit is made.
+`,
+	New: New,
+}
+
+type Command struct {
+	*root.Command
+	commitFlags commitflags.Flags
+	keepAuthor  bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	c.commitFlags.SetFlags(f)
+	f.BoolVar(&c.keepAuthor, "keepauthor", false, "credit the new commit to the author of the original commit")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("commit ID must be specified")
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.LakeFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	if head.Pool == "" {
+		return lakeflags.ErrNoHEAD
+	}
+	poolID, err := lake.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	if _, err := lakeparse.ParseID(head.Branch); err == nil {
+		return errors.New("branch must be named")
+	}
+	commitID, err := lakeparse.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+	message := c.commitFlags.CommitMessage()
+	if c.keepAuthor {
+		// An empty author tells the lake to use the original author.
+		message.Author = ""
+	}
+	pickID, err := lake.CherryPick(ctx, poolID, head.Branch, commitID, message)
+	if err != nil {
+		return err
+	}
+	if !c.LakeFlags.Quiet {
+		fmt.Printf("%q: %s cherry-picked in %s\n", head.Branch, commitID, pickID)
+	}
+	return nil
+}
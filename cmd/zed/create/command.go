@@ -7,14 +7,16 @@ import (
 
 	"github.com/brimdata/zed/cmd/zed/root"
 	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/charm"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/units"
 )
 
 var Cmd = &charm.Spec{
 	Name:  "create",
-	Usage: "create [-orderby key[,key...][:asc|:desc]] name",
+	Usage: "create [-orderby key[,key...][:asc|:desc]] [-partitionby key [-buckets n]] name",
 	Short: "create a new data pool",
 	Long: `
 The lake create command creates new pools.  One or more pool keys may be specified
@@ -24,6 +26,14 @@ keys indicates the sort order.  If no sort order is given, ascending is assumed.
 
 The single argument specifies the name for the pool.
 
+The -partitionby option specifies a field whose values partition the data
+objects of the pool so that each object holds values from a single partition.
+The partition key is a dotted field path, not an expression.
+Queries with filters on the partition key skip the objects of partitions
+that cannot match.  If -buckets is given, values are partitioned into that
+many buckets by the hash of the partition key, and only equality filters
+on the key are used to skip objects.
+
 The lake query command can efficiently perform
 range scans with respect to the pool key using the
 "range" parameter to the Zed "from" operator as the data is laid out
@@ -37,9 +47,11 @@ By default, a branch called "main" is initialized in the newly created pool.
 
 type Command struct {
 	*root.Command
-	layout     string
-	thresh     units.Bytes
-	seekStride units.Bytes
+	layout      string
+	partitionBy string
+	buckets     int
+	thresh      units.Bytes
+	seekStride  units.Bytes
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -50,6 +62,8 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c.thresh = data.DefaultThreshold
 	f.Var(&c.thresh, "S", "target size of pool data objects, as '10MB' or '4GiB', etc.")
 	f.StringVar(&c.layout, "orderby", "ts:desc", "comma-separated pool keys with optional :asc or :desc suffix to organize data in pool (cannot be changed)")
+	f.StringVar(&c.partitionBy, "partitionby", "", "field whose values partition the data objects of the pool (cannot be changed)")
+	f.IntVar(&c.buckets, "buckets", 0, "number of buckets to hash partition key values into (default is to partition by value)")
 	f.Var(&c.seekStride, "seekstride", "size of seek-index unit for ZNG data, as '32KB', '1MB', etc.")
 	return c, nil
 }
@@ -71,8 +85,12 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	partition := pools.Partition{Buckets: c.buckets}
+	if c.partitionBy != "" {
+		partition.Key = field.Dotted(c.partitionBy)
+	}
 	poolName := args[0]
-	id, err := lake.CreatePool(ctx, poolName, layout, int(c.seekStride), int64(c.thresh))
+	id, err := lake.CreatePartitionedPool(ctx, poolName, layout, partition, int(c.seekStride), int64(c.thresh))
 	if err != nil {
 		return err
 	}
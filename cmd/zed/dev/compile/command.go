@@ -1,6 +1,7 @@
 package compile
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -9,17 +10,24 @@ import (
 	"os/exec"
 	"strings"
 
+	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/cmd/zed/dev"
 	"github.com/brimdata/zed/cmd/zed/root"
 	"github.com/brimdata/zed/compiler"
 	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/compiler/ast/dag"
 	"github.com/brimdata/zed/compiler/data"
+	"github.com/brimdata/zed/compiler/lint"
 	"github.com/brimdata/zed/compiler/parser"
 	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/api"
 	"github.com/brimdata/zed/pkg/charm"
+	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zfmt"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/anyio"
 )
 
 var Cmd = &charm.Spec{
@@ -42,6 +50,16 @@ by execing node in the currrent directory running the JavaScript in ./compiler/p
 The -O flag is handy for turning on and off the compiler, which lets you see
 how the parsed AST is transformed into a runtime object comprised of the
 Zed kernel operators.
+
+The -lint flag infers the types of the values flowing through the query and
+prints a warning for each reference to a field that never exists, comparison
+of values whose types never match, aggregation of values that are never
+numeric, and switch case that is never reached.  The types of the values read
+from pools are taken from the lake given by -lake.  If -types is given, the
+types of the values input to a query without a from operator are taken from
+the values in the named file, where a value of type "type" stands for the type
+it denotes, and the query is checked without a lake service, so it may read
+only from the pools of a lake on the local file system.
 `,
 	New: New,
 }
@@ -59,6 +77,8 @@ type Command struct {
 	semantic bool
 	optimize bool
 	parallel int
+	lint     bool
+	types    string
 	layout   string
 	n        int
 	includes includes
@@ -74,6 +94,8 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.IntVar(&c.parallel, "P", 0, "display parallelized AST (implies -proc)")
 	f.BoolVar(&c.canon, "C", false, "display AST in Zed canonical format (implies -proc)")
 	f.Var(&c.includes, "I", "source file containing Zed query text (may be repeated)")
+	f.BoolVar(&c.lint, "lint", false, "display warnings from type checking the query")
+	f.StringVar(&c.types, "types", "", "file of sample input values whose types are used by -lint")
 	return c, nil
 }
 
@@ -116,6 +138,9 @@ func (c *Command) Run(args []string) error {
 	if c.parallel > 0 {
 		c.n++
 	}
+	if c.lint {
+		c.n++
+	}
 	if c.n == 0 {
 		if c.canon {
 			c.proc = true
@@ -135,13 +160,61 @@ func (c *Command) Run(args []string) error {
 	}
 	src += strings.Join(args, " ")
 	var lk *lake.Root
-	if c.semantic || c.optimize || c.parallel != 0 {
-		lakeAPI, err := c.LakeFlags.Open(ctx)
+	var lakeAPI api.Interface
+	if c.semantic || c.optimize || c.parallel != 0 || c.lint {
+		lakeAPI, err = c.LakeFlags.Open(ctx)
 		if err == nil {
 			lk = lakeAPI.Root()
 		}
 	}
-	return c.parse(src, lk)
+	if err := c.parse(src, lk); err != nil {
+		return err
+	}
+	if c.lint {
+		return c.writeLint(ctx, src, lakeAPI, lk)
+	}
+	return nil
+}
+
+func (c *Command) writeLint(ctx context.Context, src string, lakeAPI api.Interface, lk *lake.Root) error {
+	zctx := zed.NewContext()
+	var types []zed.Type
+	if c.types != "" {
+		file, err := anyio.Open(ctx, zctx, storage.NewLocalEngine(), c.types, anyio.ReaderOpts{})
+		if err != nil {
+			return err
+		}
+		var vals zbuf.Array
+		err = zio.Copy(&vals, file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+		if types, err = lint.TypesOf(zctx, vals.Values()); err != nil {
+			return err
+		}
+	}
+	var warnings []string
+	if c.types == "" && lakeAPI != nil {
+		head, _ := c.LakeFlags.HEAD()
+		var err error
+		if warnings, err = lakeAPI.Lint(ctx, head, src, types); err != nil {
+			return err
+		}
+	} else {
+		p, err := compiler.Parse(src)
+		if err != nil {
+			return err
+		}
+		if warnings, err = compiler.Lint(ctx, zctx, p, lk, nil, types); err != nil {
+			return err
+		}
+	}
+	c.header("lint")
+	for _, w := range warnings {
+		fmt.Println(w)
+	}
+	return nil
 }
 
 func (c *Command) header(msg string) {
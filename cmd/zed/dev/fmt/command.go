@@ -0,0 +1,93 @@
+package format
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/brimdata/zed/cmd/zed/dev"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/pkg/charm"
+	"github.com/brimdata/zed/zfmt"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "fmt",
+	Usage: "fmt [-w] [file ...]",
+	Short: "reformat Zed source",
+	Long: `
+The "zed dev fmt" command reformats the Zed source in each file argument or,
+if there are none, in standard input and writes the result to standard
+output.  With -w, each file is instead rewritten in place if its formatting
+changed.
+
+The formatted source has one operator per line.  The pipelines nested in
+an operator like fork, switch, from, over, or try are indented beneath it,
+and the cases of a switch are aligned.  Comments are kept with the
+operator, declaration, or case they precede or follow.  Formatting already
+formatted source leaves it unchanged.
+`,
+	New: New,
+}
+
+func init() {
+	dev.Cmd.Add(Cmd)
+}
+
+type Command struct {
+	*root.Command
+	write bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.BoolVar(&c.write, "w", false, "write result to each file instead of standard output")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	_, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 {
+		if c.write {
+			return errors.New("-w requires a file argument")
+		}
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		out, err := zfmt.Format(string(b))
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(os.Stdout, out)
+		return err
+	}
+	for _, path := range args {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		out, err := zfmt.Format(string(b))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if !c.write {
+			if _, err := io.WriteString(os.Stdout, out); err != nil {
+				return err
+			}
+			continue
+		}
+		if out != string(b) {
+			if err := os.WriteFile(path, []byte(out), 0666); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package lsp
+
+import (
+	"flag"
+	"os"
+
+	"github.com/brimdata/zed/cmd/zed/dev"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/lake/api"
+	"github.com/brimdata/zed/lsp"
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "lsp",
+	Usage: "lsp",
+	Short: "run a language server for the Zed language",
+	Long: `
+The "zed dev lsp" command runs a language server for the Zed language that
+communicates with an editor over standard input and output using the Language
+Server Protocol.
+
+The server reports syntax errors at their positions, errors found by the
+compiler's semantic pass such as calls to undefined functions, and completes
+the names of operators, functions, and aggregate functions.  If a lake is
+given by the -lake flag or the ZED_LAKE environment variable, the server also
+completes the names of its pools and, after "pool@", the names of the pool's
+branches.
+`,
+	New: New,
+}
+
+func init() {
+	dev.Cmd.Add(Cmd)
+}
+
+type Command struct {
+	*root.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*root.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 0 {
+		return charm.NeedHelp
+	}
+	var lk api.Interface
+	if l, err := c.LakeFlags.Open(ctx); err == nil {
+		lk = l
+	}
+	return lsp.NewServer(lk).Serve(ctx, os.Stdin, os.Stdout)
+}
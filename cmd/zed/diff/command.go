@@ -0,0 +1,94 @@
+package diff
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimdata/zed/cli/lakeflags"
+	"github.com/brimdata/zed/cli/outputflags"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/charm"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zio"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "diff",
+	Usage: "diff [options] from to",
+	Short: "show the changes that merging one commit into another would make",
+	Long: `
+The diff command shows the changes that merging "to" into "from" would make,
+e.g., "zed diff main staging" shows what "zed merge -use @staging main" would
+change in branch main.  Each of from and to is a branch name, tag name, or
+commit ID in the pool in HEAD.
+
+As for a merge, the changes are those made after the most recent commit that
+from and to have in common along the history of to.  If they conflict with
+the changes along the history of from, the conflict is reported as an error.
+
+The output lists each data object deleted ("-") and added ("+") with its
+record count and key range, followed by each index object deleted and added.
+If the -values option is specified, the values in the deleted data objects
+followed by the values in the added data objects are output instead, each as
+a record of the form {op:"delete",value:...} or {op:"add",value:...}.
+`,
+	New: New,
+}
+
+type Command struct {
+	*root.Command
+	values      bool
+	outputFlags outputflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.BoolVar(&c.values, "values", false, "output the values deleted and added instead of the objects")
+	c.outputFlags.DefaultFormat = "lake"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 2 {
+		return errors.New("two commits must be specified")
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.LakeFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	if head.Pool == "" {
+		return lakeflags.ErrNoHEAD
+	}
+	poolID, err := lakeparse.ParseID(head.Pool)
+	if err != nil {
+		poolID, err = lake.PoolID(ctx, head.Pool)
+		if err != nil {
+			return err
+		}
+	}
+	r, err := lake.Diff(ctx, poolID, args[0], args[1], c.values)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	err = zio.Copy(w, r)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package gc
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/brimdata/zed/cli/lakeflags"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/lake"
+	lakeapi "github.com/brimdata/zed/lake/api"
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "gc",
+	Usage: "gc [options] [pool ...]",
+	Short: "remove unreferenced objects from pool storage",
+	Long: `
+The gc command removes from storage the data, seek index, vector, and search
+index objects of a pool that are not referenced by any branch or tag and
+that are older than a grace period, e.g., objects deleted or compacted
+away from every branch and objects left behind by failed loads.
+Objects deleted by a commit made within the grace period are also
+retained so queries of recent commits continue to work.
+
+Objects written after the latest commit of any branch or tag and, for
+a lake service, after the start of the oldest change it is making to the
+pool are retained since a pending commit may reference them.  A change
+made to a local lake by another process must finish within the grace
+period.
+
+If one or more pools are given, they are collected.  Otherwise, the -all
+flag collects every pool in the lake and, without it, the pool in HEAD
+is collected.
+
+With -n, nothing is removed and gc reports the number of objects and bytes
+it would reclaim.
+
+DANGER ZONE.
+Objects referenced only by commits older than the grace period are removed,
+so time travel to or revert of such a commit may fail afterward.
+Tag a commit to keep it queryable.
+`,
+	New: New,
+}
+
+type Command struct {
+	*root.Command
+	all    bool
+	dryRun bool
+	grace  time.Duration
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.BoolVar(&c.all, "all", false, "collect every pool in the lake")
+	f.BoolVar(&c.dryRun, "n", false, "report what would be removed without removing it")
+	f.DurationVar(&c.grace, "grace", lake.DefaultGCGrace, "retain objects written or deleted within this duration")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if c.grace < 0 {
+		return fmt.Errorf("grace period must not be negative: %s", c.grace)
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	var names []string
+	switch {
+	case len(args) > 0:
+		names = args
+	case c.all:
+		pools, err := lakeapi.GetPools(ctx, lake)
+		if err != nil {
+			return err
+		}
+		for _, pool := range pools {
+			names = append(names, pool.Name)
+		}
+	default:
+		head, err := c.LakeFlags.HEAD()
+		if err != nil {
+			return err
+		}
+		if head.Pool == "" {
+			return lakeflags.ErrNoHEAD
+		}
+		names = append(names, head.Pool)
+	}
+	verb := "removed"
+	if c.dryRun {
+		verb = "would remove"
+	}
+	for _, name := range names {
+		poolID, err := lake.PoolID(ctx, name)
+		if err != nil {
+			return err
+		}
+		res, err := lake.GC(ctx, poolID, c.grace, c.dryRun)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if !c.LakeFlags.Quiet || c.dryRun {
+			fmt.Printf("%s: %s %d object%s in %d bytes\n", name, verb, res.Objects, plural(res.Objects), res.Bytes)
+		}
+	}
+	return nil
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
@@ -18,10 +18,12 @@ import (
 	"github.com/brimdata/zed/cmd/zed/root"
 	"github.com/brimdata/zed/pkg/charm"
 	"github.com/brimdata/zed/pkg/display"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/pkg/units"
 	"github.com/brimdata/zed/zio"
 	"github.com/paulbellamy/ratecounter"
+	"github.com/segmentio/ksuid"
 	"golang.org/x/term"
 )
 
@@ -31,6 +33,10 @@ var Cmd = &charm.Spec{
 	Short: "add and commit data to a branch",
 	Long: `
 The load command adds data to a pool and commits it to a branch.
+
+If -upsert-key is given, the loaded values replace the values in the branch
+with the same value of the key in the same commit.  Objects holding both
+replaced and other values are rewritten.
 `,
 	New: New,
 }
@@ -40,6 +46,7 @@ type Command struct {
 	commitFlags  commitflags.Flags
 	inputFlags   inputflags.Flags
 	runtimeFlags runtimeflags.Flags
+	upsertKey    string
 
 	// status output
 	ctx       context.Context
@@ -53,6 +60,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c.commitFlags.SetFlags(f)
 	c.inputFlags.SetFlags(f, true)
 	c.runtimeFlags.SetFlags(f)
+	f.StringVar(&c.upsertKey, "upsert-key", "", "replace values in the branch with the same value of this field")
 	return c, nil
 }
 
@@ -96,7 +104,13 @@ func (c *Command) Run(args []string) error {
 		go d.Run()
 	}
 	message := c.commitFlags.CommitMessage()
-	commitID, err := lake.Load(ctx, zctx, poolID, head.Branch, zio.ConcatReader(readers...), message)
+	reader := zio.ConcatReader(readers...)
+	var commitID ksuid.KSUID
+	if c.upsertKey != "" {
+		commitID, err = lake.Upsert(ctx, zctx, poolID, head.Branch, reader, field.Dotted(c.upsertKey), message)
+	} else {
+		commitID, err = lake.Load(ctx, zctx, poolID, head.Branch, reader, message)
+	}
 	if d != nil {
 		d.Close()
 	}
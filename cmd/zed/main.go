@@ -6,6 +6,7 @@ import (
 
 	"github.com/brimdata/zed/cmd/zed/auth"
 	"github.com/brimdata/zed/cmd/zed/branch"
+	"github.com/brimdata/zed/cmd/zed/cherrypick"
 	"github.com/brimdata/zed/cmd/zed/compact"
 	"github.com/brimdata/zed/cmd/zed/create"
 	zeddelete "github.com/brimdata/zed/cmd/zed/delete"
@@ -15,12 +16,16 @@ import (
 	_ "github.com/brimdata/zed/cmd/zed/dev/dig/section"
 	_ "github.com/brimdata/zed/cmd/zed/dev/dig/slice"
 	_ "github.com/brimdata/zed/cmd/zed/dev/dig/trailer"
+	_ "github.com/brimdata/zed/cmd/zed/dev/fmt"
 	_ "github.com/brimdata/zed/cmd/zed/dev/indexfile"
 	_ "github.com/brimdata/zed/cmd/zed/dev/indexfile/create"
 	_ "github.com/brimdata/zed/cmd/zed/dev/indexfile/lookup"
+	_ "github.com/brimdata/zed/cmd/zed/dev/lsp"
 	_ "github.com/brimdata/zed/cmd/zed/dev/vcache/copy"
 	_ "github.com/brimdata/zed/cmd/zed/dev/vcache/project"
+	"github.com/brimdata/zed/cmd/zed/diff"
 	"github.com/brimdata/zed/cmd/zed/drop"
+	"github.com/brimdata/zed/cmd/zed/gc"
 	"github.com/brimdata/zed/cmd/zed/index"
 	zedinit "github.com/brimdata/zed/cmd/zed/init"
 	"github.com/brimdata/zed/cmd/zed/load"
@@ -31,10 +36,13 @@ import (
 	_ "github.com/brimdata/zed/cmd/zed/manage/update"
 	"github.com/brimdata/zed/cmd/zed/merge"
 	"github.com/brimdata/zed/cmd/zed/query"
+	"github.com/brimdata/zed/cmd/zed/rebase"
 	"github.com/brimdata/zed/cmd/zed/rename"
 	"github.com/brimdata/zed/cmd/zed/revert"
 	"github.com/brimdata/zed/cmd/zed/root"
 	"github.com/brimdata/zed/cmd/zed/serve"
+	"github.com/brimdata/zed/cmd/zed/tag"
+	"github.com/brimdata/zed/cmd/zed/update"
 	"github.com/brimdata/zed/cmd/zed/use"
 	"github.com/brimdata/zed/cmd/zed/vacate"
 	"github.com/brimdata/zed/cmd/zed/vector"
@@ -44,10 +52,13 @@ func main() {
 	zed := root.Zed
 	zed.Add(auth.Cmd)
 	zed.Add(branch.Cmd)
+	zed.Add(cherrypick.Cmd)
 	zed.Add(compact.Cmd)
 	zed.Add(create.Cmd)
 	zed.Add(zeddelete.Cmd)
+	zed.Add(diff.Cmd)
 	zed.Add(drop.Cmd)
+	zed.Add(gc.Cmd)
 	zed.Add(index.Cmd)
 	zed.Add(zedinit.Cmd)
 	zed.Add(load.Cmd)
@@ -56,9 +67,12 @@ func main() {
 	zed.Add(manage.Cmd)
 	zed.Add(merge.Cmd)
 	zed.Add(query.Cmd)
+	zed.Add(rebase.Cmd)
 	zed.Add(rename.Cmd)
 	zed.Add(revert.Cmd)
 	zed.Add(serve.Cmd)
+	zed.Add(tag.Cmd)
+	zed.Add(update.Cmd)
 	zed.Add(use.Cmd)
 	zed.Add(vacate.Cmd)
 	zed.Add(vector.Cmd)
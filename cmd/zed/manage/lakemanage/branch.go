@@ -2,37 +2,44 @@ package lakemanage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/api"
 	lakeapi "github.com/brimdata/zed/lake/api"
 	"github.com/brimdata/zed/lake/index"
 	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/zson"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
 type branch struct {
-	compact CompactConfig
-	index   IndexConfig
-	lake    lakeapi.Interface
-	logger  *zap.Logger
-	pool    *pools.Config
-	name    string
-	tasks   []branchTask
+	compact   CompactConfig
+	index     IndexConfig
+	gc        GCConfig
+	retention RetentionConfig
+	lake      lakeapi.Interface
+	logger    *zap.Logger
+	pool      *pools.Config
+	name      string
+	tasks     []branchTask
 }
 
 func newBranch(c Config, pool *pools.Config, indexes []index.Rule, lake lakeapi.Interface, logger *zap.Logger) (*branch, error) {
-	branchName, compact, index, err := c.poolConfig(pool, indexes)
+	branchName, compact, index, gc, retention, err := c.poolConfig(pool, indexes)
 	if err != nil {
 		return nil, err
 	}
 	b := &branch{
-		compact: compact,
-		index:   index,
-		lake:    lake,
+		compact:   compact,
+		index:     index,
+		gc:        gc,
+		retention: retention,
+		lake:      lake,
 		logger: logger.Named("pool").With(
 			zap.String("name", pool.Name),
 			zap.Stringer("id", pool.ID),
@@ -41,12 +48,18 @@ func newBranch(c Config, pool *pools.Config, indexes []index.Rule, lake lakeapi.
 		pool: pool,
 		name: branchName,
 	}
+	if retention.Enabled() {
+		b.tasks = append(b.tasks, &retentionTask{b, b.logger.Named("retention")})
+	}
 	if !c.Compact.Disabled {
 		b.tasks = append(b.tasks, &compactTask{b, b.logger.Named("compact")})
 	}
 	if c.Index.Enabled() {
 		b.tasks = append(b.tasks, &indexTask{b, b.logger.Named("index")})
 	}
+	if gc.Enabled {
+		b.tasks = append(b.tasks, &gcTask{branch: b, log: b.logger.Named("gc")})
+	}
 	return b, nil
 }
 
@@ -61,6 +74,8 @@ func (b *branch) head(ctx context.Context) (ksuid.KSUID, error) {
 func (b *branch) MarshalLogObject(o zapcore.ObjectEncoder) error {
 	o.AddObject("compact", &b.compact)
 	o.AddObject("index", &b.index)
+	o.AddObject("retention", &b.retention)
+	o.AddObject("gc", &b.gc)
 	return nil
 }
 
@@ -144,3 +159,79 @@ func (b *indexTask) run(ctx context.Context, at ksuid.KSUID) (*time.Time, error)
 }
 
 func (c *indexTask) logger() *zap.Logger { return c.log }
+
+// RetentionAuthor is the author of the commits that enforce retention.
+const RetentionAuthor = "zed manage retention"
+
+type retentionTask struct {
+	*branch
+	log *zap.Logger
+}
+
+func (r *retentionTask) run(ctx context.Context, at ksuid.KSUID) (*time.Time, error) {
+	r.log.Debug("retention started")
+	head := lakeparse.Commitish{Pool: r.pool.Name, Branch: at.String()}
+	it, err := NewPoolDataObjectIterator(ctx, r.lake, &head, r.pool.Layout)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	ret, err := RetentionScan(it, r.pool.Layout, r.retention, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	message := api.CommitMessage{Author: RetentionAuthor}
+	if len(ret.Expired) > 0 {
+		var ids []ksuid.KSUID
+		for _, o := range ret.Expired {
+			ids = append(ids, o.ID)
+		}
+		commit, err := r.lake.Delete(ctx, r.pool.ID, r.name, ids, message)
+		if err != nil {
+			return nil, err
+		}
+		r.log.Debug("deleted", zap.Stringer("commit", commit), zap.Int("objects_deleted", len(ids)))
+	}
+	if len(ret.Straddling) > 0 {
+		src := fmt.Sprintf("%s < %s", r.pool.Layout.Primary(), zson.String(zed.NewTime(*ret.Cutoff)))
+		commit, err := r.lake.DeleteWhere(ctx, r.pool.ID, r.name, src, message)
+		if err != nil {
+			return nil, err
+		}
+		r.log.Debug("rewritten", zap.Stringer("commit", commit), zap.Int("objects_rewritten", len(ret.Straddling)))
+	}
+	level := zap.InfoLevel
+	if len(ret.Expired) == 0 && len(ret.Straddling) == 0 {
+		level = zap.DebugLevel
+	}
+	r.log.Log(level, "retention completed", zap.Int("objects_deleted", len(ret.Expired)), zap.Int("objects_rewritten", len(ret.Straddling)))
+	return ret.Next, nil
+}
+
+func (r *retentionTask) logger() *zap.Logger { return r.log }
+
+type gcTask struct {
+	*branch
+	log  *zap.Logger
+	last time.Time
+}
+
+func (g *gcTask) run(ctx context.Context, _ ksuid.KSUID) (*time.Time, error) {
+	if next := g.last.Add(g.gc.interval()); time.Now().Before(next) {
+		return &next, nil
+	}
+	g.log.Debug("gc started")
+	res, err := g.lake.GC(ctx, g.pool.ID, g.gc.grace(), false)
+	if err != nil {
+		return nil, err
+	}
+	g.last = time.Now()
+	level := zap.InfoLevel
+	if res.Objects == 0 {
+		level = zap.DebugLevel
+	}
+	g.log.Log(level, "gc completed", zap.Int("objects_removed", res.Objects), zap.Int64("bytes_removed", res.Bytes))
+	return nil, nil
+}
+
+func (g *gcTask) logger() *zap.Logger { return g.log }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/lake/api"
 	"github.com/brimdata/zed/lake/data"
 	"github.com/brimdata/zed/lake/pools"
@@ -16,7 +17,8 @@ import (
 )
 
 // CompactionScan recieves a sorted stream of objects and sends to ch a series
-// of Runs that are good candidates for compaction. If there are hot objects
+// of Runs that are good candidates for compaction. Objects from different
+// partitions are never in the same Run. If there are hot objects
 // in the pool, CompactionScan returns the timestamp when the next object turns cool,
 // otherwise nil.
 func CompactionScan(ctx context.Context, it DataObjectIterator, pool *pools.Config,
@@ -35,8 +37,9 @@ func CompactionScan(ctx context.Context, it DataObjectIterator, pool *pools.Conf
 		return nil
 	}
 	var nextcold *time.Time
-	cmp := expr.NewValueCompareFn(order.Asc, true)
-	run := NewRun(cmp)
+	cmp := expr.NewKeyCompareFn(order.NewLayout(order.Asc, pool.Layout.Keys), true)
+	runs := make(map[partitionKey]*Run)
+	var partitions []partitionKey
 	for {
 		object, err := it.Next()
 		if object == nil {
@@ -62,16 +65,35 @@ func CompactionScan(ctx context.Context, it DataObjectIterator, pool *pools.Conf
 		// 2. Consolidating patches of small objects into larger single blocks.
 		// add object to current run if it overlaps *or* object size is less than
 		// a quarter of thresh.
+		key := partitionKey{object.Partition.Type, string(object.Partition.Bytes)}
+		run, ok := runs[key]
+		if !ok {
+			r := NewRun(cmp)
+			run = &r
+			runs[key] = run
+			partitions = append(partitions, key)
+		}
 		if cold && (object.Size <= pool.Threshold/4 || run.Overlaps(&object.First, &object.Last)) {
 			run.Add(object)
 			continue
 		}
-		if err := send(run, object.Span(order.Asc)); err != nil {
+		if err := send(*run, extent.NewGeneric(object.First, object.Last, cmp)); err != nil {
+			return nil, err
+		}
+		*run = NewRun(cmp)
+	}
+	for _, key := range partitions {
+		if err := send(*runs[key], nil); err != nil {
 			return nil, err
 		}
-		run = NewRun(cmp)
 	}
-	return nextcold, send(run, nil)
+	return nextcold, nil
+}
+
+// partitionKey identifies the partition value of an object.
+type partitionKey struct {
+	typ   zed.Type
+	bytes string
 }
 
 type PoolDataObjectIterator struct {
@@ -5,9 +5,11 @@ import (
 	"sort"
 	"time"
 
+	"github.com/brimdata/zed/lake"
 	"github.com/brimdata/zed/lake/index"
 	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/units"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/exp/slices"
 )
@@ -15,18 +17,23 @@ import (
 const (
 	defaultCompactColdThresh = 5 * time.Minute
 	defaultIndexColdThresh   = 10 * time.Minute
+	defaultGCInterval        = time.Hour
 )
 
 type Config struct {
-	Compact CompactConfig `yaml:"compact"`
-	Index   IndexConfig   `yaml:"index"`
-	Pools   []PoolConfig  `yaml:"pools"`
+	Compact   CompactConfig   `yaml:"compact"`
+	Index     IndexConfig     `yaml:"index"`
+	GC        GCConfig        `yaml:"gc"`
+	Retention RetentionConfig `yaml:"retention"`
+	Pools     []PoolConfig    `yaml:"pools"`
 }
 
-func (c *Config) poolConfig(p *pools.Config, indexes []index.Rule) (string, CompactConfig, IndexConfig, error) {
+func (c *Config) poolConfig(p *pools.Config, indexes []index.Rule) (string, CompactConfig, IndexConfig, GCConfig, RetentionConfig, error) {
 	var branch string
 	compact := c.Compact
 	index := c.Index.Clone()
+	gc := c.GC
+	retention := c.Retention
 	for _, pc := range c.Pools {
 		if p.Name != pc.Pool && p.ID.String() != pc.Pool {
 			continue
@@ -47,13 +54,25 @@ func (c *Config) poolConfig(p *pools.Config, indexes []index.Rule) (string, Comp
 				index.ColdThreshold = c.Index.ColdThreshold
 			}
 		}
+		if pc.GC != nil {
+			gc = *pc.GC
+			if gc.Grace == nil {
+				gc.Grace = c.GC.Grace
+			}
+			if gc.Interval == nil {
+				gc.Interval = c.GC.Interval
+			}
+		}
+		if pc.Retention != nil {
+			retention = *pc.Retention
+		}
 		break
 	}
 	if branch == "" {
 		branch = "main"
 	}
 	err := index.fillRules(indexes)
-	return branch, compact, index, err
+	return branch, compact, index, gc, retention, err
 }
 
 type PoolConfig struct {
@@ -65,6 +84,12 @@ type PoolConfig struct {
 	// Index specifies the indexing options for this pool. If nil the Index
 	// options from the global settings will be used.
 	Index *PoolIndexConfig `yaml:"index"`
+	// GC specifies the garbage collection options for this pool. If nil the
+	// GC options from the global settings will be used.
+	GC *GCConfig `yaml:"gc"`
+	// Retention specifies the retention limits for this pool. If nil the
+	// Retention options from the global settings will be used.
+	Retention *RetentionConfig `yaml:"retention"`
 
 	pool pools.Config
 }
@@ -87,6 +112,72 @@ func (c *CompactConfig) MarshalLogObject(o zapcore.ObjectEncoder) error {
 	return nil
 }
 
+// GCConfig specifies the garbage collection of a pool's unreferenced
+// objects, which is disabled unless Enabled is true.
+type GCConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Grace is the age an unreferenced object must reach before it is removed.
+	Grace *time.Duration `yaml:"grace"`
+	// Interval is the minimum time between collections of a pool.
+	Interval *time.Duration `yaml:"interval"`
+}
+
+func (c *GCConfig) grace() time.Duration {
+	if c.Grace == nil {
+		return lake.DefaultGCGrace
+	}
+	return *c.Grace
+}
+
+func (c *GCConfig) interval() time.Duration {
+	if c.Interval == nil {
+		return defaultGCInterval
+	}
+	return *c.Interval
+}
+
+func (c *GCConfig) MarshalLogObject(o zapcore.ObjectEncoder) error {
+	o.AddBool("enabled", c.Enabled)
+	o.AddDuration("grace", c.grace())
+	o.AddDuration("interval", c.interval())
+	return nil
+}
+
+// RetentionConfig specifies limits on the data kept in a pool, which are
+// enforced by deleting data objects in the order of their primary pool keys.
+// The primary pool key must be a time.
+type RetentionConfig struct {
+	// MaxAge is the maximum age of a value as given by its primary pool key.
+	MaxAge *time.Duration `yaml:"max_age"`
+	// MaxSize is the maximum total size of a pool's data objects.
+	MaxSize *units.Bytes `yaml:"max_size"`
+}
+
+func (c *RetentionConfig) Enabled() bool {
+	return c.MaxAge != nil || c.MaxSize != nil
+}
+
+func (c *RetentionConfig) maxAge() time.Duration {
+	if c.MaxAge == nil {
+		return 0
+	}
+	return *c.MaxAge
+}
+
+func (c *RetentionConfig) maxSize() int64 {
+	if c.MaxSize == nil {
+		return 0
+	}
+	return int64(*c.MaxSize)
+}
+
+func (c *RetentionConfig) MarshalLogObject(o zapcore.ObjectEncoder) error {
+	o.AddBool("enabled", c.Enabled())
+	o.AddDuration("max_age", c.maxAge())
+	o.AddInt64("max_size", c.maxSize())
+	return nil
+}
+
 type PoolIndexConfig struct {
 	IndexConfig  `yaml:",inline"`
 	InheritRules bool `yaml:"inherit_rules"`
@@ -34,11 +34,13 @@ func Update(ctx context.Context, lk lakeapi.Interface, conf Config, logger *zap.
 		branch := branch
 		branch.logger.Info("updating pool", zap.Object("config", branch))
 		group.Go(func() error {
-			head, err := branch.head(ctx)
-			if err != nil {
-				return err
-			}
 			for _, task := range branch.tasks {
+				// Fetch the head for each task since the previous task
+				// may have committed to the branch.
+				head, err := branch.head(ctx)
+				if err != nil {
+					return err
+				}
 				if _, err := task.run(ctx, head); err != nil {
 					task.logger().Error("task error", zap.Error(err))
 					return err
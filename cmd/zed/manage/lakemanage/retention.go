@@ -0,0 +1,134 @@
+package lakemanage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/expr/extent"
+	"github.com/brimdata/zed/zson"
+)
+
+// Retention describes the changes needed to bring a pool within the limits
+// of a RetentionConfig.
+type Retention struct {
+	// Cutoff is the time before which values are expired, or nil if the
+	// RetentionConfig has no MaxAge.
+	Cutoff *nano.Ts
+	// Expired are the data objects to delete in their entirety.
+	Expired []*data.Object
+	// Straddling are the data objects holding values on both sides of
+	// Cutoff.  These must be rewritten to delete the expired values.
+	Straddling []*data.Object
+	// Next is the time when the oldest value retained expires, or nil if
+	// there is no such value.
+	Next *time.Time
+}
+
+// RetentionScan receives a stream of objects of a pool with layout sorted by
+// the minimum values of their pool keys and returns the Retention needed to
+// bring the objects within the limits of conf as of now.  Objects past
+// conf.MaxSize are expired oldest first and are never rewritten.  Objects
+// are ordered by age only if the primary pool key is a time, so that is
+// required.
+func RetentionScan(it DataObjectIterator, layout order.Layout, conf RetentionConfig, now time.Time) (*Retention, error) {
+	var r Retention
+	if conf.MaxAge != nil {
+		cutoff := nano.TimeToTs(now.Add(-*conf.MaxAge))
+		r.Cutoff = &cutoff
+	}
+	asc := layout
+	asc.Order = order.Asc
+	cmp := expr.NewKeyCompareFn(asc, true)
+	var kept []*data.Object
+	var oldests []nano.Ts
+	var size int64
+	for {
+		o, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if o == nil {
+			break
+		}
+		span := extent.NewGeneric(o.First, o.Last, cmp)
+		min, err := keyTime(span.First(), layout)
+		if err != nil {
+			return nil, err
+		}
+		max, err := keyTime(span.Last(), layout)
+		if err != nil {
+			return nil, err
+		}
+		oldest := nano.MaxTs
+		if r.Cutoff != nil {
+			if min != nil && *min < *r.Cutoff {
+				if max != nil && *max < *r.Cutoff {
+					r.Expired = append(r.Expired, o)
+					continue
+				}
+				// After the rewrite, the oldest value retained is at
+				// or after the cutoff.
+				r.Straddling = append(r.Straddling, o)
+				min = r.Cutoff
+			}
+			if min != nil {
+				oldest = *min
+			}
+		}
+		kept = append(kept, o)
+		oldests = append(oldests, oldest)
+		size += o.Size
+	}
+	if conf.MaxSize != nil {
+		for len(kept) > 0 && size > int64(*conf.MaxSize) {
+			o := kept[0]
+			kept, oldests = kept[1:], oldests[1:]
+			size -= o.Size
+			r.Expired = append(r.Expired, o)
+			r.Straddling = removeObject(r.Straddling, o)
+		}
+	}
+	if conf.MaxAge != nil {
+		for _, ts := range oldests {
+			if ts == nano.MaxTs {
+				continue
+			}
+			next := ts.Time().Add(*conf.MaxAge)
+			if r.Next == nil || next.Before(*r.Next) {
+				r.Next = &next
+			}
+		}
+	}
+	return &r, nil
+}
+
+// keyTime returns the time in the primary key of pool key val, which is a
+// record of the keys if layout has more than one, or nil if it is null.
+func keyTime(val *zed.Value, layout order.Layout) (*nano.Ts, error) {
+	if len(layout.Keys) > 1 && zed.TypeRecordOf(val.Type) != nil {
+		val = val.DerefPath(field.Path{layout.Primary().String()}).MissingAsNull()
+	}
+	if val.IsNull() {
+		return nil, nil
+	}
+	if zed.TypeUnder(val.Type) != zed.TypeTime {
+		return nil, fmt.Errorf("retention requires a primary pool key of type time: encountered %s", zson.String(val))
+	}
+	ts := zed.DecodeTime(val.Bytes)
+	return &ts, nil
+}
+
+func removeObject(objects []*data.Object, o *data.Object) []*data.Object {
+	for k := range objects {
+		if objects[k].ID == o.ID {
+			return append(objects[:k], objects[k+1:]...)
+		}
+	}
+	return objects
+}
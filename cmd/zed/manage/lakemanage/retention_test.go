@@ -0,0 +1,129 @@
+package lakemanage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/cmd/zed/manage/lakemanage"
+	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/pkg/units"
+	"github.com/brimdata/zed/zson"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionScan(t *testing.T) {
+	now := time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)
+	day := func(d int) nano.Ts {
+		return nano.TimeToTs(time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	objs := []testTimeObj{
+		{first: day(1), last: day(2), size: MB},
+		{first: day(3), last: day(8), size: MB},
+		{first: day(8), last: day(9), size: MB},
+	}
+	t.Run("max-age", func(t *testing.T) {
+		maxAge := 5 * 24 * time.Hour
+		r := testRetentionScan(t, objs, lakemanage.RetentionConfig{MaxAge: &maxAge}, now)
+		assert.Equal(t, day(5), *r.Cutoff)
+		assert.Equal(t, []int64{1}, objectDays(r.Expired))
+		assert.Equal(t, []int64{3}, objectDays(r.Straddling))
+		assert.Equal(t, now, *r.Next)
+	})
+	t.Run("max-size", func(t *testing.T) {
+		maxSize := units.Bytes(2 * MB)
+		r := testRetentionScan(t, objs, lakemanage.RetentionConfig{MaxSize: &maxSize}, now)
+		assert.Nil(t, r.Cutoff)
+		assert.Equal(t, []int64{1}, objectDays(r.Expired))
+		assert.Len(t, r.Straddling, 0)
+		assert.Nil(t, r.Next)
+	})
+	t.Run("max-size-expires-straddling", func(t *testing.T) {
+		maxAge := 5 * 24 * time.Hour
+		maxSize := units.Bytes(MB)
+		r := testRetentionScan(t, objs, lakemanage.RetentionConfig{MaxAge: &maxAge, MaxSize: &maxSize}, now)
+		assert.Equal(t, []int64{1, 3}, objectDays(r.Expired))
+		assert.Len(t, r.Straddling, 0)
+		assert.Equal(t, day(8).Time().Add(maxAge), *r.Next)
+	})
+	t.Run("key-not-time", func(t *testing.T) {
+		maxAge := time.Hour
+		reader := newTestObjectReader([]testObj{{first: 0, last: 1, size: MB}}, nil, time.Minute)
+		_, err := lakemanage.RetentionScan(reader, tsLayout, lakemanage.RetentionConfig{MaxAge: &maxAge}, now)
+		assert.EqualError(t, err, "retention requires a primary pool key of type time: encountered 0")
+	})
+}
+
+func TestRetentionScanMultiKey(t *testing.T) {
+	now := time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)
+	newObject := func(first, last string) *data.Object {
+		zctx := zed.NewContext()
+		return &data.Object{
+			ID: ksuid.New(),
+			Meta: data.Meta{
+				First: *zson.MustParseValue(zctx, first),
+				Last:  *zson.MustParseValue(zctx, last),
+				Count: 2,
+				Size:  MB,
+			},
+		}
+	}
+	t.Run("time-primary", func(t *testing.T) {
+		expired := newObject(`{ts:2022-01-01T00:00:00Z,tenant:"b"}`, `{ts:2022-01-02T00:00:00Z,tenant:"a"}`)
+		straddling := newObject(`{ts:2022-01-03T00:00:00Z,tenant:"b"}`, `{ts:2022-01-08T00:00:00Z,tenant:"a"}`)
+		reader := testObjectReader{expired, straddling}
+		maxAge := 5 * 24 * time.Hour
+		layout := order.NewLayout(order.Asc, field.DottedList("ts,tenant"))
+		r, err := lakemanage.RetentionScan(&reader, layout, lakemanage.RetentionConfig{MaxAge: &maxAge}, now)
+		require.NoError(t, err)
+		assert.Equal(t, []*data.Object{expired}, r.Expired)
+		assert.Equal(t, []*data.Object{straddling}, r.Straddling)
+	})
+	t.Run("time-secondary", func(t *testing.T) {
+		reader := testObjectReader{newObject(`{tenant:"a",ts:2022-01-01T00:00:00Z}`, `{tenant:"b",ts:2022-01-02T00:00:00Z}`)}
+		maxSize := units.Bytes(MB)
+		layout := order.NewLayout(order.Asc, field.DottedList("tenant,ts"))
+		_, err := lakemanage.RetentionScan(&reader, layout, lakemanage.RetentionConfig{MaxSize: &maxSize}, now)
+		assert.EqualError(t, err, `retention requires a primary pool key of type time: encountered "a"`)
+	})
+}
+
+var tsLayout = order.NewLayout(order.Asc, field.DottedList("ts"))
+
+type testTimeObj struct {
+	first, last nano.Ts
+	size        int64
+}
+
+func testRetentionScan(t *testing.T, objs []testTimeObj, conf lakemanage.RetentionConfig, now time.Time) *lakemanage.Retention {
+	var objects []*data.Object
+	for _, o := range objs {
+		objects = append(objects, &data.Object{
+			ID: ksuid.New(),
+			Meta: data.Meta{
+				First: *zed.NewTime(o.first),
+				Last:  *zed.NewTime(o.last),
+				Count: 2,
+				Size:  o.size,
+			},
+		})
+	}
+	reader := testObjectReader(objects)
+	r, err := lakemanage.RetentionScan(&reader, tsLayout, conf, now)
+	require.NoError(t, err)
+	return r
+}
+
+// objectDays returns the day of the month of the first key of each object.
+func objectDays(objects []*data.Object) []int64 {
+	var days []int64
+	for _, o := range objects {
+		days = append(days, int64(zed.DecodeTime(o.First.Bytes).Time().Day()))
+	}
+	return days
+}
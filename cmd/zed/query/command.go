@@ -1,6 +1,7 @@
 package query
 
 import (
+	"errors"
 	"flag"
 
 	"github.com/brimdata/zed/cli/outputflags"
@@ -9,6 +10,7 @@ import (
 	"github.com/brimdata/zed/cmd/zed/root"
 	"github.com/brimdata/zed/pkg/charm"
 	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/runtime/op"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
 )
@@ -55,23 +57,80 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
-	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	head, _ := c.LakeFlags.HEAD()
+	if c.queryFlags.Explain {
+		query, err := lake.QueryWithControl(op.WithExplain(ctx), head, src, c.queryFlags.Includes...)
+		if err != nil {
+			return err
+		}
+		defer query.Close()
+		if err := zio.Copy(zio.Discard, zbuf.NoControl(query)); err != nil {
+			return err
+		}
+		if e, ok := query.(zbuf.Explainer); ok {
+			c.queryFlags.PrintExplain(e)
+		}
+		c.queryFlags.PrintStats(query.Progress())
+		return nil
+	}
+	local := storage.NewLocalEngine()
+	named, err := c.outputFlags.OpenNamed(ctx, local)
 	if err != nil {
 		return err
 	}
-	head, _ := c.LakeFlags.HEAD()
+	w, err := c.outputFlags.Open(ctx, local)
+	if err != nil {
+		outputflags.CloseNamed(named)
+		return err
+	}
 	query, err := lake.QueryWithControl(ctx, head, src, c.queryFlags.Includes...)
 	if err != nil {
 		w.Close()
+		outputflags.CloseNamed(named)
 		return err
 	}
 	defer query.Close()
-	err = zio.Copy(w, zbuf.NoControl(query))
+	if len(named) == 0 {
+		err = zio.Copy(w, zbuf.NoControl(query))
+	} else {
+		err = copyChannels(w, named, query)
+	}
 	if closeErr := w.Close(); err == nil {
 		err = closeErr
 	}
+	if closeErr := outputflags.CloseNamed(named); err == nil {
+		err = closeErr
+	}
 	if err == nil {
 		c.queryFlags.PrintStats(query.Progress())
 	}
 	return err
 }
+
+// copyChannels copies the values read from r to w except that the values of
+// each named output channel in named are written to the corresponding writer.
+func copyChannels(w zio.Writer, named map[string]zio.WriteCloser, r zio.Reader) error {
+	out := w
+	for {
+		val, err := r.Read()
+		if err != nil {
+			var ctrl *zbuf.Control
+			if !errors.As(err, &ctrl) {
+				return err
+			}
+			if set, ok := ctrl.Message.(zbuf.SetChannel); ok {
+				out = w
+				if nw, ok := named[set.Name]; ok {
+					out = nw
+				}
+			}
+			continue
+		}
+		if val == nil {
+			return nil
+		}
+		if err := out.Write(val); err != nil {
+			return err
+		}
+	}
+}
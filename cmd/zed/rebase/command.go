@@ -0,0 +1,79 @@
+package rebase
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/zed/cli/lakeflags"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "rebase",
+	Usage: "rebase onto",
+	Short: "replay the commits on the current branch on top of another",
+	Long: `
+The rebase command replays the commits made on the current branch since its
+most recent common ancestor with "onto" as new commits on top of "onto", then
+moves the current branch to the last of these commits.  "onto" is a branch
+name, tag name, or commit ID in the current pool.
+
+Each new commit keeps the author of the commit it replays and its message
+notes the ID and message of that commit.  Commits whose changes are already
+present in "onto", e.g., because the current branch was merged into it,
+are dropped, so rebasing a branch onto the branch it was merged into
+brings it up to date with that branch.
+
+If a commit conflicts with the changes in "onto", the conflict is reported as
+an error and the current branch is left unchanged.
+`,
+	New: New,
+}
+
+type Command struct {
+	*root.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*root.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("rebase target must be specified")
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.LakeFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	if head.Pool == "" {
+		return lakeflags.ErrNoHEAD
+	}
+	poolID, err := lake.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	if _, err := lakeparse.ParseID(head.Branch); err == nil {
+		return errors.New("branch must be named")
+	}
+	tip, err := lake.Rebase(ctx, poolID, head.Branch, args[0])
+	if err != nil {
+		return err
+	}
+	if !c.LakeFlags.Quiet {
+		fmt.Printf("%q: rebased onto %q at %s\n", head.Branch, args[0], tip)
+	}
+	return nil
+}
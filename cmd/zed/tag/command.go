@@ -0,0 +1,71 @@
+package tag
+
+import (
+	"context"
+	"flag"
+
+	"github.com/brimdata/zed/cli/lakeflags"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/lake/api"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/charm"
+	"github.com/segmentio/ksuid"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "tag",
+	Usage: "tag [subcommand]",
+	Short: "create, list, and delete tags",
+	Long: `
+The tag subcommands manage the tags of the pool in HEAD.  A tag is a
+name for a commit object.  Unlike a branch, a tag never moves: once
+created, it refers to the same commit until it is deleted.  A tag may
+be used anywhere a commitish is accepted, e.g., "from logs@q3-report".
+
+Tag and branch names share a namespace within a pool so a tag may not
+have the same name as a branch.
+
+As long as a tag exists, the data and index objects reachable from its
+commit remain in the lake.
+`,
+	New: New,
+}
+
+func init() {
+	Cmd.Add(create)
+	Cmd.Add(del)
+	Cmd.Add(ls)
+}
+
+type Command struct {
+	*root.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*root.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) == 0 {
+		return charm.NeedHelp
+	}
+	return charm.ErrNoRun
+}
+
+func (c *Command) headPool(ctx context.Context, lake api.Interface) (*lakeparse.Commitish, ksuid.KSUID, error) {
+	head, err := c.LakeFlags.HEAD()
+	if err != nil {
+		return nil, ksuid.Nil, err
+	}
+	if head.Pool == "" {
+		return nil, ksuid.Nil, lakeflags.ErrNoHEAD
+	}
+	poolID, err := lakeparse.ParseID(head.Pool)
+	if err != nil {
+		poolID, err = lake.PoolID(ctx, head.Pool)
+		if err != nil {
+			return nil, ksuid.Nil, err
+		}
+	}
+	return head, poolID, nil
+}
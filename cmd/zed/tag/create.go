@@ -0,0 +1,68 @@
+package tag
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var create = &charm.Spec{
+	Name:  "create",
+	Usage: "create name [commitish]",
+	Short: "create a tag",
+	Long: `
+The create command creates a tag with the indicated name in the pool in HEAD.
+If specified, commitish is a branch name, tag name, or commit ID in that pool
+and identifies the commit to tag.  If not specified, the commit at HEAD is
+tagged.
+`,
+	New: newCreate,
+}
+
+type createCommand struct {
+	*Command
+}
+
+func newCreate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &createCommand{Command: parent.(*Command)}, nil
+}
+
+func (c *createCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New("a tag name and an optional commitish must be specified")
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, poolID, err := c.headPool(ctx, lake)
+	if err != nil {
+		return err
+	}
+	ref := head.Branch
+	if len(args) == 2 {
+		ref = args[1]
+	}
+	commit, err := lakeparse.ParseID(ref)
+	if err != nil {
+		commit, err = lake.CommitObject(ctx, poolID, ref)
+		if err != nil {
+			return err
+		}
+	}
+	if err := lake.CreateTag(ctx, poolID, args[0], commit); err != nil {
+		return err
+	}
+	if !c.LakeFlags.Quiet {
+		fmt.Printf("%q: tag created at commit %s\n", args[0], commit)
+	}
+	return nil
+}
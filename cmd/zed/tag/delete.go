@@ -0,0 +1,56 @@
+package tag
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var del = &charm.Spec{
+	Name:  "delete",
+	Usage: "delete name...",
+	Short: "delete tags",
+	Long: `
+The delete command deletes the indicated tags from the pool in HEAD.
+The commits they referred to are not affected.
+`,
+	New: newDelete,
+}
+
+type deleteCommand struct {
+	*Command
+}
+
+func newDelete(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &deleteCommand{Command: parent.(*Command)}, nil
+}
+
+func (c *deleteCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 {
+		return errors.New("one or more tag names must be specified")
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	_, poolID, err := c.headPool(ctx, lake)
+	if err != nil {
+		return err
+	}
+	for _, name := range args {
+		if err := lake.RemoveTag(ctx, poolID, name); err != nil {
+			return err
+		}
+		if !c.LakeFlags.Quiet {
+			fmt.Printf("tag deleted: %s\n", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package tag
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/zed/cli/outputflags"
+	"github.com/brimdata/zed/pkg/charm"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zio"
+)
+
+var ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "ls [options]",
+	Short: "list the tags of a pool",
+	Long: `
+The ls command lists the tags of the pool in HEAD.
+`,
+	New: newLs,
+}
+
+type lsCommand struct {
+	*Command
+	outputFlags outputflags.Flags
+}
+
+func newLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &lsCommand{Command: parent.(*Command)}
+	c.outputFlags.DefaultFormat = "lake"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *lsCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, _, err := c.headPool(ctx, lake)
+	if err != nil {
+		return err
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	q, err := lake.Query(ctx, nil, fmt.Sprintf("from '%s':tags | sort tag.name", head.Pool))
+	if err != nil {
+		w.Close()
+		return err
+	}
+	defer q.Close()
+	err = zio.Copy(w, q)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
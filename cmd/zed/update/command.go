@@ -0,0 +1,90 @@
+package update
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/zed/cli/commitflags"
+	"github.com/brimdata/zed/cli/lakeflags"
+	"github.com/brimdata/zed/cmd/zed/root"
+	"github.com/brimdata/zed/pkg/charm"
+)
+
+var Cmd = &charm.Spec{
+	Name:  "update",
+	Usage: "update -where filter pipeline",
+	Short: "rewrite values in a pool branch that match a filter",
+	Long: `
+The update command rewrites the values of a pool branch for which the
+filter expression given by -where is true by passing them through the
+Zed pipeline given as its argument, e.g.:
+
+zed update -where 'src_ip==10.0.0.1' 'put src_ip:=10.0.0.2'
+
+The pipeline must turn each value into exactly one value, so it may contain
+only cut, drop, put, rename, and single-expression yield operators (e.g.,
+"yield shape(this, <type>)") whose expressions do not aggregate or use over.
+
+Values for which the filter is false are left intact.  Each data object
+holding a matching value is deleted and replaced by new objects holding
+its rewritten values along with its untouched values, all in a single
+commit.  As with "zed delete -where", the filter uses the pool key and
+any indexes to skip objects that cannot hold matching values.
+
+Any update can be "undone" by reverting its commit with "zed revert".
+`,
+	New: New,
+}
+
+type Command struct {
+	*root.Command
+	commitFlags commitflags.Flags
+	where       string
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	c.commitFlags.SetFlags(f)
+	f.StringVar(&c.where, "where", "", "update values matching filter")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if c.where == "" {
+		return errors.New("a filter must be specified with -where")
+	}
+	if len(args) == 0 {
+		return errors.New("no update pipeline specified")
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.LakeFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	poolName := head.Pool
+	if poolName == "" {
+		return lakeflags.ErrNoHEAD
+	}
+	poolID, err := lake.PoolID(ctx, poolName)
+	if err != nil {
+		return err
+	}
+	commit, err := lake.Update(ctx, poolID, head.Branch, c.where, strings.Join(args, " "), c.commitFlags.CommitMessage())
+	if err != nil {
+		return err
+	}
+	if !c.LakeFlags.Quiet {
+		fmt.Printf("%s update committed\n", commit)
+	}
+	return nil
+}
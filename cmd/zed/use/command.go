@@ -40,6 +40,12 @@ The working pool must already be set.
 The pool must be the name or ID of an existing pool.  The branch must be
 the name of an existing branch or a commit ID.
 
+The branch may also be a time, either a timestamp like 2022-06-01T09:00:00Z or
+a negative duration relative to the current time like -24h, optionally
+following a branch name as in "pool@branch@-24h".  In this case, use sets the
+working branch to the ID of the last commit on the branch (or on "main" if
+no branch is given) at or before that time.
+
 Any command that relies upon HEAD can also be run with the -use option
 to refer to a different HEAD without executing an explicit "use" command.
 While the use of HEAD is convenient for interactive CLI sessions,
@@ -107,9 +113,15 @@ func (c *Command) Run(args []string) error {
 			return err
 		}
 	}
-	if _, err = lake.CommitObject(ctx, poolID, commitish.Branch); err != nil {
+	commitID, err := lake.CommitObject(ctx, poolID, commitish.Branch)
+	if err != nil {
 		return err
 	}
+	if _, _, ok := lakeparse.SplitTime(commitish.Branch); ok {
+		// Pin HEAD to the commit at the time given so that a relative
+		// time like "-24h" does not move as time passes.
+		commitish.Branch = commitID.String()
+	}
 	if err := lakeflags.WriteHead(commitish.Pool, commitish.Branch); err != nil {
 		return err
 	}
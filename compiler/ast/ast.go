@@ -197,6 +197,7 @@ func (*MapExpr) ExprAST()    {}
 func (*OverExpr) ExprAST() {}
 
 func (*SQLExpr) ExprAST() {}
+func (*SQLOver) ExprAST() {}
 
 type ConstDecl struct {
 	Kind string `json:"kind" unpack:""`
@@ -263,10 +264,12 @@ type (
 	Head struct {
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
+		Keys  []Expr `json:"keys"`
 	}
 	Tail struct {
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
+		Keys  []Expr `json:"keys"`
 	}
 	Pass struct {
 		Kind string `json:"kind" unpack:""`
@@ -286,6 +289,7 @@ type (
 		Limit int    `json:"limit"`
 		Args  []Expr `json:"args"`
 		Flush bool   `json:"flush"`
+		Keys  []Expr `json:"keys"`
 	}
 	Put struct {
 		Kind string       `json:"kind" unpack:""`
@@ -317,6 +321,47 @@ type (
 		Kind  string `json:"kind" unpack:""`
 		Exprs []Expr `json:"exprs"`
 	}
+	// A Pivot operator groups its input by Keys and, for each group,
+	// computes Agg separately for each distinct value of Column, emitting
+	// one record per group with a field for each column value.
+	Pivot struct {
+		Kind   string       `json:"kind" unpack:""`
+		Agg    *Agg         `json:"agg"`
+		Column Expr         `json:"column"`
+		Keys   []Assignment `json:"keys"`
+	}
+	// An Unpivot operator is the inverse of Pivot: it turns each of the
+	// fields in Args into a separate record holding the remaining fields
+	// of the input along with the field's name and value.
+	Unpivot struct {
+		Kind  string `json:"kind" unpack:""`
+		Args  []Expr `json:"args"`
+		Name  Expr   `json:"name"`
+		Value Expr   `json:"value"`
+	}
+	// A Sample operator emits a uniform random sample of Limit values
+	// from each group of its input as determined by Keys.  Seed, when
+	// non-nil, makes the sample reproducible.
+	Sample struct {
+		Kind  string `json:"kind" unpack:""`
+		Limit int    `json:"limit"`
+		Seed  *int64 `json:"seed"`
+		Keys  []Expr `json:"keys"`
+	}
+	// An Output operator names the output of the branch it terminates.
+	Output struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+	}
+	// A Try operator applies the per-value operators in Body to each
+	// of its input values.  Values whose transformation produced an
+	// error are instead sent, along with the error, to Catch, whose output
+	// is a separate output of the query.
+	Try struct {
+		Kind  string      `json:"kind" unpack:""`
+		Body  *Sequential `json:"body"`
+		Catch *Sequential `json:"catch"`
+	}
 	// An OpAssignment is a list of assignments whose parent operator
 	// is unknown: It could be a Summarize or Put operator. This will be
 	// determined in the semantic phase.
@@ -350,16 +395,20 @@ type (
 	// keyword collides with the select() generator function (it can be parsed
 	// unambiguosly because of the parens but this is not user friendly
 	// so we need a new name for select()... see issue #2133).
-	// TBD: from alias, "in" over tuples, WITH sub-queries, multi-table FROM
-	// implying a JOIN, aliases for tables in FROM and JOIN.
+	// The queries in Union are combined with this one by UNION ALL, and
+	// OrderBy and Limit then apply to the combined result.
+	// TBD: "in" over tuples, multi-table FROM implying a JOIN.
 	SQLExpr struct {
 		Kind    string       `json:"kind" unpack:""`
+		With    []SQLCTE     `json:"with"`
 		Select  []Assignment `json:"select"`
 		From    *SQLFrom     `json:"from"`
 		Joins   []SQLJoin    `json:"joins"`
 		Where   Expr         `json:"where"`
 		GroupBy []Expr       `json:"group_by"`
 		Having  Expr         `json:"having"`
+		Windows []SQLWindow  `json:"windows"`
+		Union   []*SQLExpr   `json:"union"`
 		OrderBy *SQLOrderBy  `json:"order_by"`
 		Limit   int          `json:"limit"`
 	}
@@ -372,6 +421,62 @@ type (
 	}
 )
 
+// Statements are the commands that manage the pools of a lake, e.g.,
+// "CREATE POOL logs ORDER BY ts DESC".  A statement is a program on its own
+// and is run against a lake rather than compiled into a flowgraph.
+
+type Statement interface {
+	Op
+	StatementAST()
+}
+
+type (
+	CreatePool struct {
+		Kind   string  `json:"kind" unpack:""`
+		Name   string  `json:"name"`
+		Layout *Layout `json:"layout"`
+	}
+	DropPool struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+	}
+	// CreateBranch creates the branch Name of Pool at the head of the
+	// branch or commit From.
+	CreateBranch struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+		Pool string `json:"pool"`
+		From string `json:"from"`
+	}
+	// InsertInto loads the results of Query into Branch of Pool.
+	InsertInto struct {
+		Kind   string      `json:"kind" unpack:""`
+		Pool   string      `json:"pool"`
+		Branch string      `json:"branch"`
+		Query  *Sequential `json:"query"`
+	}
+	// DeleteFrom deletes the values of Branch of Pool for which Where is
+	// true.
+	DeleteFrom struct {
+		Kind   string `json:"kind" unpack:""`
+		Pool   string `json:"pool"`
+		Branch string `json:"branch"`
+		Where  Expr   `json:"where"`
+	}
+)
+
+func (*CreatePool) OpAST()   {}
+func (*DropPool) OpAST()     {}
+func (*CreateBranch) OpAST() {}
+func (*InsertInto) OpAST()   {}
+func (*DeleteFrom) OpAST()   {}
+
+func (*CreatePool) StatementAST()   {}
+func (*DropPool) StatementAST()     {}
+func (*CreateBranch) StatementAST() {}
+func (*InsertInto) StatementAST()   {}
+func (*DeleteFrom) StatementAST()   {}
+
 // Source structure
 
 type (
@@ -446,6 +551,47 @@ type SQLFrom struct {
 	Alias Expr `json:"alias"`
 }
 
+// A SQLOver is a window function call, e.g., "ROW_NUMBER() OVER (...)",
+// in the SELECT clause of a SQLExpr.
+type SQLOver struct {
+	Kind   string     `json:"kind" unpack:""`
+	Func   *Call      `json:"func"`
+	Window *SQLWindow `json:"window"`
+}
+
+// A SQLWindow is a window specification in an OVER clause or, when Name is
+// set, in the WINDOW clause of a SQLExpr.  If Base is set, the window refines
+// the window of that name.
+type SQLWindow struct {
+	Kind        string      `json:"kind" unpack:""`
+	Name        string      `json:"name"`
+	Base        string      `json:"base"`
+	PartitionBy []Expr      `json:"partition_by"`
+	OrderBy     *SQLOrderBy `json:"order_by"`
+	Frame       *SQLFrame   `json:"frame"`
+}
+
+// A SQLFrame is a ROWS or RANGE frame of a window.
+type SQLFrame struct {
+	Units string        `json:"units"`
+	Start SQLFrameBound `json:"start"`
+	End   SQLFrameBound `json:"end"`
+}
+
+// Type is "unbounded_preceding", "preceding", "current_row", "following",
+// or "unbounded_following".
+type SQLFrameBound struct {
+	Type   string `json:"type"`
+	Offset int    `json:"offset"`
+}
+
+// A SQLCTE is a common table expression, i.e., a named query in the WITH
+// clause of a SQLExpr that may be used as a table in the rest of the query.
+type SQLCTE struct {
+	Name  string   `json:"name"`
+	Query *SQLExpr `json:"query"`
+}
+
 type SQLOrderBy struct {
 	Kind  string      `json:"kind" unpack:""`
 	Keys  []Expr      `json:"keys"`
@@ -501,6 +647,11 @@ func (*Let) OpAST()          {}
 func (*Search) OpAST()       {}
 func (*Where) OpAST()        {}
 func (*Yield) OpAST()        {}
+func (*Pivot) OpAST()        {}
+func (*Unpivot) OpAST()      {}
+func (*Sample) OpAST()       {}
+func (*Try) OpAST()          {}
+func (*Output) OpAST()       {}
 
 func (*SQLExpr) OpAST() {}
 
@@ -515,8 +666,9 @@ func (seq *Sequential) Prepend(front Op) {
 // upon a function of the record, e.g., count() counts up records without
 // looking into them.
 type Agg struct {
-	Kind  string `json:"kind" unpack:""`
-	Name  string `json:"name"`
-	Expr  Expr   `json:"expr"`
-	Where Expr   `json:"where"`
+	Kind     string `json:"kind" unpack:""`
+	Name     string `json:"name"`
+	Distinct bool   `json:"distinct,omitempty"`
+	Expr     Expr   `json:"expr"`
+	Where    Expr   `json:"where"`
 }
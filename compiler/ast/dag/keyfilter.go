@@ -32,7 +32,7 @@ func NewKeyFilter(key field.Path, node Expr) *KeyFilter {
 }
 
 func (k *KeyFilter) CroppedByExpr(o order.Which, prefix ...string) Expr {
-	return k.newExpr(o, prefix, true)
+	return k.newExpr(o, boundPath(prefix, "lower"), boundPath(prefix, "upper"), true, nil, nil)
 }
 
 // SpanExpr creates an Expr that returns true if the KeyFilter has a value
@@ -40,12 +40,13 @@ func (k *KeyFilter) CroppedByExpr(o order.Which, prefix ...string) Expr {
 // fields "lower" and "upper", where "lower" is the inclusive lower bounder and
 // "upper" is the exclusive upper bound.
 func (k *KeyFilter) SpanExpr(o order.Which, prefix ...string) Expr {
-	return k.newExpr(o, prefix, false)
+	return k.newExpr(o, boundPath(prefix, "lower"), boundPath(prefix, "upper"), false, nil, nil)
 }
 
-func (k *KeyFilter) newExpr(o order.Which, prefix []string, cropped bool) Expr {
-	lower := append(slices.Clone(prefix), "lower")
-	upper := append(slices.Clone(prefix), "upper")
+// newExpr creates the span or cropped-by Expr for the key values at paths
+// lower and upper.  If lowerOpen (upperOpen) is non-nil and true, the lower
+// (upper) bound is treated as unbounded.
+func (k *KeyFilter) newExpr(o order.Which, lower, upper []string, cropped bool, lowerOpen, upperOpen Expr) Expr {
 	if cropped {
 		lower, upper = upper, lower
 	}
@@ -57,17 +58,175 @@ func (k *KeyFilter) newExpr(o order.Which, prefix []string, cropped bool) Expr {
 			}
 			lhs := relativeToCompare("<=", &This{"This", lower}, lit, o)
 			rhs := relativeToCompare(">=", &This{"This", upper}, lit, o)
-			return NewBinaryExpr("and", lhs, rhs)
+			return NewBinaryExpr("and", orOpen(lowerOpen, lhs), orOpen(upperOpen, rhs))
 		case "<", "<=":
 			this.Path = lower
+			return orOpen(lowerOpen, relativeToCompare(op, this, lit, o))
 		case ">", ">=":
 			this.Path = upper
+			return orOpen(upperOpen, relativeToCompare(op, this, lit, o))
 		}
 		return relativeToCompare(op, this, lit, o)
 	})
 	return e
 }
 
+// BucketExpr creates an Expr that returns true if the KeyFilter may have a
+// value in the hash bucket of the span compared against, whose "lower" field
+// holds the bucket.  The function bucket returns the literal bucket of a key
+// literal or nil if it is unknown.  Only equality predicates are used.
+func (k *KeyFilter) BucketExpr(bucket func(*Literal) *Literal) Expr {
+	e, _ := visitLeaves(k.Expr, func(op string, _ *This, lit *Literal) Expr {
+		if op != "==" {
+			return nil
+		}
+		b := bucket(lit)
+		if b == nil {
+			return nil
+		}
+		return NewBinaryExpr("==", &This{"This", []string{"lower"}}, b)
+	})
+	return e
+}
+
+func orOpen(open, e Expr) Expr {
+	if open == nil {
+		return e
+	}
+	return NewBinaryExpr("or", open, e)
+}
+
+func boundPath(prefix []string, bound string, suffix ...string) []string {
+	return append(append(slices.Clone(prefix), bound), suffix...)
+}
+
+// CompoundKeyFilter is the analog of KeyFilter for a compound key, whose
+// values are records of the values of Keys (with fields named by the keys)
+// ordered lexicographically.  It comprises the equality predicates against a
+// literal value on a leading run of Keys that must all hold for the parent
+// filter to hold, followed by the KeyFilter for the key after that run.
+type CompoundKeyFilter struct {
+	Keys   field.List
+	Equals []*Literal
+	Next   *KeyFilter
+}
+
+// NewCompoundKeyFilter creates a CompoundKeyFilter for keys from node or
+// returns nil if node has no predicates that the filter can use.
+func NewCompoundKeyFilter(keys field.List, node Expr) *CompoundKeyFilter {
+	conjuncts := conjunctsOf(node)
+	var equals []*Literal
+loop:
+	for _, key := range keys {
+		for _, e := range conjuncts {
+			this, ok := e.LHS.(*This)
+			if !ok || e.Op != "==" || !key.Equal(this.Path) {
+				continue
+			}
+			if lit, ok := e.RHS.(*Literal); ok {
+				equals = append(equals, lit)
+				continue loop
+			}
+		}
+		break
+	}
+	var next *KeyFilter
+	if len(equals) < len(keys) {
+		next = NewKeyFilter(keys[len(equals)], node)
+	}
+	if len(equals) == 0 && next == nil {
+		return nil
+	}
+	return &CompoundKeyFilter{Keys: keys, Equals: equals, Next: next}
+}
+
+// conjunctsOf returns the predicates that must all be true for node to be true.
+func conjunctsOf(node Expr) []*BinaryExpr {
+	e, ok := node.(*BinaryExpr)
+	if !ok {
+		return nil
+	}
+	if e.Op == "and" {
+		return append(conjunctsOf(e.LHS), conjunctsOf(e.RHS)...)
+	}
+	return []*BinaryExpr{e}
+}
+
+// SpanExpr is like KeyFilter.SpanExpr except that the "lower" and "upper"
+// bounds of the span are compound key values.  A span whose bounds differ in
+// the keys of Equals can contain any value of the key that follows them, so
+// the KeyFilter for that key applies only at a bound whose leading keys are
+// equal to Equals.
+func (c *CompoundKeyFilter) SpanExpr(o order.Which, prefix ...string) Expr {
+	var e, lowerOpen, upperOpen Expr
+	if len(c.Equals) > 0 {
+		lower := c.prefixPaths(prefix, "lower")
+		upper := c.prefixPaths(prefix, "upper")
+		e = NewBinaryExpr("and", c.compareEquals("<=", lower, o), c.compareEquals(">=", upper, o))
+		lowerOpen = c.compareEquals("<", lower, o)
+		upperOpen = c.compareEquals(">", upper, o)
+	}
+	if c.Next != nil {
+		name := c.Keys[len(c.Equals)].String()
+		e = andExpr(e, c.Next.newExpr(o, boundPath(prefix, "lower", name), boundPath(prefix, "upper", name), false, lowerOpen, upperOpen))
+	}
+	return e
+}
+
+// CroppedByExpr is like KeyFilter.CroppedByExpr except that the "lower" and
+// "upper" bounds of the span are compound key values.
+func (c *CompoundKeyFilter) CroppedByExpr(o order.Which, prefix ...string) Expr {
+	var e Expr
+	if len(c.Equals) > 0 {
+		lower := c.prefixPaths(prefix, "lower")
+		upper := c.prefixPaths(prefix, "upper")
+		e = NewBinaryExpr("and", c.compareEquals("==", lower, o), c.compareEquals("==", upper, o))
+	}
+	if c.Next != nil {
+		name := c.Keys[len(c.Equals)].String()
+		e = andExpr(e, c.Next.newExpr(o, boundPath(prefix, "lower", name), boundPath(prefix, "upper", name), true, nil, nil))
+	}
+	return e
+}
+
+// prefixPaths returns the paths of the keys of Equals in the compound key at
+// bound.
+func (c *CompoundKeyFilter) prefixPaths(prefix []string, bound string) []*This {
+	var paths []*This
+	for _, key := range c.Keys[:len(c.Equals)] {
+		paths = append(paths, &This{"This", boundPath(prefix, bound, key.String())})
+	}
+	return paths
+}
+
+// compareEquals returns an Expr comparing the values at paths with Equals
+// lexicographically using op, which is one of "==", "<", "<=", ">", or ">=".
+func (c *CompoundKeyFilter) compareEquals(op string, paths []*This, o order.Which) Expr {
+	k := len(paths) - 1
+	e := Expr(relativeToCompare(op, paths[k], c.Equals[k], o))
+	strict := op
+	if op == "<=" || op == ">=" {
+		strict = op[:1]
+	}
+	for k--; k >= 0; k-- {
+		eq := relativeToCompare("==", paths[k], c.Equals[k], o)
+		if op == "==" {
+			e = NewBinaryExpr("and", eq, e)
+			continue
+		}
+		lt := relativeToCompare(strict, paths[k], c.Equals[k], o)
+		e = NewBinaryExpr("or", lt, NewBinaryExpr("and", eq, e))
+	}
+	return e
+}
+
+func andExpr(lhs, rhs Expr) Expr {
+	if lhs == nil {
+		return rhs
+	}
+	return NewBinaryExpr("and", lhs, rhs)
+}
+
 func relativeToCompare(op string, lhs, rhs Expr, o order.Which) *BinaryExpr {
 	nullsMax := &Literal{"Literal", "false"}
 	if o == order.Asc {
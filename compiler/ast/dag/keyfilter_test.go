@@ -36,3 +36,60 @@ func TestKeyFilter(t *testing.T) {
 	test("(pk>1 and pk<3) or (pk>4 and pk<6)", "pk>1 and pk<3 or pk>4 and pk<6", t)
 	test("pk==1 and pk ==1 and (pk==3 or foo==\"bar\")", "", t)
 }
+
+func TestCompoundKeyFilter(t *testing.T) {
+	keys := field.DottedList("a,b,c")
+	test := func(query string, equals []string, next string, t *testing.T) {
+		t.Run(query, func(t *testing.T) {
+			p := compiler.MustParse(query)
+			op, err := semantic.Analyze(context.Background(), p.(*ast.Sequential), nil, nil)
+			require.NoError(t, err)
+			kf := dag.NewCompoundKeyFilter(keys, op.Ops[0].(*dag.Filter).Expr)
+			if kf == nil {
+				assert.True(t, equals == nil && next == "", "expected compound key filter to be optimizable but it was not")
+				return
+			}
+			var actual []string
+			for _, lit := range kf.Equals {
+				actual = append(actual, lit.Value)
+			}
+			assert.Equal(t, equals, actual)
+			if kf.Next == nil {
+				assert.Equal(t, next, "")
+			} else {
+				assert.Equal(t, next, zfmt.DAGExpr(kf.Next.Expr))
+			}
+		})
+	}
+	test("a==1 and b>2 and b<5", []string{"1"}, "b>2 and b<5", t)
+	test("b==2 and a==1 and c==3", []string{"1", "2", "3"}, "", t)
+	test("a==1 and (b==2 or foo==3)", []string{"1"}, "", t)
+	test("a==1 or b==2", nil, "", t)
+	test("a>1 and b==2", nil, "a>1", t)
+	test("foo==1", nil, "", t)
+}
+
+func TestKeyFilterBucketExpr(t *testing.T) {
+	test := func(query, expected string, t *testing.T) {
+		t.Run(query, func(t *testing.T) {
+			p := compiler.MustParse(query)
+			op, err := semantic.Analyze(context.Background(), p.(*ast.Sequential), nil, nil)
+			require.NoError(t, err)
+			kf := dag.NewKeyFilter(field.New("pk"), op.Ops[0].(*dag.Filter).Expr)
+			require.NotNil(t, kf)
+			e := kf.BucketExpr(func(lit *dag.Literal) *dag.Literal {
+				return &dag.Literal{Kind: "Literal", Value: "b" + lit.Value}
+			})
+			if e == nil {
+				assert.Equal(t, expected, "", "expected bucket expression but there was none")
+				return
+			}
+			assert.Equal(t, expected, zfmt.DAGExpr(e))
+		})
+	}
+	test("pk==1", "lower==b1", t)
+	test("pk==1 or pk==2", "lower==b1 or lower==b2", t)
+	test("pk==1 and pk<3", "lower==b1", t)
+	test("pk==1 or pk<3", "", t)
+	test("pk<3", "", t)
+}
@@ -47,6 +47,7 @@ type (
 	Head struct {
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
+		Keys  []Expr `json:"keys"`
 	}
 	Join struct {
 		Kind     string       `json:"kind" unpack:""`
@@ -55,9 +56,11 @@ type (
 		RightKey Expr         `json:"right_key"`
 		Args     []Assignment `json:"args"`
 	}
+	// A Merge merges its inputs, which are sorted by Exprs in Order,
+	// comparing values by each of Exprs in turn.
 	Merge struct {
 		Kind  string      `json:"kind" unpack:""`
-		Expr  Expr        `json:"expr"`
+		Exprs []Expr      `json:"exprs"`
 		Order order.Which `json:"order"`
 	}
 	Parallel struct {
@@ -71,6 +74,12 @@ type (
 		Kind string       `json:"kind" unpack:""`
 		Args []Assignment `json:"args"`
 	}
+	Pivot struct {
+		Kind   string       `json:"kind" unpack:""`
+		Agg    *Agg         `json:"agg"`
+		Column Expr         `json:"column"`
+		Keys   []Assignment `json:"keys"`
+	}
 	Put struct {
 		Kind string       `json:"kind" unpack:""`
 		Args []Assignment `json:"args"`
@@ -79,6 +88,14 @@ type (
 		Kind string       `json:"kind" unpack:""`
 		Args []Assignment `json:"args"`
 	}
+	Sample struct {
+		Kind        string `json:"kind" unpack:""`
+		Limit       int    `json:"limit"`
+		Seed        *int64 `json:"seed"`
+		Keys        []Expr `json:"keys"`
+		PartialsIn  bool   `json:"partials_in,omitempty"`
+		PartialsOut bool   `json:"partials_out,omitempty"`
+	}
 	Sequential struct {
 		Kind   string  `json:"kind" unpack:""`
 		Consts []Def   `json:"consts"`
@@ -111,27 +128,54 @@ type (
 	Tail struct {
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
+		Keys  []Expr `json:"keys"`
 	}
 	Top struct {
 		Kind  string `json:"kind" unpack:""`
 		Limit int    `json:"limit"`
 		Args  []Expr `json:"args"`
 		Flush bool   `json:"flush"`
+		Keys  []Expr `json:"keys"`
 	}
 	Let struct {
 		Kind string `json:"kind" unpack:""`
 		Defs []Def  `json:"defs"`
 		Over *Over  `json:"over"`
 	}
+	Output struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+	}
 	Over struct {
 		Kind  string      `json:"kind" unpack:""`
 		Exprs []Expr      `json:"exprs"`
 		Scope *Sequential `json:"scope"`
 	}
+	Try struct {
+		Kind  string      `json:"kind" unpack:""`
+		Body  *Sequential `json:"body"`
+		Catch *Sequential `json:"catch"`
+	}
 	Uniq struct {
 		Kind  string `json:"kind" unpack:""`
 		Cflag bool   `json:"cflag"`
 	}
+	Unpivot struct {
+		Kind  string `json:"kind" unpack:""`
+		Args  []Expr `json:"args"`
+		Name  Expr   `json:"name"`
+		Value Expr   `json:"value"`
+	}
+	// A Window assigns the values of window functions to each input
+	// value.  The input must be sorted by PartitionBy and then OrderBy
+	// in the direction Order, so each partition is a run of values.
+	Window struct {
+		Kind        string       `json:"kind" unpack:""`
+		PartitionBy []Expr       `json:"partition_by"`
+		OrderBy     []Expr       `json:"order_by"`
+		Order       order.Which  `json:"order"`
+		Funcs       []WindowFunc `json:"funcs"`
+	}
 	Yield struct {
 		Kind  string `json:"kind" unpack:""`
 		Exprs []Expr `json:"exprs"`
@@ -161,6 +205,9 @@ type (
 		Source   Source      `json:"source"`
 		Seq      *Sequential `json:"seq"`
 		Pushdown Op          `json:"pushdown"`
+		// Projection lists the top-level fields of the source's records
+		// that are needed downstream.  If nil, all fields are needed.
+		Projection []string `json:"projection"`
 	}
 
 	// Leaf sources
@@ -185,6 +232,7 @@ type (
 		ScanLower Expr        `json:"scan_lower"`
 		ScanUpper Expr        `json:"scan_upper"`
 		ScanOrder string      `json:"scan_order"`
+		Partition *Partition  `json:"partition"`
 	}
 	PoolMeta struct {
 		Kind string      `json:"kind" unpack:""`
@@ -228,10 +276,11 @@ func (*From) OpNode() {}
 
 type (
 	Agg struct {
-		Kind  string `json:"kind" unpack:""`
-		Name  string `json:"name"`
-		Expr  Expr   `json:"expr"`
-		Where Expr   `json:"where"`
+		Kind     string `json:"kind" unpack:""`
+		Name     string `json:"name"`
+		Distinct bool   `json:"distinct,omitempty"`
+		Expr     Expr   `json:"expr"`
+		Where    Expr   `json:"where"`
 	}
 	Case struct {
 		Expr Expr `json:"expr"`
@@ -245,6 +294,26 @@ type (
 		Name string `json:"name"`
 		Args []Expr `json:"args"`
 	}
+	WindowFunc struct {
+		LHS   Expr        `json:"lhs"`
+		Name  string      `json:"name"`
+		Args  []Expr      `json:"args"`
+		Frame WindowFrame `json:"frame"`
+	}
+	// A WindowFrame is the range of values of a partition over which
+	// a window function is computed relative to the current value.
+	// Units is "rows" or "range".
+	WindowFrame struct {
+		Units string      `json:"units"`
+		Start WindowBound `json:"start"`
+		End   WindowBound `json:"end"`
+	}
+	// Type is "unbounded_preceding", "preceding", "current_row",
+	// "following", or "unbounded_following".
+	WindowBound struct {
+		Type   string `json:"type"`
+		Offset int    `json:"offset"`
+	}
 )
 
 func (*Sequential) OpNode() {}
@@ -271,6 +340,12 @@ func (*Over) OpNode()       {}
 func (*Let) OpNode()        {}
 func (*Yield) OpNode()      {}
 func (*Merge) OpNode()      {}
+func (*Pivot) OpNode()      {}
+func (*Unpivot) OpNode()    {}
+func (*Sample) OpNode()     {}
+func (*Try) OpNode()        {}
+func (*Output) OpNode()     {}
+func (*Window) OpNode()     {}
 
 func (seq *Sequential) IsEntry() bool {
 	if len(seq.Ops) == 0 {
@@ -315,6 +390,14 @@ func FanIn(op Op) int {
 	return 1
 }
 
+// Partition describes how the data objects of a Pool are partitioned.  Values
+// are partitioned by the value of Key or, if Buckets is nonzero, by the hash
+// of that value into Buckets buckets as computed by expr.Bucket.
+type Partition struct {
+	Key     field.Path `json:"key"`
+	Buckets int        `json:"buckets"`
+}
+
 func FilterToOp(e Expr) *Filter {
 	return &Filter{
 		Kind: "Filter",
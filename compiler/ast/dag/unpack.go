@@ -29,13 +29,16 @@ var unpacker = unpack.New(
 	Literal{},
 	MapExpr{},
 	Merge{},
+	Sample{},
 	Shape{},
 	Spread{},
 	Over{},
 	OverExpr{},
 	Parallel{},
+	Output{},
 	Pass{},
 	Pick{},
+	Pivot{},
 	Pool{},
 	Put{},
 	Agg{},
@@ -52,11 +55,14 @@ var unpacker = unpack.New(
 	Tail{},
 	This{},
 	Top{},
+	Try{},
 	Trunk{},
 	UnaryExpr{},
 	Uniq{},
+	Unpivot{},
 	Var{},
 	VectorValue{},
+	Window{},
 	Yield{},
 )
 
@@ -20,9 +20,13 @@ var unpacker = unpack.New(
 	astzed.CastValue{},
 	Conditional{},
 	ConstDecl{},
+	CreateBranch{},
+	CreatePool{},
 	Cut{},
 	astzed.DefValue{},
+	DeleteFrom{},
 	Drop{},
+	DropPool{},
 	Explode{},
 	astzed.Enum{},
 	astzed.Error{},
@@ -37,6 +41,7 @@ var unpacker = unpack.New(
 	HTTP{},
 	ID{},
 	astzed.ImpliedValue{},
+	InsertInto{},
 	Join{},
 	Layout{},
 	Let{},
@@ -45,10 +50,13 @@ var unpacker = unpack.New(
 	Trunk{},
 	astzed.Map{},
 	MapExpr{},
+	Sample{},
 	Shape{},
 	OverExpr{},
 	Parallel{},
+	Output{},
 	Pass{},
+	Pivot{},
 	Pool{},
 	astzed.Primitive{},
 	Put{},
@@ -66,12 +74,15 @@ var unpacker = unpack.New(
 	Spread{},
 	SQLExpr{},
 	SQLOrderBy{},
+	SQLOver{},
+	SQLWindow{},
 	Sort{},
 	String{},
 	Switch{},
 	Tail{},
 	Term{},
 	Top{},
+	Try{},
 	astzed.TypeArray{},
 	astzed.TypeDef{},
 	astzed.TypeEnum{},
@@ -86,6 +97,7 @@ var unpacker = unpack.New(
 	astzed.TypeValue{},
 	UnaryExpr{},
 	Uniq{},
+	Unpivot{},
 	VectorValue{},
 	Where{},
 	Yield{},
@@ -49,6 +49,13 @@ func (s *Source) CommitObject(ctx context.Context, id ksuid.KSUID, name string)
 	return ksuid.Nil, nil
 }
 
+func (s *Source) Partition(ctx context.Context, id ksuid.KSUID) (*dag.Partition, error) {
+	if s.lake != nil {
+		return s.lake.Partition(ctx, id)
+	}
+	return nil, nil
+}
+
 func (s *Source) Layout(ctx context.Context, src dag.Source) order.Layout {
 	if s.lake != nil {
 		return s.lake.Layout(ctx, src)
@@ -0,0 +1,339 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/anyio"
+	"github.com/brimdata/zed/zio/zngio"
+)
+
+// minSplitSize is the smallest number of bytes in a split.
+var minSplitSize int64 = 1024 * 1024
+
+// Splitter divides a file or URL into splits that are scanned concurrently
+// by the parallel instances of a trunk, each of which scans the splits it
+// takes from the Splitter in turn.  ZNG is split at frame boundaries and
+// the line format and newline-delimited JSON, i.e., the json format for
+// paths ending in .ndjson or .jsonl, are split at line boundaries.  Any
+// other input, including compressed input and input whose size is not
+// known, forms a single split.
+type Splitter struct {
+	ctx    context.Context
+	zctx   *zed.Context
+	source *Source
+	path   string
+	format string
+	n      int
+
+	mu     sync.Mutex
+	reader storage.Reader
+	next   func() (io.Reader, error)
+	refs   int
+	err    error
+}
+
+// CanSplit returns false if the input at path in format cannot be split.
+// An input for which it returns true might still form a single split.
+func CanSplit(path, format string) bool {
+	if path == "-" || strings.HasPrefix(path, string(storage.StdioScheme)+":") {
+		return false
+	}
+	switch format {
+	case "", "auto", "line", "zng":
+		return true
+	case "json":
+		return isNDJSON(path)
+	}
+	return false
+}
+
+func isNDJSON(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return true
+	}
+	return false
+}
+
+// NewSplitter returns a Splitter for path that is shared by n scanners.
+func (s *Source) NewSplitter(ctx context.Context, zctx *zed.Context, path, format string, n int) *Splitter {
+	if path == "-" {
+		path = "stdio:stdin"
+	}
+	return &Splitter{
+		ctx:    ctx,
+		zctx:   zctx,
+		source: s,
+		path:   path,
+		format: format,
+		n:      n,
+		refs:   n,
+	}
+}
+
+// NewScanner returns a scanner that applies pushdown to the splits it
+// takes from s.
+func (s *Splitter) NewScanner(pushdown zbuf.Filter) zbuf.Puller {
+	return &splitScanner{splitter: s, pushdown: pushdown}
+}
+
+func (s *Splitter) open() error {
+	uri, err := storage.ParseURI(s.path)
+	if err != nil {
+		return err
+	}
+	r, err := s.source.engine.Get(s.ctx, uri)
+	if err != nil {
+		return err
+	}
+	s.reader = r
+	format := s.splitFormat()
+	if format == "" {
+		// The input forms a single split read from r.
+		done := false
+		s.next = func() (io.Reader, error) {
+			if done {
+				return nil, nil
+			}
+			done = true
+			return r, nil
+		}
+		return nil
+	}
+	s.format = format
+	size, _ := storage.Size(r)
+	target := size / int64(4*s.n)
+	if target < minSplitSize {
+		target = minSplitSize
+	}
+	if format == "zng" {
+		splitter := zngio.NewSplitter(r, size)
+		s.next = func() (io.Reader, error) {
+			return splitter.Next(target)
+		}
+		return nil
+	}
+	var off int64
+	s.next = func() (io.Reader, error) {
+		if off >= size {
+			return nil, nil
+		}
+		end := off + target
+		if end > size {
+			end = size
+		}
+		split := newLineSplit(r, off, end, size)
+		off = end
+		return split, nil
+	}
+	return nil
+}
+
+// splitFormat returns the format of s.reader if it can be split or
+// the empty string otherwise.
+func (s *Splitter) splitFormat() string {
+	size, err := storage.Size(s.reader)
+	if err != nil {
+		return ""
+	}
+	var magic [2]byte
+	if _, err := s.reader.ReadAt(magic[:], 0); err != nil || magic == [2]byte{0x1f, 0x8b} {
+		// The input is empty, cannot be read at an offset, or is
+		// compressed with gzip.
+		return ""
+	}
+	switch s.format {
+	case "", "auto":
+		zr, err := anyio.NewReader(zed.NewContext(), io.NewSectionReader(s.reader, 0, size))
+		if err != nil {
+			return ""
+		}
+		defer zr.Close()
+		if _, ok := zr.(*zngio.Reader); ok {
+			return "zng"
+		}
+	case "json":
+		if isNDJSON(s.path) {
+			return "json"
+		}
+	case "line", "zng":
+		return s.format
+	}
+	return ""
+}
+
+// take returns a reader for the next split or nil if there are no more.
+func (s *Splitter) take() (zio.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.next == nil {
+		if s.err = s.open(); s.err != nil {
+			return nil, s.err
+		}
+	}
+	r, err := s.next()
+	if r == nil || err != nil {
+		s.err = err
+		return nil, err
+	}
+	opts := anyio.ReaderOpts{Format: s.format}
+	if r == s.reader {
+		// The input is read whole so it may be compressed and its
+		// format may be any that can be detected.
+		if r, err = anyio.GzipReader(r); err != nil {
+			return nil, err
+		}
+		return anyio.NewReaderWithOpts(s.zctx, r, opts)
+	}
+	// The scanners run in parallel so a ZNG split is decoded by a
+	// single thread.
+	opts.ZNG.Threads = 1
+	return anyio.NewReaderWithOpts(s.zctx, r, opts)
+}
+
+// release closes the input after each scanner is done with s.
+func (s *Splitter) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refs--
+	if s.refs == 0 && s.reader != nil {
+		s.reader.Close()
+	}
+}
+
+type splitScanner struct {
+	splitter *Splitter
+	pushdown zbuf.Filter
+	reader   zio.ReadCloser
+	scanner  zbuf.Scanner
+	eof      bool
+}
+
+func (s *splitScanner) Pull(done bool) (zbuf.Batch, error) {
+	if s.eof {
+		return nil, nil
+	}
+	if done {
+		if s.scanner != nil {
+			s.scanner.Pull(true)
+		}
+		s.close()
+		return nil, nil
+	}
+	for {
+		if s.scanner == nil {
+			r, err := s.splitter.take()
+			if r == nil || err != nil {
+				s.close()
+				return nil, err
+			}
+			scanner, err := zbuf.NewScanner(s.splitter.ctx, r, s.pushdown)
+			if err != nil {
+				r.Close()
+				s.close()
+				return nil, err
+			}
+			s.reader = r
+			s.scanner = zbuf.NamedScanner(scanner, s.splitter.path)
+		}
+		batch, err := s.scanner.Pull(false)
+		if batch != nil || err != nil {
+			return batch, err
+		}
+		s.reader.Close()
+		s.reader = nil
+		s.scanner = nil
+	}
+}
+
+func (s *splitScanner) close() {
+	if s.reader != nil {
+		s.reader.Close()
+		s.reader = nil
+	}
+	s.scanner = nil
+	s.eof = true
+	s.splitter.release()
+}
+
+// lineSplit reads the lines of a split of the input that begin at an offset
+// in [start, end).
+type lineSplit struct {
+	r     io.ReaderAt
+	start int64
+	end   int64
+	size  int64
+
+	reader  *bufio.Reader
+	off     int64
+	newline bool
+	line    []byte
+	err     error
+}
+
+func newLineSplit(r io.ReaderAt, start, end, size int64) *lineSplit {
+	return &lineSplit{
+		r:     r,
+		start: start,
+		end:   end,
+		size:  size,
+	}
+}
+
+func (l *lineSplit) Read(b []byte) (int, error) {
+	if l.reader == nil {
+		l.off = l.start
+		l.newline = true
+		if l.start > 0 {
+			// Skip the line that begins before this split by
+			// beginning with the preceding byte, which is a newline
+			// only if a line begins at l.start.
+			l.off--
+			l.newline = false
+		}
+		l.reader = bufio.NewReaderSize(io.NewSectionReader(l.r, l.off, l.size-l.off), 1024*1024)
+		for !l.newline {
+			if err := l.readLine(); err != nil {
+				return 0, err
+			}
+		}
+		l.line = nil
+	}
+	for len(l.line) == 0 {
+		if l.err != nil {
+			return 0, l.err
+		}
+		if l.newline && l.off >= l.end {
+			return 0, io.EOF
+		}
+		l.err = l.readLine()
+	}
+	n := copy(b, l.line)
+	l.line = l.line[n:]
+	return n, nil
+}
+
+// readLine reads the next line or, for a line longer than the buffer of
+// l.reader, the next portion of it into l.line.
+func (l *lineSplit) readLine() error {
+	line, err := l.reader.ReadSlice('\n')
+	l.line = line
+	l.off += int64(len(line))
+	l.newline = err == nil
+	if err == bufio.ErrBufferFull {
+		err = nil
+	}
+	return err
+}
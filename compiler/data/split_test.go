@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/zngio"
+	"github.com/brimdata/zed/zio/zsonio"
+	"github.com/brimdata/zed/zson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineSplit(t *testing.T) {
+	const input = "a\nbb\n\nccc\ndddd\ne"
+	for _, target := range []int64{1, 2, 3, 5, 100} {
+		var out strings.Builder
+		r := strings.NewReader(input)
+		size := int64(len(input))
+		for off := int64(0); off < size; off += target {
+			end := off + target
+			if end > size {
+				end = size
+			}
+			b, err := io.ReadAll(newLineSplit(r, off, end, size))
+			require.NoError(t, err)
+			out.Write(b)
+		}
+		require.Equal(t, input, out.String(), "target %d", target)
+	}
+}
+
+func TestSplitter(t *testing.T) {
+	saved := minSplitSize
+	minSplitSize = 16
+	defer func() { minSplitSize = saved }()
+	var lines []string
+	for k := 0; k < 200; k++ {
+		lines = append(lines, zson.String(zed.NewInt64(int64(k))))
+	}
+	dir := t.TempDir()
+	ndjson := filepath.Join(dir, "in.ndjson")
+	require.NoError(t, os.WriteFile(ndjson, []byte(strings.Join(lines, "\n")+"\n"), 0666))
+	zng := filepath.Join(dir, "in.zng")
+	f, err := os.Create(zng)
+	require.NoError(t, err)
+	w := zngio.NewWriterWithOpts(f, zngio.WriterOpts{FrameThresh: 16})
+	require.NoError(t, zio.Copy(w, zsonio.NewReader(zed.NewContext(), strings.NewReader(strings.Join(lines, "\n")))))
+	require.NoError(t, w.Close())
+	source := NewSource(storage.NewLocalEngine(), nil)
+	for _, c := range []struct{ path, format string }{
+		{ndjson, "json"},
+		{ndjson, "line"},
+		{zng, ""},
+		{zng, "zng"},
+	} {
+		const n = 3
+		zctx := zed.NewContext()
+		splitter := source.NewSplitter(context.Background(), zctx, c.path, c.format, n)
+		var mu sync.Mutex
+		var out []string
+		var wg sync.WaitGroup
+		for k := 0; k < n; k++ {
+			scanner := splitter.NewScanner(nil)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					batch, err := scanner.Pull(false)
+					if !assert.NoError(t, err) || batch == nil {
+						return
+					}
+					mu.Lock()
+					for _, val := range batch.Values() {
+						s := zson.String(&val)
+						if c.format == "line" {
+							s = val.AsString()
+						}
+						out = append(out, s)
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		require.NotEqual(t, "", splitter.format)
+		sort.Slice(out, func(i, j int) bool {
+			a, _ := strconv.Atoi(out[i])
+			b, _ := strconv.Atoi(out[j])
+			return a < b
+		})
+		require.Equal(t, lines, out, "%s (%s)", c.path, c.format)
+	}
+}
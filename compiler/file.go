@@ -81,6 +81,11 @@ func optimizeAndBuild(job *Job) (*runtime.Query, error) {
 	if err := job.Optimize(); err != nil {
 		return nil, err
 	}
+	// A query that reads its own files or URLs may scan each of them
+	// in parallel.
+	if len(job.readers) == 0 && Parallelism > 1 {
+		job.Parallelize(Parallelism)
+	}
 	// For an internal reader (like a shaper on intake), we don't do
 	// any parallelization right now though this could be potentially
 	// beneficial depending on where the bottleneck is for a given shaper.
@@ -88,5 +93,5 @@ func optimizeAndBuild(job *Job) (*runtime.Query, error) {
 	if err := job.Build(); err != nil {
 		return nil, err
 	}
-	return runtime.NewQuery(job.pctx, job.Puller(), job.builder.Meter()), nil
+	return runtime.NewQuery(job.pctx, job.Puller(), job.builder.Meter(), job.Channels(), job.Explain), nil
 }
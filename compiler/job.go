@@ -14,8 +14,14 @@ import (
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/runtime/op"
 	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zfmt"
 )
 
+// ErrStatement is returned when a statement that manages the pools of a lake,
+// e.g., CREATE POOL, is compiled as a query.  Statements are run with
+// lake/api.ExecStatement instead.
+var ErrStatement = errors.New("pool management statements cannot be compiled as a query")
+
 type Job struct {
 	pctx      *op.Context
 	builder   *kernel.Builder
@@ -38,6 +44,9 @@ func NewJob(pctx *op.Context, inAST ast.Op, src *data.Source, head *lakeparse.Co
 	// caller via runtime.readers.  In most cases, the AST is left
 	// with an ast.From at the entry point, and hence a dag.From for the
 	// DAG's entry point.
+	if _, ok := parserAST.(ast.Statement); ok {
+		return nil, ErrStatement
+	}
 	seq, ok := parserAST.(*ast.Sequential)
 	if !ok {
 		return nil, fmt.Errorf("internal error: AST must begin with a Sequential op: %T", parserAST)
@@ -173,6 +182,17 @@ func (j *Job) Build() error {
 	return nil
 }
 
+// Channels returns the name of each output of the job's flowgraph.
+func (j *Job) Channels() []string {
+	return j.builder.Channels()
+}
+
+// Explain returns the optimized DAG of the job annotated with the runtime
+// statistics of its flowgraph.
+func (j *Job) Explain() string {
+	return zfmt.Explain(j.optimizer.Entry(), j.builder.Stats())
+}
+
 func (j *Job) Puller() zbuf.Puller {
 	if j.puller == nil {
 		switch outputs := j.outputs; len(outputs) {
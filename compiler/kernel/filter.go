@@ -1,16 +1,25 @@
 package kernel
 
 import (
+	"fmt"
+
+	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/compiler/ast/dag"
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zson"
 )
 
+// Filter implements zbuf.Filter for the pushdown predicate and projection
+// of a trunk.  Either may be absent.  If the trunk's source is a partitioned
+// pool, partition describes its partitioning.
 type Filter struct {
-	pushdown dag.Expr
-	builder  *Builder
+	pushdown   dag.Expr
+	projection []string
+	partition  *dag.Partition
+	builder    *Builder
 }
 
 var _ zbuf.Filter = (*Filter)(nil)
@@ -23,25 +32,52 @@ func (f *Filter) Pushdown() dag.Expr {
 }
 
 func (f *Filter) AsEvaluator() (expr.Evaluator, error) {
-	if f == nil {
+	if f == nil || f.pushdown == nil {
 		return nil, nil
 	}
 	return f.builder.compileExpr(f.pushdown)
 }
 
 func (f *Filter) AsBufferFilter() (*expr.BufferFilter, error) {
-	if f == nil {
+	if f == nil || f.pushdown == nil {
 		return nil, nil
 	}
 	return CompileBufferFilter(f.builder.pctx.Zctx, f.pushdown)
 }
 
-func (f *Filter) AsKeySpanFilter(key field.Path, o order.Which) (*expr.SpanFilter, error) {
-	k := f.keyFilter(key)
+func (f *Filter) AsKeySpanFilter(keys field.List, o order.Which) (*expr.SpanFilter, error) {
+	return f.keySpanFilter(keys, o, false)
+}
+
+func (f *Filter) AsKeyCroppedByFilter(keys field.List, o order.Which) (*expr.SpanFilter, error) {
+	return f.keySpanFilter(keys, o, true)
+}
+
+// AsPartitionFilter returns a filter for the partition values of objects in
+// a partitioned pool.  If the pool is hash partitioned, partition values are
+// hash buckets and only equality predicates on the partition key are used.
+func (f *Filter) AsPartitionFilter() (*expr.SpanFilter, error) {
+	if f == nil || f.pushdown == nil || f.partition == nil {
+		return nil, nil
+	}
+	key, buckets := f.partition.Key, f.partition.Buckets
+	if buckets == 0 {
+		return f.keySpanFilter(field.List{key}, order.Asc, false)
+	}
+	k := dag.NewKeyFilter(key, f.pushdown)
 	if k == nil {
 		return nil, nil
 	}
-	e := k.SpanExpr(o)
+	e := k.BucketExpr(func(lit *dag.Literal) *dag.Literal {
+		val, err := zson.ParseValue(zed.NewContext(), lit.Value)
+		if err != nil {
+			return nil
+		}
+		return &dag.Literal{Kind: "Literal", Value: fmt.Sprintf("%d(uint64)", expr.Bucket(val, buckets))}
+	})
+	if e == nil {
+		return nil, nil
+	}
 	eval, err := compileExpr(e)
 	if err != nil {
 		return nil, err
@@ -49,12 +85,11 @@ func (f *Filter) AsKeySpanFilter(key field.Path, o order.Which) (*expr.SpanFilte
 	return expr.NewSpanFilter(eval), nil
 }
 
-func (f *Filter) AsKeyCroppedByFilter(key field.Path, o order.Which) (*expr.SpanFilter, error) {
-	k := f.keyFilter(key)
-	if k == nil {
+func (f *Filter) keySpanFilter(keys field.List, o order.Which, cropped bool) (*expr.SpanFilter, error) {
+	e := f.keyExpr(keys, o, cropped)
+	if e == nil {
 		return nil, nil
 	}
-	e := k.CroppedByExpr(o)
 	eval, err := compileExpr(e)
 	if err != nil {
 		return nil, err
@@ -62,11 +97,42 @@ func (f *Filter) AsKeyCroppedByFilter(key field.Path, o order.Which) (*expr.Span
 	return expr.NewSpanFilter(eval), nil
 }
 
-func (f *Filter) keyFilter(key field.Path) *dag.KeyFilter {
-	if f == nil {
+func (f *Filter) AsProjection() (*expr.Projection, error) {
+	if f == nil || f.projection == nil {
+		return nil, nil
+	}
+	return expr.NewProjection(f.builder.pctx.Zctx, f.projection), nil
+}
+
+// keyExpr returns the span or, if cropped is true, the cropped-by expression
+// for keys or nil if the pushdown has no predicates on keys.  If there is
+// more than one key, the span's bounds are compound key values.
+func (f *Filter) keyExpr(keys field.List, o order.Which, cropped bool) dag.Expr {
+	if f == nil || f.pushdown == nil {
+		return nil
+	}
+	if len(keys) > 1 {
+		k := dag.NewCompoundKeyFilter(keys, f.pushdown)
+		if k == nil {
+			return nil
+		}
+		if cropped {
+			return k.CroppedByExpr(o)
+		}
+		return k.SpanExpr(o)
+	}
+	var key field.Path
+	if len(keys) == 1 {
+		key = keys[0]
+	}
+	k := dag.NewKeyFilter(key, f.pushdown)
+	if k == nil {
 		return nil
 	}
-	return dag.NewKeyFilter(key, f.pushdown)
+	if cropped {
+		return k.CroppedByExpr(o)
+	}
+	return k.SpanExpr(o)
 }
 
 type DeleteFilter struct {
@@ -74,7 +140,7 @@ type DeleteFilter struct {
 }
 
 func (f *DeleteFilter) AsEvaluator() (expr.Evaluator, error) {
-	if f == nil {
+	if f == nil || f.pushdown == nil {
 		return nil, nil
 	}
 	// For a DeleteFilter Evaluator the pushdown gets wrapped in a unary !
@@ -100,3 +166,8 @@ func (f *DeleteFilter) AsEvaluator() (expr.Evaluator, error) {
 func (f *DeleteFilter) AsBufferFilter() (*expr.BufferFilter, error) {
 	return nil, nil
 }
+
+// AsProjection returns nil since a delete must rewrite whole values.
+func (f *DeleteFilter) AsProjection() (*expr.Projection, error) {
+	return nil, nil
+}
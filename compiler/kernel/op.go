@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/compiler/ast/dag"
@@ -25,13 +26,16 @@ import (
 	"github.com/brimdata/zed/runtime/op/merge"
 	"github.com/brimdata/zed/runtime/op/meta"
 	"github.com/brimdata/zed/runtime/op/pass"
+	"github.com/brimdata/zed/runtime/op/pivot"
 	"github.com/brimdata/zed/runtime/op/shape"
 	"github.com/brimdata/zed/runtime/op/sort"
 	"github.com/brimdata/zed/runtime/op/switcher"
 	"github.com/brimdata/zed/runtime/op/tail"
 	"github.com/brimdata/zed/runtime/op/top"
 	"github.com/brimdata/zed/runtime/op/traverse"
+	"github.com/brimdata/zed/runtime/op/try"
 	"github.com/brimdata/zed/runtime/op/uniq"
+	"github.com/brimdata/zed/runtime/op/window"
 	"github.com/brimdata/zed/runtime/op/yield"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
@@ -41,21 +45,42 @@ import (
 var ErrJoinParents = errors.New("join requires two upstream parallel query paths")
 
 type Builder struct {
-	pctx     *op.Context
-	source   *data.Source
-	slicers  map[dag.Source]*meta.Slicer
-	pools    map[dag.Source]*lake.Pool
-	progress *zbuf.Progress
-	deletes  *sync.Map
-	funcs    map[string]expr.Function
+	pctx    *op.Context
+	source  *data.Source
+	slicers map[dag.Source]*meta.Slicer
+	pools   map[dag.Source]*lake.Pool
+	// splitters holds the splitter shared by the parallel instances
+	// of each trunk that scans a file or URL.
+	splitters map[dag.Source]*data.Splitter
+	progress  *zbuf.Progress
+	deletes   *sync.Map
+	funcs     map[string]expr.Function
+	// catches holds the outputs of the catch sequences of try operators,
+	// which are appended to the outputs of the flowgraph.
+	catches []zbuf.Puller
+	// outputs maps the puller terminating each branch ended by an output
+	// operator to the operator's name, and channels holds the names of
+	// the outputs of the flowgraph after Build.
+	outputs  map[zbuf.Puller]string
+	channels []string
+	listers  map[dag.Source]*meta.Lister
+	stats    *Stats
 }
 
 func NewBuilder(pctx *op.Context, source *data.Source) *Builder {
+	var stats *Stats
+	if op.ExplainRequested(pctx) {
+		stats = newStats()
+	}
 	return &Builder{
-		pctx:    pctx,
-		source:  source,
-		slicers: make(map[dag.Source]*meta.Slicer),
-		pools:   make(map[dag.Source]*lake.Pool),
+		pctx:      pctx,
+		source:    source,
+		slicers:   make(map[dag.Source]*meta.Slicer),
+		pools:     make(map[dag.Source]*lake.Pool),
+		splitters: make(map[dag.Source]*data.Splitter),
+		outputs:   make(map[zbuf.Puller]string),
+		listers:   make(map[dag.Source]*meta.Lister),
+		stats:     stats,
 		progress: &zbuf.Progress{
 			BytesRead:      0,
 			BytesMatched:   0,
@@ -79,7 +104,40 @@ func (b *Builder) Build(seq *dag.Sequential) ([]zbuf.Puller, error) {
 	if !seq.IsEntry() {
 		return nil, errors.New("internal error: DAG entry point is not a data source")
 	}
-	return b.compile(seq, nil)
+	outputs, err := b.compile(seq, nil)
+	if err != nil {
+		return nil, err
+	}
+	outputs = append(outputs, b.catches...)
+	b.channels = make([]string, 0, len(outputs))
+	names := make(map[string]struct{})
+	for _, o := range outputs {
+		name, ok := b.outputs[o]
+		if ok {
+			if _, ok := names[name]; ok {
+				return nil, fmt.Errorf("output %q used more than once", name)
+			}
+			names[name] = struct{}{}
+			delete(b.outputs, o)
+		}
+		b.channels = append(b.channels, name)
+	}
+	if len(b.outputs) != 0 {
+		return nil, errors.New("output operator must be the last operator of a branch")
+	}
+	return outputs, nil
+}
+
+// Channels returns the name of each output returned by Build, where the
+// name of an output not terminated by an output operator is empty.
+func (b *Builder) Channels() []string {
+	return b.channels
+}
+
+// Stats returns the runtime statistics of the flowgraph returned by Build
+// or nil if they were not requested with op.WithExplain.
+func (b *Builder) Stats() *Stats {
+	return b.stats
 }
 
 func (b *Builder) zctx() *zed.Context {
@@ -98,34 +156,12 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 	switch v := o.(type) {
 	case *dag.Summarize:
 		return b.compileGroupBy(parent, v)
-	case *dag.Cut:
-		assignments, err := b.compileAssignments(v.Args)
+	case *dag.Cut, *dag.Drop, *dag.Filter, *dag.Put, *dag.Rename:
+		applier, err := b.compileApplier(v)
 		if err != nil {
 			return nil, err
 		}
-		lhs, rhs := splitAssignments(assignments)
-		cutter, err := expr.NewCutter(b.pctx.Zctx, lhs, rhs)
-		if err != nil {
-			return nil, err
-		}
-		if v.Quiet {
-			cutter.Quiet()
-		}
-		return op.NewApplier(b.pctx, parent, cutter), nil
-	case *dag.Drop:
-		if len(v.Args) == 0 {
-			return nil, errors.New("drop: no fields given")
-		}
-		fields := make(field.List, 0, len(v.Args))
-		for _, e := range v.Args {
-			field, ok := e.(*dag.This)
-			if !ok {
-				return nil, errors.New("drop: arg not a field")
-			}
-			fields = append(fields, field.Path)
-		}
-		dropper := expr.NewDropper(b.pctx.Zctx, fields)
-		return op.NewApplier(b.pctx, parent, dropper), nil
+		return op.NewApplier(b.pctx, parent, applier), nil
 	case *dag.Sort:
 		fields, err := b.compileExprs(v.Args)
 		if err != nil {
@@ -141,67 +177,54 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 		if limit == 0 {
 			limit = 1
 		}
+		if len(v.Keys) != 0 {
+			keys, err := b.compileExprs(v.Keys)
+			if err != nil {
+				return nil, fmt.Errorf("compiling head: %w", err)
+			}
+			return top.NewGroupedHead(b.pctx, parent, keys, limit), nil
+		}
 		return head.New(parent, limit), nil
 	case *dag.Tail:
 		limit := v.Count
 		if limit == 0 {
 			limit = 1
 		}
+		if len(v.Keys) != 0 {
+			keys, err := b.compileExprs(v.Keys)
+			if err != nil {
+				return nil, fmt.Errorf("compiling tail: %w", err)
+			}
+			return top.NewGroupedTail(b.pctx, parent, keys, limit), nil
+		}
 		return tail.New(parent, limit), nil
+	case *dag.Sample:
+		keys, err := b.compileExprs(v.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("compiling sample: %w", err)
+		}
+		seed := time.Now().UnixNano()
+		if v.Seed != nil {
+			seed = *v.Seed
+		}
+		return top.NewGroupedSample(b.pctx, parent, keys, v.Limit, seed, v.PartialsIn, v.PartialsOut), nil
 	case *dag.Uniq:
 		return uniq.New(b.pctx, parent, v.Cflag), nil
 	case *dag.Pass:
 		return pass.New(parent), nil
-	case *dag.Filter:
-		f, err := b.compileExpr(v.Expr)
-		if err != nil {
-			return nil, fmt.Errorf("compiling filter: %w", err)
-		}
-		return op.NewApplier(b.pctx, parent, expr.NewFilterApplier(b.pctx.Zctx, f)), nil
 	case *dag.Top:
 		fields, err := b.compileExprs(v.Args)
 		if err != nil {
 			return nil, fmt.Errorf("compiling top: %w", err)
 		}
-		return top.New(b.pctx.Zctx, parent, v.Limit, fields, v.Flush), nil
-	case *dag.Put:
-		clauses, err := b.compileAssignments(v.Args)
-		if err != nil {
-			return nil, err
-		}
-		putter, err := expr.NewPutter(b.pctx.Zctx, clauses)
-		if err != nil {
-			return nil, err
-		}
-		return op.NewApplier(b.pctx, parent, putter), nil
-	case *dag.Rename:
-		var srcs, dsts field.List
-		for _, fa := range v.Args {
-			dst, err := compileLval(fa.LHS)
-			if err != nil {
-				return nil, err
-			}
-			// We call CompileLval on the RHS because renames are
-			// restricted to dotted field name expressions.
-			src, err := compileLval(fa.RHS)
+		if len(v.Keys) != 0 {
+			keys, err := b.compileExprs(v.Keys)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("compiling top: %w", err)
 			}
-			if len(dst) != len(src) {
-				return nil, fmt.Errorf("cannot rename %s to %s", src, dst)
-			}
-			// Check that the prefixes match and, if not, report first place
-			// that they don't.
-			for i := 0; i <= len(src)-2; i++ {
-				if src[i] != dst[i] {
-					return nil, fmt.Errorf("cannot rename %s to %s (differ in %s vs %s)", src, dst, src[i], dst[i])
-				}
-			}
-			dsts = append(dsts, dst)
-			srcs = append(srcs, src)
+			return top.NewGroupedTop(b.pctx, parent, keys, v.Limit, fields), nil
 		}
-		renamer := expr.NewRenamer(b.pctx.Zctx, srcs, dsts)
-		return op.NewApplier(b.pctx, parent, renamer), nil
+		return top.New(b.pctx.Zctx, parent, v.Limit, fields, v.Flush), nil
 	case *dag.Fuse:
 		return fuse.New(b.pctx, parent)
 	case *dag.Shape:
@@ -236,6 +259,49 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 		}
 		t := yield.New(parent, exprs)
 		return t, nil
+	case *dag.Output:
+		if _, ok := b.outputs[parent]; ok {
+			return nil, errors.New("output operator must be the last operator of a branch")
+		}
+		b.outputs[parent] = v.Name
+		return parent, nil
+	case *dag.Pivot:
+		if v.Agg == nil {
+			return nil, errors.New("pivot: no aggregation given")
+		}
+		agg, err := b.compileAgg(v.Agg)
+		if err != nil {
+			return nil, fmt.Errorf("compiling pivot: %w", err)
+		}
+		column, err := b.compileExpr(v.Column)
+		if err != nil {
+			return nil, fmt.Errorf("compiling pivot: %w", err)
+		}
+		keys, err := b.compileAssignments(v.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("compiling pivot: %w", err)
+		}
+		return pivot.New(b.pctx, parent, keys, column, agg), nil
+	case *dag.Window:
+		return b.compileWindow(parent, v)
+	case *dag.Unpivot:
+		fields := make(field.List, 0, len(v.Args))
+		for _, e := range v.Args {
+			f, err := compileLval(e)
+			if err != nil {
+				return nil, fmt.Errorf("unpivot: %w", err)
+			}
+			fields = append(fields, f)
+		}
+		name, err := compileLval(v.Name)
+		if err != nil || len(name) != 1 {
+			return nil, errors.New("unpivot: name field must be a top-level field")
+		}
+		value, err := compileLval(v.Value)
+		if err != nil || len(value) != 1 {
+			return nil, errors.New("unpivot: value field must be a top-level field")
+		}
+		return pivot.NewUnpivot(b.pctx.Zctx, parent, fields, name.Leaf(), value.Leaf()), nil
 	case *dag.Let:
 		if v.Over == nil {
 			return nil, errors.New("let operator missing over expression in DAG")
@@ -250,6 +316,87 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 	}
 }
 
+// compileApplier compiles an operator that transforms each value
+// independently into an expr.Applier.
+func (b *Builder) compileApplier(o dag.Op) (expr.Applier, error) {
+	switch v := o.(type) {
+	case *dag.Cut:
+		assignments, err := b.compileAssignments(v.Args)
+		if err != nil {
+			return nil, err
+		}
+		lhs, rhs := splitAssignments(assignments)
+		cutter, err := expr.NewCutter(b.pctx.Zctx, lhs, rhs)
+		if err != nil {
+			return nil, err
+		}
+		if v.Quiet {
+			cutter.Quiet()
+		}
+		return cutter, nil
+	case *dag.Drop:
+		if len(v.Args) == 0 {
+			return nil, errors.New("drop: no fields given")
+		}
+		fields := make(field.List, 0, len(v.Args))
+		for _, e := range v.Args {
+			field, ok := e.(*dag.This)
+			if !ok {
+				return nil, errors.New("drop: arg not a field")
+			}
+			fields = append(fields, field.Path)
+		}
+		dropper := expr.NewDropper(b.pctx.Zctx, fields)
+		return dropper, nil
+	case *dag.Filter:
+		f, err := b.compileExpr(v.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("compiling filter: %w", err)
+		}
+		return expr.NewFilterApplier(b.pctx.Zctx, f), nil
+	case *dag.Put:
+		clauses, err := b.compileAssignments(v.Args)
+		if err != nil {
+			return nil, err
+		}
+		putter, err := expr.NewPutter(b.pctx.Zctx, clauses)
+		if err != nil {
+			return nil, err
+		}
+		return putter, nil
+	case *dag.Rename:
+		var srcs, dsts field.List
+		for _, fa := range v.Args {
+			dst, err := compileLval(fa.LHS)
+			if err != nil {
+				return nil, err
+			}
+			// We call CompileLval on the RHS because renames are
+			// restricted to dotted field name expressions.
+			src, err := compileLval(fa.RHS)
+			if err != nil {
+				return nil, err
+			}
+			if len(dst) != len(src) {
+				return nil, fmt.Errorf("cannot rename %s to %s", src, dst)
+			}
+			// Check that the prefixes match and, if not, report first place
+			// that they don't.
+			for i := 0; i <= len(src)-2; i++ {
+				if src[i] != dst[i] {
+					return nil, fmt.Errorf("cannot rename %s to %s (differ in %s vs %s)", src, dst, src[i], dst[i])
+				}
+			}
+			dsts = append(dsts, dst)
+			srcs = append(srcs, src)
+		}
+		renamer := expr.NewRenamer(b.pctx.Zctx, srcs, dsts)
+		return renamer, nil
+	default:
+		return nil, fmt.Errorf("unknown DAG applier type: %v", v)
+	}
+}
+
 func (b *Builder) compileLets(defs []dag.Def) ([]string, []expr.Evaluator, error) {
 	exprs := make([]expr.Evaluator, 0, len(defs))
 	names := make([]string, 0, len(defs))
@@ -471,6 +618,7 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 		if err != nil {
 			return nil, err
 		}
+		parents = b.measureIn(o, parents)
 		leftParent, rightParent := parents[0], parents[1]
 		var anti, inner bool
 		switch o.Style {
@@ -489,16 +637,21 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 		if err != nil {
 			return nil, err
 		}
-		return []zbuf.Puller{join}, nil
+		return []zbuf.Puller{b.measure(o, join)}, nil
+	case *dag.Try:
+		return b.compileTry(o, parents)
 	case *dag.Merge:
-		e, err := b.compileExpr(o.Expr)
+		exprs, err := b.compileExprs(o.Exprs)
 		if err != nil {
 			return nil, err
 		}
 		nullsMax := o.Order == order.Asc
-		cmp := expr.NewComparator(nullsMax, !nullsMax, e).WithMissingAsNull()
-		return []zbuf.Puller{merge.New(b.pctx, parents, cmp.Compare)}, nil
-	default:
+		cmp := expr.NewComparator(nullsMax, !nullsMax, exprs...).WithMissingAsNull()
+		m := merge.New(b.pctx, b.measureIn(o, parents), cmp.Compare)
+		return []zbuf.Puller{b.measure(o, m)}, nil
+	case *dag.Output:
+		// An output operator has no runtime presence and its parent
+		// must remain the key of b.outputs, so it is not measured.
 		var parent zbuf.Puller
 		if len(parents) == 1 {
 			parent = parents[0]
@@ -510,10 +663,63 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 			return nil, err
 		}
 		return []zbuf.Puller{p}, nil
+	default:
+		var parent zbuf.Puller
+		if len(parents) == 1 {
+			parent = parents[0]
+		} else {
+			parent = combine.New(b.pctx, parents)
+		}
+		p, err := b.compileLeaf(o, b.measureIn(o, []zbuf.Puller{parent})[0])
+		if err != nil {
+			return nil, err
+		}
+		return []zbuf.Puller{b.measure(o, p)}, nil
 	}
 }
 
+// compileTry compiles the body of a try operator into a try.Selector and
+// the catch sequence into a separate output of the flowgraph.
+func (b *Builder) compileTry(t *dag.Try, parents []zbuf.Puller) ([]zbuf.Puller, error) {
+	if err := b.compileFuncs(t.Body.Funcs); err != nil {
+		return nil, err
+	}
+	var stages [][]expr.Evaluator
+	for _, o := range t.Body.Ops {
+		switch o := o.(type) {
+		case *dag.Cut, *dag.Drop, *dag.Filter, *dag.Put, *dag.Rename:
+			applier, err := b.compileApplier(o)
+			if err != nil {
+				return nil, err
+			}
+			stages = append(stages, []expr.Evaluator{applier})
+		case *dag.Yield:
+			exprs, err := b.compileExprs(o.Exprs)
+			if err != nil {
+				return nil, err
+			}
+			stages = append(stages, exprs)
+		default:
+			return nil, errors.New("try: only cut, drop, put, rename, where, and yield may appear in a try body")
+		}
+	}
+	var parent zbuf.Puller
+	if len(parents) == 1 {
+		parent = parents[0]
+	} else {
+		parent = combine.New(b.pctx, parents)
+	}
+	s := try.New(b.pctx, b.measureIn(t, []zbuf.Puller{parent})[0], stages)
+	catches, err := b.compileSequential(t.Catch, []zbuf.Puller{s.Catch()})
+	if err != nil {
+		return nil, err
+	}
+	b.catches = append(b.catches, catches...)
+	return []zbuf.Puller{b.measure(t, s.Main())}, nil
+}
+
 func (b *Builder) compileFrom(from *dag.From, parent zbuf.Puller) ([]zbuf.Puller, error) {
+	b.newSplitters(from)
 	var parents []zbuf.Puller
 	var npass int
 	for k := range from.Trunks {
@@ -540,6 +746,27 @@ func (b *Builder) compileFrom(from *dag.From, parent zbuf.Puller) ([]zbuf.Puller
 	return parents, nil
 }
 
+// newSplitters creates a splitter for each file or URL that is scanned by
+// the parallel instances of a trunk of from, which share the trunk's
+// source pointer.
+func (b *Builder) newSplitters(from *dag.From) {
+	counts := make(map[dag.Source]int)
+	for _, trunk := range from.Trunks {
+		counts[trunk.Source]++
+	}
+	for src, n := range counts {
+		if n < 2 {
+			continue
+		}
+		switch src := src.(type) {
+		case *dag.File:
+			b.splitters[src] = b.source.NewSplitter(b.pctx.Context, b.pctx.Zctx, src.Path, src.Format, n)
+		case *dag.HTTP:
+			b.splitters[src] = b.source.NewSplitter(b.pctx.Context, b.pctx.Zctx, src.URL, src.Format, n)
+		}
+	}
+}
+
 func (b *Builder) compileTrunk(trunk *dag.Trunk, parent zbuf.Puller) ([]zbuf.Puller, error) {
 	pushdown, err := b.PushdownOf(trunk)
 	if err != nil {
@@ -598,18 +825,26 @@ func (b *Builder) compileTrunk(trunk *dag.Trunk, parent zbuf.Puller) ([]zbuf.Pul
 			if err != nil {
 				return nil, err
 			}
-			slicer = meta.NewSlicer(l, zctx, pool.Layout.Order)
+			slicer = meta.NewSlicer(l, zctx, pool.Layout)
 			b.pools[src] = pool
 			b.slicers[src] = slicer
+			b.listers[src] = l
 		}
 		pool := b.pools[src]
+		if b.stats != nil {
+			b.stats.trunk(trunk).lister = b.listers[src]
+		}
 		if src.Delete {
 			if b.deletes == nil {
 				b.deletes = &sync.Map{}
 			}
 			source = meta.NewDeleter(b.pctx, slicer, pool, slicer.Snapshot(), filter, b.progress, b.deletes)
 		} else {
-			source = meta.NewSequenceScanner(b.pctx, slicer, pool, slicer.Snapshot(), filter, b.progress)
+			scanner := meta.NewSequenceScanner(b.pctx, slicer, pool, slicer.Snapshot(), filter, b.progress)
+			if b.stats != nil {
+				b.stats.trunk(trunk).scanners = append(b.stats.trunk(trunk).scanners, scanner)
+			}
+			source = scanner
 		}
 	case *dag.PoolMeta:
 		scanner, err := meta.NewPoolMetaScanner(b.pctx.Context, b.pctx.Zctx, b.source.Lake(), src.ID, src.Meta, pushdown)
@@ -630,12 +865,20 @@ func (b *Builder) compileTrunk(trunk *dag.Trunk, parent zbuf.Puller) ([]zbuf.Pul
 		}
 		source = scanner
 	case *dag.HTTP:
+		if splitter, ok := b.splitters[src]; ok {
+			source = splitter.NewScanner(pushdown)
+			break
+		}
 		puller, err := b.source.Open(b.pctx.Context, b.pctx.Zctx, src.URL, src.Format, pushdown)
 		if err != nil {
 			return nil, err
 		}
 		source = puller
 	case *dag.File:
+		if splitter, ok := b.splitters[src]; ok {
+			source = splitter.NewScanner(pushdown)
+			break
+		}
 		scanner, err := b.source.Open(b.pctx.Context, b.pctx.Zctx, src.Path, src.Format, pushdown)
 		if err != nil {
 			return nil, err
@@ -644,6 +887,9 @@ func (b *Builder) compileTrunk(trunk *dag.Trunk, parent zbuf.Puller) ([]zbuf.Pul
 	default:
 		return nil, fmt.Errorf("Builder.compileTrunk: unknown type: %T", src)
 	}
+	if _, ok := trunk.Source.(*dag.Pass); !ok && b.stats != nil {
+		source = op.Measure(source, &b.stats.trunk(trunk).stats)
+	}
 	if trunk.Seq == nil {
 		return []zbuf.Puller{source}, nil
 	}
@@ -676,14 +922,21 @@ func (b *Builder) compileRange(src dag.Source, exprLower, exprUpper dag.Expr) (e
 }
 
 func (b *Builder) PushdownOf(trunk *dag.Trunk) (*Filter, error) {
+	var partition *dag.Partition
+	if pool, ok := trunk.Source.(*dag.Pool); ok {
+		partition = pool.Partition
+	}
 	if trunk.Pushdown == nil {
-		return nil, nil
+		if trunk.Projection == nil {
+			return nil, nil
+		}
+		return &Filter{projection: trunk.Projection, partition: partition, builder: b}, nil
 	}
 	f, ok := trunk.Pushdown.(*dag.Filter)
 	if !ok {
 		return nil, errors.New("non-filter pushdown operator not yet supported")
 	}
-	return &Filter{f.Expr, b}, nil
+	return &Filter{f.Expr, trunk.Projection, partition, b}, nil
 }
 
 func (b *Builder) evalAtCompileTime(in dag.Expr) (val *zed.Value, err error) {
@@ -715,3 +968,84 @@ func EvalAtCompileTime(zctx *zed.Context, in dag.Expr) (val *zed.Value, err erro
 	b := NewBuilder(op.NewContext(context.Background(), zctx, nil), nil)
 	return b.evalAtCompileTime(in)
 }
+
+func (b *Builder) compileWindow(parent zbuf.Puller, w *dag.Window) (zbuf.Puller, error) {
+	partition, err := b.compileExprs(w.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := b.compileExprs(w.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	var funcs []window.Func
+	for _, f := range w.Funcs {
+		lhs, err := compileLval(f.LHS)
+		if err != nil {
+			return nil, fmt.Errorf("window: %w", err)
+		}
+		args, err := b.compileExprs(f.Args)
+		if err != nil {
+			return nil, err
+		}
+		fn := window.Func{
+			LHS:  lhs,
+			Name: f.Name,
+			Args: args,
+		}
+		fn.Frame, err = compileWindowFrame(f.Frame)
+		if err != nil {
+			return nil, err
+		}
+		switch f.Name {
+		case "row_number", "rank", "dense_rank", "lag", "lead", "first_value", "last_value":
+		default:
+			var arg expr.Evaluator
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			fn.Agg, err = expr.NewAggregator(f.Name, false, arg, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		funcs = append(funcs, fn)
+	}
+	return window.New(b.pctx, parent, partition, orderBy, w.Order, funcs)
+}
+
+func compileWindowFrame(f dag.WindowFrame) (window.Frame, error) {
+	var frame window.Frame
+	switch f.Units {
+	case "rows":
+	case "range":
+		frame.Range = true
+	default:
+		return frame, fmt.Errorf("window: unknown frame units %q", f.Units)
+	}
+	var err error
+	if frame.Start, err = compileWindowBound(f.Start); err != nil {
+		return frame, err
+	}
+	frame.End, err = compileWindowBound(f.End)
+	return frame, err
+}
+
+func compileWindowBound(b dag.WindowBound) (window.Bound, error) {
+	bound := window.Bound{Offset: b.Offset}
+	switch b.Type {
+	case "unbounded_preceding":
+		bound.Type = window.UnboundedPreceding
+	case "preceding":
+		bound.Type = window.Preceding
+	case "current_row":
+		bound.Type = window.CurrentRow
+	case "following":
+		bound.Type = window.Following
+	case "unbounded_following":
+		bound.Type = window.UnboundedFollowing
+	default:
+		return bound, fmt.Errorf("window: unknown frame bound %q", b.Type)
+	}
+	return bound, nil
+}
@@ -0,0 +1,127 @@
+package kernel
+
+import (
+	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/runtime/op/meta"
+	"github.com/brimdata/zed/zbuf"
+)
+
+// Stats collects the runtime statistics of a flowgraph keyed by the DAG
+// operators and trunks from which the Builder compiled it.
+type Stats struct {
+	ops    map[dag.Op]*opStats
+	trunks map[*dag.Trunk]*trunkStats
+}
+
+type opStats struct {
+	stats   op.Stats
+	pullers []zbuf.Puller
+}
+
+type trunkStats struct {
+	stats    op.Stats
+	lister   *meta.Lister
+	scanners []*meta.SequenceScanner
+}
+
+// SourceStats holds the runtime statistics of the data source of a trunk.
+// ObjectsTotal, ObjectsPruned, and the ScanStats are set only for pool
+// sources.
+type SourceStats struct {
+	op.Stats
+	meta.ScanStats
+	ObjectsTotal  int64
+	ObjectsPruned int64
+}
+
+func newStats() *Stats {
+	return &Stats{
+		ops:    make(map[dag.Op]*opStats),
+		trunks: make(map[*dag.Trunk]*trunkStats),
+	}
+}
+
+// Op returns the statistics of the operator compiled from o.  The values in
+// and out and the wall time of an operator compiled more than once are
+// summed, as are its bytes spilled, while its peak memory is the largest
+// of any instance.
+func (s *Stats) Op(o dag.Op) (op.Stats, bool) {
+	st, ok := s.ops[o]
+	if !ok {
+		return op.Stats{}, false
+	}
+	stats := st.stats.Copy()
+	for _, p := range st.pullers {
+		if r, ok := p.(op.Resourcer); ok {
+			stats.BytesSpilled += r.BytesSpilled()
+			if peak := r.PeakMemory(); peak > stats.PeakMemory {
+				stats.PeakMemory = peak
+			}
+		}
+	}
+	return stats, true
+}
+
+// Source returns the statistics of the data source of trunk.
+func (s *Stats) Source(trunk *dag.Trunk) (SourceStats, bool) {
+	ts, ok := s.trunks[trunk]
+	if !ok {
+		return SourceStats{}, false
+	}
+	stats := SourceStats{Stats: ts.stats.Copy()}
+	if ts.lister != nil {
+		total, pruned := ts.lister.Pruned()
+		stats.ObjectsTotal = int64(total)
+		stats.ObjectsPruned = int64(pruned)
+	}
+	for _, scanner := range ts.scanners {
+		scan := scanner.ScanStats()
+		stats.Objects += scan.Objects
+		stats.Seeks += scan.Seeks
+		stats.BytesSkipped += scan.BytesSkipped
+	}
+	return stats, true
+}
+
+func (s *Stats) op(o dag.Op) *opStats {
+	st, ok := s.ops[o]
+	if !ok {
+		st = &opStats{}
+		s.ops[o] = st
+	}
+	return st
+}
+
+func (s *Stats) trunk(trunk *dag.Trunk) *trunkStats {
+	ts, ok := s.trunks[trunk]
+	if !ok {
+		ts = &trunkStats{}
+		s.trunks[trunk] = ts
+	}
+	return ts
+}
+
+// measureIn wraps each parent of o so that the values o pulls are counted.
+func (b *Builder) measureIn(o dag.Op, parents []zbuf.Puller) []zbuf.Puller {
+	if b.stats == nil {
+		return parents
+	}
+	st := b.stats.op(o)
+	out := make([]zbuf.Puller, 0, len(parents))
+	for _, p := range parents {
+		out = append(out, op.MeasureIn(p, &st.stats))
+	}
+	return out
+}
+
+// measure wraps p, which was compiled from o, so that the values it
+// emits and the time spent pulling from it are recorded.
+func (b *Builder) measure(o dag.Op, p zbuf.Puller) zbuf.Puller {
+	if b.stats == nil {
+		return p
+	}
+	st := b.stats.op(o)
+	st.pullers = append(st.pullers, p)
+	return op.Measure(p, &st.stats)
+}
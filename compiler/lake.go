@@ -52,7 +52,7 @@ func (l *lakeCompiler) NewLakeQuery(pctx *op.Context, program ast.Op, parallelis
 	if err := job.Build(); err != nil {
 		return nil, err
 	}
-	return zedruntime.NewQuery(job.pctx, job.Puller(), job.builder.Meter()), nil
+	return zedruntime.NewQuery(job.pctx, job.Puller(), job.builder.Meter(), job.Channels(), job.Explain), nil
 }
 
 func (l *lakeCompiler) NewLakeDeleteQuery(pctx *op.Context, program ast.Op, head *lakeparse.Commitish) (*zedruntime.DeleteQuery, error) {
@@ -80,6 +80,9 @@ func (InvalidDeleteWhereQuery) Error() string {
 
 func newDeleteJob(pctx *op.Context, inAST ast.Op, src *data.Source, head *lakeparse.Commitish) (*Job, error) {
 	parserAST := ast.Copy(inAST)
+	if _, ok := parserAST.(ast.Statement); ok {
+		return nil, ErrStatement
+	}
 	seq, ok := parserAST.(*ast.Sequential)
 	if !ok {
 		return nil, fmt.Errorf("internal error: AST must begin with a Sequential op: %T", parserAST)
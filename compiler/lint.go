@@ -0,0 +1,75 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler/ast"
+	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/compiler/data"
+	"github.com/brimdata/zed/compiler/kernel"
+	"github.com/brimdata/zed/compiler/lint"
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/storage"
+	zedruntime "github.com/brimdata/zed/runtime"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zio"
+	"github.com/segmentio/ksuid"
+)
+
+// Lint infers the types of the values flowing through program and returns
+// warnings about the likely mistakes these types reveal (see lint.Lint).
+// The types of the values read from pools are those of the values in the
+// scanned commit of each pool and the types of the values read from the
+// implied input of a program without a from operator are input.  The types
+// read from any other source are unknown.  If lk is nil, the program may
+// not reference pools.  The types in input must belong to zctx.
+func Lint(ctx context.Context, zctx *zed.Context, program ast.Op, lk *lake.Root, head *lakeparse.Commitish, input []zed.Type) ([]string, error) {
+	pctx := op.NewContext(ctx, zctx, nil)
+	defer pctx.Cancel()
+	var engine storage.Engine = storage.NewRemoteEngine()
+	if lk == nil {
+		engine = storage.NewLocalEngine()
+	}
+	job, err := NewJob(pctx, program, data.NewSource(engine, lk), head)
+	if err != nil {
+		return nil, err
+	}
+	inputs := func(src dag.Source) ([]zed.Type, error) {
+		switch src := src.(type) {
+		case *kernel.Reader:
+			return input, nil
+		case *dag.Pool:
+			return poolTypes(ctx, pctx.Zctx, lk, src)
+		}
+		return nil, nil
+	}
+	return lint.Lint(pctx.Zctx, job.optimizer.Entry(), inputs)
+}
+
+// poolTypes returns the types of the values in the commit of pool scanned
+// by src.
+func poolTypes(ctx context.Context, zctx *zed.Context, lk *lake.Root, src *dag.Pool) ([]zed.Type, error) {
+	if src.Commit == ksuid.Nil {
+		// The pool has no commits and hence no values.
+		return []zed.Type{}, nil
+	}
+	query := fmt.Sprintf("from %s@%s | count() by typ:=typeof(this) | yield typ", src.ID, src.Commit)
+	program, err := Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	q, err := zedruntime.CompileLakeQuery(ctx, zctx, NewLakeCompiler(lk), program, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+	var vals zbuf.Array
+	if err := zio.Copy(&vals, q.AsReader()); err != nil {
+		return nil, err
+	}
+	return lint.TypesOf(zctx, vals.Values())
+}
@@ -0,0 +1,706 @@
+// Package lint infers the types of the values that flow through a Zed DAG
+// from the types of the values scanned by its sources and reports likely
+// mistakes in the query that these types reveal.
+package lint
+
+import (
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/zfmt"
+	"github.com/brimdata/zed/zson"
+)
+
+// Inputs returns the types of the values scanned by a source or nil if they
+// are not known.
+type Inputs func(dag.Source) ([]zed.Type, error)
+
+// Lint returns warnings about references to fields that exist in none of the
+// types of the values reaching them, comparisons of values whose types
+// never match, aggregations of values whose types are never numeric, and
+// cases of switch operators that are never reached.  Type inference along a
+// path ends at a source whose types are unknown and at an operator whose
+// output types cannot be inferred, and an unknown type, e.g., the type of a
+// field computed by a function, is represented by the null type so that
+// nothing is reported about it.
+func Lint(zctx *zed.Context, seq *dag.Sequential, inputs Inputs) ([]string, error) {
+	l := &linter{
+		zctx:   zctx,
+		inputs: inputs,
+		funcs:  make(map[string]struct{}),
+		seen:   make(map[string]struct{}),
+	}
+	if _, err := l.seq(seq, nil); err != nil {
+		return nil, err
+	}
+	return l.warnings, nil
+}
+
+type linter struct {
+	zctx     *zed.Context
+	inputs   Inputs
+	funcs    map[string]struct{}
+	warnings []string
+	seen     map[string]struct{}
+}
+
+func (l *linter) warn(msg string) {
+	if _, ok := l.seen[msg]; !ok {
+		l.seen[msg] = struct{}{}
+		l.warnings = append(l.warnings, msg)
+	}
+}
+
+// seq returns the types of the output of seq given the types of its input,
+// where nil means the types are unknown.
+func (l *linter) seq(seq *dag.Sequential, types []zed.Type) ([]zed.Type, error) {
+	if seq == nil {
+		return types, nil
+	}
+	for _, f := range seq.Funcs {
+		l.funcs[f.Name] = struct{}{}
+	}
+	for _, o := range seq.Ops {
+		var err error
+		if types, err = l.op(o, types); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+func (l *linter) op(o dag.Op, types []zed.Type) ([]zed.Type, error) {
+	switch o := o.(type) {
+	case *dag.Sequential:
+		return l.seq(o, types)
+	case *dag.Parallel:
+		out := []zed.Type{}
+		for _, branch := range o.Ops {
+			t, err := l.op(branch, types)
+			if err != nil {
+				return nil, err
+			}
+			out = union(out, t)
+		}
+		return out, nil
+	case *dag.From:
+		out := []zed.Type{}
+		for _, trunk := range o.Trunks {
+			t := types
+			if _, ok := trunk.Source.(*dag.Pass); !ok {
+				var err error
+				if t, err = l.inputs(trunk.Source); err != nil {
+					return nil, err
+				}
+			}
+			t, err := l.seq(trunk.Seq, t)
+			if err != nil {
+				return nil, err
+			}
+			out = union(out, t)
+		}
+		return out, nil
+	case *dag.Switch:
+		return l.switchOp(o, types)
+	}
+	if types == nil {
+		// Operators nested in o are checked with unknown input types
+		// so that user functions are recorded.
+		l.nested(o)
+		return nil, nil
+	}
+	c := l.newCheck()
+	switch o := o.(type) {
+	case *dag.Filter:
+		c.exprs(types, o.Expr)
+		c.report()
+		return types, nil
+	case *dag.Head:
+		c.exprs(types, o.Keys...)
+		c.report()
+		return types, nil
+	case *dag.Tail:
+		c.exprs(types, o.Keys...)
+		c.report()
+		return types, nil
+	case *dag.Sort:
+		c.exprs(types, o.Args...)
+		c.report()
+		return types, nil
+	case *dag.Top:
+		c.exprs(types, o.Args...)
+		c.exprs(types, o.Keys...)
+		c.report()
+		return types, nil
+	case *dag.Merge:
+		c.exprs(types, o.Exprs...)
+		c.report()
+		return types, nil
+	case *dag.Pass, *dag.Uniq, *dag.Output:
+		return types, nil
+	case *dag.Sample:
+		c.exprs(types, o.Keys...)
+		c.report()
+		return types, nil
+	case *dag.Cut:
+		return c.assign(types, o.Args, true), nil
+	case *dag.Pick:
+		// Pick drops values that lack the fields, which may be
+		// missing in some of the types.
+		return c.assign(types, o.Args, true), nil
+	case *dag.Put:
+		return c.assign(types, o.Args, false), nil
+	case *dag.Drop:
+		out := []zed.Type{}
+		for _, t := range types {
+			for _, e := range o.Args {
+				c.typeOf(e, t)
+				if this, ok := e.(*dag.This); ok {
+					t = l.drop(t, this.Path)
+				}
+			}
+			out = union(out, []zed.Type{t})
+		}
+		c.report()
+		return out, nil
+	case *dag.Rename:
+		out := []zed.Type{}
+		for _, t := range types {
+			for _, a := range o.Args {
+				src, ok1 := a.RHS.(*dag.This)
+				dst, ok2 := a.LHS.(*dag.This)
+				if !ok1 || !ok2 {
+					t = zed.TypeNull
+					continue
+				}
+				typ := c.typeOf(src, t)
+				t = l.put(l.drop(t, src.Path), dst.Path, typ)
+			}
+			out = union(out, []zed.Type{t})
+		}
+		c.report()
+		return out, nil
+	case *dag.Yield:
+		out := []zed.Type{}
+		for _, t := range types {
+			for _, e := range o.Exprs {
+				out = union(out, []zed.Type{c.typeOf(e, t)})
+			}
+		}
+		c.report()
+		return out, nil
+	case *dag.Summarize:
+		return c.summarize(o, types), nil
+	default:
+		l.nested(o)
+		return nil, nil
+	}
+}
+
+// nested records the user functions of the sequences nested in o.
+func (l *linter) nested(o dag.Op) {
+	switch o := o.(type) {
+	case *dag.Over:
+		l.seq(o.Scope, nil)
+	case *dag.Let:
+		if o.Over != nil {
+			l.seq(o.Over.Scope, nil)
+		}
+	case *dag.Try:
+		l.seq(o.Body, nil)
+		l.seq(o.Catch, nil)
+	}
+}
+
+func (l *linter) switchOp(o *dag.Switch, types []zed.Type) ([]zed.Type, error) {
+	c := l.newCheck()
+	if types != nil {
+		c.exprs(types, o.Expr)
+	}
+	values := make(map[string]struct{})
+	var unreachable bool
+	out := []zed.Type{}
+	for k := range o.Cases {
+		e := o.Cases[k].Expr
+		if unreachable {
+			l.warn(fmt.Sprintf("unreachable switch case %s: follows a case that matches every value", caseString(e)))
+		}
+		if lit, ok := e.(*dag.Literal); ok {
+			if o.Expr == nil {
+				switch lit.Value {
+				case "true":
+					unreachable = true
+				case "false":
+					l.warn("unreachable switch case false: never matches")
+				}
+			} else {
+				if _, ok := values[lit.Value]; ok {
+					l.warn(fmt.Sprintf("unreachable switch case %s: duplicates an earlier case", lit.Value))
+				}
+				values[lit.Value] = struct{}{}
+				if types != nil {
+					c.caseLiteral(&o.Cases[k], o.Expr, lit, types)
+				}
+			}
+		} else if types != nil && e != nil {
+			c.exprs(types, e)
+		}
+		t, err := l.op(o.Cases[k].Op, types)
+		if err != nil {
+			return nil, err
+		}
+		out = union(out, t)
+	}
+	c.report()
+	return out, nil
+}
+
+func caseString(e dag.Expr) string {
+	if e == nil {
+		return "default"
+	}
+	return zfmt.DAGExpr(e)
+}
+
+// check accumulates the evidence for each finding about the expressions of
+// an operator over the types of its input so that a finding is reported
+// only if it holds for every type.
+type check struct {
+	*linter
+	findings []*finding
+	index    map[any]*finding
+}
+
+type finding struct {
+	msg  string
+	ok   bool
+	fail bool
+}
+
+func (l *linter) newCheck() *check {
+	return &check{linter: l, index: make(map[any]*finding)}
+}
+
+func (c *check) note(key any, ok bool, msg string) {
+	f := c.index[key]
+	if f == nil {
+		f = &finding{}
+		c.index[key] = f
+		c.findings = append(c.findings, f)
+	}
+	if ok {
+		f.ok = true
+	} else if !f.fail {
+		f.fail = true
+		f.msg = msg
+	}
+}
+
+func (c *check) report() {
+	for _, f := range c.findings {
+		if f.fail && !f.ok {
+			c.warn(f.msg)
+		}
+	}
+}
+
+func (c *check) exprs(types []zed.Type, exprs ...dag.Expr) {
+	for _, t := range types {
+		for _, e := range exprs {
+			if e != nil {
+				c.typeOf(e, t)
+			}
+		}
+	}
+}
+
+func (c *check) assign(types []zed.Type, args []dag.Assignment, cut bool) []zed.Type {
+	out := []zed.Type{}
+	for _, t := range types {
+		typ := t
+		if cut {
+			typ = c.zctx.MustLookupTypeRecord([]zed.Field{})
+		}
+		for _, a := range args {
+			rhs := c.typeOf(a.RHS, t)
+			this, ok := a.LHS.(*dag.This)
+			if !ok {
+				typ = zed.TypeNull
+				continue
+			}
+			typ = c.put(typ, this.Path, rhs)
+		}
+		out = union(out, []zed.Type{typ})
+	}
+	c.report()
+	return out
+}
+
+func (c *check) summarize(o *dag.Summarize, types []zed.Type) []zed.Type {
+	out := []zed.Type{}
+	for _, t := range types {
+		var typ zed.Type = c.zctx.MustLookupTypeRecord([]zed.Field{})
+		for _, a := range o.Keys {
+			typ = c.assignTo(typ, a.LHS, c.typeOf(a.RHS, t))
+		}
+		for _, a := range o.Aggs {
+			agg, ok := a.RHS.(*dag.Agg)
+			if !ok {
+				typ = c.assignTo(typ, a.LHS, zed.TypeNull)
+				continue
+			}
+			if agg.Where != nil {
+				c.typeOf(agg.Where, t)
+			}
+			var arg zed.Type = zed.TypeNull
+			if agg.Expr != nil {
+				arg = c.typeOf(agg.Expr, t)
+			}
+			typ = c.assignTo(typ, a.LHS, c.agg(agg, arg))
+		}
+		out = union(out, []zed.Type{typ})
+	}
+	c.report()
+	return out
+}
+
+func (c *check) assignTo(typ zed.Type, lhs dag.Expr, rhs zed.Type) zed.Type {
+	this, ok := lhs.(*dag.This)
+	if !ok {
+		return zed.TypeNull
+	}
+	return c.put(typ, this.Path, rhs)
+}
+
+// agg returns the type of the result of agg given the type of its argument.
+func (c *check) agg(agg *dag.Agg, arg zed.Type) zed.Type {
+	switch agg.Name {
+	case "count", "dcount":
+		return zed.TypeUint64
+	case "and", "or":
+		return zed.TypeBool
+	case "any":
+		return arg
+	case "collect":
+		if arg == zed.TypeNull {
+			return zed.TypeNull
+		}
+		return c.zctx.LookupTypeArray(arg)
+	case "union":
+		if arg == zed.TypeNull {
+			return zed.TypeNull
+		}
+		return c.zctx.LookupTypeSet(arg)
+	case "sum", "avg", "min", "max":
+		if arg == zed.TypeNull || zed.IsUnionType(zed.TypeUnder(arg)) {
+			return zed.TypeNull
+		}
+		id := zed.TypeUnder(arg).ID()
+		ok := zed.IsNumber(id) || id == zed.IDDuration || id == zed.IDTime
+		c.note(agg, ok, fmt.Sprintf("%s of non-numeric type %s", agg.Name, zson.FormatType(arg)))
+		if !ok {
+			return zed.TypeNull
+		}
+		if agg.Name == "avg" {
+			return zed.TypeFloat64
+		}
+		return arg
+	}
+	return zed.TypeNull
+}
+
+func (c *check) caseLiteral(key *dag.Case, e dag.Expr, lit *dag.Literal, types []zed.Type) {
+	val, err := zson.ParseValue(c.zctx, lit.Value)
+	if err != nil {
+		return
+	}
+	for _, t := range types {
+		typ := c.typeOf(e, t)
+		if typ == zed.TypeNull || val.Type == zed.TypeNull {
+			c.note(key, true, "")
+			continue
+		}
+		c.note(key, comparable(typ, val.Type), fmt.Sprintf("unreachable switch case %s: never matches type %s", lit.Value, zson.FormatType(typ)))
+	}
+}
+
+// typeOf returns the type of e evaluated on a value of type t, which is the
+// null type if it is not known.
+func (c *check) typeOf(e dag.Expr, t zed.Type) zed.Type {
+	switch e := e.(type) {
+	case *dag.This:
+		return c.path(e, t, e.Path)
+	case *dag.Dot:
+		return c.field(e, c.typeOf(e.LHS, t), e.RHS)
+	case *dag.Literal:
+		val, err := zson.ParseValue(c.zctx, e.Value)
+		if err != nil {
+			return zed.TypeNull
+		}
+		return val.Type
+	case *dag.UnaryExpr:
+		typ := c.typeOf(e.Operand, t)
+		if e.Op == "!" {
+			return zed.TypeBool
+		}
+		return typ
+	case *dag.BinaryExpr:
+		return c.binary(e, t)
+	case *dag.Conditional:
+		c.typeOf(e.Cond, t)
+		then := c.typeOf(e.Then, t)
+		if els := c.typeOf(e.Else, t); els != then {
+			return zed.TypeNull
+		}
+		return then
+	case *dag.Call:
+		for _, arg := range e.Args {
+			c.typeOf(arg, t)
+		}
+		if _, ok := c.funcs[e.Name]; ok {
+			return zed.TypeNull
+		}
+		switch e.Name {
+		case "len":
+			return zed.TypeInt64
+		case "typeof":
+			return zed.TypeType
+		}
+		return zed.TypeNull
+	case *dag.RegexpMatch:
+		c.typeOf(e.Expr, t)
+		return zed.TypeBool
+	case *dag.RegexpSearch, *dag.Search:
+		return zed.TypeBool
+	case *dag.RecordExpr:
+		var fields []zed.Field
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *dag.Field:
+				fields = setField(fields, elem.Name, c.typeOf(elem.Value, t))
+			case *dag.Spread:
+				rec, ok := zed.TypeUnder(c.typeOf(elem.Expr, t)).(*zed.TypeRecord)
+				if !ok {
+					return zed.TypeNull
+				}
+				for _, f := range rec.Fields {
+					fields = setField(fields, f.Name, f.Type)
+				}
+			default:
+				return zed.TypeNull
+			}
+		}
+		typ, err := c.zctx.LookupTypeRecord(fields)
+		if err != nil {
+			return zed.TypeNull
+		}
+		return typ
+	case *dag.ArrayExpr:
+		c.vector(e.Elems, t)
+		return zed.TypeNull
+	case *dag.SetExpr:
+		c.vector(e.Elems, t)
+		return zed.TypeNull
+	case *dag.MapExpr:
+		for _, entry := range e.Entries {
+			c.typeOf(entry.Key, t)
+			c.typeOf(entry.Value, t)
+		}
+		return zed.TypeNull
+	}
+	return zed.TypeNull
+}
+
+func (c *check) vector(elems []dag.VectorElem, t zed.Type) {
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.VectorValue:
+			c.typeOf(elem.Expr, t)
+		case *dag.Spread:
+			c.typeOf(elem.Expr, t)
+		}
+	}
+}
+
+func (c *check) binary(e *dag.BinaryExpr, t zed.Type) zed.Type {
+	lhs := c.typeOf(e.LHS, t)
+	rhs := c.typeOf(e.RHS, t)
+	switch e.Op {
+	case "and", "or", "in":
+		return zed.TypeBool
+	case "==", "!=", "<", "<=", ">", ">=":
+		if lhs != zed.TypeNull && rhs != zed.TypeNull {
+			c.note(e, comparable(lhs, rhs), fmt.Sprintf("type mismatch in comparison: %s %s %s", zson.FormatType(lhs), e.Op, zson.FormatType(rhs)))
+		}
+		return zed.TypeBool
+	case "+", "-", "*", "/", "%":
+		if lhs == rhs {
+			return lhs
+		}
+	}
+	return zed.TypeNull
+}
+
+// path returns the type of the value at path in a value of type t and notes
+// whether it exists.
+func (c *check) path(key any, t zed.Type, path field.Path) zed.Type {
+	for _, name := range path {
+		var ok bool
+		if t, ok = fieldOf(t, name); !ok {
+			c.note(key, false, fmt.Sprintf("field %s does not exist", zfmt.DAGExpr(&dag.This{Kind: "This", Path: path})))
+			return zed.TypeNull
+		}
+	}
+	c.note(key, true, "")
+	return t
+}
+
+// field returns the type of the field name of a value of type t and notes
+// whether it exists.
+func (c *check) field(key any, t zed.Type, name string) zed.Type {
+	typ, ok := fieldOf(t, name)
+	c.note(key, ok, fmt.Sprintf("field %s does not exist", zson.QuotedName(name)))
+	return typ
+}
+
+// fieldOf returns the type of the field name of a value of type t and
+// whether the field might exist.  The type is null if it is not known.
+func fieldOf(t zed.Type, name string) (zed.Type, bool) {
+	if t == zed.TypeNull {
+		return t, true
+	}
+	switch typ := zed.TypeUnder(t).(type) {
+	case *zed.TypeRecord:
+		if ftyp, ok := typ.TypeOfField(name); ok {
+			return ftyp, true
+		}
+		return zed.TypeNull, false
+	case *zed.TypeUnion, *zed.TypeMap:
+		return zed.TypeNull, true
+	}
+	return zed.TypeNull, false
+}
+
+// put returns the type of a value of type t with the field at path set to
+// a value of type typ.
+func (l *linter) put(t zed.Type, path field.Path, typ zed.Type) zed.Type {
+	if len(path) == 0 {
+		return typ
+	}
+	rec, ok := zed.TypeUnder(t).(*zed.TypeRecord)
+	if !ok {
+		return zed.TypeNull
+	}
+	fields := append([]zed.Field{}, rec.Fields...)
+	child, _ := rec.TypeOfField(path[0])
+	if len(path) > 1 && child == nil {
+		child = l.zctx.MustLookupTypeRecord([]zed.Field{})
+	}
+	fields = setField(fields, path[0], l.put(child, path[1:], typ))
+	out, err := l.zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return zed.TypeNull
+	}
+	return out
+}
+
+// drop returns the type of a value of type t without the field at path.
+func (l *linter) drop(t zed.Type, path field.Path) zed.Type {
+	rec, ok := zed.TypeUnder(t).(*zed.TypeRecord)
+	if !ok || len(path) == 0 {
+		return t
+	}
+	var fields []zed.Field
+	for _, f := range rec.Fields {
+		if f.Name == path[0] {
+			if len(path) == 1 {
+				continue
+			}
+			f = zed.Field{Name: f.Name, Type: l.drop(f.Type, path[1:])}
+		}
+		fields = append(fields, f)
+	}
+	out, err := l.zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return zed.TypeNull
+	}
+	return out
+}
+
+func setField(fields []zed.Field, name string, typ zed.Type) []zed.Field {
+	for k := range fields {
+		if fields[k].Name == name {
+			fields[k].Type = typ
+			return fields
+		}
+	}
+	return append(fields, zed.Field{Name: name, Type: typ})
+}
+
+// comparable returns true if values of types a and b may be equal.
+func comparable(a, b zed.Type) bool {
+	a, b = zed.TypeUnder(a), zed.TypeUnder(b)
+	if a == b || zed.IsUnionType(a) || zed.IsUnionType(b) {
+		return true
+	}
+	if a == zed.TypeNull || b == zed.TypeNull {
+		return true
+	}
+	aid, bid := a.ID(), b.ID()
+	if zed.IsNumber(aid) && zed.IsNumber(bid) {
+		return true
+	}
+	if zed.IsRecordType(a) && zed.IsRecordType(b) {
+		return true
+	}
+	return isContainer(a) && isContainer(b) && a.Kind() == b.Kind()
+}
+
+func isContainer(t zed.Type) bool {
+	return zed.IsContainerType(t) && !zed.IsRecordType(t)
+}
+
+// union returns the union of the sets of types a and b where a nil set
+// means the types are unknown.
+func union(a, b []zed.Type) []zed.Type {
+	if a == nil || b == nil {
+		return nil
+	}
+	out := a
+	for _, t := range b {
+		if t == zed.TypeNull {
+			// A value of unknown type makes the set unknown.
+			return nil
+		}
+		found := false
+		for _, u := range out {
+			if u == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// TypesOf returns the distinct types of vals, where a value of type type
+// stands for the type it denotes.
+func TypesOf(zctx *zed.Context, vals []zed.Value) ([]zed.Type, error) {
+	types := []zed.Type{}
+	for _, val := range vals {
+		typ := val.Type
+		if typ == zed.TypeType {
+			var err error
+			if typ, err = zctx.LookupByValue(val.Bytes); err != nil {
+				return nil, err
+			}
+		}
+		types = union(types, []zed.Type{typ})
+	}
+	return types, nil
+}
@@ -0,0 +1,429 @@
+package optimizer
+
+import (
+	"sort"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/compiler/kernel"
+	"github.com/brimdata/zed/runtime/expr/function"
+	"golang.org/x/exp/maps"
+)
+
+// demand is a set of top-level field names that are referenced by the
+// operators downstream of some point in a flowgraph.  A nil demand means
+// that every field may be referenced.
+type demand map[string]struct{}
+
+func (d demand) union(other demand) demand {
+	if d == nil || other == nil {
+		return nil
+	}
+	out := make(demand, len(d)+len(other))
+	for name := range d {
+		out[name] = struct{}{}
+	}
+	for name := range other {
+		out[name] = struct{}{}
+	}
+	return out
+}
+
+func (d demand) without(names ...string) demand {
+	if d == nil {
+		return nil
+	}
+	out := make(demand, len(d))
+	for name := range d {
+		out[name] = struct{}{}
+	}
+	for _, name := range names {
+		delete(out, name)
+	}
+	return out
+}
+
+// projector computes the projection of each trunk from the demand of the
+// operators downstream of it.
+type projector struct {
+	zctx  *zed.Context
+	funcs map[string]struct{}
+	o     *Optimizer
+}
+
+// projectTrunks sets the Projection of each trunk of the from operators
+// in seq whose source can be projected.
+func (o *Optimizer) projectTrunks(seq *dag.Sequential) {
+	p := &projector{
+		zctx:  zed.NewContext(),
+		funcs: make(map[string]struct{}),
+		o:     o,
+	}
+	p.seq(seq, nil)
+}
+
+func (p *projector) seq(seq *dag.Sequential, d demand) demand {
+	if seq == nil {
+		return d
+	}
+	for _, f := range seq.Funcs {
+		p.funcs[f.Name] = struct{}{}
+	}
+	ops := seq.Ops
+	for k := len(ops) - 1; k >= 0; k-- {
+		if join, ok := ops[k].(*dag.Join); ok && k > 0 {
+			d = p.fanIn(ops[k-1], p.join(join, d))
+			k--
+			continue
+		}
+		d = p.op(ops[k], d)
+	}
+	return d
+}
+
+// join returns the demands of the two parents of join given the demand
+// of its output.
+func (p *projector) join(join *dag.Join, d demand) []demand {
+	preserved, other := 0, 1
+	leftKey, rightKey := join.LeftKey, join.RightKey
+	if join.Style == "right" {
+		preserved, other = 1, 0
+		leftKey, rightKey = rightKey, leftKey
+	}
+	// The fields assigned from the other parent are kept in the preserved
+	// parent's demand as the join fails if they are present in both.
+	assigned := demand{}
+	for _, a := range join.Args {
+		this, ok := a.LHS.(*dag.This)
+		if !ok || len(this.Path) == 0 {
+			return []demand{nil, nil}
+		}
+		assigned[this.Path[0]] = struct{}{}
+	}
+	demands := make([]demand, 2)
+	demands[preserved] = d.union(assigned).union(p.expr(leftKey))
+	demands[other] = p.rhs(join.Args).union(p.expr(rightKey))
+	return demands
+}
+
+// fanIn returns the demand of the input of o given the demand of each of
+// its outputs, which is a single demand shared by all outputs unless o
+// feeds a join.
+func (p *projector) fanIn(o dag.Op, demands []demand) demand {
+	at := func(k int) demand {
+		if len(demands) == 1 {
+			return demands[0]
+		}
+		return demands[k]
+	}
+	switch o := o.(type) {
+	case *dag.Parallel:
+		if len(demands) != 1 && len(demands) != len(o.Ops) {
+			return nil
+		}
+		out := demand{}
+		for k, branch := range o.Ops {
+			out = out.union(p.op(branch, at(k)))
+		}
+		return out
+	case *dag.From:
+		if len(demands) != 1 && len(demands) != len(o.Trunks) {
+			return nil
+		}
+		// Sources consume no input except a pass source, which
+		// scans the input of the from operator.
+		out := demand{}
+		for k := range o.Trunks {
+			trunk := &o.Trunks[k]
+			d := p.seq(trunk.Seq, at(k))
+			if _, ok := trunk.Source.(*dag.Pass); ok {
+				out = out.union(d)
+				continue
+			}
+			p.project(trunk, d)
+		}
+		return out
+	case *dag.Switch:
+		if len(demands) != 1 && len(demands) != len(o.Cases) {
+			return nil
+		}
+		out := p.expr(o.Expr)
+		for k, c := range o.Cases {
+			out = out.union(p.op(c.Op, at(k))).union(p.expr(c.Expr))
+		}
+		return out
+	}
+	if len(demands) != 1 {
+		return nil
+	}
+	return p.op(o, demands[0])
+}
+
+func (p *projector) project(trunk *dag.Trunk, d demand) {
+	trunk.Projection = nil
+	switch src := trunk.Source.(type) {
+	case *dag.Pool:
+		if src.Delete {
+			return
+		}
+		// The scanner merges overlapping objects by the pool key.
+		layout := p.o.source.Layout(p.o.ctx, src)
+		if layout.IsNil() {
+			return
+		}
+		for _, key := range layout.Keys {
+			if len(key) == 0 {
+				return
+			}
+			d = d.union(demand{key[0]: {}})
+		}
+	case *dag.File, *dag.HTTP, *kernel.Reader:
+	default:
+		return
+	}
+	if trunk.Pushdown != nil {
+		d = p.op(trunk.Pushdown, d)
+	}
+	if d == nil {
+		return
+	}
+	names := maps.Keys(d)
+	sort.Strings(names)
+	trunk.Projection = names
+}
+
+// op returns the demand of the input of o given the demand of its output.
+func (p *projector) op(o dag.Op, d demand) demand {
+	switch o := o.(type) {
+	case *dag.Sequential:
+		return p.seq(o, d)
+	case *dag.Parallel, *dag.From, *dag.Switch:
+		return p.fanIn(o, []demand{d})
+	case *dag.Pass:
+		return d
+	case *dag.Filter:
+		return d.union(p.expr(o.Expr))
+	case *dag.Head:
+		return d.union(p.exprs(o.Keys))
+	case *dag.Tail:
+		return d.union(p.exprs(o.Keys))
+	case *dag.Merge:
+		return d.union(p.exprs(o.Exprs))
+	case *dag.Sort:
+		if len(o.Args) == 0 {
+			// The sort key is guessed from the values.
+			return nil
+		}
+		return d.union(p.exprs(o.Args))
+	case *dag.Window:
+		// The fields assigned by the window functions are kept in case
+		// they would otherwise replace demanded fields of the input.
+		out := d.union(p.exprs(o.PartitionBy)).union(p.exprs(o.OrderBy))
+		for _, f := range o.Funcs {
+			out = out.union(p.exprs(f.Args))
+		}
+		return out
+	case *dag.Top:
+		if len(o.Args) == 0 {
+			return nil
+		}
+		return d.union(p.exprs(o.Args)).union(p.exprs(o.Keys))
+	case *dag.Cut:
+		return p.rhs(o.Args)
+	case *dag.Pick:
+		return p.rhs(o.Args)
+	case *dag.Yield:
+		return p.exprs(o.Exprs)
+	case *dag.Summarize:
+		out := p.rhs(o.Keys)
+		for _, a := range o.Aggs {
+			agg, ok := a.RHS.(*dag.Agg)
+			if !ok {
+				return nil
+			}
+			out = out.union(p.expr(agg.Expr)).union(p.expr(agg.Where))
+		}
+		return out
+	case *dag.Put:
+		lhs, ok := assignedFields(o.Args)
+		if !ok {
+			return nil
+		}
+		return d.without(lhs...).union(p.rhs(o.Args))
+	case *dag.Rename:
+		// A renamed field and a field it would replace are both kept
+		// so that rename behaves the same on projected values.
+		out := d
+		for _, a := range o.Args {
+			dst, ok := dag.TopLevelField(a.LHS)
+			if !ok {
+				return nil
+			}
+			src, ok := dag.TopLevelField(a.RHS)
+			if !ok {
+				return nil
+			}
+			out = out.union(demand{dst: {}, src: {}})
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func (p *projector) rhs(assignments []dag.Assignment) demand {
+	out := demand{}
+	for _, a := range assignments {
+		out = out.union(p.expr(a.RHS))
+	}
+	return out
+}
+
+func (p *projector) exprs(exprs []dag.Expr) demand {
+	out := demand{}
+	for _, e := range exprs {
+		out = out.union(p.expr(e))
+	}
+	return out
+}
+
+// expr returns the top-level fields referenced by e.
+func (p *projector) expr(e dag.Expr) demand {
+	if e == nil {
+		return demand{}
+	}
+	out := demand{}
+	ok := visitFields(p.zctx, p.funcs, e, func(this *dag.This) {
+		out[this.Path[0]] = struct{}{}
+	})
+	if !ok {
+		return nil
+	}
+	return out
+}
+
+// assignedFields returns the top-level fields assigned by assignments.
+// A nested field is not included since the other fields of its
+// enclosing record are not assigned.
+func assignedFields(assignments []dag.Assignment) ([]string, bool) {
+	var names []string
+	for _, a := range assignments {
+		this, ok := a.LHS.(*dag.This)
+		if !ok || len(this.Path) == 0 {
+			return nil, false
+		}
+		if len(this.Path) == 1 {
+			names = append(names, this.Path[0])
+		}
+	}
+	return names, true
+}
+
+// visitFields calls visit for each field reference in e and returns true
+// if the references are all known, i.e., e does not refer to this as a
+// whole or to fields that cannot be determined statically.  The This
+// passed to visit always has a non-empty path.
+func visitFields(zctx *zed.Context, funcs map[string]struct{}, e dag.Expr, visit func(*dag.This)) bool {
+	var walk func(dag.Expr) bool
+	walks := func(exprs ...dag.Expr) bool {
+		for _, e := range exprs {
+			if e != nil && !walk(e) {
+				return false
+			}
+		}
+		return true
+	}
+	walk = func(e dag.Expr) bool {
+		switch e := e.(type) {
+		case *dag.Literal, *dag.Var:
+			return true
+		case *dag.This:
+			if len(e.Path) == 0 {
+				return false
+			}
+			visit(e)
+			return true
+		case *dag.Dot:
+			return walk(e.LHS)
+		case *dag.UnaryExpr:
+			return walk(e.Operand)
+		case *dag.BinaryExpr:
+			return walks(e.LHS, e.RHS)
+		case *dag.Conditional:
+			return walks(e.Cond, e.Then, e.Else)
+		case *dag.RegexpMatch:
+			return walk(e.Expr)
+		case *dag.Call:
+			if _, ok := funcs[e.Name]; ok {
+				return false
+			}
+			switch e.Name {
+			case "cast", "crop", "fill", "order", "shape":
+				if len(e.Args) != 2 {
+					return false
+				}
+				return walks(e.Args...)
+			}
+			_, path, err := function.New(zctx, e.Name, len(e.Args))
+			if err != nil {
+				return false
+			}
+			if path != nil {
+				// The function has an implied field argument.
+				if len(path) == 0 {
+					return false
+				}
+				visit(&dag.This{Kind: "This", Path: path})
+			}
+			return walks(e.Args...)
+		case *dag.RecordExpr:
+			for _, elem := range e.Elems {
+				switch elem := elem.(type) {
+				case *dag.Field:
+					if !walk(elem.Value) {
+						return false
+					}
+				case *dag.Spread:
+					if !walk(elem.Expr) {
+						return false
+					}
+				default:
+					return false
+				}
+			}
+			return true
+		case *dag.ArrayExpr:
+			return walkVector(walk, e.Elems)
+		case *dag.SetExpr:
+			return walkVector(walk, e.Elems)
+		case *dag.MapExpr:
+			for _, entry := range e.Entries {
+				if !walks(entry.Key, entry.Value) {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	}
+	return walk(e)
+}
+
+func walkVector(walk func(dag.Expr) bool, elems []dag.VectorElem) bool {
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.VectorValue:
+			if !walk(elem.Expr) {
+				return false
+			}
+		case *dag.Spread:
+			if !walk(elem.Expr) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,503 @@
+package optimizer
+
+import (
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/compiler/kernel"
+	"github.com/brimdata/zed/runtime/expr/function"
+	"github.com/brimdata/zed/zson"
+)
+
+// simplify rewrites seq in place by folding constant subexpressions into
+// literals, simplifying boolean logic, and removing the dead branches of
+// switch operators.  Constants are folded by the same evaluators used at
+// runtime, so folding never changes the value of an expression.  Identities
+// like x+0 are not applied since the type of x is not known here and
+// arithmetic coerces its operands.
+func simplify(seq *dag.Sequential) {
+	f := &folder{
+		zctx:  zed.NewContext(),
+		funcs: make(map[string]struct{}),
+	}
+	f.seq(seq)
+}
+
+type folder struct {
+	zctx *zed.Context
+	// funcs holds the names of user-defined functions, whose calls are
+	// not folded.
+	funcs map[string]struct{}
+}
+
+func (f *folder) seq(seq *dag.Sequential) {
+	if seq == nil {
+		return
+	}
+	for _, fn := range seq.Funcs {
+		f.funcs[fn.Name] = struct{}{}
+	}
+	for k := range seq.Consts {
+		seq.Consts[k].Expr = f.expr(seq.Consts[k].Expr)
+	}
+	for _, fn := range seq.Funcs {
+		fn.Expr = f.expr(fn.Expr)
+	}
+	for _, o := range seq.Ops {
+		f.op(o)
+	}
+	var ops []dag.Op
+	for k, o := range seq.Ops {
+		if swtch, ok := o.(*dag.Switch); ok && isPrunable(seq.Ops, k) {
+			if branch := pruneSwitch(f.zctx, swtch); branch != nil {
+				ops = append(ops, branch...)
+				continue
+			}
+		}
+		ops = append(ops, o)
+	}
+	seq.Ops = ops
+}
+
+func (f *folder) op(o dag.Op) {
+	switch o := o.(type) {
+	case *dag.Sequential:
+		f.seq(o)
+	case *dag.Parallel:
+		for _, o := range o.Ops {
+			f.op(o)
+		}
+	case *dag.Switch:
+		o.Expr = f.expr(o.Expr)
+		for k := range o.Cases {
+			o.Cases[k].Expr = f.expr(o.Cases[k].Expr)
+			f.op(o.Cases[k].Op)
+		}
+	case *dag.From:
+		for k := range o.Trunks {
+			trunk := &o.Trunks[k]
+			f.seq(trunk.Seq)
+			if trunk.Pushdown != nil {
+				f.op(trunk.Pushdown)
+			}
+		}
+	case *dag.Filter:
+		o.Expr = f.expr(o.Expr)
+	case *dag.Cut:
+		f.assignments(o.Args)
+	case *dag.Put:
+		f.assignments(o.Args)
+	case *dag.Explode:
+		f.exprs(o.Args)
+	case *dag.Head:
+		f.exprs(o.Keys)
+	case *dag.Tail:
+		f.exprs(o.Keys)
+	case *dag.Sample:
+		f.exprs(o.Keys)
+	case *dag.Join:
+		o.LeftKey = f.expr(o.LeftKey)
+		o.RightKey = f.expr(o.RightKey)
+		f.assignments(o.Args)
+	case *dag.Pivot:
+		f.expr(o.Agg)
+		o.Column = f.expr(o.Column)
+		f.assignments(o.Keys)
+	case *dag.Sort:
+		f.exprs(o.Args)
+	case *dag.Window:
+		f.exprs(o.PartitionBy)
+		f.exprs(o.OrderBy)
+		for k := range o.Funcs {
+			f.exprs(o.Funcs[k].Args)
+		}
+	case *dag.Summarize:
+		f.assignments(o.Keys)
+		f.assignments(o.Aggs)
+	case *dag.Top:
+		f.exprs(o.Args)
+		f.exprs(o.Keys)
+	case *dag.Let:
+		for k := range o.Defs {
+			o.Defs[k].Expr = f.expr(o.Defs[k].Expr)
+		}
+		f.op(o.Over)
+	case *dag.Over:
+		f.exprs(o.Exprs)
+		f.seq(o.Scope)
+	case *dag.Try:
+		f.seq(o.Body)
+		f.seq(o.Catch)
+	case *dag.Unpivot:
+		f.exprs(o.Args)
+	case *dag.Yield:
+		f.exprs(o.Exprs)
+	}
+}
+
+// assignments folds the right-hand side of each assignment.  The
+// left-hand sides are paths and are left alone.
+func (f *folder) assignments(assignments []dag.Assignment) {
+	for k := range assignments {
+		assignments[k].RHS = f.expr(assignments[k].RHS)
+	}
+}
+
+func (f *folder) exprs(exprs []dag.Expr) {
+	for k := range exprs {
+		exprs[k] = f.expr(exprs[k])
+	}
+}
+
+func (f *folder) expr(e dag.Expr) dag.Expr {
+	switch e := e.(type) {
+	case nil:
+		return nil
+	case *dag.Literal:
+		return e
+	case *dag.BinaryExpr:
+		e.LHS = f.expr(e.LHS)
+		e.RHS = f.expr(e.RHS)
+		if out := simplifyLogic(e); out != nil {
+			return out
+		}
+	case *dag.UnaryExpr:
+		e.Operand = f.expr(e.Operand)
+		if inner, ok := e.Operand.(*dag.UnaryExpr); ok && e.Op == "!" && inner.Op == "!" && isPredicate(inner.Operand) {
+			return inner.Operand
+		}
+	case *dag.Conditional:
+		e.Cond = f.expr(e.Cond)
+		e.Then = f.expr(e.Then)
+		e.Else = f.expr(e.Else)
+		switch {
+		case isLiteralBool(e.Cond, true):
+			return e.Then
+		case isLiteralBool(e.Cond, false):
+			return e.Else
+		}
+	case *dag.Call:
+		f.exprs(e.Args)
+	case *dag.Dot:
+		e.LHS = f.expr(e.LHS)
+	case *dag.RecordExpr:
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *dag.Field:
+				elem.Value = f.expr(elem.Value)
+			case *dag.Spread:
+				elem.Expr = f.expr(elem.Expr)
+			}
+		}
+	case *dag.ArrayExpr:
+		f.vectorElems(e.Elems)
+	case *dag.SetExpr:
+		f.vectorElems(e.Elems)
+	case *dag.MapExpr:
+		for k := range e.Entries {
+			e.Entries[k].Key = f.expr(e.Entries[k].Key)
+			e.Entries[k].Value = f.expr(e.Entries[k].Value)
+		}
+	case *dag.RegexpMatch:
+		e.Expr = f.expr(e.Expr)
+	case *dag.RegexpSearch:
+		e.Expr = f.expr(e.Expr)
+	case *dag.Search:
+		e.Expr = f.expr(e.Expr)
+	case *dag.Agg:
+		e.Expr = f.expr(e.Expr)
+		e.Where = f.expr(e.Where)
+		return e
+	case *dag.OverExpr:
+		for k := range e.Defs {
+			e.Defs[k].Expr = f.expr(e.Defs[k].Expr)
+		}
+		f.exprs(e.Exprs)
+		f.seq(e.Scope)
+		return e
+	default:
+		return e
+	}
+	return f.fold(e)
+}
+
+func (f *folder) vectorElems(elems []dag.VectorElem) {
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.VectorValue:
+			elem.Expr = f.expr(elem.Expr)
+		case *dag.Spread:
+			elem.Expr = f.expr(elem.Expr)
+		}
+	}
+}
+
+// fold evaluates e and returns the result as a literal if e is constant.
+// Expressions that evaluate to an error are left as written so that the
+// error is reported where the expression appears.
+func (f *folder) fold(e dag.Expr) dag.Expr {
+	if !f.isConst(e) {
+		return e
+	}
+	val, err := kernel.EvalAtCompileTime(f.zctx, e)
+	if err != nil || val.IsError() {
+		return e
+	}
+	s, err := zson.FormatValue(val)
+	if err != nil {
+		return e
+	}
+	return &dag.Literal{Kind: "Literal", Value: s}
+}
+
+func (f *folder) isConst(e dag.Expr) bool {
+	switch e := e.(type) {
+	case *dag.Literal:
+		return true
+	case *dag.BinaryExpr:
+		return f.isConst(e.LHS) && f.isConst(e.RHS)
+	case *dag.UnaryExpr:
+		return f.isConst(e.Operand)
+	case *dag.Conditional:
+		return f.isConst(e.Cond) && f.isConst(e.Then) && f.isConst(e.Else)
+	case *dag.Dot:
+		return f.isConst(e.LHS)
+	case *dag.Call:
+		if !f.isFoldable(e) {
+			return false
+		}
+		for _, arg := range e.Args {
+			if !f.isConst(arg) {
+				return false
+			}
+		}
+		return true
+	case *dag.RecordExpr:
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *dag.Field:
+				if !f.isConst(elem.Value) {
+					return false
+				}
+			case *dag.Spread:
+				if !f.isConst(elem.Expr) {
+					return false
+				}
+			default:
+				return false
+			}
+		}
+		return true
+	case *dag.ArrayExpr:
+		return f.isConstVector(e.Elems)
+	case *dag.SetExpr:
+		return f.isConstVector(e.Elems)
+	case *dag.MapExpr:
+		for _, entry := range e.Entries {
+			if !f.isConst(entry.Key) || !f.isConst(entry.Value) {
+				return false
+			}
+		}
+		return true
+	case *dag.RegexpMatch:
+		return f.isConst(e.Expr)
+	case *dag.RegexpSearch:
+		return f.isConst(e.Expr)
+	case *dag.Search:
+		return f.isConst(e.Expr)
+	default:
+		return false
+	}
+}
+
+func (f *folder) isConstVector(elems []dag.VectorElem) bool {
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.VectorValue:
+			if !f.isConst(elem.Expr) {
+				return false
+			}
+		case *dag.Spread:
+			if !f.isConst(elem.Expr) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isFoldable returns true if call is to a deterministic built-in function
+// whose result depends only on its arguments.
+func (f *folder) isFoldable(call *dag.Call) bool {
+	if _, ok := f.funcs[call.Name]; ok {
+		return false
+	}
+	switch call.Name {
+	case "cast", "crop", "fill", "order", "shape":
+		// Shapers applied to an implied this take one argument.
+		return len(call.Args) == 2
+	case "ksuid", "now":
+		return false
+	case "typename":
+		// Named types may be defined only in the runtime's type context,
+		// as they are for metadata queries.
+		return false
+	}
+	_, path, err := function.New(f.zctx, call.Name, len(call.Args))
+	return err == nil && path == nil
+}
+
+// simplifyLogic simplifies a logical and or or with a constant left-hand
+// side.  Since the runtime short circuits evaluation of the right-hand side
+// and converts it to a bool, the right-hand side replaces the expression
+// only if it is known to be a predicate.
+func simplifyLogic(e *dag.BinaryExpr) dag.Expr {
+	switch e.Op {
+	case "and":
+		if isLiteralBool(e.LHS, false) {
+			return e.LHS
+		}
+		if isLiteralBool(e.LHS, true) && isPredicate(e.RHS) {
+			return e.RHS
+		}
+		if isLiteralBool(e.RHS, true) && isPredicate(e.LHS) {
+			return e.LHS
+		}
+	case "or":
+		if isLiteralBool(e.LHS, true) {
+			return e.LHS
+		}
+		if isLiteralBool(e.LHS, false) && isPredicate(e.RHS) {
+			return e.RHS
+		}
+		if isLiteralBool(e.RHS, false) && isPredicate(e.LHS) {
+			return e.LHS
+		}
+	}
+	return nil
+}
+
+// isPredicate returns true if e always evaluates to a bool or an error.
+func isPredicate(e dag.Expr) bool {
+	switch e := e.(type) {
+	case *dag.Literal:
+		return e.Value == "true" || e.Value == "false"
+	case *dag.UnaryExpr:
+		return e.Op == "!"
+	case *dag.BinaryExpr:
+		switch e.Op {
+		case "and", "or", "in", "==", "!=", "<", "<=", ">", ">=":
+			return true
+		}
+	case *dag.Search, *dag.RegexpMatch, *dag.RegexpSearch:
+		return true
+	}
+	return false
+}
+
+func isLiteralBool(e dag.Expr, b bool) bool {
+	lit, ok := e.(*dag.Literal)
+	if !ok {
+		return false
+	}
+	if b {
+		return lit.Value == "true"
+	}
+	return lit.Value == "false"
+}
+
+// isPrunable returns true if the cases of the switch at ops[k] may be
+// removed without changing the number of parents expected by the
+// operators adjacent to it.
+func isPrunable(ops []dag.Op, k int) bool {
+	if k > 0 {
+		switch prev := ops[k-1].(type) {
+		case *dag.Parallel, *dag.Switch:
+			return false
+		case *dag.From:
+			if len(prev.Trunks) > 1 {
+				return false
+			}
+		}
+	}
+	if k+1 < len(ops) {
+		switch ops[k+1].(type) {
+		case *dag.Join, *dag.Switch:
+			return false
+		}
+	}
+	return true
+}
+
+// pruneSwitch removes the cases of swtch that can never match.  If only
+// one case can match, it returns the operators of that case, which
+// replace the switch.  If no case can match, it returns a filter that
+// drops every value.
+func pruneSwitch(zctx *zed.Context, swtch *dag.Switch) []dag.Op {
+	var cases []dag.Case
+	if swtch.Expr != nil {
+		lit, ok := swtch.Expr.(*dag.Literal)
+		if !ok {
+			return nil
+		}
+		val, err := zson.ParseValue(zctx, lit.Value)
+		if err != nil {
+			return nil
+		}
+		var match, dflt *dag.Case
+		for k := range swtch.Cases {
+			c := &swtch.Cases[k]
+			if c.Expr == nil {
+				if dflt == nil {
+					dflt = c
+				}
+				continue
+			}
+			caseLit, ok := c.Expr.(*dag.Literal)
+			if !ok {
+				return nil
+			}
+			caseVal, err := zson.ParseValue(zctx, caseLit.Value)
+			if err != nil {
+				return nil
+			}
+			if string(caseVal.Bytes) == string(val.Bytes) {
+				if match != nil {
+					return nil
+				}
+				match = c
+			}
+		}
+		if match == nil {
+			match = dflt
+		}
+		if match != nil {
+			cases = []dag.Case{*match}
+		}
+	} else {
+		for _, c := range swtch.Cases {
+			if isLiteralBool(c.Expr, false) {
+				continue
+			}
+			cases = append(cases, c)
+			if isLiteralBool(c.Expr, true) {
+				break
+			}
+		}
+		if len(cases) == len(swtch.Cases) {
+			return nil
+		}
+		if len(cases) > 1 || len(cases) == 1 && !isLiteralBool(cases[0].Expr, true) {
+			swtch.Cases = cases
+			return []dag.Op{swtch}
+		}
+	}
+	if len(cases) == 0 {
+		return []dag.Op{dag.FilterToOp(&dag.Literal{Kind: "Literal", Value: "false"})}
+	}
+	if seq, ok := cases[0].Op.(*dag.Sequential); ok && len(seq.Consts) == 0 && len(seq.Funcs) == 0 {
+		return seq.Ops
+	}
+	return []dag.Op{cases[0].Op}
+}
@@ -23,7 +23,18 @@ func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, err
 		return order.Nil, nil
 	}
 	switch op := op.(type) {
-	case *dag.Filter, *dag.Head, *dag.Pass, *dag.Uniq, *dag.Tail, *dag.Fuse:
+	case *dag.Filter, *dag.Pass, *dag.Uniq, *dag.Fuse, *dag.Output:
+		return layout, nil
+	case *dag.Head:
+		// Per-group head emits its values one group after another.
+		if len(op.Keys) != 0 {
+			return order.Nil, nil
+		}
+		return layout, nil
+	case *dag.Tail:
+		if len(op.Keys) != 0 {
+			return order.Nil, nil
+		}
 		return layout, nil
 	case *dag.Cut:
 		return analyzeCuts(op.Args, layout), nil
@@ -31,9 +42,7 @@ func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, err
 		return analyzeCuts(op.Args, layout), nil
 	case *dag.Drop:
 		for _, f := range op.Args {
-			if fieldOf(f).Equal(key) {
-				return order.Nil, nil
-			}
+			layout = truncateLayout(layout, fieldOf(f))
 		}
 		return layout, nil
 	case *dag.Rename:
@@ -46,11 +55,11 @@ func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, err
 		return layout, nil
 	case *dag.Summarize:
 		return analyzeOpSummarize(op, layout), nil
+	case *dag.Try:
+		return o.analyzeOp(op.Body, layout)
 	case *dag.Put:
 		for _, assignment := range op.Args {
-			if fieldOf(assignment.LHS).Equal(key) {
-				return order.Nil, nil
-			}
+			layout = truncateLayout(layout, fieldOf(assignment.LHS))
 		}
 		return layout, nil
 	case *dag.Sequential:
@@ -97,6 +106,21 @@ func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, err
 	}
 }
 
+// truncateLayout returns layout with its keys truncated before the first key
+// equal to f, so the result is order.Nil if f is the primary key.  This is
+// the layout remaining after f is modified.
+func truncateLayout(layout order.Layout, f field.Path) order.Layout {
+	for k, key := range layout.Keys {
+		if key.Equal(f) {
+			if k == 0 {
+				return order.Nil
+			}
+			return order.NewLayout(layout.Order, layout.Keys[:k])
+		}
+	}
+	return layout
+}
+
 // summarizeOrderAndAssign determines whether its first groupby key is the
 // same as the scan order or an order-preserving function thereof, and if so,
 // sets ast.Summarize.InputSortDir to the propagated scan order.  It returns
@@ -114,7 +138,8 @@ func analyzeOpSummarize(summarize *dag.Summarize, layout order.Layout) order.Lay
 		rhsExpr := summarize.Keys[0].RHS
 		rhs := fieldOf(rhsExpr)
 		if rhs.Equal(key) || orderPreservingCall(rhsExpr, groupByKey) {
-			return layout
+			// The output is ordered by the primary key only.
+			return order.NewLayout(layout.Order, field.List{key})
 		}
 	}
 	return order.Nil
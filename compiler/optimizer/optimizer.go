@@ -35,15 +35,39 @@ func (o *Optimizer) Entry() *dag.Sequential {
 // from the downstream sequence into the trunk of each data source in the From
 // operator at the entry point of the DAG.  Once these paths are lifted,
 // it also attempts to move any candidate filtering operations into the
-// source's pushdown predicate.  This should be called before ParallelizeScan().
-// TBD: we need to do pushdown for search/cut to optimize columnar extraction.
+// source's pushdown predicate.  Expressions are first simplified so that
+// the pushdown predicate is in simplest form.  Filters that follow forks
+// and joins are then pushed toward their sources and each trunk is given
+// the projection of the fields needed downstream.  This should be called
+// before ParallelizeScan().
 func (o *Optimizer) OptimizeScan() error {
-	if _, ok := o.entry.Ops[0].(*dag.From); !ok {
+	simplify(o.entry)
+	if len(o.entry.Ops) == 0 {
 		return nil
 	}
 	seq := o.entry
+	from, ok := seq.Ops[0].(*dag.From)
+	if ok {
+		if err := o.liftIntoTrunks(seq, from); err != nil {
+			return err
+		}
+	}
+	o.pushFilters(seq)
+	if ok {
+		// Add pool range query to pushdown (if available).
+		for k := range from.Trunks {
+			trunk := &from.Trunks[k]
+			if layout, ok := o.layouts[trunk.Source]; ok {
+				addRangeToPushdown(trunk, layout.Primary())
+			}
+		}
+	}
+	o.projectTrunks(seq)
+	return nil
+}
+
+func (o *Optimizer) liftIntoTrunks(seq *dag.Sequential, from *dag.From) error {
 	o.propagateScanOrder(seq, order.Nil)
-	from := seq.Ops[0].(*dag.From)
 	chain := seq.Ops[1:]
 	layout, err := o.layoutOfFrom(from)
 	if err != nil {
@@ -62,13 +86,6 @@ func (o *Optimizer) OptimizeScan() error {
 		}
 		seq.Delete(1, len)
 	}
-	// Add pool range query to pushdown (if available).
-	for k := range from.Trunks {
-		trunk := &from.Trunks[k]
-		if layout, ok := o.layouts[trunk.Source]; ok {
-			addRangeToPushdown(trunk, layout.Primary())
-		}
-	}
 	return nil
 }
 
@@ -148,7 +165,7 @@ func (o *Optimizer) propagateScanOrder(op dag.Op, parent order.Layout) (order.La
 					// should relax this and do an analysis here as
 					// to whether the sort is necessary for the
 					// downstream consumer.
-					return parent, nil
+					return order.NewLayout(parent.Order, field.List{key}), nil
 				}
 			}
 		}
@@ -184,8 +201,13 @@ func (o *Optimizer) propagateScanOrder(op dag.Op, parent order.Layout) (order.La
 		return egress, nil
 	case *dag.Merge:
 		layout := order.NewLayout(op.Order, nil)
-		if this, ok := op.Expr.(*dag.This); ok {
-			layout.Keys = field.List{this.Path}
+		for _, e := range op.Exprs {
+			this, ok := e.(*dag.This)
+			if !ok {
+				layout.Keys = nil
+				break
+			}
+			layout.Keys = append(layout.Keys, this.Path)
 		}
 		if !layout.Equal(parent) {
 			layout = order.Nil
@@ -255,7 +277,7 @@ func (o *Optimizer) Parallelize(n int) error {
 	if !ok {
 		return nil
 	}
-	trunks := poolTrunks(from)
+	trunks := splittableTrunks(from)
 	if len(trunks) == 1 {
 		quietCuts(trunks[0])
 		if err := o.parallelizeTrunk(seq, trunks[0], replicas); err != nil {
@@ -276,11 +298,23 @@ func quietCuts(trunk *dag.Trunk) {
 	}
 }
 
-func poolTrunks(from *dag.From) []*dag.Trunk {
+// splittableTrunks returns the trunks of from whose scans may be divided
+// among parallel instances of the trunk, i.e., those that scan a pool or a
+// file or URL that might be split.
+func splittableTrunks(from *dag.From) []*dag.Trunk {
 	var trunks []*dag.Trunk
 	for k := range from.Trunks {
 		trunk := &from.Trunks[k]
-		if _, ok := trunk.Source.(*dag.Pool); ok {
+		var ok bool
+		switch src := trunk.Source.(type) {
+		case *dag.Pool:
+			ok = true
+		case *dag.File:
+			ok = data.CanSplit(src.Path, src.Format)
+		case *dag.HTTP:
+			ok = data.CanSplit(src.URL, src.Format)
+		}
+		if ok {
 			trunks = append(trunks, trunk)
 		}
 	}
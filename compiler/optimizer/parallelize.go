@@ -113,6 +113,27 @@ func (o *Optimizer) parallelizeTrunk(seq *dag.Sequential, trunk *dag.Trunk, repl
 		}
 		replicateTrunk(from, trunk, replicas)
 		return nil
+	case *dag.Sample:
+		// As with summarize, each branch computes a partial sample
+		// whose values carry their random ranks, and the ingress
+		// sample merges the partials by retaining the values with
+		// the smallest ranks.
+		egress := copyOp(ingress).(*dag.Sample)
+		egress.PartialsOut = true
+		ingress.PartialsIn = true
+		extend(trunk, egress)
+		replicateTrunk(from, trunk, replicas)
+		if egress.Seed != nil {
+			// Give each branch its own seed so the branches do not
+			// draw identical sequences of ranks.
+			base := *egress.Seed
+			for k := range from.Trunks {
+				ops := from.Trunks[k].Seq.Ops
+				seed := base + int64(k)
+				ops[len(ops)-1].(*dag.Sample).Seed = &seed
+			}
+		}
+		return nil
 	case *dag.Sort:
 		if len(ingress.Args) > 1 {
 			// Unknown or multiple sort fields: we sort after
@@ -153,6 +174,12 @@ func (o *Optimizer) parallelizeTrunk(seq *dag.Sequential, trunk *dag.Trunk, repl
 			// Unknown order: we can't parallelize because we can't maintain this unknown order at the merge point.
 			return nil
 		}
+		if hasKeys(ingress) {
+			// A keyed head/tail emits its values group by group
+			// rather than in layout order, so a merge on layout
+			// would not reassemble its output.
+			return nil
+		}
 		// Copy a head/tail into the trunk and leave the original in
 		// place which will apply another head/tail after the merge.
 		egress := copyOp(ingress)
@@ -195,10 +222,13 @@ func insertMerge(seq *dag.Sequential, layout order.Layout) error {
 	if layout.IsNil() {
 		return nil
 	}
-	// XXX Fix this to handle multi-key merge. See Issue #2657.
+	var exprs []dag.Expr
+	for _, key := range layout.Keys {
+		exprs = append(exprs, &dag.This{Kind: "This", Path: key})
+	}
 	head := []dag.Op{seq.Ops[0], &dag.Merge{
 		Kind:  "Merge",
-		Expr:  &dag.This{Kind: "This", Path: layout.Primary()},
+		Exprs: exprs,
 		Order: layout.Order,
 	}}
 	seq.Ops = append(head, seq.Ops[1:]...)
@@ -228,10 +258,11 @@ func replicateTrunk(from *dag.From, trunk *dag.Trunk, replicas int) {
 			pd = copyOp(pd)
 		}
 		replica := dag.Trunk{
-			Kind:     "Trunk",
-			Source:   src,
-			Seq:      newSeq,
-			Pushdown: pd,
+			Kind:       "Trunk",
+			Source:     src,
+			Seq:        newSeq,
+			Pushdown:   pd,
+			Projection: trunk.Projection,
 		}
 		from.Trunks = append(from.Trunks, replica)
 	}
@@ -304,7 +335,7 @@ func (o *Optimizer) splittablePath(ops []dag.Op, layout order.Layout) (int, orde
 		// function can be parallelized... need to think through
 		// what the meaning is here exactly.  This is all still a bit
 		// of a heuristic.  See #2660 and #2661.
-		case *dag.Summarize, *dag.Sort, *dag.Parallel, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Fuse, *dag.Sequential, *dag.Join:
+		case *dag.Summarize, *dag.Sample, *dag.Sort, *dag.Parallel, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Fuse, *dag.Sequential, *dag.Join, *dag.Try, *dag.Output:
 			return k, layout, nil
 		default:
 			next, err := o.analyzeOp(op, layout)
@@ -319,3 +350,13 @@ func (o *Optimizer) splittablePath(ops []dag.Op, layout order.Layout) (int, orde
 	}
 	return len(ops), layout, nil
 }
+
+func hasKeys(op dag.Op) bool {
+	switch op := op.(type) {
+	case *dag.Head:
+		return len(op.Keys) > 0
+	case *dag.Tail:
+		return len(op.Keys) > 0
+	}
+	return false
+}
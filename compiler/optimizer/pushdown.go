@@ -0,0 +1,373 @@
+package optimizer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/compiler/kernel"
+	"github.com/brimdata/zed/pkg/field"
+)
+
+// pushFilters moves the filters in seq and in the sequences nested in it
+// toward the data sources.  A filter that follows a fork or a from
+// operator is copied into each of its branches, a filter that follows a
+// join is split into the predicates that reference only one side of the
+// join, and a filter that reaches the front of a trunk becomes part of the
+// trunk's pushdown.  When each branch of a fork begins with a filter, the
+// disjunction of these filters is pushed into the from operator that
+// feeds the fork.
+func (o *Optimizer) pushFilters(seq *dag.Sequential) {
+	if seq == nil {
+		return
+	}
+	for _, op := range seq.Ops {
+		switch op := op.(type) {
+		case *dag.Sequential:
+			o.pushFilters(op)
+		case *dag.Parallel:
+			for _, branch := range op.Ops {
+				if branch, ok := branch.(*dag.Sequential); ok {
+					o.pushFilters(branch)
+				}
+			}
+		case *dag.From:
+			for k := range op.Trunks {
+				o.pushFilters(op.Trunks[k].Seq)
+			}
+		}
+	}
+	for k := 0; k < len(seq.Ops); k++ {
+		switch op := seq.Ops[k].(type) {
+		case *dag.Filter:
+			if o.pushFilter(seq, k) {
+				k--
+			}
+		case *dag.Parallel:
+			if k > 0 {
+				o.pushForkFilters(seq.Ops[k-1], op)
+			}
+		}
+	}
+}
+
+// pushFilter moves the filter at seq.Ops[k] upstream and returns true if it
+// was removed from seq.
+func (o *Optimizer) pushFilter(seq *dag.Sequential, k int) bool {
+	for k > 0 && commutesWithFilter(seq.Ops[k-1]) {
+		seq.Ops[k-1], seq.Ops[k] = seq.Ops[k], seq.Ops[k-1]
+		k--
+	}
+	if k == 0 {
+		return false
+	}
+	filter := seq.Ops[k].(*dag.Filter)
+	var pushed bool
+	switch op := seq.Ops[k-1].(type) {
+	case *dag.Parallel:
+		pushed = o.pushIntoFork(op, filter)
+	case *dag.From:
+		pushed = o.pushIntoFrom(op, filter)
+	case *dag.Join:
+		if k > 1 {
+			filter.Expr = o.pushThroughJoin(seq.Ops[k-2], op, filter.Expr)
+			pushed = filter.Expr == nil
+		}
+	}
+	if pushed {
+		seq.Delete(k, 1)
+	}
+	return pushed
+}
+
+// commutesWithFilter returns true if a filter that follows op may instead
+// precede it.
+func commutesWithFilter(op dag.Op) bool {
+	switch op.(type) {
+	case *dag.Filter, *dag.Pass, *dag.Sort:
+		return true
+	}
+	return false
+}
+
+func (o *Optimizer) pushIntoFork(fork *dag.Parallel, filter *dag.Filter) bool {
+	for _, branch := range fork.Ops {
+		if _, ok := branch.(*dag.Sequential); !ok {
+			return false
+		}
+	}
+	for _, branch := range fork.Ops {
+		seq := branch.(*dag.Sequential)
+		seq.Append(copyOp(filter))
+		o.pushFilter(seq, len(seq.Ops)-1)
+	}
+	return true
+}
+
+func (o *Optimizer) pushIntoFrom(from *dag.From, filter *dag.Filter) bool {
+	if isDelete(from) {
+		return false
+	}
+	for k := range from.Trunks {
+		o.pushIntoTrunk(&from.Trunks[k], copyOp(filter).(*dag.Filter))
+	}
+	return true
+}
+
+func isDelete(from *dag.From) bool {
+	for _, trunk := range from.Trunks {
+		if pool, ok := trunk.Source.(*dag.Pool); ok && pool.Delete {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Optimizer) pushIntoTrunk(trunk *dag.Trunk, filter *dag.Filter) {
+	if trunk.Seq == nil {
+		trunk.Seq = &dag.Sequential{Kind: "Sequential"}
+	}
+	seq := trunk.Seq
+	seq.Append(filter)
+	o.pushFilter(seq, len(seq.Ops)-1)
+	if filter, ok := seq.Ops[0].(*dag.Filter); ok && scansPushdown(trunk.Source) {
+		seq.Delete(0, 1)
+		if trunk.Pushdown == nil {
+			trunk.Pushdown = filter
+		} else {
+			pushdown := trunk.Pushdown.(*dag.Filter)
+			pushdown.Expr = dag.NewBinaryExpr("and", pushdown.Expr, filter.Expr)
+		}
+	}
+	if len(seq.Ops) == 0 {
+		trunk.Seq = nil
+	}
+}
+
+// scansPushdown returns true if the runtime applies the pushdown of a
+// trunk with source s.
+func scansPushdown(s dag.Source) bool {
+	switch s.(type) {
+	case *dag.Pool, *dag.File, *dag.HTTP, *kernel.Reader:
+		return true
+	}
+	return false
+}
+
+// pushThroughJoin pushes the conjuncts of e that can be evaluated by one
+// parent of join into that parent and returns the conjunction of those
+// that remain or nil if none remain.  A conjunct is pushed only when the
+// parent each of its fields comes from is certain.  The join appends the
+// fields assigned from the other parent to the values of the preserved
+// parent, renaming an assigned field x to x_2, x_3, etc. when the preserved
+// value already has a field x, so a field whose name is assigned by the join
+// comes from the other parent only if the fields of the preserved parent are
+// known statically.  A conjunct that references only fields of the preserved
+// parent may be pushed into it for any style of join.  A conjunct that
+// references only fields assigned from the other parent may be pushed into
+// that parent only for an inner join, since an outer join emits values
+// without a match.
+func (o *Optimizer) pushThroughJoin(parents dag.Op, join *dag.Join, e dag.Expr) dag.Expr {
+	preserved, other := 0, 1
+	if join.Style == "right" {
+		preserved, other = 1, 0
+	}
+	var push func(int, *dag.Filter)
+	var preservedSeq *dag.Sequential
+	switch parents := parents.(type) {
+	case *dag.Parallel:
+		if len(parents.Ops) != 2 {
+			return e
+		}
+		for _, branch := range parents.Ops {
+			if _, ok := branch.(*dag.Sequential); !ok {
+				return e
+			}
+		}
+		push = func(k int, filter *dag.Filter) {
+			seq := parents.Ops[k].(*dag.Sequential)
+			seq.Append(filter)
+			o.pushFilter(seq, len(seq.Ops)-1)
+		}
+		preservedSeq = parents.Ops[preserved].(*dag.Sequential)
+	case *dag.From:
+		if len(parents.Trunks) != 2 || isDelete(parents) {
+			return e
+		}
+		push = func(k int, filter *dag.Filter) {
+			o.pushIntoTrunk(&parents.Trunks[k], filter)
+		}
+		preservedSeq = parents.Trunks[preserved].Seq
+	default:
+		return e
+	}
+	// names holds the top-level fields assigned by the join in order and
+	// rhs maps each to the field of the other parent it is assigned from
+	// or to nil if it is not a simple field reference.
+	var names []string
+	rhs := make(map[string]field.Path)
+	for _, a := range join.Args {
+		lhs, ok := a.LHS.(*dag.This)
+		if !ok || len(lhs.Path) == 0 {
+			return e
+		}
+		name := lhs.Path[0]
+		if _, ok := rhs[name]; !ok {
+			names = append(names, name)
+		}
+		var path field.Path
+		if len(lhs.Path) == 1 {
+			if this, ok := a.RHS.(*dag.This); ok && len(this.Path) != 0 {
+				path = this.Path
+			}
+		}
+		rhs[name] = path
+	}
+	// assigned maps each top-level output field of the join known to come
+	// from the other parent to the field it is assigned from (or nil).
+	// ambiguous holds the output fields that may come from either parent.
+	assigned := make(map[string]field.Path)
+	ambiguous := func(string) bool { return false }
+	if fields, ok := outputFields(preservedSeq); ok {
+		for _, name := range names {
+			out := name
+			for k := 2; fields[out]; k++ {
+				out = fmt.Sprintf("%s_%d", name, k)
+			}
+			assigned[out] = rhs[name]
+		}
+	} else {
+		ambiguous = func(name string) bool {
+			if _, ok := rhs[name]; ok {
+				return true
+			}
+			if i := strings.LastIndexByte(name, '_'); i > 0 {
+				if _, err := strconv.Atoi(name[i+1:]); err == nil {
+					_, ok := rhs[name[:i]]
+					return ok
+				}
+			}
+			return false
+		}
+	}
+	zctx := zed.NewContext()
+	var remaining []dag.Expr
+	for _, conjunct := range splitAnd(e) {
+		var fromPreserved, fromOther, uncertain bool
+		rewritable := true
+		ok := visitFields(zctx, nil, conjunct, func(this *dag.This) {
+			name := this.Path[0]
+			if ambiguous(name) {
+				uncertain = true
+				return
+			}
+			path, ok := assigned[name]
+			if !ok {
+				fromPreserved = true
+				return
+			}
+			fromOther = true
+			if path == nil {
+				rewritable = false
+			}
+		})
+		switch {
+		case !ok || uncertain || fromPreserved && fromOther:
+			remaining = append(remaining, conjunct)
+		case !fromOther:
+			push(preserved, dag.FilterToOp(conjunct))
+		case join.Style == "inner" && rewritable:
+			filter := copyOp(dag.FilterToOp(conjunct)).(*dag.Filter)
+			visitFields(zctx, nil, filter.Expr, func(this *dag.This) {
+				path := assigned[this.Path[0]]
+				this.Path = append(append(field.Path{}, path...), this.Path[1:]...)
+			})
+			push(other, filter)
+		default:
+			remaining = append(remaining, conjunct)
+		}
+	}
+	return joinAnd(remaining)
+}
+
+// outputFields returns the top-level fields of every value output by seq if
+// they are known statically, i.e., if the last operator of seq that is not a
+// filter or otherwise passes its input through unmodified is a yield of a
+// single record literal with no spreads.
+func outputFields(seq *dag.Sequential) (map[string]bool, bool) {
+	if seq == nil {
+		return nil, false
+	}
+	for k := len(seq.Ops) - 1; k >= 0; k-- {
+		switch op := seq.Ops[k].(type) {
+		case *dag.Filter, *dag.Sort, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Pass:
+			continue
+		case *dag.Yield:
+			if len(op.Exprs) != 1 {
+				return nil, false
+			}
+			record, ok := op.Exprs[0].(*dag.RecordExpr)
+			if !ok {
+				return nil, false
+			}
+			fields := make(map[string]bool)
+			for _, elem := range record.Elems {
+				f, ok := elem.(*dag.Field)
+				if !ok {
+					return nil, false
+				}
+				fields[f.Name] = true
+			}
+			return fields, true
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+func (o *Optimizer) pushForkFilters(parent dag.Op, fork *dag.Parallel) {
+	from, ok := parent.(*dag.From)
+	if !ok || isDelete(from) {
+		return
+	}
+	var disjunction dag.Expr
+	for _, branch := range fork.Ops {
+		seq, ok := branch.(*dag.Sequential)
+		if !ok || len(seq.Ops) == 0 {
+			return
+		}
+		filter, ok := seq.Ops[0].(*dag.Filter)
+		if !ok {
+			return
+		}
+		e := copyOp(filter).(*dag.Filter).Expr
+		if disjunction == nil {
+			disjunction = e
+		} else {
+			disjunction = dag.NewBinaryExpr("or", disjunction, e)
+		}
+	}
+	if disjunction != nil {
+		o.pushIntoFrom(from, dag.FilterToOp(disjunction))
+	}
+}
+
+func splitAnd(e dag.Expr) []dag.Expr {
+	if b, ok := e.(*dag.BinaryExpr); ok && b.Op == "and" {
+		return append(splitAnd(b.LHS), splitAnd(b.RHS)...)
+	}
+	return []dag.Expr{e}
+}
+
+func joinAnd(exprs []dag.Expr) dag.Expr {
+	if len(exprs) == 0 {
+		return nil
+	}
+	e := exprs[0]
+	for _, next := range exprs[1:] {
+		e = dag.NewBinaryExpr("and", e, next)
+	}
+	return e
+}
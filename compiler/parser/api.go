@@ -20,6 +20,16 @@ func ParseZed(filenames []string, src string) (interface{}, error) {
 	return p, nil
 }
 
+const locationsKey = "locations"
+
+// Locations returns an Option that causes Parse to add to the AST node of
+// each operator, declaration, fork leg, switch case, and from trunk a "loc"
+// field holding the byte offsets of the first and last bytes of the node in
+// the source.
+func Locations() Option {
+	return GlobalStore(locationsKey, true)
+}
+
 // SourceInfo holds source file offsets.
 type SourceInfo struct {
 	filename string
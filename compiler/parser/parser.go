@@ -22,31 +22,372 @@ var g = &grammar{
 		{
 			name: "start",
 			pos:  position{line: 7, col: 1, offset: 26},
+			expr: &choiceExpr{
+				pos: position{line: 8, col: 5, offset: 36},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 8, col: 5, offset: 36},
+						run: (*parser).callonstart2,
+						expr: &seqExpr{
+							pos: position{line: 8, col: 5, offset: 36},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 8, col: 5, offset: 36},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 8, col: 8, offset: 39},
+									label: "stmt",
+									expr: &ruleRefExpr{
+										pos:  position{line: 8, col: 13, offset: 44},
+										name: "Statement",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 8, col: 23, offset: 54},
+									name: "__",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 8, col: 26, offset: 57},
+									name: "EOF",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 9, col: 5, offset: 86},
+						run: (*parser).callonstart9,
+						expr: &seqExpr{
+							pos: position{line: 9, col: 5, offset: 86},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 9, col: 5, offset: 86},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 9, col: 8, offset: 89},
+									label: "ast",
+									expr: &ruleRefExpr{
+										pos:  position{line: 9, col: 12, offset: 93},
+										name: "Sequential",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 9, col: 23, offset: 104},
+									name: "__",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 9, col: 26, offset: 107},
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Statement",
+			pos:  position{line: 13, col: 1, offset: 156},
+			expr: &choiceExpr{
+				pos: position{line: 14, col: 5, offset: 170},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 14, col: 5, offset: 170},
+						run: (*parser).callonStatement2,
+						expr: &seqExpr{
+							pos: position{line: 14, col: 5, offset: 170},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 14, col: 5, offset: 170},
+									name: "CREATE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 14, col: 12, offset: 177},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 14, col: 14, offset: 179},
+									name: "POOL",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 14, col: 19, offset: 184},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 14, col: 21, offset: 186},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 14, col: 26, offset: 191},
+										name: "PoolNameString",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 14, col: 41, offset: 206},
+									label: "layout",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 14, col: 48, offset: 213},
+										expr: &ruleRefExpr{
+											pos:  position{line: 14, col: 48, offset: 213},
+											name: "StatementLayout",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 17, col: 5, offset: 337},
+						run: (*parser).callonStatement13,
+						expr: &seqExpr{
+							pos: position{line: 17, col: 5, offset: 337},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 17, col: 5, offset: 337},
+									name: "DROP",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 17, col: 10, offset: 342},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 17, col: 12, offset: 344},
+									name: "POOL",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 17, col: 17, offset: 349},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 17, col: 19, offset: 351},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 17, col: 24, offset: 356},
+										name: "PoolNameString",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 20, col: 5, offset: 458},
+						run: (*parser).callonStatement21,
+						expr: &seqExpr{
+							pos: position{line: 20, col: 5, offset: 458},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 5, offset: 458},
+									name: "CREATE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 12, offset: 465},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 14, offset: 467},
+									name: "BRANCH",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 21, offset: 474},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 20, col: 23, offset: 476},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 20, col: 28, offset: 481},
+										name: "PoolNameString",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 43, offset: 496},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 45, offset: 498},
+									name: "FROM",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 20, col: 50, offset: 503},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 20, col: 52, offset: 505},
+									label: "pool",
+									expr: &ruleRefExpr{
+										pos:  position{line: 20, col: 57, offset: 510},
+										name: "PoolNameString",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 20, col: 72, offset: 525},
+									label: "at",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 20, col: 75, offset: 528},
+										expr: &ruleRefExpr{
+											pos:  position{line: 20, col: 75, offset: 528},
+											name: "PoolCommit",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 27, col: 5, offset: 725},
+						run: (*parser).callonStatement37,
+						expr: &seqExpr{
+							pos: position{line: 27, col: 5, offset: 725},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 27, col: 5, offset: 725},
+									name: "INSERT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 27, col: 12, offset: 732},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 27, col: 14, offset: 734},
+									name: "INTO",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 27, col: 19, offset: 739},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 27, col: 21, offset: 741},
+									label: "pool",
+									expr: &ruleRefExpr{
+										pos:  position{line: 27, col: 26, offset: 746},
+										name: "PoolNameString",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 27, col: 41, offset: 761},
+									label: "branch",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 27, col: 48, offset: 768},
+										expr: &ruleRefExpr{
+											pos:  position{line: 27, col: 48, offset: 768},
+											name: "PoolCommit",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 27, col: 60, offset: 780},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 27, col: 62, offset: 782},
+									label: "query",
+									expr: &ruleRefExpr{
+										pos:  position{line: 27, col: 68, offset: 788},
+										name: "Sequential",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 34, col: 5, offset: 996},
+						run: (*parser).callonStatement51,
+						expr: &seqExpr{
+							pos: position{line: 34, col: 5, offset: 996},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 34, col: 5, offset: 996},
+									name: "DELETE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 34, col: 12, offset: 1003},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 34, col: 14, offset: 1005},
+									name: "FROM",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 34, col: 19, offset: 1010},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 34, col: 21, offset: 1012},
+									label: "pool",
+									expr: &ruleRefExpr{
+										pos:  position{line: 34, col: 26, offset: 1017},
+										name: "PoolNameString",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 34, col: 41, offset: 1032},
+									label: "branch",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 34, col: 48, offset: 1039},
+										expr: &ruleRefExpr{
+											pos:  position{line: 34, col: 48, offset: 1039},
+											name: "PoolCommit",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 34, col: 60, offset: 1051},
+									label: "where",
+									expr: &ruleRefExpr{
+										pos:  position{line: 34, col: 66, offset: 1057},
+										name: "SQLWhere",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StatementLayout",
+			pos:  position{line: 42, col: 1, offset: 1260},
 			expr: &actionExpr{
-				pos: position{line: 7, col: 9, offset: 34},
-				run: (*parser).callonstart1,
+				pos: position{line: 43, col: 5, offset: 1280},
+				run: (*parser).callonStatementLayout1,
 				expr: &seqExpr{
-					pos: position{line: 7, col: 9, offset: 34},
+					pos: position{line: 43, col: 5, offset: 1280},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 7, col: 9, offset: 34},
-							name: "__",
+							pos:  position{line: 43, col: 5, offset: 1280},
+							name: "_",
 						},
-						&labeledExpr{
-							pos:   position{line: 7, col: 12, offset: 37},
-							label: "ast",
-							expr: &ruleRefExpr{
-								pos:  position{line: 7, col: 16, offset: 41},
-								name: "Sequential",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 43, col: 7, offset: 1282},
+							name: "ORDER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 7, col: 27, offset: 52},
-							name: "__",
+							pos:  position{line: 43, col: 13, offset: 1288},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 43, col: 15, offset: 1290},
+							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 7, col: 30, offset: 55},
-							name: "EOF",
+							pos:  position{line: 43, col: 18, offset: 1293},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 43, col: 20, offset: 1295},
+							label: "keys",
+							expr: &ruleRefExpr{
+								pos:  position{line: 43, col: 25, offset: 1300},
+								name: "FieldExprs",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 43, col: 36, offset: 1311},
+							label: "order",
+							expr: &ruleRefExpr{
+								pos:  position{line: 43, col: 42, offset: 1317},
+								name: "SQLOrder",
+							},
 						},
 					},
 				},
@@ -54,40 +395,40 @@ var g = &grammar{
 		},
 		{
 			name: "Sequential",
-			pos:  position{line: 9, col: 1, offset: 80},
+			pos:  position{line: 47, col: 1, offset: 1424},
 			expr: &actionExpr{
-				pos: position{line: 10, col: 5, offset: 95},
+				pos: position{line: 48, col: 5, offset: 1439},
 				run: (*parser).callonSequential1,
 				expr: &seqExpr{
-					pos: position{line: 10, col: 5, offset: 95},
+					pos: position{line: 48, col: 5, offset: 1439},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 10, col: 5, offset: 95},
+							pos:   position{line: 48, col: 5, offset: 1439},
 							label: "decls",
 							expr: &ruleRefExpr{
-								pos:  position{line: 10, col: 11, offset: 101},
+								pos:  position{line: 48, col: 11, offset: 1445},
 								name: "Decls",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 10, col: 17, offset: 107},
+							pos:  position{line: 48, col: 17, offset: 1451},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 10, col: 20, offset: 110},
+							pos:   position{line: 48, col: 20, offset: 1454},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 10, col: 26, offset: 116},
+								pos:  position{line: 48, col: 26, offset: 1460},
 								name: "Operation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 10, col: 36, offset: 126},
+							pos:   position{line: 48, col: 36, offset: 1470},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 10, col: 41, offset: 131},
+								pos: position{line: 48, col: 41, offset: 1475},
 								expr: &ruleRefExpr{
-									pos:  position{line: 10, col: 41, offset: 131},
+									pos:  position{line: 48, col: 41, offset: 1475},
 									name: "SequentialTail",
 								},
 							},
@@ -98,30 +439,30 @@ var g = &grammar{
 		},
 		{
 			name: "SequentialTail",
-			pos:  position{line: 14, col: 1, offset: 300},
+			pos:  position{line: 52, col: 1, offset: 1644},
 			expr: &actionExpr{
-				pos: position{line: 14, col: 18, offset: 317},
+				pos: position{line: 52, col: 18, offset: 1661},
 				run: (*parser).callonSequentialTail1,
 				expr: &seqExpr{
-					pos: position{line: 14, col: 18, offset: 317},
+					pos: position{line: 52, col: 18, offset: 1661},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 14, col: 18, offset: 317},
+							pos:  position{line: 52, col: 18, offset: 1661},
 							name: "__",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 14, col: 21, offset: 320},
+							pos:  position{line: 52, col: 21, offset: 1664},
 							name: "Pipe",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 14, col: 26, offset: 325},
+							pos:  position{line: 52, col: 26, offset: 1669},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 14, col: 29, offset: 328},
+							pos:   position{line: 52, col: 29, offset: 1672},
 							label: "p",
 							expr: &ruleRefExpr{
-								pos:  position{line: 14, col: 31, offset: 330},
+								pos:  position{line: 52, col: 31, offset: 1674},
 								name: "Operation",
 							},
 						},
@@ -131,22 +472,22 @@ var g = &grammar{
 		},
 		{
 			name: "Decls",
-			pos:  position{line: 16, col: 1, offset: 359},
+			pos:  position{line: 54, col: 1, offset: 1703},
 			expr: &choiceExpr{
-				pos: position{line: 17, col: 5, offset: 369},
+				pos: position{line: 55, col: 5, offset: 1713},
 				alternatives: []interface{}{
 					&oneOrMoreExpr{
-						pos: position{line: 17, col: 5, offset: 369},
+						pos: position{line: 55, col: 5, offset: 1713},
 						expr: &ruleRefExpr{
-							pos:  position{line: 17, col: 5, offset: 369},
+							pos:  position{line: 55, col: 5, offset: 1713},
 							name: "Decl",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 18, col: 5, offset: 379},
+						pos: position{line: 56, col: 5, offset: 1723},
 						run: (*parser).callonDecls4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 18, col: 5, offset: 379},
+							pos:  position{line: 56, col: 5, offset: 1723},
 							name: "__",
 						},
 					},
@@ -155,29 +496,29 @@ var g = &grammar{
 		},
 		{
 			name: "Decl",
-			pos:  position{line: 20, col: 1, offset: 415},
+			pos:  position{line: 58, col: 1, offset: 1759},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 5, offset: 424},
+				pos: position{line: 59, col: 5, offset: 1768},
 				run: (*parser).callonDecl1,
 				expr: &seqExpr{
-					pos: position{line: 21, col: 5, offset: 424},
+					pos: position{line: 59, col: 5, offset: 1768},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 5, offset: 424},
+							pos:  position{line: 59, col: 5, offset: 1768},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 21, col: 8, offset: 427},
+							pos:   position{line: 59, col: 8, offset: 1771},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 21, col: 11, offset: 430},
+								pos: position{line: 59, col: 11, offset: 1774},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 21, col: 11, offset: 430},
+										pos:  position{line: 59, col: 11, offset: 1774},
 										name: "ConstDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 21, col: 23, offset: 442},
+										pos:  position{line: 59, col: 23, offset: 1786},
 										name: "FuncDecl",
 									},
 								},
@@ -189,51 +530,51 @@ var g = &grammar{
 		},
 		{
 			name: "ConstDecl",
-			pos:  position{line: 23, col: 1, offset: 471},
+			pos:  position{line: 61, col: 1, offset: 1815},
 			expr: &choiceExpr{
-				pos: position{line: 24, col: 5, offset: 485},
+				pos: position{line: 62, col: 5, offset: 1829},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 24, col: 5, offset: 485},
+						pos: position{line: 62, col: 5, offset: 1829},
 						run: (*parser).callonConstDecl2,
 						expr: &seqExpr{
-							pos: position{line: 24, col: 5, offset: 485},
+							pos: position{line: 62, col: 5, offset: 1829},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 24, col: 5, offset: 485},
+									pos:        position{line: 62, col: 5, offset: 1829},
 									val:        "const",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 24, col: 13, offset: 493},
+									pos:  position{line: 62, col: 13, offset: 1837},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 24, col: 15, offset: 495},
+									pos:   position{line: 62, col: 15, offset: 1839},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 24, col: 18, offset: 498},
+										pos:  position{line: 62, col: 18, offset: 1842},
 										name: "IdentifierName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 24, col: 33, offset: 513},
+									pos:  position{line: 62, col: 33, offset: 1857},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 24, col: 36, offset: 516},
+									pos:        position{line: 62, col: 36, offset: 1860},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 24, col: 40, offset: 520},
+									pos:  position{line: 62, col: 40, offset: 1864},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 24, col: 43, offset: 523},
+									pos:   position{line: 62, col: 43, offset: 1867},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 24, col: 48, offset: 528},
+										pos:  position{line: 62, col: 48, offset: 1872},
 										name: "Expr",
 									},
 								},
@@ -241,55 +582,55 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 27, col: 5, offset: 630},
+						pos: position{line: 65, col: 5, offset: 1981},
 						run: (*parser).callonConstDecl13,
 						expr: &seqExpr{
-							pos: position{line: 27, col: 5, offset: 630},
+							pos: position{line: 65, col: 5, offset: 1981},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 27, col: 5, offset: 630},
+									pos:        position{line: 65, col: 5, offset: 1981},
 									val:        "type",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 27, col: 12, offset: 637},
+									pos:  position{line: 65, col: 12, offset: 1988},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 27, col: 14, offset: 639},
+									pos:   position{line: 65, col: 14, offset: 1990},
 									label: "id",
 									expr: &choiceExpr{
-										pos: position{line: 27, col: 18, offset: 643},
+										pos: position{line: 65, col: 18, offset: 1994},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 27, col: 18, offset: 643},
+												pos:  position{line: 65, col: 18, offset: 1994},
 												name: "IdentifierName",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 27, col: 35, offset: 660},
+												pos:  position{line: 65, col: 35, offset: 2011},
 												name: "QuotedString",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 27, col: 49, offset: 674},
+									pos:  position{line: 65, col: 49, offset: 2025},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 27, col: 52, offset: 677},
+									pos:        position{line: 65, col: 52, offset: 2028},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 27, col: 56, offset: 681},
+									pos:  position{line: 65, col: 56, offset: 2032},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 27, col: 59, offset: 684},
+									pos:   position{line: 65, col: 59, offset: 2035},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 27, col: 63, offset: 688},
+										pos:  position{line: 65, col: 63, offset: 2039},
 										name: "Type",
 									},
 								},
@@ -301,96 +642,96 @@ var g = &grammar{
 		},
 		{
 			name: "FuncDecl",
-			pos:  position{line: 38, col: 1, offset: 946},
+			pos:  position{line: 76, col: 1, offset: 2304},
 			expr: &actionExpr{
-				pos: position{line: 39, col: 5, offset: 959},
+				pos: position{line: 77, col: 5, offset: 2317},
 				run: (*parser).callonFuncDecl1,
 				expr: &seqExpr{
-					pos: position{line: 39, col: 5, offset: 959},
+					pos: position{line: 77, col: 5, offset: 2317},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 39, col: 5, offset: 959},
+							pos:        position{line: 77, col: 5, offset: 2317},
 							val:        "func",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 12, offset: 966},
+							pos:  position{line: 77, col: 12, offset: 2324},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 14, offset: 968},
+							pos:   position{line: 77, col: 14, offset: 2326},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 17, offset: 971},
+								pos:  position{line: 77, col: 17, offset: 2329},
 								name: "IdentifierName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 32, offset: 986},
+							pos:  position{line: 77, col: 32, offset: 2344},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 35, offset: 989},
+							pos:        position{line: 77, col: 35, offset: 2347},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 39, offset: 993},
+							pos:  position{line: 77, col: 39, offset: 2351},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 42, offset: 996},
+							pos:   position{line: 77, col: 42, offset: 2354},
 							label: "params",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 49, offset: 1003},
+								pos:  position{line: 77, col: 49, offset: 2361},
 								name: "IdentifierNames",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 65, offset: 1019},
+							pos:  position{line: 77, col: 65, offset: 2377},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 68, offset: 1022},
+							pos:        position{line: 77, col: 68, offset: 2380},
 							val:        ")",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 72, offset: 1026},
+							pos:  position{line: 77, col: 72, offset: 2384},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 75, offset: 1029},
+							pos:        position{line: 77, col: 75, offset: 2387},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 79, offset: 1033},
+							pos:  position{line: 77, col: 79, offset: 2391},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 82, offset: 1036},
+							pos:        position{line: 77, col: 82, offset: 2394},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 86, offset: 1040},
+							pos:  position{line: 77, col: 86, offset: 2398},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 89, offset: 1043},
+							pos:   position{line: 77, col: 89, offset: 2401},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 94, offset: 1048},
+								pos:  position{line: 77, col: 94, offset: 2406},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 99, offset: 1053},
+							pos:  position{line: 77, col: 99, offset: 2411},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 102, offset: 1056},
+							pos:        position{line: 77, col: 102, offset: 2414},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -400,47 +741,63 @@ var g = &grammar{
 		},
 		{
 			name: "Operation",
-			pos:  position{line: 52, col: 1, offset: 1236},
+			pos:  position{line: 90, col: 1, offset: 2601},
+			expr: &actionExpr{
+				pos: position{line: 91, col: 5, offset: 2615},
+				run: (*parser).callonOperation1,
+				expr: &labeledExpr{
+					pos:   position{line: 91, col: 5, offset: 2615},
+					label: "op",
+					expr: &ruleRefExpr{
+						pos:  position{line: 91, col: 8, offset: 2618},
+						name: "OperationBody",
+					},
+				},
+			},
+		},
+		{
+			name: "OperationBody",
+			pos:  position{line: 93, col: 1, offset: 2659},
 			expr: &choiceExpr{
-				pos: position{line: 53, col: 5, offset: 1250},
+				pos: position{line: 94, col: 5, offset: 2677},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 53, col: 5, offset: 1250},
-						run: (*parser).callonOperation2,
+						pos: position{line: 94, col: 5, offset: 2677},
+						run: (*parser).callonOperationBody2,
 						expr: &seqExpr{
-							pos: position{line: 53, col: 5, offset: 1250},
+							pos: position{line: 94, col: 5, offset: 2677},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 53, col: 5, offset: 1250},
+									pos:        position{line: 94, col: 5, offset: 2677},
 									val:        "fork",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 53, col: 12, offset: 1257},
+									pos:  position{line: 94, col: 12, offset: 2684},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 53, col: 15, offset: 1260},
+									pos:        position{line: 94, col: 15, offset: 2687},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 53, col: 19, offset: 1264},
+									pos:   position{line: 94, col: 19, offset: 2691},
 									label: "ops",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 53, col: 23, offset: 1268},
+										pos: position{line: 94, col: 23, offset: 2695},
 										expr: &ruleRefExpr{
-											pos:  position{line: 53, col: 23, offset: 1268},
+											pos:  position{line: 94, col: 23, offset: 2695},
 											name: "Leg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 53, col: 28, offset: 1273},
+									pos:  position{line: 94, col: 28, offset: 2700},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 53, col: 31, offset: 1276},
+									pos:        position{line: 94, col: 31, offset: 2703},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -448,54 +805,54 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 56, col: 5, offset: 1365},
-						run: (*parser).callonOperation12,
+						pos: position{line: 97, col: 5, offset: 2792},
+						run: (*parser).callonOperationBody12,
 						expr: &seqExpr{
-							pos: position{line: 56, col: 5, offset: 1365},
+							pos: position{line: 97, col: 5, offset: 2792},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 56, col: 5, offset: 1365},
+									pos:        position{line: 97, col: 5, offset: 2792},
 									val:        "switch",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 56, col: 14, offset: 1374},
+									pos:  position{line: 97, col: 14, offset: 2801},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 56, col: 16, offset: 1376},
+									pos:   position{line: 97, col: 16, offset: 2803},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 56, col: 21, offset: 1381},
+										pos:  position{line: 97, col: 21, offset: 2808},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 56, col: 26, offset: 1386},
+									pos:  position{line: 97, col: 26, offset: 2813},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 56, col: 28, offset: 1388},
+									pos:        position{line: 97, col: 28, offset: 2815},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 56, col: 32, offset: 1392},
+									pos:   position{line: 97, col: 32, offset: 2819},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 56, col: 38, offset: 1398},
+										pos: position{line: 97, col: 38, offset: 2825},
 										expr: &ruleRefExpr{
-											pos:  position{line: 56, col: 38, offset: 1398},
+											pos:  position{line: 97, col: 38, offset: 2825},
 											name: "SwitchLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 56, col: 49, offset: 1409},
+									pos:  position{line: 97, col: 49, offset: 2836},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 56, col: 52, offset: 1412},
+									pos:        position{line: 97, col: 52, offset: 2839},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -503,42 +860,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 59, col: 5, offset: 1517},
-						run: (*parser).callonOperation25,
+						pos: position{line: 100, col: 5, offset: 2944},
+						run: (*parser).callonOperationBody25,
 						expr: &seqExpr{
-							pos: position{line: 59, col: 5, offset: 1517},
+							pos: position{line: 100, col: 5, offset: 2944},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 59, col: 5, offset: 1517},
+									pos:        position{line: 100, col: 5, offset: 2944},
 									val:        "switch",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 59, col: 14, offset: 1526},
+									pos:  position{line: 100, col: 14, offset: 2953},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 59, col: 17, offset: 1529},
+									pos:        position{line: 100, col: 17, offset: 2956},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 59, col: 21, offset: 1533},
+									pos:   position{line: 100, col: 21, offset: 2960},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 59, col: 27, offset: 1539},
+										pos: position{line: 100, col: 27, offset: 2966},
 										expr: &ruleRefExpr{
-											pos:  position{line: 59, col: 27, offset: 1539},
+											pos:  position{line: 100, col: 27, offset: 2966},
 											name: "SwitchLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 59, col: 38, offset: 1550},
+									pos:  position{line: 100, col: 38, offset: 2977},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 59, col: 41, offset: 1553},
+									pos:        position{line: 100, col: 41, offset: 2980},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -546,74 +903,157 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 62, col: 5, offset: 1657},
-						run: (*parser).callonOperation35,
+						pos: position{line: 103, col: 5, offset: 3084},
+						run: (*parser).callonOperationBody35,
 						expr: &seqExpr{
-							pos: position{line: 62, col: 5, offset: 1657},
+							pos: position{line: 103, col: 5, offset: 3084},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 62, col: 5, offset: 1657},
+									pos:        position{line: 103, col: 5, offset: 3084},
 									val:        "from",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 62, col: 12, offset: 1664},
+									pos:  position{line: 103, col: 12, offset: 3091},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 62, col: 15, offset: 1667},
+									pos:        position{line: 103, col: 15, offset: 3094},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 62, col: 19, offset: 1671},
+									pos:   position{line: 103, col: 19, offset: 3098},
 									label: "trunks",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 62, col: 26, offset: 1678},
+										pos: position{line: 103, col: 26, offset: 3105},
 										expr: &ruleRefExpr{
-											pos:  position{line: 62, col: 26, offset: 1678},
+											pos:  position{line: 103, col: 26, offset: 3105},
 											name: "FromLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 62, col: 35, offset: 1687},
+									pos:  position{line: 103, col: 35, offset: 3114},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 62, col: 38, offset: 1690},
+									pos:        position{line: 103, col: 38, offset: 3117},
 									val:        ")",
 									ignoreCase: false,
 								},
 							},
 						},
 					},
-					&labeledExpr{
-						pos:   position{line: 65, col: 5, offset: 1781},
-						label: "op",
-						expr: &ruleRefExpr{
-							pos:  position{line: 65, col: 8, offset: 1784},
-							name: "Operator",
-						},
-					},
 					&actionExpr{
-						pos: position{line: 66, col: 5, offset: 1797},
-						run: (*parser).callonOperation47,
+						pos: position{line: 106, col: 5, offset: 3208},
+						run: (*parser).callonOperationBody45,
 						expr: &seqExpr{
-							pos: position{line: 66, col: 5, offset: 1797},
+							pos: position{line: 106, col: 5, offset: 3208},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 66, col: 5, offset: 1797},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 66, col: 7, offset: 1799},
-										name: "OpAssignment",
-									},
+								&litMatcher{
+									pos:        position{line: 106, col: 5, offset: 3208},
+									val:        "try",
+									ignoreCase: false,
 								},
-								&andExpr{
-									pos: position{line: 66, col: 20, offset: 1812},
-									expr: &ruleRefExpr{
-										pos:  position{line: 66, col: 21, offset: 1813},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 11, offset: 3214},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 106, col: 14, offset: 3217},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 18, offset: 3221},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 106, col: 21, offset: 3224},
+									label: "body",
+									expr: &ruleRefExpr{
+										pos:  position{line: 106, col: 26, offset: 3229},
+										name: "Sequential",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 37, offset: 3240},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 106, col: 40, offset: 3243},
+									val:        ")",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 44, offset: 3247},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 106, col: 47, offset: 3250},
+									val:        "catch",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 55, offset: 3258},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 106, col: 58, offset: 3261},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 62, offset: 3265},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 106, col: 65, offset: 3268},
+									label: "handler",
+									expr: &ruleRefExpr{
+										pos:  position{line: 106, col: 73, offset: 3276},
+										name: "Sequential",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 106, col: 84, offset: 3287},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 106, col: 87, offset: 3290},
+									val:        ")",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&labeledExpr{
+						pos:   position{line: 109, col: 5, offset: 3394},
+						label: "op",
+						expr: &ruleRefExpr{
+							pos:  position{line: 109, col: 8, offset: 3397},
+							name: "Operator",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 110, col: 5, offset: 3410},
+						run: (*parser).callonOperationBody66,
+						expr: &seqExpr{
+							pos: position{line: 110, col: 5, offset: 3410},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 110, col: 5, offset: 3410},
+									label: "a",
+									expr: &ruleRefExpr{
+										pos:  position{line: 110, col: 7, offset: 3412},
+										name: "OpAssignment",
+									},
+								},
+								&andExpr{
+									pos: position{line: 110, col: 20, offset: 3425},
+									expr: &ruleRefExpr{
+										pos:  position{line: 110, col: 21, offset: 3426},
 										name: "EndOfOp",
 									},
 								},
@@ -621,39 +1061,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 67, col: 5, offset: 1843},
-						run: (*parser).callonOperation53,
+						pos: position{line: 111, col: 5, offset: 3456},
+						run: (*parser).callonOperationBody72,
 						expr: &seqExpr{
-							pos: position{line: 67, col: 5, offset: 1843},
+							pos: position{line: 111, col: 5, offset: 3456},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 67, col: 5, offset: 1843},
+									pos: position{line: 111, col: 5, offset: 3456},
 									expr: &seqExpr{
-										pos: position{line: 67, col: 7, offset: 1845},
+										pos: position{line: 111, col: 7, offset: 3458},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 67, col: 7, offset: 1845},
+												pos:  position{line: 111, col: 7, offset: 3458},
 												name: "Function",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 67, col: 16, offset: 1854},
+												pos:  position{line: 111, col: 16, offset: 3467},
 												name: "EndOfOp",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 67, col: 25, offset: 1863},
+									pos:   position{line: 111, col: 25, offset: 3476},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 27, offset: 1865},
+										pos:  position{line: 111, col: 27, offset: 3478},
 										name: "Aggregation",
 									},
 								},
 								&andExpr{
-									pos: position{line: 67, col: 39, offset: 1877},
+									pos: position{line: 111, col: 39, offset: 3490},
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 40, offset: 1878},
+										pos:  position{line: 111, col: 40, offset: 3491},
 										name: "EndOfOp",
 									},
 								},
@@ -661,25 +1101,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 68, col: 5, offset: 1909},
-						run: (*parser).callonOperation63,
+						pos: position{line: 112, col: 5, offset: 3522},
+						run: (*parser).callonOperationBody82,
 						expr: &seqExpr{
-							pos: position{line: 68, col: 5, offset: 1909},
+							pos: position{line: 112, col: 5, offset: 3522},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 68, col: 5, offset: 1909},
+									pos:        position{line: 112, col: 5, offset: 3522},
 									val:        "search",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 68, col: 14, offset: 1918},
+									pos:  position{line: 112, col: 14, offset: 3531},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 68, col: 16, offset: 1920},
+									pos:   position{line: 112, col: 16, offset: 3533},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 68, col: 21, offset: 1925},
+										pos:  position{line: 112, col: 21, offset: 3538},
 										name: "SearchBoolean",
 									},
 								},
@@ -687,37 +1127,37 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 71, col: 5, offset: 2024},
-						run: (*parser).callonOperation69,
+						pos: position{line: 115, col: 5, offset: 3637},
+						run: (*parser).callonOperationBody88,
 						expr: &labeledExpr{
-							pos:   position{line: 71, col: 5, offset: 2024},
+							pos:   position{line: 115, col: 5, offset: 3637},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 71, col: 10, offset: 2029},
+								pos:  position{line: 115, col: 10, offset: 3642},
 								name: "SearchBoolean",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 74, col: 5, offset: 2128},
-						run: (*parser).callonOperation72,
+						pos: position{line: 118, col: 5, offset: 3741},
+						run: (*parser).callonOperationBody91,
 						expr: &labeledExpr{
-							pos:   position{line: 74, col: 5, offset: 2128},
+							pos:   position{line: 118, col: 5, offset: 3741},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 74, col: 10, offset: 2133},
+								pos:  position{line: 118, col: 10, offset: 3746},
 								name: "Cast",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 77, col: 5, offset: 2221},
-						run: (*parser).callonOperation75,
+						pos: position{line: 121, col: 5, offset: 3834},
+						run: (*parser).callonOperationBody94,
 						expr: &labeledExpr{
-							pos:   position{line: 77, col: 5, offset: 2221},
+							pos:   position{line: 121, col: 5, offset: 3834},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 77, col: 10, offset: 2226},
+								pos:  position{line: 121, col: 10, offset: 3839},
 								name: "Expr",
 							},
 						},
@@ -727,37 +1167,37 @@ var g = &grammar{
 		},
 		{
 			name: "EndOfOp",
-			pos:  position{line: 81, col: 1, offset: 2313},
+			pos:  position{line: 125, col: 1, offset: 3926},
 			expr: &seqExpr{
-				pos: position{line: 81, col: 11, offset: 2323},
+				pos: position{line: 125, col: 11, offset: 3936},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 81, col: 11, offset: 2323},
+						pos:  position{line: 125, col: 11, offset: 3936},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 81, col: 15, offset: 2327},
+						pos: position{line: 125, col: 15, offset: 3940},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 81, col: 15, offset: 2327},
+								pos:  position{line: 125, col: 15, offset: 3940},
 								name: "Pipe",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 81, col: 22, offset: 2334},
+								pos:  position{line: 125, col: 22, offset: 3947},
 								name: "SearchKeywordGuard",
 							},
 							&litMatcher{
-								pos:        position{line: 81, col: 43, offset: 2355},
+								pos:        position{line: 125, col: 43, offset: 3968},
 								val:        "=>",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 81, col: 50, offset: 2362},
+								pos:        position{line: 125, col: 50, offset: 3975},
 								val:        ")",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 81, col: 56, offset: 2368},
+								pos:  position{line: 125, col: 56, offset: 3981},
 								name: "EOF",
 							},
 						},
@@ -767,27 +1207,27 @@ var g = &grammar{
 		},
 		{
 			name: "Pipe",
-			pos:  position{line: 82, col: 1, offset: 2373},
+			pos:  position{line: 126, col: 1, offset: 3986},
 			expr: &seqExpr{
-				pos: position{line: 82, col: 8, offset: 2380},
+				pos: position{line: 126, col: 8, offset: 3993},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 82, col: 8, offset: 2380},
+						pos:        position{line: 126, col: 8, offset: 3993},
 						val:        "|",
 						ignoreCase: false,
 					},
 					&notExpr{
-						pos: position{line: 82, col: 12, offset: 2384},
+						pos: position{line: 126, col: 12, offset: 3997},
 						expr: &choiceExpr{
-							pos: position{line: 82, col: 14, offset: 2386},
+							pos: position{line: 126, col: 14, offset: 3999},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 82, col: 14, offset: 2386},
+									pos:        position{line: 126, col: 14, offset: 3999},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 82, col: 20, offset: 2392},
+									pos:        position{line: 126, col: 20, offset: 4005},
 									val:        "[",
 									ignoreCase: false,
 								},
@@ -799,32 +1239,45 @@ var g = &grammar{
 		},
 		{
 			name: "Leg",
-			pos:  position{line: 84, col: 1, offset: 2398},
+			pos:  position{line: 128, col: 1, offset: 4011},
 			expr: &actionExpr{
-				pos: position{line: 85, col: 5, offset: 2406},
+				pos: position{line: 129, col: 5, offset: 4019},
 				run: (*parser).callonLeg1,
 				expr: &seqExpr{
-					pos: position{line: 85, col: 5, offset: 2406},
+					pos: position{line: 129, col: 5, offset: 4019},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 85, col: 5, offset: 2406},
-							name: "__",
-						},
-						&litMatcher{
-							pos:        position{line: 85, col: 8, offset: 2409},
-							val:        "=>",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 85, col: 13, offset: 2414},
+							pos:  position{line: 129, col: 5, offset: 4019},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 85, col: 16, offset: 2417},
-							label: "s",
-							expr: &ruleRefExpr{
-								pos:  position{line: 85, col: 18, offset: 2419},
-								name: "Sequential",
+							pos:   position{line: 129, col: 8, offset: 4022},
+							label: "leg",
+							expr: &actionExpr{
+								pos: position{line: 129, col: 13, offset: 4027},
+								run: (*parser).callonLeg5,
+								expr: &seqExpr{
+									pos: position{line: 129, col: 13, offset: 4027},
+									exprs: []interface{}{
+										&litMatcher{
+											pos:        position{line: 129, col: 13, offset: 4027},
+											val:        "=>",
+											ignoreCase: false,
+										},
+										&ruleRefExpr{
+											pos:  position{line: 129, col: 18, offset: 4032},
+											name: "__",
+										},
+										&labeledExpr{
+											pos:   position{line: 129, col: 21, offset: 4035},
+											label: "s",
+											expr: &ruleRefExpr{
+												pos:  position{line: 129, col: 23, offset: 4037},
+												name: "Sequential",
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -833,44 +1286,57 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchLeg",
-			pos:  position{line: 87, col: 1, offset: 2449},
+			pos:  position{line: 131, col: 1, offset: 4095},
 			expr: &actionExpr{
-				pos: position{line: 88, col: 5, offset: 2463},
+				pos: position{line: 132, col: 5, offset: 4109},
 				run: (*parser).callonSwitchLeg1,
 				expr: &seqExpr{
-					pos: position{line: 88, col: 5, offset: 2463},
+					pos: position{line: 132, col: 5, offset: 4109},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 88, col: 5, offset: 2463},
-							name: "__",
-						},
-						&labeledExpr{
-							pos:   position{line: 88, col: 8, offset: 2466},
-							label: "expr",
-							expr: &ruleRefExpr{
-								pos:  position{line: 88, col: 13, offset: 2471},
-								name: "Case",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 88, col: 18, offset: 2476},
-							name: "__",
-						},
-						&litMatcher{
-							pos:        position{line: 88, col: 21, offset: 2479},
-							val:        "=>",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 88, col: 26, offset: 2484},
+							pos:  position{line: 132, col: 5, offset: 4109},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 88, col: 29, offset: 2487},
-							label: "op",
-							expr: &ruleRefExpr{
-								pos:  position{line: 88, col: 32, offset: 2490},
-								name: "Sequential",
+							pos:   position{line: 132, col: 8, offset: 4112},
+							label: "leg",
+							expr: &actionExpr{
+								pos: position{line: 132, col: 13, offset: 4117},
+								run: (*parser).callonSwitchLeg5,
+								expr: &seqExpr{
+									pos: position{line: 132, col: 13, offset: 4117},
+									exprs: []interface{}{
+										&labeledExpr{
+											pos:   position{line: 132, col: 13, offset: 4117},
+											label: "expr",
+											expr: &ruleRefExpr{
+												pos:  position{line: 132, col: 18, offset: 4122},
+												name: "Case",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 132, col: 23, offset: 4127},
+											name: "__",
+										},
+										&litMatcher{
+											pos:        position{line: 132, col: 26, offset: 4130},
+											val:        "=>",
+											ignoreCase: false,
+										},
+										&ruleRefExpr{
+											pos:  position{line: 132, col: 31, offset: 4135},
+											name: "__",
+										},
+										&labeledExpr{
+											pos:   position{line: 132, col: 34, offset: 4138},
+											label: "op",
+											expr: &ruleRefExpr{
+												pos:  position{line: 132, col: 37, offset: 4141},
+												name: "Sequential",
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -879,30 +1345,30 @@ var g = &grammar{
 		},
 		{
 			name: "Case",
-			pos:  position{line: 92, col: 1, offset: 2575},
+			pos:  position{line: 136, col: 1, offset: 4254},
 			expr: &choiceExpr{
-				pos: position{line: 93, col: 5, offset: 2584},
+				pos: position{line: 137, col: 5, offset: 4263},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 93, col: 5, offset: 2584},
+						pos: position{line: 137, col: 5, offset: 4263},
 						run: (*parser).callonCase2,
 						expr: &seqExpr{
-							pos: position{line: 93, col: 5, offset: 2584},
+							pos: position{line: 137, col: 5, offset: 4263},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 93, col: 5, offset: 2584},
+									pos:        position{line: 137, col: 5, offset: 4263},
 									val:        "case",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 93, col: 12, offset: 2591},
+									pos:  position{line: 137, col: 12, offset: 4270},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 93, col: 14, offset: 2593},
+									pos:   position{line: 137, col: 14, offset: 4272},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 93, col: 19, offset: 2598},
+										pos:  position{line: 137, col: 19, offset: 4277},
 										name: "Expr",
 									},
 								},
@@ -910,10 +1376,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 94, col: 5, offset: 2628},
+						pos: position{line: 138, col: 5, offset: 4307},
 						run: (*parser).callonCase8,
 						expr: &litMatcher{
-							pos:        position{line: 94, col: 5, offset: 2628},
+							pos:        position{line: 138, col: 5, offset: 4307},
 							val:        "default",
 							ignoreCase: false,
 						},
@@ -923,48 +1389,69 @@ var g = &grammar{
 		},
 		{
 			name: "FromLeg",
-			pos:  position{line: 96, col: 1, offset: 2659},
+			pos:  position{line: 140, col: 1, offset: 4338},
 			expr: &actionExpr{
-				pos: position{line: 97, col: 5, offset: 2671},
+				pos: position{line: 141, col: 5, offset: 4350},
 				run: (*parser).callonFromLeg1,
 				expr: &seqExpr{
-					pos: position{line: 97, col: 5, offset: 2671},
+					pos: position{line: 141, col: 5, offset: 4350},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 97, col: 5, offset: 2671},
+							pos:  position{line: 141, col: 5, offset: 4350},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 97, col: 8, offset: 2674},
+							pos:   position{line: 141, col: 8, offset: 4353},
+							label: "trunk",
+							expr: &ruleRefExpr{
+								pos:  position{line: 141, col: 14, offset: 4359},
+								name: "FromTrunk",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FromTrunk",
+			pos:  position{line: 143, col: 1, offset: 4392},
+			expr: &actionExpr{
+				pos: position{line: 144, col: 5, offset: 4406},
+				run: (*parser).callonFromTrunk1,
+				expr: &seqExpr{
+					pos: position{line: 144, col: 5, offset: 4406},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 144, col: 5, offset: 4406},
 							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 97, col: 15, offset: 2681},
+								pos:  position{line: 144, col: 12, offset: 4413},
 								name: "FromSource",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 97, col: 26, offset: 2692},
+							pos:   position{line: 144, col: 23, offset: 4424},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 97, col: 30, offset: 2696},
+								pos: position{line: 144, col: 27, offset: 4428},
 								expr: &seqExpr{
-									pos: position{line: 97, col: 31, offset: 2697},
+									pos: position{line: 144, col: 28, offset: 4429},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 31, offset: 2697},
+											pos:  position{line: 144, col: 28, offset: 4429},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 97, col: 34, offset: 2700},
+											pos:        position{line: 144, col: 31, offset: 4432},
 											val:        "=>",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 39, offset: 2705},
+											pos:  position{line: 144, col: 36, offset: 4437},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 43, offset: 2709},
+											pos:  position{line: 144, col: 40, offset: 4441},
 											name: "Sequential",
 										},
 									},
@@ -977,24 +1464,24 @@ var g = &grammar{
 		},
 		{
 			name: "FromSource",
-			pos:  position{line: 105, col: 1, offset: 2907},
+			pos:  position{line: 152, col: 1, offset: 4646},
 			expr: &choiceExpr{
-				pos: position{line: 106, col: 5, offset: 2922},
+				pos: position{line: 153, col: 5, offset: 4661},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 106, col: 5, offset: 2922},
+						pos:  position{line: 153, col: 5, offset: 4661},
 						name: "File",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 107, col: 5, offset: 2931},
+						pos:  position{line: 154, col: 5, offset: 4670},
 						name: "Get",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 108, col: 5, offset: 2939},
+						pos:  position{line: 155, col: 5, offset: 4678},
 						name: "Pool",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 109, col: 5, offset: 2948},
+						pos:  position{line: 156, col: 5, offset: 4687},
 						name: "PassOp",
 					},
 				},
@@ -1002,59 +1489,59 @@ var g = &grammar{
 		},
 		{
 			name: "ExprGuard",
-			pos:  position{line: 111, col: 1, offset: 2956},
+			pos:  position{line: 158, col: 1, offset: 4695},
 			expr: &seqExpr{
-				pos: position{line: 111, col: 13, offset: 2968},
+				pos: position{line: 158, col: 13, offset: 4707},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 111, col: 13, offset: 2968},
+						pos:  position{line: 158, col: 13, offset: 4707},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 111, col: 17, offset: 2972},
+						pos: position{line: 158, col: 17, offset: 4711},
 						alternatives: []interface{}{
 							&seqExpr{
-								pos: position{line: 111, col: 18, offset: 2973},
+								pos: position{line: 158, col: 18, offset: 4712},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 111, col: 18, offset: 2973},
+										pos: position{line: 158, col: 18, offset: 4712},
 										expr: &litMatcher{
-											pos:        position{line: 111, col: 19, offset: 2974},
+											pos:        position{line: 158, col: 19, offset: 4713},
 											val:        "=>",
 											ignoreCase: false,
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 111, col: 24, offset: 2979},
+										pos:  position{line: 158, col: 24, offset: 4718},
 										name: "Comparator",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 111, col: 38, offset: 2993},
+								pos:  position{line: 158, col: 38, offset: 4732},
 								name: "AdditiveOperator",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 111, col: 57, offset: 3012},
+								pos:  position{line: 158, col: 57, offset: 4751},
 								name: "MultiplicativeOperator",
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 82, offset: 3037},
+								pos:        position{line: 158, col: 82, offset: 4776},
 								val:        ":",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 88, offset: 3043},
+								pos:        position{line: 158, col: 88, offset: 4782},
 								val:        "(",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 94, offset: 3049},
+								pos:        position{line: 158, col: 94, offset: 4788},
 								val:        "[",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 100, offset: 3055},
+								pos:        position{line: 158, col: 100, offset: 4794},
 								val:        "~",
 								ignoreCase: false,
 							},
@@ -1065,57 +1552,57 @@ var g = &grammar{
 		},
 		{
 			name: "Comparator",
-			pos:  position{line: 113, col: 1, offset: 3061},
+			pos:  position{line: 160, col: 1, offset: 4800},
 			expr: &actionExpr{
-				pos: position{line: 113, col: 14, offset: 3074},
+				pos: position{line: 160, col: 14, offset: 4813},
 				run: (*parser).callonComparator1,
 				expr: &choiceExpr{
-					pos: position{line: 113, col: 15, offset: 3075},
+					pos: position{line: 160, col: 15, offset: 4814},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 113, col: 15, offset: 3075},
+							pos:        position{line: 160, col: 15, offset: 4814},
 							val:        "==",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 22, offset: 3082},
+							pos:        position{line: 160, col: 22, offset: 4821},
 							val:        "!=",
 							ignoreCase: false,
 						},
 						&seqExpr{
-							pos: position{line: 113, col: 30, offset: 3090},
+							pos: position{line: 160, col: 30, offset: 4829},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 113, col: 30, offset: 3090},
+									pos:        position{line: 160, col: 30, offset: 4829},
 									val:        "in",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 113, col: 35, offset: 3095},
+									pos: position{line: 160, col: 35, offset: 4834},
 									expr: &ruleRefExpr{
-										pos:  position{line: 113, col: 36, offset: 3096},
+										pos:  position{line: 160, col: 36, offset: 4835},
 										name: "IdentifierRest",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 54, offset: 3114},
+							pos:        position{line: 160, col: 54, offset: 4853},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 61, offset: 3121},
+							pos:        position{line: 160, col: 61, offset: 4860},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 67, offset: 3127},
+							pos:        position{line: 160, col: 67, offset: 4866},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 74, offset: 3134},
+							pos:        position{line: 160, col: 74, offset: 4873},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -1125,28 +1612,28 @@ var g = &grammar{
 		},
 		{
 			name: "SearchBoolean",
-			pos:  position{line: 115, col: 1, offset: 3171},
+			pos:  position{line: 162, col: 1, offset: 4910},
 			expr: &actionExpr{
-				pos: position{line: 116, col: 5, offset: 3189},
+				pos: position{line: 163, col: 5, offset: 4928},
 				run: (*parser).callonSearchBoolean1,
 				expr: &seqExpr{
-					pos: position{line: 116, col: 5, offset: 3189},
+					pos: position{line: 163, col: 5, offset: 4928},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 116, col: 5, offset: 3189},
+							pos:   position{line: 163, col: 5, offset: 4928},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 116, col: 11, offset: 3195},
+								pos:  position{line: 163, col: 11, offset: 4934},
 								name: "SearchAnd",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 116, col: 21, offset: 3205},
+							pos:   position{line: 163, col: 21, offset: 4944},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 116, col: 26, offset: 3210},
+								pos: position{line: 163, col: 26, offset: 4949},
 								expr: &ruleRefExpr{
-									pos:  position{line: 116, col: 26, offset: 3210},
+									pos:  position{line: 163, col: 26, offset: 4949},
 									name: "SearchOrTerm",
 								},
 							},
@@ -1157,30 +1644,30 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOrTerm",
-			pos:  position{line: 120, col: 1, offset: 3284},
+			pos:  position{line: 167, col: 1, offset: 5023},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 16, offset: 3299},
+				pos: position{line: 167, col: 16, offset: 5038},
 				run: (*parser).callonSearchOrTerm1,
 				expr: &seqExpr{
-					pos: position{line: 120, col: 16, offset: 3299},
+					pos: position{line: 167, col: 16, offset: 5038},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 16, offset: 3299},
+							pos:  position{line: 167, col: 16, offset: 5038},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 18, offset: 3301},
+							pos:  position{line: 167, col: 18, offset: 5040},
 							name: "OrToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 26, offset: 3309},
+							pos:  position{line: 167, col: 26, offset: 5048},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 28, offset: 3311},
+							pos:   position{line: 167, col: 28, offset: 5050},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 30, offset: 3313},
+								pos:  position{line: 167, col: 30, offset: 5052},
 								name: "SearchAnd",
 							},
 						},
@@ -1190,73 +1677,73 @@ var g = &grammar{
 		},
 		{
 			name: "SearchAnd",
-			pos:  position{line: 122, col: 1, offset: 3363},
+			pos:  position{line: 169, col: 1, offset: 5102},
 			expr: &actionExpr{
-				pos: position{line: 123, col: 5, offset: 3377},
+				pos: position{line: 170, col: 5, offset: 5116},
 				run: (*parser).callonSearchAnd1,
 				expr: &seqExpr{
-					pos: position{line: 123, col: 5, offset: 3377},
+					pos: position{line: 170, col: 5, offset: 5116},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 123, col: 5, offset: 3377},
+							pos:   position{line: 170, col: 5, offset: 5116},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 123, col: 11, offset: 3383},
+								pos:  position{line: 170, col: 11, offset: 5122},
 								name: "SearchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 124, col: 5, offset: 3400},
+							pos:   position{line: 171, col: 5, offset: 5139},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 124, col: 10, offset: 3405},
+								pos: position{line: 171, col: 10, offset: 5144},
 								expr: &actionExpr{
-									pos: position{line: 124, col: 11, offset: 3406},
+									pos: position{line: 171, col: 11, offset: 5145},
 									run: (*parser).callonSearchAnd7,
 									expr: &seqExpr{
-										pos: position{line: 124, col: 11, offset: 3406},
+										pos: position{line: 171, col: 11, offset: 5145},
 										exprs: []interface{}{
 											&zeroOrOneExpr{
-												pos: position{line: 124, col: 11, offset: 3406},
+												pos: position{line: 171, col: 11, offset: 5145},
 												expr: &seqExpr{
-													pos: position{line: 124, col: 12, offset: 3407},
+													pos: position{line: 171, col: 12, offset: 5146},
 													exprs: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 12, offset: 3407},
+															pos:  position{line: 171, col: 12, offset: 5146},
 															name: "_",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 14, offset: 3409},
+															pos:  position{line: 171, col: 14, offset: 5148},
 															name: "AndToken",
 														},
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 124, col: 25, offset: 3420},
+												pos:  position{line: 171, col: 25, offset: 5159},
 												name: "_",
 											},
 											&notExpr{
-												pos: position{line: 124, col: 27, offset: 3422},
+												pos: position{line: 171, col: 27, offset: 5161},
 												expr: &choiceExpr{
-													pos: position{line: 124, col: 29, offset: 3424},
+													pos: position{line: 171, col: 29, offset: 5163},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 29, offset: 3424},
+															pos:  position{line: 171, col: 29, offset: 5163},
 															name: "OrToken",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 39, offset: 3434},
+															pos:  position{line: 171, col: 39, offset: 5173},
 															name: "SearchKeywordGuard",
 														},
 													},
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 124, col: 59, offset: 3454},
+												pos:   position{line: 171, col: 59, offset: 5193},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 124, col: 64, offset: 3459},
+													pos:  position{line: 171, col: 64, offset: 5198},
 													name: "SearchFactor",
 												},
 											},
@@ -1271,41 +1758,41 @@ var g = &grammar{
 		},
 		{
 			name: "SearchKeywordGuard",
-			pos:  position{line: 128, col: 1, offset: 3575},
+			pos:  position{line: 175, col: 1, offset: 5314},
 			expr: &choiceExpr{
-				pos: position{line: 129, col: 5, offset: 3598},
+				pos: position{line: 176, col: 5, offset: 5337},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 129, col: 5, offset: 3598},
+						pos: position{line: 176, col: 5, offset: 5337},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 129, col: 5, offset: 3598},
+								pos:  position{line: 176, col: 5, offset: 5337},
 								name: "FromSource",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 129, col: 16, offset: 3609},
+								pos:  position{line: 176, col: 16, offset: 5348},
 								name: "__",
 							},
 							&litMatcher{
-								pos:        position{line: 129, col: 19, offset: 3612},
+								pos:        position{line: 176, col: 19, offset: 5351},
 								val:        "=>",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 129, col: 24, offset: 3617},
+								pos:  position{line: 176, col: 24, offset: 5356},
 								name: "__",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 130, col: 5, offset: 3624},
+						pos: position{line: 177, col: 5, offset: 5363},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 130, col: 5, offset: 3624},
+								pos:  position{line: 177, col: 5, offset: 5363},
 								name: "Case",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 130, col: 10, offset: 3629},
+								pos:  position{line: 177, col: 10, offset: 5368},
 								name: "__",
 							},
 						},
@@ -1315,42 +1802,42 @@ var g = &grammar{
 		},
 		{
 			name: "SearchFactor",
-			pos:  position{line: 132, col: 1, offset: 3633},
+			pos:  position{line: 179, col: 1, offset: 5372},
 			expr: &choiceExpr{
-				pos: position{line: 133, col: 5, offset: 3650},
+				pos: position{line: 180, col: 5, offset: 5389},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 133, col: 5, offset: 3650},
+						pos: position{line: 180, col: 5, offset: 5389},
 						run: (*parser).callonSearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 133, col: 5, offset: 3650},
+							pos: position{line: 180, col: 5, offset: 5389},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 133, col: 6, offset: 3651},
+									pos: position{line: 180, col: 6, offset: 5390},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 133, col: 6, offset: 3651},
+											pos: position{line: 180, col: 6, offset: 5390},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 133, col: 6, offset: 3651},
+													pos:  position{line: 180, col: 6, offset: 5390},
 													name: "NotToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 133, col: 15, offset: 3660},
+													pos:  position{line: 180, col: 15, offset: 5399},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 133, col: 19, offset: 3664},
+											pos: position{line: 180, col: 19, offset: 5403},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 133, col: 19, offset: 3664},
+													pos:        position{line: 180, col: 19, offset: 5403},
 													val:        "!",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 133, col: 23, offset: 3668},
+													pos:  position{line: 180, col: 23, offset: 5407},
 													name: "__",
 												},
 											},
@@ -1358,10 +1845,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 133, col: 27, offset: 3672},
+									pos:   position{line: 180, col: 27, offset: 5411},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 133, col: 29, offset: 3674},
+										pos:  position{line: 180, col: 29, offset: 5413},
 										name: "SearchFactor",
 									},
 								},
@@ -1369,34 +1856,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 136, col: 5, offset: 3786},
+						pos: position{line: 183, col: 5, offset: 5525},
 						run: (*parser).callonSearchFactor13,
 						expr: &seqExpr{
-							pos: position{line: 136, col: 5, offset: 3786},
+							pos: position{line: 183, col: 5, offset: 5525},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 136, col: 5, offset: 3786},
+									pos:        position{line: 183, col: 5, offset: 5525},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 136, col: 9, offset: 3790},
+									pos:  position{line: 183, col: 9, offset: 5529},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 136, col: 12, offset: 3793},
+									pos:   position{line: 183, col: 12, offset: 5532},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 136, col: 17, offset: 3798},
+										pos:  position{line: 183, col: 17, offset: 5537},
 										name: "SearchBoolean",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 136, col: 31, offset: 3812},
+									pos:  position{line: 183, col: 31, offset: 5551},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 136, col: 34, offset: 3815},
+									pos:        position{line: 183, col: 34, offset: 5554},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1404,7 +1891,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 137, col: 5, offset: 3844},
+						pos:  position{line: 184, col: 5, offset: 5583},
 						name: "SearchExpr",
 					},
 				},
@@ -1412,53 +1899,53 @@ var g = &grammar{
 		},
 		{
 			name: "SearchExpr",
-			pos:  position{line: 139, col: 1, offset: 3856},
+			pos:  position{line: 186, col: 1, offset: 5595},
 			expr: &choiceExpr{
-				pos: position{line: 140, col: 5, offset: 3871},
+				pos: position{line: 187, col: 5, offset: 5610},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 140, col: 5, offset: 3871},
+						pos:  position{line: 187, col: 5, offset: 5610},
 						name: "Glob",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 141, col: 5, offset: 3880},
+						pos:  position{line: 188, col: 5, offset: 5619},
 						name: "Regexp",
 					},
 					&actionExpr{
-						pos: position{line: 142, col: 5, offset: 3891},
+						pos: position{line: 189, col: 5, offset: 5630},
 						run: (*parser).callonSearchExpr4,
 						expr: &seqExpr{
-							pos: position{line: 142, col: 5, offset: 3891},
+							pos: position{line: 189, col: 5, offset: 5630},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 142, col: 5, offset: 3891},
+									pos:   position{line: 189, col: 5, offset: 5630},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 142, col: 7, offset: 3893},
+										pos:  position{line: 189, col: 7, offset: 5632},
 										name: "SearchValue",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 142, col: 20, offset: 3906},
+									pos: position{line: 189, col: 20, offset: 5645},
 									alternatives: []interface{}{
 										&notExpr{
-											pos: position{line: 142, col: 20, offset: 3906},
+											pos: position{line: 189, col: 20, offset: 5645},
 											expr: &ruleRefExpr{
-												pos:  position{line: 142, col: 21, offset: 3907},
+												pos:  position{line: 189, col: 21, offset: 5646},
 												name: "ExprGuard",
 											},
 										},
 										&andExpr{
-											pos: position{line: 142, col: 33, offset: 3919},
+											pos: position{line: 189, col: 33, offset: 5658},
 											expr: &seqExpr{
-												pos: position{line: 142, col: 35, offset: 3921},
+												pos: position{line: 189, col: 35, offset: 5660},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 142, col: 35, offset: 3921},
+														pos:  position{line: 189, col: 35, offset: 5660},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 142, col: 37, offset: 3923},
+														pos:  position{line: 189, col: 37, offset: 5662},
 														name: "Glob",
 													},
 												},
@@ -1470,20 +1957,20 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 145, col: 5, offset: 4035},
+						pos: position{line: 192, col: 5, offset: 5774},
 						run: (*parser).callonSearchExpr15,
 						expr: &seqExpr{
-							pos: position{line: 145, col: 5, offset: 4035},
+							pos: position{line: 192, col: 5, offset: 5774},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 145, col: 5, offset: 4035},
+									pos:        position{line: 192, col: 5, offset: 5774},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 145, col: 9, offset: 4039},
+									pos: position{line: 192, col: 9, offset: 5778},
 									expr: &ruleRefExpr{
-										pos:  position{line: 145, col: 10, offset: 4040},
+										pos:  position{line: 192, col: 10, offset: 5779},
 										name: "ExprGuard",
 									},
 								},
@@ -1491,7 +1978,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 5, offset: 4156},
+						pos:  position{line: 195, col: 5, offset: 5895},
 						name: "SearchPredicate",
 					},
 				},
@@ -1499,45 +1986,45 @@ var g = &grammar{
 		},
 		{
 			name: "SearchPredicate",
-			pos:  position{line: 150, col: 1, offset: 4173},
+			pos:  position{line: 197, col: 1, offset: 5912},
 			expr: &choiceExpr{
-				pos: position{line: 151, col: 5, offset: 4193},
+				pos: position{line: 198, col: 5, offset: 5932},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 151, col: 5, offset: 4193},
+						pos: position{line: 198, col: 5, offset: 5932},
 						run: (*parser).callonSearchPredicate2,
 						expr: &seqExpr{
-							pos: position{line: 151, col: 5, offset: 4193},
+							pos: position{line: 198, col: 5, offset: 5932},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 151, col: 5, offset: 4193},
+									pos:   position{line: 198, col: 5, offset: 5932},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 9, offset: 4197},
+										pos:  position{line: 198, col: 9, offset: 5936},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 151, col: 22, offset: 4210},
+									pos:  position{line: 198, col: 22, offset: 5949},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 151, col: 25, offset: 4213},
+									pos:   position{line: 198, col: 25, offset: 5952},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 28, offset: 4216},
+										pos:  position{line: 198, col: 28, offset: 5955},
 										name: "Comparator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 151, col: 39, offset: 4227},
+									pos:  position{line: 198, col: 39, offset: 5966},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 151, col: 42, offset: 4230},
+									pos:   position{line: 198, col: 42, offset: 5969},
 									label: "rhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 46, offset: 4234},
+										pos:  position{line: 198, col: 46, offset: 5973},
 										name: "AdditiveExpr",
 									},
 								},
@@ -1545,26 +2032,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 154, col: 6, offset: 4357},
+						pos: position{line: 201, col: 6, offset: 6096},
 						run: (*parser).callonSearchPredicate12,
 						expr: &seqExpr{
-							pos: position{line: 154, col: 6, offset: 4357},
+							pos: position{line: 201, col: 6, offset: 6096},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 154, col: 6, offset: 4357},
+									pos:   position{line: 201, col: 6, offset: 6096},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 154, col: 12, offset: 4363},
+										pos:  position{line: 201, col: 12, offset: 6102},
 										name: "Function",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 154, col: 21, offset: 4372},
+									pos:   position{line: 201, col: 21, offset: 6111},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 154, col: 26, offset: 4377},
+										pos: position{line: 201, col: 26, offset: 6116},
 										expr: &ruleRefExpr{
-											pos:  position{line: 154, col: 27, offset: 4378},
+											pos:  position{line: 201, col: 27, offset: 6117},
 											name: "Deref",
 										},
 									},
@@ -1577,32 +2064,32 @@ var g = &grammar{
 		},
 		{
 			name: "SearchValue",
-			pos:  position{line: 158, col: 1, offset: 4450},
+			pos:  position{line: 205, col: 1, offset: 6189},
 			expr: &choiceExpr{
-				pos: position{line: 159, col: 5, offset: 4466},
+				pos: position{line: 206, col: 5, offset: 6205},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 5, offset: 4466},
+						pos:  position{line: 206, col: 5, offset: 6205},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 160, col: 5, offset: 4478},
+						pos: position{line: 207, col: 5, offset: 6217},
 						run: (*parser).callonSearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 160, col: 5, offset: 4478},
+							pos: position{line: 207, col: 5, offset: 6217},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 160, col: 5, offset: 4478},
+									pos: position{line: 207, col: 5, offset: 6217},
 									expr: &ruleRefExpr{
-										pos:  position{line: 160, col: 6, offset: 4479},
+										pos:  position{line: 207, col: 6, offset: 6218},
 										name: "RegexpPattern",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 160, col: 20, offset: 4493},
+									pos:   position{line: 207, col: 20, offset: 6232},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 160, col: 22, offset: 4495},
+										pos:  position{line: 207, col: 22, offset: 6234},
 										name: "KeyWord",
 									},
 								},
@@ -1614,15 +2101,15 @@ var g = &grammar{
 		},
 		{
 			name: "Glob",
-			pos:  position{line: 164, col: 1, offset: 4603},
+			pos:  position{line: 211, col: 1, offset: 6342},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 5, offset: 4612},
+				pos: position{line: 212, col: 5, offset: 6351},
 				run: (*parser).callonGlob1,
 				expr: &labeledExpr{
-					pos:   position{line: 165, col: 5, offset: 4612},
+					pos:   position{line: 212, col: 5, offset: 6351},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 165, col: 13, offset: 4620},
+						pos:  position{line: 212, col: 13, offset: 6359},
 						name: "GlobPattern",
 					},
 				},
@@ -1630,15 +2117,15 @@ var g = &grammar{
 		},
 		{
 			name: "Regexp",
-			pos:  position{line: 169, col: 1, offset: 4716},
+			pos:  position{line: 216, col: 1, offset: 6455},
 			expr: &actionExpr{
-				pos: position{line: 170, col: 5, offset: 4727},
+				pos: position{line: 217, col: 5, offset: 6466},
 				run: (*parser).callonRegexp1,
 				expr: &labeledExpr{
-					pos:   position{line: 170, col: 5, offset: 4727},
+					pos:   position{line: 217, col: 5, offset: 6466},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 170, col: 13, offset: 4735},
+						pos:  position{line: 217, col: 13, offset: 6474},
 						name: "RegexpPattern",
 					},
 				},
@@ -1646,36 +2133,36 @@ var g = &grammar{
 		},
 		{
 			name: "Aggregation",
-			pos:  position{line: 176, col: 1, offset: 4861},
+			pos:  position{line: 223, col: 1, offset: 6600},
 			expr: &choiceExpr{
-				pos: position{line: 177, col: 5, offset: 4877},
+				pos: position{line: 224, col: 5, offset: 6616},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 177, col: 5, offset: 4877},
+						pos: position{line: 224, col: 5, offset: 6616},
 						run: (*parser).callonAggregation2,
 						expr: &seqExpr{
-							pos: position{line: 177, col: 5, offset: 4877},
+							pos: position{line: 224, col: 5, offset: 6616},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 177, col: 5, offset: 4877},
+									pos: position{line: 224, col: 5, offset: 6616},
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 5, offset: 4877},
+										pos:  position{line: 224, col: 5, offset: 6616},
 										name: "Summarize",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 177, col: 16, offset: 4888},
+									pos:   position{line: 224, col: 16, offset: 6627},
 									label: "keys",
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 21, offset: 4893},
+										pos:  position{line: 224, col: 21, offset: 6632},
 										name: "GroupByKeys",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 177, col: 33, offset: 4905},
+									pos:   position{line: 224, col: 33, offset: 6644},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 39, offset: 4911},
+										pos:  position{line: 224, col: 39, offset: 6650},
 										name: "LimitArg",
 									},
 								},
@@ -1683,40 +2170,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 180, col: 5, offset: 5037},
+						pos: position{line: 227, col: 5, offset: 6776},
 						run: (*parser).callonAggregation10,
 						expr: &seqExpr{
-							pos: position{line: 180, col: 5, offset: 5037},
+							pos: position{line: 227, col: 5, offset: 6776},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 180, col: 5, offset: 5037},
+									pos: position{line: 227, col: 5, offset: 6776},
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 5, offset: 5037},
+										pos:  position{line: 227, col: 5, offset: 6776},
 										name: "Summarize",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 16, offset: 5048},
+									pos:   position{line: 227, col: 16, offset: 6787},
 									label: "aggs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 21, offset: 5053},
+										pos:  position{line: 227, col: 21, offset: 6792},
 										name: "AggAssignments",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 36, offset: 5068},
+									pos:   position{line: 227, col: 36, offset: 6807},
 									label: "keys",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 180, col: 41, offset: 5073},
+										pos: position{line: 227, col: 41, offset: 6812},
 										expr: &seqExpr{
-											pos: position{line: 180, col: 42, offset: 5074},
+											pos: position{line: 227, col: 42, offset: 6813},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 180, col: 42, offset: 5074},
+													pos:  position{line: 227, col: 42, offset: 6813},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 180, col: 44, offset: 5076},
+													pos:  position{line: 227, col: 44, offset: 6815},
 													name: "GroupByKeys",
 												},
 											},
@@ -1724,10 +2211,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 58, offset: 5090},
+									pos:   position{line: 227, col: 58, offset: 6829},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 64, offset: 5096},
+										pos:  position{line: 227, col: 64, offset: 6835},
 										name: "LimitArg",
 									},
 								},
@@ -1739,17 +2226,17 @@ var g = &grammar{
 		},
 		{
 			name: "Summarize",
-			pos:  position{line: 188, col: 1, offset: 5310},
+			pos:  position{line: 235, col: 1, offset: 7049},
 			expr: &seqExpr{
-				pos: position{line: 188, col: 13, offset: 5322},
+				pos: position{line: 235, col: 13, offset: 7061},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 188, col: 13, offset: 5322},
+						pos:        position{line: 235, col: 13, offset: 7061},
 						val:        "summarize",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 188, col: 25, offset: 5334},
+						pos:  position{line: 235, col: 25, offset: 7073},
 						name: "_",
 					},
 				},
@@ -1757,26 +2244,26 @@ var g = &grammar{
 		},
 		{
 			name: "GroupByKeys",
-			pos:  position{line: 190, col: 1, offset: 5337},
+			pos:  position{line: 237, col: 1, offset: 7076},
 			expr: &actionExpr{
-				pos: position{line: 191, col: 5, offset: 5353},
+				pos: position{line: 238, col: 5, offset: 7092},
 				run: (*parser).callonGroupByKeys1,
 				expr: &seqExpr{
-					pos: position{line: 191, col: 5, offset: 5353},
+					pos: position{line: 238, col: 5, offset: 7092},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 191, col: 5, offset: 5353},
+							pos:  position{line: 238, col: 5, offset: 7092},
 							name: "ByToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 191, col: 13, offset: 5361},
+							pos:  position{line: 238, col: 13, offset: 7100},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 15, offset: 5363},
+							pos:   position{line: 238, col: 15, offset: 7102},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 191, col: 23, offset: 5371},
+								pos:  position{line: 238, col: 23, offset: 7110},
 								name: "FlexAssignments",
 							},
 						},
@@ -1786,43 +2273,43 @@ var g = &grammar{
 		},
 		{
 			name: "LimitArg",
-			pos:  position{line: 193, col: 1, offset: 5412},
+			pos:  position{line: 240, col: 1, offset: 7151},
 			expr: &choiceExpr{
-				pos: position{line: 194, col: 5, offset: 5425},
+				pos: position{line: 241, col: 5, offset: 7164},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 194, col: 5, offset: 5425},
+						pos: position{line: 241, col: 5, offset: 7164},
 						run: (*parser).callonLimitArg2,
 						expr: &seqExpr{
-							pos: position{line: 194, col: 5, offset: 5425},
+							pos: position{line: 241, col: 5, offset: 7164},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 194, col: 5, offset: 5425},
+									pos:  position{line: 241, col: 5, offset: 7164},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 194, col: 7, offset: 5427},
+									pos:        position{line: 241, col: 7, offset: 7166},
 									val:        "with",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 194, col: 14, offset: 5434},
+									pos:  position{line: 241, col: 14, offset: 7173},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 194, col: 16, offset: 5436},
+									pos:        position{line: 241, col: 16, offset: 7175},
 									val:        "-limit",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 194, col: 25, offset: 5445},
+									pos:  position{line: 241, col: 25, offset: 7184},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 194, col: 27, offset: 5447},
+									pos:   position{line: 241, col: 27, offset: 7186},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 194, col: 33, offset: 5453},
+										pos:  position{line: 241, col: 33, offset: 7192},
 										name: "UInt",
 									},
 								},
@@ -1830,10 +2317,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 195, col: 5, offset: 5484},
+						pos: position{line: 242, col: 5, offset: 7223},
 						run: (*parser).callonLimitArg11,
 						expr: &litMatcher{
-							pos:        position{line: 195, col: 5, offset: 5484},
+							pos:        position{line: 242, col: 5, offset: 7223},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -1843,22 +2330,22 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignment",
-			pos:  position{line: 200, col: 1, offset: 5744},
+			pos:  position{line: 247, col: 1, offset: 7483},
 			expr: &choiceExpr{
-				pos: position{line: 201, col: 5, offset: 5763},
+				pos: position{line: 248, col: 5, offset: 7502},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 201, col: 5, offset: 5763},
+						pos:  position{line: 248, col: 5, offset: 7502},
 						name: "Assignment",
 					},
 					&actionExpr{
-						pos: position{line: 202, col: 5, offset: 5778},
+						pos: position{line: 249, col: 5, offset: 7517},
 						run: (*parser).callonFlexAssignment3,
 						expr: &labeledExpr{
-							pos:   position{line: 202, col: 5, offset: 5778},
+							pos:   position{line: 249, col: 5, offset: 7517},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 202, col: 10, offset: 5783},
+								pos:  position{line: 249, col: 10, offset: 7522},
 								name: "Expr",
 							},
 						},
@@ -1868,50 +2355,50 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignments",
-			pos:  position{line: 204, col: 1, offset: 5875},
+			pos:  position{line: 251, col: 1, offset: 7614},
 			expr: &actionExpr{
-				pos: position{line: 205, col: 5, offset: 5895},
+				pos: position{line: 252, col: 5, offset: 7634},
 				run: (*parser).callonFlexAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 205, col: 5, offset: 5895},
+					pos: position{line: 252, col: 5, offset: 7634},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 205, col: 5, offset: 5895},
+							pos:   position{line: 252, col: 5, offset: 7634},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 205, col: 11, offset: 5901},
+								pos:  position{line: 252, col: 11, offset: 7640},
 								name: "FlexAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 205, col: 26, offset: 5916},
+							pos:   position{line: 252, col: 26, offset: 7655},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 205, col: 31, offset: 5921},
+								pos: position{line: 252, col: 31, offset: 7660},
 								expr: &actionExpr{
-									pos: position{line: 205, col: 32, offset: 5922},
+									pos: position{line: 252, col: 32, offset: 7661},
 									run: (*parser).callonFlexAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 205, col: 32, offset: 5922},
+										pos: position{line: 252, col: 32, offset: 7661},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 205, col: 32, offset: 5922},
+												pos:  position{line: 252, col: 32, offset: 7661},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 205, col: 35, offset: 5925},
+												pos:        position{line: 252, col: 35, offset: 7664},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 205, col: 39, offset: 5929},
+												pos:  position{line: 252, col: 39, offset: 7668},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 205, col: 42, offset: 5932},
+												pos:   position{line: 252, col: 42, offset: 7671},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 205, col: 47, offset: 5937},
+													pos:  position{line: 252, col: 47, offset: 7676},
 													name: "FlexAssignment",
 												},
 											},
@@ -1926,42 +2413,42 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignment",
-			pos:  position{line: 209, col: 1, offset: 6059},
+			pos:  position{line: 256, col: 1, offset: 7798},
 			expr: &choiceExpr{
-				pos: position{line: 210, col: 5, offset: 6077},
+				pos: position{line: 257, col: 5, offset: 7816},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 210, col: 5, offset: 6077},
+						pos: position{line: 257, col: 5, offset: 7816},
 						run: (*parser).callonAggAssignment2,
 						expr: &seqExpr{
-							pos: position{line: 210, col: 5, offset: 6077},
+							pos: position{line: 257, col: 5, offset: 7816},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 210, col: 5, offset: 6077},
+									pos:   position{line: 257, col: 5, offset: 7816},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 10, offset: 6082},
+										pos:  position{line: 257, col: 10, offset: 7821},
 										name: "Lval",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 15, offset: 6087},
+									pos:  position{line: 257, col: 15, offset: 7826},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 210, col: 18, offset: 6090},
+									pos:        position{line: 257, col: 18, offset: 7829},
 									val:        ":=",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 23, offset: 6095},
+									pos:  position{line: 257, col: 23, offset: 7834},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 210, col: 26, offset: 6098},
+									pos:   position{line: 257, col: 26, offset: 7837},
 									label: "agg",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 30, offset: 6102},
+										pos:  position{line: 257, col: 30, offset: 7841},
 										name: "Agg",
 									},
 								},
@@ -1969,13 +2456,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 5, offset: 6206},
+						pos: position{line: 260, col: 5, offset: 7945},
 						run: (*parser).callonAggAssignment11,
 						expr: &labeledExpr{
-							pos:   position{line: 213, col: 5, offset: 6206},
+							pos:   position{line: 260, col: 5, offset: 7945},
 							label: "agg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 213, col: 9, offset: 6210},
+								pos:  position{line: 260, col: 9, offset: 7949},
 								name: "Agg",
 							},
 						},
@@ -1985,55 +2472,75 @@ var g = &grammar{
 		},
 		{
 			name: "Agg",
-			pos:  position{line: 217, col: 1, offset: 6310},
+			pos:  position{line: 264, col: 1, offset: 8049},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 5, offset: 6318},
+				pos: position{line: 265, col: 5, offset: 8057},
 				run: (*parser).callonAgg1,
 				expr: &seqExpr{
-					pos: position{line: 218, col: 5, offset: 6318},
+					pos: position{line: 265, col: 5, offset: 8057},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 218, col: 5, offset: 6318},
+							pos: position{line: 265, col: 5, offset: 8057},
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 6, offset: 6319},
+								pos:  position{line: 265, col: 6, offset: 8058},
 								name: "FuncGuard",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 16, offset: 6329},
+							pos:   position{line: 265, col: 16, offset: 8068},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 19, offset: 6332},
+								pos:  position{line: 265, col: 19, offset: 8071},
 								name: "AggName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 27, offset: 6340},
+							pos:  position{line: 265, col: 27, offset: 8079},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 218, col: 30, offset: 6343},
+							pos:        position{line: 265, col: 30, offset: 8082},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 34, offset: 6347},
+							pos:  position{line: 265, col: 34, offset: 8086},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 37, offset: 6350},
+							pos:   position{line: 265, col: 37, offset: 8089},
+							label: "distinct",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 265, col: 46, offset: 8098},
+								expr: &seqExpr{
+									pos: position{line: 265, col: 47, offset: 8099},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 265, col: 47, offset: 8099},
+											name: "DISTINCT",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 265, col: 56, offset: 8108},
+											name: "_",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 265, col: 60, offset: 8112},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 42, offset: 6355},
+								pos: position{line: 265, col: 65, offset: 8117},
 								expr: &choiceExpr{
-									pos: position{line: 218, col: 43, offset: 6356},
+									pos: position{line: 265, col: 66, offset: 8118},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 218, col: 43, offset: 6356},
+											pos:  position{line: 265, col: 66, offset: 8118},
 											name: "OverExpr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 218, col: 54, offset: 6367},
+											pos:  position{line: 265, col: 77, offset: 8129},
 											name: "Expr",
 										},
 									},
@@ -2041,25 +2548,25 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 62, offset: 6375},
+							pos:  position{line: 265, col: 85, offset: 8137},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 218, col: 65, offset: 6378},
+							pos:        position{line: 265, col: 88, offset: 8140},
 							val:        ")",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 218, col: 69, offset: 6382},
+							pos: position{line: 265, col: 92, offset: 8144},
 							expr: &seqExpr{
-								pos: position{line: 218, col: 71, offset: 6384},
+								pos: position{line: 265, col: 94, offset: 8146},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 218, col: 71, offset: 6384},
+										pos:  position{line: 265, col: 94, offset: 8146},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 218, col: 74, offset: 6387},
+										pos:        position{line: 265, col: 97, offset: 8149},
 										val:        ".",
 										ignoreCase: false,
 									},
@@ -2067,12 +2574,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 79, offset: 6392},
+							pos:   position{line: 265, col: 102, offset: 8154},
 							label: "where",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 85, offset: 6398},
+								pos: position{line: 265, col: 108, offset: 8160},
 								expr: &ruleRefExpr{
-									pos:  position{line: 218, col: 85, offset: 6398},
+									pos:  position{line: 265, col: 108, offset: 8160},
 									name: "WhereClause",
 								},
 							},
@@ -2083,20 +2590,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggName",
-			pos:  position{line: 226, col: 1, offset: 6588},
+			pos:  position{line: 276, col: 1, offset: 8414},
 			expr: &choiceExpr{
-				pos: position{line: 227, col: 5, offset: 6600},
+				pos: position{line: 277, col: 5, offset: 8426},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 227, col: 5, offset: 6600},
+						pos:  position{line: 277, col: 5, offset: 8426},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 228, col: 5, offset: 6619},
+						pos:  position{line: 278, col: 5, offset: 8445},
 						name: "AndToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 229, col: 5, offset: 6632},
+						pos:  position{line: 279, col: 5, offset: 8458},
 						name: "OrToken",
 					},
 				},
@@ -2104,31 +2611,31 @@ var g = &grammar{
 		},
 		{
 			name: "WhereClause",
-			pos:  position{line: 231, col: 1, offset: 6641},
+			pos:  position{line: 281, col: 1, offset: 8467},
 			expr: &actionExpr{
-				pos: position{line: 231, col: 15, offset: 6655},
+				pos: position{line: 281, col: 15, offset: 8481},
 				run: (*parser).callonWhereClause1,
 				expr: &seqExpr{
-					pos: position{line: 231, col: 15, offset: 6655},
+					pos: position{line: 281, col: 15, offset: 8481},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 231, col: 15, offset: 6655},
+							pos:  position{line: 281, col: 15, offset: 8481},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 231, col: 17, offset: 6657},
+							pos:        position{line: 281, col: 17, offset: 8483},
 							val:        "where",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 231, col: 25, offset: 6665},
+							pos:  position{line: 281, col: 25, offset: 8491},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 231, col: 27, offset: 6667},
+							pos:   position{line: 281, col: 27, offset: 8493},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 231, col: 32, offset: 6672},
+								pos:  position{line: 281, col: 32, offset: 8498},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -2138,44 +2645,44 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignments",
-			pos:  position{line: 233, col: 1, offset: 6708},
+			pos:  position{line: 283, col: 1, offset: 8534},
 			expr: &actionExpr{
-				pos: position{line: 234, col: 5, offset: 6727},
+				pos: position{line: 284, col: 5, offset: 8553},
 				run: (*parser).callonAggAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 234, col: 5, offset: 6727},
+					pos: position{line: 284, col: 5, offset: 8553},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 234, col: 5, offset: 6727},
+							pos:   position{line: 284, col: 5, offset: 8553},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 234, col: 11, offset: 6733},
+								pos:  position{line: 284, col: 11, offset: 8559},
 								name: "AggAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 234, col: 25, offset: 6747},
+							pos:   position{line: 284, col: 25, offset: 8573},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 234, col: 30, offset: 6752},
+								pos: position{line: 284, col: 30, offset: 8578},
 								expr: &seqExpr{
-									pos: position{line: 234, col: 31, offset: 6753},
+									pos: position{line: 284, col: 31, offset: 8579},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 31, offset: 6753},
+											pos:  position{line: 284, col: 31, offset: 8579},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 234, col: 34, offset: 6756},
+											pos:        position{line: 284, col: 34, offset: 8582},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 38, offset: 6760},
+											pos:  position{line: 284, col: 38, offset: 8586},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 41, offset: 6763},
+											pos:  position{line: 284, col: 41, offset: 8589},
 											name: "AggAssignment",
 										},
 									},
@@ -2188,130 +2695,142 @@ var g = &grammar{
 		},
 		{
 			name: "Operator",
-			pos:  position{line: 244, col: 1, offset: 6987},
+			pos:  position{line: 294, col: 1, offset: 8813},
 			expr: &choiceExpr{
-				pos: position{line: 245, col: 5, offset: 7000},
+				pos: position{line: 295, col: 5, offset: 8826},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 5, offset: 7000},
+						pos:  position{line: 295, col: 5, offset: 8826},
 						name: "AssertOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 246, col: 5, offset: 7013},
+						pos:  position{line: 296, col: 5, offset: 8839},
 						name: "SortOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 247, col: 5, offset: 7024},
+						pos:  position{line: 297, col: 5, offset: 8850},
 						name: "TopOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 248, col: 5, offset: 7034},
+						pos:  position{line: 298, col: 5, offset: 8860},
 						name: "CutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 249, col: 5, offset: 7044},
+						pos:  position{line: 299, col: 5, offset: 8870},
 						name: "DropOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 250, col: 5, offset: 7055},
+						pos:  position{line: 300, col: 5, offset: 8881},
 						name: "HeadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 5, offset: 7066},
+						pos:  position{line: 301, col: 5, offset: 8892},
 						name: "TailOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 252, col: 5, offset: 7077},
+						pos:  position{line: 302, col: 5, offset: 8903},
 						name: "WhereOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 253, col: 5, offset: 7089},
+						pos:  position{line: 303, col: 5, offset: 8915},
 						name: "UniqOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 254, col: 5, offset: 7100},
+						pos:  position{line: 304, col: 5, offset: 8926},
 						name: "PutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 255, col: 5, offset: 7110},
+						pos:  position{line: 305, col: 5, offset: 8936},
 						name: "RenameOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 256, col: 5, offset: 7123},
+						pos:  position{line: 306, col: 5, offset: 8949},
 						name: "FuseOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 257, col: 5, offset: 7134},
+						pos:  position{line: 307, col: 5, offset: 8960},
 						name: "ShapeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 258, col: 5, offset: 7146},
+						pos:  position{line: 308, col: 5, offset: 8972},
 						name: "JoinOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 259, col: 5, offset: 7157},
+						pos:  position{line: 309, col: 5, offset: 8983},
 						name: "SampleOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 260, col: 5, offset: 7170},
+						pos:  position{line: 310, col: 5, offset: 8996},
 						name: "SQLOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 261, col: 5, offset: 7180},
+						pos:  position{line: 311, col: 5, offset: 9006},
 						name: "FromOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 262, col: 5, offset: 7191},
+						pos:  position{line: 312, col: 5, offset: 9017},
 						name: "PassOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 263, col: 5, offset: 7202},
+						pos:  position{line: 313, col: 5, offset: 9028},
 						name: "ExplodeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 264, col: 5, offset: 7216},
+						pos:  position{line: 314, col: 5, offset: 9042},
 						name: "MergeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 265, col: 5, offset: 7228},
+						pos:  position{line: 315, col: 5, offset: 9054},
 						name: "OverOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 266, col: 5, offset: 7239},
+						pos:  position{line: 316, col: 5, offset: 9065},
 						name: "YieldOp",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 5, offset: 9077},
+						name: "PivotOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 318, col: 5, offset: 9089},
+						name: "UnpivotOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 319, col: 5, offset: 9103},
+						name: "OutputOp",
+					},
 				},
 			},
 		},
 		{
 			name: "AssertOp",
-			pos:  position{line: 268, col: 1, offset: 7248},
+			pos:  position{line: 321, col: 1, offset: 9113},
 			expr: &actionExpr{
-				pos: position{line: 269, col: 5, offset: 7261},
+				pos: position{line: 322, col: 5, offset: 9126},
 				run: (*parser).callonAssertOp1,
 				expr: &seqExpr{
-					pos: position{line: 269, col: 5, offset: 7261},
+					pos: position{line: 322, col: 5, offset: 9126},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 269, col: 5, offset: 7261},
+							pos:        position{line: 322, col: 5, offset: 9126},
 							val:        "assert",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 269, col: 14, offset: 7270},
+							pos:  position{line: 322, col: 14, offset: 9135},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 269, col: 16, offset: 7272},
+							pos:   position{line: 322, col: 16, offset: 9137},
 							label: "expr",
 							expr: &actionExpr{
-								pos: position{line: 269, col: 22, offset: 7278},
+								pos: position{line: 322, col: 22, offset: 9143},
 								run: (*parser).callonAssertOp6,
 								expr: &labeledExpr{
-									pos:   position{line: 269, col: 22, offset: 7278},
+									pos:   position{line: 322, col: 22, offset: 9143},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 269, col: 24, offset: 7280},
+										pos:  position{line: 322, col: 24, offset: 9145},
 										name: "Expr",
 									},
 								},
@@ -2323,53 +2842,53 @@ var g = &grammar{
 		},
 		{
 			name: "SortOp",
-			pos:  position{line: 305, col: 1, offset: 8631},
+			pos:  position{line: 358, col: 1, offset: 10496},
 			expr: &actionExpr{
-				pos: position{line: 306, col: 5, offset: 8642},
+				pos: position{line: 359, col: 5, offset: 10507},
 				run: (*parser).callonSortOp1,
 				expr: &seqExpr{
-					pos: position{line: 306, col: 5, offset: 8642},
+					pos: position{line: 359, col: 5, offset: 10507},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 306, col: 5, offset: 8642},
+							pos:        position{line: 359, col: 5, offset: 10507},
 							val:        "sort",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 306, col: 12, offset: 8649},
+							pos: position{line: 359, col: 12, offset: 10514},
 							expr: &ruleRefExpr{
-								pos:  position{line: 306, col: 13, offset: 8650},
+								pos:  position{line: 359, col: 13, offset: 10515},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 306, col: 18, offset: 8655},
+							pos:   position{line: 359, col: 18, offset: 10520},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 306, col: 23, offset: 8660},
+								pos:  position{line: 359, col: 23, offset: 10525},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 306, col: 32, offset: 8669},
+							pos:   position{line: 359, col: 32, offset: 10534},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 306, col: 37, offset: 8674},
+								pos: position{line: 359, col: 37, offset: 10539},
 								expr: &actionExpr{
-									pos: position{line: 306, col: 38, offset: 8675},
+									pos: position{line: 359, col: 38, offset: 10540},
 									run: (*parser).callonSortOp10,
 									expr: &seqExpr{
-										pos: position{line: 306, col: 38, offset: 8675},
+										pos: position{line: 359, col: 38, offset: 10540},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 306, col: 38, offset: 8675},
+												pos:  position{line: 359, col: 38, offset: 10540},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 306, col: 40, offset: 8677},
+												pos:   position{line: 359, col: 40, offset: 10542},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 306, col: 42, offset: 8679},
+													pos:  position{line: 359, col: 42, offset: 10544},
 													name: "Exprs",
 												},
 											},
@@ -2384,30 +2903,30 @@ var g = &grammar{
 		},
 		{
 			name: "SortArgs",
-			pos:  position{line: 320, col: 1, offset: 9090},
+			pos:  position{line: 373, col: 1, offset: 10955},
 			expr: &actionExpr{
-				pos: position{line: 320, col: 12, offset: 9101},
+				pos: position{line: 373, col: 12, offset: 10966},
 				run: (*parser).callonSortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 320, col: 12, offset: 9101},
+					pos:   position{line: 373, col: 12, offset: 10966},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 320, col: 17, offset: 9106},
+						pos: position{line: 373, col: 17, offset: 10971},
 						expr: &actionExpr{
-							pos: position{line: 320, col: 18, offset: 9107},
+							pos: position{line: 373, col: 18, offset: 10972},
 							run: (*parser).callonSortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 320, col: 18, offset: 9107},
+								pos: position{line: 373, col: 18, offset: 10972},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 320, col: 18, offset: 9107},
+										pos:  position{line: 373, col: 18, offset: 10972},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 320, col: 20, offset: 9109},
+										pos:   position{line: 373, col: 20, offset: 10974},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 320, col: 22, offset: 9111},
+											pos:  position{line: 373, col: 22, offset: 10976},
 											name: "SortArg",
 										},
 									},
@@ -2420,50 +2939,50 @@ var g = &grammar{
 		},
 		{
 			name: "SortArg",
-			pos:  position{line: 322, col: 1, offset: 9167},
+			pos:  position{line: 375, col: 1, offset: 11032},
 			expr: &choiceExpr{
-				pos: position{line: 323, col: 5, offset: 9179},
+				pos: position{line: 376, col: 5, offset: 11044},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 323, col: 5, offset: 9179},
+						pos: position{line: 376, col: 5, offset: 11044},
 						run: (*parser).callonSortArg2,
 						expr: &litMatcher{
-							pos:        position{line: 323, col: 5, offset: 9179},
+							pos:        position{line: 376, col: 5, offset: 11044},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 324, col: 5, offset: 9254},
+						pos: position{line: 377, col: 5, offset: 11119},
 						run: (*parser).callonSortArg4,
 						expr: &seqExpr{
-							pos: position{line: 324, col: 5, offset: 9254},
+							pos: position{line: 377, col: 5, offset: 11119},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 324, col: 5, offset: 9254},
+									pos:        position{line: 377, col: 5, offset: 11119},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 324, col: 14, offset: 9263},
+									pos:  position{line: 377, col: 14, offset: 11128},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 324, col: 16, offset: 9265},
+									pos:   position{line: 377, col: 16, offset: 11130},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 324, col: 23, offset: 9272},
+										pos: position{line: 377, col: 23, offset: 11137},
 										run: (*parser).callonSortArg9,
 										expr: &choiceExpr{
-											pos: position{line: 324, col: 24, offset: 9273},
+											pos: position{line: 377, col: 24, offset: 11138},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 324, col: 24, offset: 9273},
+													pos:        position{line: 377, col: 24, offset: 11138},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 324, col: 34, offset: 9283},
+													pos:        position{line: 377, col: 34, offset: 11148},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2479,45 +2998,45 @@ var g = &grammar{
 		},
 		{
 			name: "TopOp",
-			pos:  position{line: 326, col: 1, offset: 9397},
+			pos:  position{line: 379, col: 1, offset: 11262},
 			expr: &actionExpr{
-				pos: position{line: 327, col: 5, offset: 9407},
+				pos: position{line: 380, col: 5, offset: 11272},
 				run: (*parser).callonTopOp1,
 				expr: &seqExpr{
-					pos: position{line: 327, col: 5, offset: 9407},
+					pos: position{line: 380, col: 5, offset: 11272},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 327, col: 5, offset: 9407},
+							pos:        position{line: 380, col: 5, offset: 11272},
 							val:        "top",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 327, col: 11, offset: 9413},
+							pos: position{line: 380, col: 11, offset: 11278},
 							expr: &ruleRefExpr{
-								pos:  position{line: 327, col: 12, offset: 9414},
+								pos:  position{line: 380, col: 12, offset: 11279},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 17, offset: 9419},
+							pos:   position{line: 380, col: 17, offset: 11284},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 327, col: 23, offset: 9425},
+								pos: position{line: 380, col: 23, offset: 11290},
 								expr: &actionExpr{
-									pos: position{line: 327, col: 24, offset: 9426},
+									pos: position{line: 380, col: 24, offset: 11291},
 									run: (*parser).callonTopOp8,
 									expr: &seqExpr{
-										pos: position{line: 327, col: 24, offset: 9426},
+										pos: position{line: 380, col: 24, offset: 11291},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 327, col: 24, offset: 9426},
+												pos:  position{line: 380, col: 24, offset: 11291},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 327, col: 26, offset: 9428},
+												pos:   position{line: 380, col: 26, offset: 11293},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 327, col: 28, offset: 9430},
+													pos:  position{line: 380, col: 28, offset: 11295},
 													name: "UInt",
 												},
 											},
@@ -2527,19 +3046,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 52, offset: 9454},
+							pos:   position{line: 380, col: 52, offset: 11319},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 327, col: 58, offset: 9460},
+								pos: position{line: 380, col: 58, offset: 11325},
 								expr: &seqExpr{
-									pos: position{line: 327, col: 59, offset: 9461},
+									pos: position{line: 380, col: 59, offset: 11326},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 327, col: 59, offset: 9461},
+											pos:  position{line: 380, col: 59, offset: 11326},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 327, col: 61, offset: 9463},
+											pos:        position{line: 380, col: 61, offset: 11328},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2548,25 +3067,32 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 72, offset: 9474},
+							pos:   position{line: 380, col: 72, offset: 11339},
 							label: "fields",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 327, col: 79, offset: 9481},
+								pos: position{line: 380, col: 79, offset: 11346},
 								expr: &actionExpr{
-									pos: position{line: 327, col: 80, offset: 9482},
+									pos: position{line: 380, col: 80, offset: 11347},
 									run: (*parser).callonTopOp20,
 									expr: &seqExpr{
-										pos: position{line: 327, col: 80, offset: 9482},
+										pos: position{line: 380, col: 80, offset: 11347},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 327, col: 80, offset: 9482},
+												pos:  position{line: 380, col: 80, offset: 11347},
 												name: "_",
 											},
+											&notExpr{
+												pos: position{line: 380, col: 82, offset: 11349},
+												expr: &ruleRefExpr{
+													pos:  position{line: 380, col: 83, offset: 11350},
+													name: "ByToken",
+												},
+											},
 											&labeledExpr{
-												pos:   position{line: 327, col: 82, offset: 9484},
+												pos:   position{line: 380, col: 91, offset: 11358},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 327, col: 84, offset: 9486},
+													pos:  position{line: 380, col: 93, offset: 11360},
 													name: "FieldExprs",
 												},
 											},
@@ -2575,33 +3101,77 @@ var g = &grammar{
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 380, col: 124, offset: 11391},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 380, col: 129, offset: 11396},
+								expr: &ruleRefExpr{
+									pos:  position{line: 380, col: 129, offset: 11396},
+									name: "PartitionKeys",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PartitionKeys",
+			pos:  position{line: 394, col: 1, offset: 11729},
+			expr: &actionExpr{
+				pos: position{line: 394, col: 17, offset: 11745},
+				run: (*parser).callonPartitionKeys1,
+				expr: &seqExpr{
+					pos: position{line: 394, col: 17, offset: 11745},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 394, col: 17, offset: 11745},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 394, col: 19, offset: 11747},
+							name: "ByToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 394, col: 27, offset: 11755},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 394, col: 29, offset: 11757},
+							label: "keys",
+							expr: &ruleRefExpr{
+								pos:  position{line: 394, col: 34, offset: 11762},
+								name: "Exprs",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "CutOp",
-			pos:  position{line: 341, col: 1, offset: 9821},
+			pos:  position{line: 396, col: 1, offset: 11790},
 			expr: &actionExpr{
-				pos: position{line: 342, col: 5, offset: 9831},
+				pos: position{line: 397, col: 5, offset: 11800},
 				run: (*parser).callonCutOp1,
 				expr: &seqExpr{
-					pos: position{line: 342, col: 5, offset: 9831},
+					pos: position{line: 397, col: 5, offset: 11800},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 342, col: 5, offset: 9831},
+							pos:        position{line: 397, col: 5, offset: 11800},
 							val:        "cut",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 342, col: 11, offset: 9837},
+							pos:  position{line: 397, col: 11, offset: 11806},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 342, col: 13, offset: 9839},
+							pos:   position{line: 397, col: 13, offset: 11808},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 342, col: 18, offset: 9844},
+								pos:  position{line: 397, col: 18, offset: 11813},
 								name: "FlexAssignments",
 							},
 						},
@@ -2611,27 +3181,27 @@ var g = &grammar{
 		},
 		{
 			name: "DropOp",
-			pos:  position{line: 346, col: 1, offset: 9939},
+			pos:  position{line: 401, col: 1, offset: 11908},
 			expr: &actionExpr{
-				pos: position{line: 347, col: 5, offset: 9950},
+				pos: position{line: 402, col: 5, offset: 11919},
 				run: (*parser).callonDropOp1,
 				expr: &seqExpr{
-					pos: position{line: 347, col: 5, offset: 9950},
+					pos: position{line: 402, col: 5, offset: 11919},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 347, col: 5, offset: 9950},
+							pos:        position{line: 402, col: 5, offset: 11919},
 							val:        "drop",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 347, col: 12, offset: 9957},
+							pos:  position{line: 402, col: 12, offset: 11926},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 14, offset: 9959},
+							pos:   position{line: 402, col: 14, offset: 11928},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 19, offset: 9964},
+								pos:  position{line: 402, col: 19, offset: 11933},
 								name: "FieldExprs",
 							},
 						},
@@ -2641,43 +3211,70 @@ var g = &grammar{
 		},
 		{
 			name: "HeadOp",
-			pos:  position{line: 351, col: 1, offset: 10055},
+			pos:  position{line: 406, col: 1, offset: 12024},
 			expr: &choiceExpr{
-				pos: position{line: 352, col: 5, offset: 10066},
+				pos: position{line: 407, col: 5, offset: 12035},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 352, col: 5, offset: 10066},
+						pos: position{line: 407, col: 5, offset: 12035},
 						run: (*parser).callonHeadOp2,
 						expr: &seqExpr{
-							pos: position{line: 352, col: 5, offset: 10066},
+							pos: position{line: 407, col: 5, offset: 12035},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 352, col: 5, offset: 10066},
+									pos:        position{line: 407, col: 5, offset: 12035},
 									val:        "head",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 352, col: 12, offset: 10073},
+									pos:  position{line: 407, col: 12, offset: 12042},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 352, col: 14, offset: 10075},
+									pos:   position{line: 407, col: 14, offset: 12044},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 352, col: 20, offset: 10081},
+										pos:  position{line: 407, col: 20, offset: 12050},
 										name: "UInt",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 407, col: 25, offset: 12055},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 407, col: 30, offset: 12060},
+										expr: &ruleRefExpr{
+											pos:  position{line: 407, col: 30, offset: 12060},
+											name: "PartitionKeys",
+										},
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 353, col: 5, offset: 10161},
-						run: (*parser).callonHeadOp8,
-						expr: &litMatcher{
-							pos:        position{line: 353, col: 5, offset: 10161},
-							val:        "head",
-							ignoreCase: false,
+						pos: position{line: 408, col: 5, offset: 12164},
+						run: (*parser).callonHeadOp11,
+						expr: &seqExpr{
+							pos: position{line: 408, col: 5, offset: 12164},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 408, col: 5, offset: 12164},
+									val:        "head",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 408, col: 12, offset: 12171},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 408, col: 17, offset: 12176},
+										expr: &ruleRefExpr{
+											pos:  position{line: 408, col: 17, offset: 12176},
+											name: "PartitionKeys",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -2685,43 +3282,70 @@ var g = &grammar{
 		},
 		{
 			name: "TailOp",
-			pos:  position{line: 355, col: 1, offset: 10236},
+			pos:  position{line: 410, col: 1, offset: 12273},
 			expr: &choiceExpr{
-				pos: position{line: 356, col: 5, offset: 10247},
+				pos: position{line: 411, col: 5, offset: 12284},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 356, col: 5, offset: 10247},
+						pos: position{line: 411, col: 5, offset: 12284},
 						run: (*parser).callonTailOp2,
 						expr: &seqExpr{
-							pos: position{line: 356, col: 5, offset: 10247},
+							pos: position{line: 411, col: 5, offset: 12284},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 356, col: 5, offset: 10247},
+									pos:        position{line: 411, col: 5, offset: 12284},
 									val:        "tail",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 356, col: 12, offset: 10254},
+									pos:  position{line: 411, col: 12, offset: 12291},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 356, col: 14, offset: 10256},
+									pos:   position{line: 411, col: 14, offset: 12293},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 356, col: 20, offset: 10262},
+										pos:  position{line: 411, col: 20, offset: 12299},
 										name: "UInt",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 411, col: 25, offset: 12304},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 411, col: 30, offset: 12309},
+										expr: &ruleRefExpr{
+											pos:  position{line: 411, col: 30, offset: 12309},
+											name: "PartitionKeys",
+										},
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 357, col: 5, offset: 10342},
-						run: (*parser).callonTailOp8,
-						expr: &litMatcher{
-							pos:        position{line: 357, col: 5, offset: 10342},
-							val:        "tail",
-							ignoreCase: false,
+						pos: position{line: 412, col: 5, offset: 12413},
+						run: (*parser).callonTailOp11,
+						expr: &seqExpr{
+							pos: position{line: 412, col: 5, offset: 12413},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 412, col: 5, offset: 12413},
+									val:        "tail",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 412, col: 12, offset: 12420},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 412, col: 17, offset: 12425},
+										expr: &ruleRefExpr{
+											pos:  position{line: 412, col: 17, offset: 12425},
+											name: "PartitionKeys",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -2729,27 +3353,27 @@ var g = &grammar{
 		},
 		{
 			name: "WhereOp",
-			pos:  position{line: 359, col: 1, offset: 10417},
+			pos:  position{line: 414, col: 1, offset: 12522},
 			expr: &actionExpr{
-				pos: position{line: 360, col: 5, offset: 10429},
+				pos: position{line: 415, col: 5, offset: 12534},
 				run: (*parser).callonWhereOp1,
 				expr: &seqExpr{
-					pos: position{line: 360, col: 5, offset: 10429},
+					pos: position{line: 415, col: 5, offset: 12534},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 360, col: 5, offset: 10429},
+							pos:        position{line: 415, col: 5, offset: 12534},
 							val:        "where",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 360, col: 13, offset: 10437},
+							pos:  position{line: 415, col: 13, offset: 12542},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 360, col: 15, offset: 10439},
+							pos:   position{line: 415, col: 15, offset: 12544},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 360, col: 20, offset: 10444},
+								pos:  position{line: 415, col: 20, offset: 12549},
 								name: "Expr",
 							},
 						},
@@ -2759,27 +3383,27 @@ var g = &grammar{
 		},
 		{
 			name: "UniqOp",
-			pos:  position{line: 364, col: 1, offset: 10530},
+			pos:  position{line: 419, col: 1, offset: 12635},
 			expr: &choiceExpr{
-				pos: position{line: 365, col: 5, offset: 10541},
+				pos: position{line: 420, col: 5, offset: 12646},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 365, col: 5, offset: 10541},
+						pos: position{line: 420, col: 5, offset: 12646},
 						run: (*parser).callonUniqOp2,
 						expr: &seqExpr{
-							pos: position{line: 365, col: 5, offset: 10541},
+							pos: position{line: 420, col: 5, offset: 12646},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 365, col: 5, offset: 10541},
+									pos:        position{line: 420, col: 5, offset: 12646},
 									val:        "uniq",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 365, col: 12, offset: 10548},
+									pos:  position{line: 420, col: 12, offset: 12653},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 365, col: 14, offset: 10550},
+									pos:        position{line: 420, col: 14, offset: 12655},
 									val:        "-c",
 									ignoreCase: false,
 								},
@@ -2787,10 +3411,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 368, col: 5, offset: 10639},
+						pos: position{line: 423, col: 5, offset: 12744},
 						run: (*parser).callonUniqOp7,
 						expr: &litMatcher{
-							pos:        position{line: 368, col: 5, offset: 10639},
+							pos:        position{line: 423, col: 5, offset: 12744},
 							val:        "uniq",
 							ignoreCase: false,
 						},
@@ -2800,27 +3424,27 @@ var g = &grammar{
 		},
 		{
 			name: "PutOp",
-			pos:  position{line: 372, col: 1, offset: 10728},
+			pos:  position{line: 427, col: 1, offset: 12833},
 			expr: &actionExpr{
-				pos: position{line: 373, col: 5, offset: 10738},
+				pos: position{line: 428, col: 5, offset: 12843},
 				run: (*parser).callonPutOp1,
 				expr: &seqExpr{
-					pos: position{line: 373, col: 5, offset: 10738},
+					pos: position{line: 428, col: 5, offset: 12843},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 373, col: 5, offset: 10738},
+							pos:        position{line: 428, col: 5, offset: 12843},
 							val:        "put",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 373, col: 11, offset: 10744},
+							pos:  position{line: 428, col: 11, offset: 12849},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 373, col: 13, offset: 10746},
+							pos:   position{line: 428, col: 13, offset: 12851},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 373, col: 18, offset: 10751},
+								pos:  position{line: 428, col: 18, offset: 12856},
 								name: "Assignments",
 							},
 						},
@@ -2830,59 +3454,59 @@ var g = &grammar{
 		},
 		{
 			name: "RenameOp",
-			pos:  position{line: 377, col: 1, offset: 10842},
+			pos:  position{line: 432, col: 1, offset: 12947},
 			expr: &actionExpr{
-				pos: position{line: 378, col: 5, offset: 10855},
+				pos: position{line: 433, col: 5, offset: 12960},
 				run: (*parser).callonRenameOp1,
 				expr: &seqExpr{
-					pos: position{line: 378, col: 5, offset: 10855},
+					pos: position{line: 433, col: 5, offset: 12960},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 378, col: 5, offset: 10855},
+							pos:        position{line: 433, col: 5, offset: 12960},
 							val:        "rename",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 378, col: 14, offset: 10864},
+							pos:  position{line: 433, col: 14, offset: 12969},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 378, col: 16, offset: 10866},
+							pos:   position{line: 433, col: 16, offset: 12971},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 378, col: 22, offset: 10872},
+								pos:  position{line: 433, col: 22, offset: 12977},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 378, col: 33, offset: 10883},
+							pos:   position{line: 433, col: 33, offset: 12988},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 378, col: 38, offset: 10888},
+								pos: position{line: 433, col: 38, offset: 12993},
 								expr: &actionExpr{
-									pos: position{line: 378, col: 39, offset: 10889},
+									pos: position{line: 433, col: 39, offset: 12994},
 									run: (*parser).callonRenameOp9,
 									expr: &seqExpr{
-										pos: position{line: 378, col: 39, offset: 10889},
+										pos: position{line: 433, col: 39, offset: 12994},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 378, col: 39, offset: 10889},
+												pos:  position{line: 433, col: 39, offset: 12994},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 378, col: 42, offset: 10892},
+												pos:        position{line: 433, col: 42, offset: 12997},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 378, col: 46, offset: 10896},
+												pos:  position{line: 433, col: 46, offset: 13001},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 378, col: 49, offset: 10899},
+												pos:   position{line: 433, col: 49, offset: 13004},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 378, col: 52, offset: 10902},
+													pos:  position{line: 433, col: 52, offset: 13007},
 													name: "Assignment",
 												},
 											},
@@ -2897,29 +3521,29 @@ var g = &grammar{
 		},
 		{
 			name: "FuseOp",
-			pos:  position{line: 386, col: 1, offset: 11309},
+			pos:  position{line: 441, col: 1, offset: 13414},
 			expr: &actionExpr{
-				pos: position{line: 387, col: 5, offset: 11320},
+				pos: position{line: 442, col: 5, offset: 13425},
 				run: (*parser).callonFuseOp1,
 				expr: &seqExpr{
-					pos: position{line: 387, col: 5, offset: 11320},
+					pos: position{line: 442, col: 5, offset: 13425},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 387, col: 5, offset: 11320},
+							pos:        position{line: 442, col: 5, offset: 13425},
 							val:        "fuse",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 387, col: 12, offset: 11327},
+							pos: position{line: 442, col: 12, offset: 13432},
 							expr: &seqExpr{
-								pos: position{line: 387, col: 14, offset: 11329},
+								pos: position{line: 442, col: 14, offset: 13434},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 387, col: 14, offset: 11329},
+										pos:  position{line: 442, col: 14, offset: 13434},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 387, col: 17, offset: 11332},
+										pos:        position{line: 442, col: 17, offset: 13437},
 										val:        "(",
 										ignoreCase: false,
 									},
@@ -2927,9 +3551,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 387, col: 22, offset: 11337},
+							pos: position{line: 442, col: 22, offset: 13442},
 							expr: &ruleRefExpr{
-								pos:  position{line: 387, col: 23, offset: 11338},
+								pos:  position{line: 442, col: 23, offset: 13443},
 								name: "EOKW",
 							},
 						},
@@ -2939,29 +3563,29 @@ var g = &grammar{
 		},
 		{
 			name: "ShapeOp",
-			pos:  position{line: 391, col: 1, offset: 11409},
+			pos:  position{line: 446, col: 1, offset: 13514},
 			expr: &actionExpr{
-				pos: position{line: 392, col: 5, offset: 11421},
+				pos: position{line: 447, col: 5, offset: 13526},
 				run: (*parser).callonShapeOp1,
 				expr: &seqExpr{
-					pos: position{line: 392, col: 5, offset: 11421},
+					pos: position{line: 447, col: 5, offset: 13526},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 392, col: 5, offset: 11421},
+							pos:        position{line: 447, col: 5, offset: 13526},
 							val:        "shape",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 392, col: 13, offset: 11429},
+							pos: position{line: 447, col: 13, offset: 13534},
 							expr: &seqExpr{
-								pos: position{line: 392, col: 15, offset: 11431},
+								pos: position{line: 447, col: 15, offset: 13536},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 392, col: 15, offset: 11431},
+										pos:  position{line: 447, col: 15, offset: 13536},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 392, col: 18, offset: 11434},
+										pos:        position{line: 447, col: 18, offset: 13539},
 										val:        "(",
 										ignoreCase: false,
 									},
@@ -2969,9 +3593,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 392, col: 23, offset: 11439},
+							pos: position{line: 447, col: 23, offset: 13544},
 							expr: &ruleRefExpr{
-								pos:  position{line: 392, col: 24, offset: 11440},
+								pos:  position{line: 447, col: 24, offset: 13545},
 								name: "EOKW",
 							},
 						},
@@ -2981,69 +3605,69 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOp",
-			pos:  position{line: 396, col: 1, offset: 11512},
+			pos:  position{line: 451, col: 1, offset: 13617},
 			expr: &actionExpr{
-				pos: position{line: 397, col: 5, offset: 11523},
+				pos: position{line: 452, col: 5, offset: 13628},
 				run: (*parser).callonJoinOp1,
 				expr: &seqExpr{
-					pos: position{line: 397, col: 5, offset: 11523},
+					pos: position{line: 452, col: 5, offset: 13628},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 397, col: 5, offset: 11523},
+							pos:   position{line: 452, col: 5, offset: 13628},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 11, offset: 11529},
+								pos:  position{line: 452, col: 11, offset: 13634},
 								name: "JoinStyle",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 397, col: 21, offset: 11539},
+							pos:        position{line: 452, col: 21, offset: 13644},
 							val:        "join",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 397, col: 28, offset: 11546},
+							pos:  position{line: 452, col: 28, offset: 13651},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 397, col: 30, offset: 11548},
+							pos:  position{line: 452, col: 30, offset: 13653},
 							name: "ON",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 397, col: 33, offset: 11551},
+							pos:  position{line: 452, col: 33, offset: 13656},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 35, offset: 11553},
+							pos:   position{line: 452, col: 35, offset: 13658},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 39, offset: 11557},
+								pos:  position{line: 452, col: 39, offset: 13662},
 								name: "JoinKey",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 47, offset: 11565},
+							pos:   position{line: 452, col: 47, offset: 13670},
 							label: "optKey",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 397, col: 54, offset: 11572},
+								pos: position{line: 452, col: 54, offset: 13677},
 								expr: &seqExpr{
-									pos: position{line: 397, col: 55, offset: 11573},
+									pos: position{line: 452, col: 55, offset: 13678},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 55, offset: 11573},
+											pos:  position{line: 452, col: 55, offset: 13678},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 397, col: 58, offset: 11576},
+											pos:        position{line: 452, col: 58, offset: 13681},
 											val:        "=",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 62, offset: 11580},
+											pos:  position{line: 452, col: 62, offset: 13685},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 65, offset: 11583},
+											pos:  position{line: 452, col: 65, offset: 13688},
 											name: "JoinKey",
 										},
 									},
@@ -3051,19 +3675,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 75, offset: 11593},
+							pos:   position{line: 452, col: 75, offset: 13698},
 							label: "optArgs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 397, col: 83, offset: 11601},
+								pos: position{line: 452, col: 83, offset: 13706},
 								expr: &seqExpr{
-									pos: position{line: 397, col: 84, offset: 11602},
+									pos: position{line: 452, col: 84, offset: 13707},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 84, offset: 11602},
+											pos:  position{line: 452, col: 84, offset: 13707},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 86, offset: 11604},
+											pos:  position{line: 452, col: 86, offset: 13709},
 											name: "FlexAssignments",
 										},
 									},
@@ -3076,87 +3700,87 @@ var g = &grammar{
 		},
 		{
 			name: "JoinStyle",
-			pos:  position{line: 408, col: 1, offset: 11933},
+			pos:  position{line: 463, col: 1, offset: 14038},
 			expr: &choiceExpr{
-				pos: position{line: 409, col: 5, offset: 11947},
+				pos: position{line: 464, col: 5, offset: 14052},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 409, col: 5, offset: 11947},
+						pos: position{line: 464, col: 5, offset: 14052},
 						run: (*parser).callonJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 409, col: 5, offset: 11947},
+							pos: position{line: 464, col: 5, offset: 14052},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 409, col: 5, offset: 11947},
+									pos:        position{line: 464, col: 5, offset: 14052},
 									val:        "anti",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 409, col: 12, offset: 11954},
+									pos:  position{line: 464, col: 12, offset: 14059},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 410, col: 5, offset: 11984},
+						pos: position{line: 465, col: 5, offset: 14089},
 						run: (*parser).callonJoinStyle6,
 						expr: &seqExpr{
-							pos: position{line: 410, col: 5, offset: 11984},
+							pos: position{line: 465, col: 5, offset: 14089},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 410, col: 5, offset: 11984},
+									pos:        position{line: 465, col: 5, offset: 14089},
 									val:        "inner",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 410, col: 13, offset: 11992},
+									pos:  position{line: 465, col: 13, offset: 14097},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 411, col: 5, offset: 12022},
+						pos: position{line: 466, col: 5, offset: 14127},
 						run: (*parser).callonJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 411, col: 5, offset: 12022},
+							pos: position{line: 466, col: 5, offset: 14127},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 411, col: 5, offset: 12022},
+									pos:        position{line: 466, col: 5, offset: 14127},
 									val:        "left",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 411, col: 13, offset: 12030},
+									pos:  position{line: 466, col: 13, offset: 14135},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 412, col: 5, offset: 12059},
+						pos: position{line: 467, col: 5, offset: 14164},
 						run: (*parser).callonJoinStyle14,
 						expr: &seqExpr{
-							pos: position{line: 412, col: 5, offset: 12059},
+							pos: position{line: 467, col: 5, offset: 14164},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 412, col: 5, offset: 12059},
+									pos:        position{line: 467, col: 5, offset: 14164},
 									val:        "right",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 412, col: 13, offset: 12067},
+									pos:  position{line: 467, col: 13, offset: 14172},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 413, col: 5, offset: 12097},
+						pos: position{line: 468, col: 5, offset: 14202},
 						run: (*parser).callonJoinStyle18,
 						expr: &litMatcher{
-							pos:        position{line: 413, col: 5, offset: 12097},
+							pos:        position{line: 468, col: 5, offset: 14202},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -3166,35 +3790,35 @@ var g = &grammar{
 		},
 		{
 			name: "JoinKey",
-			pos:  position{line: 415, col: 1, offset: 12133},
+			pos:  position{line: 470, col: 1, offset: 14238},
 			expr: &choiceExpr{
-				pos: position{line: 416, col: 5, offset: 12145},
+				pos: position{line: 471, col: 5, offset: 14250},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 416, col: 5, offset: 12145},
+						pos:  position{line: 471, col: 5, offset: 14250},
 						name: "Lval",
 					},
 					&actionExpr{
-						pos: position{line: 417, col: 5, offset: 12154},
+						pos: position{line: 472, col: 5, offset: 14259},
 						run: (*parser).callonJoinKey3,
 						expr: &seqExpr{
-							pos: position{line: 417, col: 5, offset: 12154},
+							pos: position{line: 472, col: 5, offset: 14259},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 417, col: 5, offset: 12154},
+									pos:        position{line: 472, col: 5, offset: 14259},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 417, col: 9, offset: 12158},
+									pos:   position{line: 472, col: 9, offset: 14263},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 417, col: 14, offset: 12163},
+										pos:  position{line: 472, col: 14, offset: 14268},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 417, col: 19, offset: 12168},
+									pos:        position{line: 472, col: 19, offset: 14273},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3205,32 +3829,155 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SampleOp",
-			pos:  position{line: 419, col: 1, offset: 12194},
+			name: "OutputOp",
+			pos:  position{line: 474, col: 1, offset: 14299},
 			expr: &actionExpr{
-				pos: position{line: 420, col: 5, offset: 12207},
-				run: (*parser).callonSampleOp1,
+				pos: position{line: 475, col: 5, offset: 14312},
+				run: (*parser).callonOutputOp1,
 				expr: &seqExpr{
-					pos: position{line: 420, col: 5, offset: 12207},
+					pos: position{line: 475, col: 5, offset: 14312},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 420, col: 5, offset: 12207},
-							val:        "sample",
+							pos:        position{line: 475, col: 5, offset: 14312},
+							val:        "output",
 							ignoreCase: false,
 						},
-						&andExpr{
-							pos: position{line: 420, col: 14, offset: 12216},
+						&ruleRefExpr{
+							pos:  position{line: 475, col: 14, offset: 14321},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 475, col: 16, offset: 14323},
+							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 420, col: 15, offset: 12217},
-								name: "EOKW",
+								pos:  position{line: 475, col: 21, offset: 14328},
+								name: "IdentifierName",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 420, col: 20, offset: 12222},
-							label: "e",
+						&andExpr{
+							pos: position{line: 475, col: 36, offset: 14343},
 							expr: &ruleRefExpr{
-								pos:  position{line: 420, col: 22, offset: 12224},
-								name: "SampleExpr",
+								pos:  position{line: 475, col: 37, offset: 14344},
+								name: "EndOfOp",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SampleOp",
+			pos:  position{line: 479, col: 1, offset: 14434},
+			expr: &choiceExpr{
+				pos: position{line: 480, col: 5, offset: 14447},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 480, col: 5, offset: 14447},
+						run: (*parser).callonSampleOp2,
+						expr: &seqExpr{
+							pos: position{line: 480, col: 5, offset: 14447},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 480, col: 5, offset: 14447},
+									val:        "sample",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 480, col: 14, offset: 14456},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 480, col: 16, offset: 14458},
+									label: "limit",
+									expr: &ruleRefExpr{
+										pos:  position{line: 480, col: 22, offset: 14464},
+										name: "UInt",
+									},
+								},
+								&andExpr{
+									pos: position{line: 480, col: 27, offset: 14469},
+									expr: &ruleRefExpr{
+										pos:  position{line: 480, col: 28, offset: 14470},
+										name: "EOKW",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 480, col: 33, offset: 14475},
+									label: "seed",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 480, col: 38, offset: 14480},
+										expr: &actionExpr{
+											pos: position{line: 480, col: 39, offset: 14481},
+											run: (*parser).callonSampleOp12,
+											expr: &seqExpr{
+												pos: position{line: 480, col: 39, offset: 14481},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 480, col: 39, offset: 14481},
+														name: "_",
+													},
+													&litMatcher{
+														pos:        position{line: 480, col: 41, offset: 14483},
+														val:        "-seed",
+														ignoreCase: false,
+													},
+													&ruleRefExpr{
+														pos:  position{line: 480, col: 49, offset: 14491},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 480, col: 51, offset: 14493},
+														label: "n",
+														expr: &ruleRefExpr{
+															pos:  position{line: 480, col: 53, offset: 14495},
+															name: "UInt",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 480, col: 78, offset: 14520},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 480, col: 83, offset: 14525},
+										expr: &ruleRefExpr{
+											pos:  position{line: 480, col: 83, offset: 14525},
+											name: "PartitionKeys",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 483, col: 5, offset: 14655},
+						run: (*parser).callonSampleOp22,
+						expr: &seqExpr{
+							pos: position{line: 483, col: 5, offset: 14655},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 483, col: 5, offset: 14655},
+									val:        "sample",
+									ignoreCase: false,
+								},
+								&andExpr{
+									pos: position{line: 483, col: 14, offset: 14664},
+									expr: &ruleRefExpr{
+										pos:  position{line: 483, col: 15, offset: 14665},
+										name: "EOKW",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 483, col: 20, offset: 14670},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 483, col: 22, offset: 14672},
+										name: "SampleExpr",
+									},
+								},
 							},
 						},
 					},
@@ -3239,15 +3986,15 @@ var g = &grammar{
 		},
 		{
 			name: "OpAssignment",
-			pos:  position{line: 462, col: 1, offset: 13723},
+			pos:  position{line: 525, col: 1, offset: 16171},
 			expr: &actionExpr{
-				pos: position{line: 463, col: 5, offset: 13740},
+				pos: position{line: 526, col: 5, offset: 16188},
 				run: (*parser).callonOpAssignment1,
 				expr: &labeledExpr{
-					pos:   position{line: 463, col: 5, offset: 13740},
+					pos:   position{line: 526, col: 5, offset: 16188},
 					label: "a",
 					expr: &ruleRefExpr{
-						pos:  position{line: 463, col: 7, offset: 13742},
+						pos:  position{line: 526, col: 7, offset: 16190},
 						name: "Assignments",
 					},
 				},
@@ -3255,25 +4002,25 @@ var g = &grammar{
 		},
 		{
 			name: "SampleExpr",
-			pos:  position{line: 467, col: 1, offset: 13842},
+			pos:  position{line: 530, col: 1, offset: 16290},
 			expr: &choiceExpr{
-				pos: position{line: 468, col: 5, offset: 13857},
+				pos: position{line: 531, col: 5, offset: 16305},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 468, col: 5, offset: 13857},
+						pos: position{line: 531, col: 5, offset: 16305},
 						run: (*parser).callonSampleExpr2,
 						expr: &seqExpr{
-							pos: position{line: 468, col: 5, offset: 13857},
+							pos: position{line: 531, col: 5, offset: 16305},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 468, col: 5, offset: 13857},
+									pos:  position{line: 531, col: 5, offset: 16305},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 468, col: 7, offset: 13859},
+									pos:   position{line: 531, col: 7, offset: 16307},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 468, col: 12, offset: 13864},
+										pos:  position{line: 531, col: 12, offset: 16312},
 										name: "Lval",
 									},
 								},
@@ -3281,10 +4028,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 469, col: 5, offset: 13893},
+						pos: position{line: 532, col: 5, offset: 16341},
 						run: (*parser).callonSampleExpr7,
 						expr: &litMatcher{
-							pos:        position{line: 469, col: 5, offset: 13893},
+							pos:        position{line: 532, col: 5, offset: 16341},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -3294,15 +4041,15 @@ var g = &grammar{
 		},
 		{
 			name: "FromOp",
-			pos:  position{line: 471, col: 1, offset: 13964},
+			pos:  position{line: 534, col: 1, offset: 16412},
 			expr: &actionExpr{
-				pos: position{line: 472, col: 5, offset: 13975},
+				pos: position{line: 535, col: 5, offset: 16423},
 				run: (*parser).callonFromOp1,
 				expr: &labeledExpr{
-					pos:   position{line: 472, col: 5, offset: 13975},
+					pos:   position{line: 535, col: 5, offset: 16423},
 					label: "source",
 					expr: &ruleRefExpr{
-						pos:  position{line: 472, col: 12, offset: 13982},
+						pos:  position{line: 535, col: 12, offset: 16430},
 						name: "FromAny",
 					},
 				},
@@ -3310,20 +4057,20 @@ var g = &grammar{
 		},
 		{
 			name: "FromAny",
-			pos:  position{line: 476, col: 1, offset: 14138},
+			pos:  position{line: 539, col: 1, offset: 16586},
 			expr: &choiceExpr{
-				pos: position{line: 477, col: 5, offset: 14150},
+				pos: position{line: 540, col: 5, offset: 16598},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 477, col: 5, offset: 14150},
+						pos:  position{line: 540, col: 5, offset: 16598},
 						name: "File",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 478, col: 5, offset: 14159},
+						pos:  position{line: 541, col: 5, offset: 16607},
 						name: "Get",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 479, col: 5, offset: 14167},
+						pos:  position{line: 542, col: 5, offset: 16615},
 						name: "From",
 					},
 				},
@@ -3331,48 +4078,48 @@ var g = &grammar{
 		},
 		{
 			name: "File",
-			pos:  position{line: 481, col: 1, offset: 14173},
+			pos:  position{line: 544, col: 1, offset: 16621},
 			expr: &actionExpr{
-				pos: position{line: 482, col: 5, offset: 14182},
+				pos: position{line: 545, col: 5, offset: 16630},
 				run: (*parser).callonFile1,
 				expr: &seqExpr{
-					pos: position{line: 482, col: 5, offset: 14182},
+					pos: position{line: 545, col: 5, offset: 16630},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 482, col: 5, offset: 14182},
+							pos:        position{line: 545, col: 5, offset: 16630},
 							val:        "file",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 482, col: 12, offset: 14189},
+							pos:  position{line: 545, col: 12, offset: 16637},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 14, offset: 14191},
+							pos:   position{line: 545, col: 14, offset: 16639},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 19, offset: 14196},
+								pos:  position{line: 545, col: 19, offset: 16644},
 								name: "Path",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 24, offset: 14201},
+							pos:   position{line: 545, col: 24, offset: 16649},
 							label: "format",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 482, col: 31, offset: 14208},
+								pos: position{line: 545, col: 31, offset: 16656},
 								expr: &ruleRefExpr{
-									pos:  position{line: 482, col: 31, offset: 14208},
+									pos:  position{line: 545, col: 31, offset: 16656},
 									name: "FormatArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 42, offset: 14219},
+							pos:   position{line: 545, col: 42, offset: 16667},
 							label: "layout",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 482, col: 49, offset: 14226},
+								pos: position{line: 545, col: 49, offset: 16674},
 								expr: &ruleRefExpr{
-									pos:  position{line: 482, col: 49, offset: 14226},
+									pos:  position{line: 545, col: 49, offset: 16674},
 									name: "LayoutArg",
 								},
 							},
@@ -3383,27 +4130,27 @@ var g = &grammar{
 		},
 		{
 			name: "From",
-			pos:  position{line: 486, col: 1, offset: 14355},
+			pos:  position{line: 549, col: 1, offset: 16803},
 			expr: &actionExpr{
-				pos: position{line: 487, col: 5, offset: 14364},
+				pos: position{line: 550, col: 5, offset: 16812},
 				run: (*parser).callonFrom1,
 				expr: &seqExpr{
-					pos: position{line: 487, col: 5, offset: 14364},
+					pos: position{line: 550, col: 5, offset: 16812},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 487, col: 5, offset: 14364},
+							pos:        position{line: 550, col: 5, offset: 16812},
 							val:        "from",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 487, col: 12, offset: 14371},
+							pos:  position{line: 550, col: 12, offset: 16819},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 487, col: 14, offset: 14373},
+							pos:   position{line: 550, col: 14, offset: 16821},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 487, col: 19, offset: 14378},
+								pos:  position{line: 550, col: 19, offset: 16826},
 								name: "PoolBody",
 							},
 						},
@@ -3413,27 +4160,27 @@ var g = &grammar{
 		},
 		{
 			name: "Pool",
-			pos:  position{line: 489, col: 1, offset: 14409},
+			pos:  position{line: 552, col: 1, offset: 16857},
 			expr: &actionExpr{
-				pos: position{line: 490, col: 5, offset: 14418},
+				pos: position{line: 553, col: 5, offset: 16866},
 				run: (*parser).callonPool1,
 				expr: &seqExpr{
-					pos: position{line: 490, col: 5, offset: 14418},
+					pos: position{line: 553, col: 5, offset: 16866},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 490, col: 5, offset: 14418},
+							pos:        position{line: 553, col: 5, offset: 16866},
 							val:        "pool",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 490, col: 12, offset: 14425},
+							pos:  position{line: 553, col: 12, offset: 16873},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 490, col: 14, offset: 14427},
+							pos:   position{line: 553, col: 14, offset: 16875},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 490, col: 19, offset: 14432},
+								pos:  position{line: 553, col: 19, offset: 16880},
 								name: "PoolBody",
 							},
 						},
@@ -3443,50 +4190,50 @@ var g = &grammar{
 		},
 		{
 			name: "PoolBody",
-			pos:  position{line: 492, col: 1, offset: 14463},
+			pos:  position{line: 555, col: 1, offset: 16911},
 			expr: &actionExpr{
-				pos: position{line: 493, col: 5, offset: 14476},
+				pos: position{line: 556, col: 5, offset: 16924},
 				run: (*parser).callonPoolBody1,
 				expr: &seqExpr{
-					pos: position{line: 493, col: 5, offset: 14476},
+					pos: position{line: 556, col: 5, offset: 16924},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 493, col: 5, offset: 14476},
+							pos:   position{line: 556, col: 5, offset: 16924},
 							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 493, col: 10, offset: 14481},
+								pos:  position{line: 556, col: 10, offset: 16929},
 								name: "PoolSpec",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 493, col: 19, offset: 14490},
+							pos:   position{line: 556, col: 19, offset: 16938},
 							label: "at",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 493, col: 22, offset: 14493},
+								pos: position{line: 556, col: 22, offset: 16941},
 								expr: &ruleRefExpr{
-									pos:  position{line: 493, col: 22, offset: 14493},
+									pos:  position{line: 556, col: 22, offset: 16941},
 									name: "PoolAt",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 493, col: 30, offset: 14501},
+							pos:   position{line: 556, col: 30, offset: 16949},
 							label: "over",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 493, col: 35, offset: 14506},
+								pos: position{line: 556, col: 35, offset: 16954},
 								expr: &ruleRefExpr{
-									pos:  position{line: 493, col: 35, offset: 14506},
+									pos:  position{line: 556, col: 35, offset: 16954},
 									name: "PoolRange",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 493, col: 46, offset: 14517},
+							pos:   position{line: 556, col: 46, offset: 16965},
 							label: "order",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 493, col: 52, offset: 14523},
+								pos: position{line: 556, col: 52, offset: 16971},
 								expr: &ruleRefExpr{
-									pos:  position{line: 493, col: 52, offset: 14523},
+									pos:  position{line: 556, col: 52, offset: 16971},
 									name: "OrderArg",
 								},
 							},
@@ -3497,48 +4244,48 @@ var g = &grammar{
 		},
 		{
 			name: "Get",
-			pos:  position{line: 497, col: 1, offset: 14659},
+			pos:  position{line: 560, col: 1, offset: 17107},
 			expr: &actionExpr{
-				pos: position{line: 498, col: 5, offset: 14667},
+				pos: position{line: 561, col: 5, offset: 17115},
 				run: (*parser).callonGet1,
 				expr: &seqExpr{
-					pos: position{line: 498, col: 5, offset: 14667},
+					pos: position{line: 561, col: 5, offset: 17115},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 498, col: 5, offset: 14667},
+							pos:        position{line: 561, col: 5, offset: 17115},
 							val:        "get",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 498, col: 11, offset: 14673},
+							pos:  position{line: 561, col: 11, offset: 17121},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 13, offset: 14675},
+							pos:   position{line: 561, col: 13, offset: 17123},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 498, col: 17, offset: 14679},
+								pos:  position{line: 561, col: 17, offset: 17127},
 								name: "URL",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 21, offset: 14683},
+							pos:   position{line: 561, col: 21, offset: 17131},
 							label: "format",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 498, col: 28, offset: 14690},
+								pos: position{line: 561, col: 28, offset: 17138},
 								expr: &ruleRefExpr{
-									pos:  position{line: 498, col: 28, offset: 14690},
+									pos:  position{line: 561, col: 28, offset: 17138},
 									name: "FormatArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 39, offset: 14701},
+							pos:   position{line: 561, col: 39, offset: 17149},
 							label: "layout",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 498, col: 46, offset: 14708},
+								pos: position{line: 561, col: 46, offset: 17156},
 								expr: &ruleRefExpr{
-									pos:  position{line: 498, col: 46, offset: 14708},
+									pos:  position{line: 561, col: 46, offset: 17156},
 									name: "LayoutArg",
 								},
 							},
@@ -3549,30 +4296,30 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 502, col: 1, offset: 14834},
+			pos:  position{line: 565, col: 1, offset: 17282},
 			expr: &actionExpr{
-				pos: position{line: 502, col: 7, offset: 14840},
+				pos: position{line: 565, col: 7, offset: 17288},
 				run: (*parser).callonURL1,
 				expr: &seqExpr{
-					pos: position{line: 502, col: 7, offset: 14840},
+					pos: position{line: 565, col: 7, offset: 17288},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 502, col: 8, offset: 14841},
+							pos: position{line: 565, col: 8, offset: 17289},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 502, col: 8, offset: 14841},
+									pos:        position{line: 565, col: 8, offset: 17289},
 									val:        "http:",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 502, col: 18, offset: 14851},
+									pos:        position{line: 565, col: 18, offset: 17299},
 									val:        "https:",
 									ignoreCase: false,
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 502, col: 28, offset: 14861},
+							pos:  position{line: 565, col: 28, offset: 17309},
 							name: "Path",
 						},
 					},
@@ -3581,29 +4328,29 @@ var g = &grammar{
 		},
 		{
 			name: "Path",
-			pos:  position{line: 504, col: 1, offset: 14898},
+			pos:  position{line: 567, col: 1, offset: 17346},
 			expr: &choiceExpr{
-				pos: position{line: 505, col: 5, offset: 14907},
+				pos: position{line: 568, col: 5, offset: 17355},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 505, col: 5, offset: 14907},
+						pos: position{line: 568, col: 5, offset: 17355},
 						run: (*parser).callonPath2,
 						expr: &labeledExpr{
-							pos:   position{line: 505, col: 5, offset: 14907},
+							pos:   position{line: 568, col: 5, offset: 17355},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 505, col: 7, offset: 14909},
+								pos:  position{line: 568, col: 7, offset: 17357},
 								name: "QuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 506, col: 5, offset: 14944},
+						pos: position{line: 569, col: 5, offset: 17392},
 						run: (*parser).callonPath5,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 506, col: 5, offset: 14944},
+							pos: position{line: 569, col: 5, offset: 17392},
 							expr: &charClassMatcher{
-								pos:        position{line: 506, col: 5, offset: 14944},
+								pos:        position{line: 569, col: 5, offset: 17392},
 								val:        "[0-9a-zA-Z!@$%^&*()_=<>,./?:[\\]{}~|+-]",
 								chars:      []rune{'!', '@', '$', '%', '^', '&', '*', '(', ')', '_', '=', '<', '>', ',', '.', '/', '?', ':', '[', ']', '{', '}', '~', '|', '+', '-'},
 								ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
@@ -3617,31 +4364,31 @@ var g = &grammar{
 		},
 		{
 			name: "PoolAt",
-			pos:  position{line: 509, col: 1, offset: 15049},
+			pos:  position{line: 572, col: 1, offset: 17497},
 			expr: &actionExpr{
-				pos: position{line: 510, col: 5, offset: 15060},
+				pos: position{line: 573, col: 5, offset: 17508},
 				run: (*parser).callonPoolAt1,
 				expr: &seqExpr{
-					pos: position{line: 510, col: 5, offset: 15060},
+					pos: position{line: 573, col: 5, offset: 17508},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 510, col: 5, offset: 15060},
+							pos:  position{line: 573, col: 5, offset: 17508},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 510, col: 7, offset: 15062},
+							pos:        position{line: 573, col: 7, offset: 17510},
 							val:        "at",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 510, col: 12, offset: 15067},
+							pos:  position{line: 573, col: 12, offset: 17515},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 510, col: 14, offset: 15069},
+							pos:   position{line: 573, col: 14, offset: 17517},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 510, col: 17, offset: 15072},
+								pos:  position{line: 573, col: 17, offset: 17520},
 								name: "KSUID",
 							},
 						},
@@ -3651,14 +4398,14 @@ var g = &grammar{
 		},
 		{
 			name: "KSUID",
-			pos:  position{line: 513, col: 1, offset: 15138},
+			pos:  position{line: 576, col: 1, offset: 17586},
 			expr: &actionExpr{
-				pos: position{line: 513, col: 9, offset: 15146},
+				pos: position{line: 576, col: 9, offset: 17594},
 				run: (*parser).callonKSUID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 513, col: 9, offset: 15146},
+					pos: position{line: 576, col: 9, offset: 17594},
 					expr: &charClassMatcher{
-						pos:        position{line: 513, col: 10, offset: 15147},
+						pos:        position{line: 576, col: 10, offset: 17595},
 						val:        "[0-9a-zA-Z]",
 						ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
 						ignoreCase: false,
@@ -3669,52 +4416,52 @@ var g = &grammar{
 		},
 		{
 			name: "PoolRange",
-			pos:  position{line: 515, col: 1, offset: 15193},
+			pos:  position{line: 578, col: 1, offset: 17641},
 			expr: &actionExpr{
-				pos: position{line: 516, col: 5, offset: 15207},
+				pos: position{line: 579, col: 5, offset: 17655},
 				run: (*parser).callonPoolRange1,
 				expr: &seqExpr{
-					pos: position{line: 516, col: 5, offset: 15207},
+					pos: position{line: 579, col: 5, offset: 17655},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 516, col: 5, offset: 15207},
+							pos:  position{line: 579, col: 5, offset: 17655},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 516, col: 7, offset: 15209},
+							pos:        position{line: 579, col: 7, offset: 17657},
 							val:        "range",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 516, col: 15, offset: 15217},
+							pos:  position{line: 579, col: 15, offset: 17665},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 516, col: 17, offset: 15219},
+							pos:   position{line: 579, col: 17, offset: 17667},
 							label: "lower",
 							expr: &ruleRefExpr{
-								pos:  position{line: 516, col: 23, offset: 15225},
+								pos:  position{line: 579, col: 23, offset: 17673},
 								name: "Literal",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 516, col: 31, offset: 15233},
+							pos:  position{line: 579, col: 31, offset: 17681},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 516, col: 33, offset: 15235},
+							pos:        position{line: 579, col: 33, offset: 17683},
 							val:        "to",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 516, col: 38, offset: 15240},
+							pos:  position{line: 579, col: 38, offset: 17688},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 516, col: 40, offset: 15242},
+							pos:   position{line: 579, col: 40, offset: 17690},
 							label: "upper",
 							expr: &ruleRefExpr{
-								pos:  position{line: 516, col: 46, offset: 15248},
+								pos:  position{line: 579, col: 46, offset: 17696},
 								name: "Literal",
 							},
 						},
@@ -3724,42 +4471,42 @@ var g = &grammar{
 		},
 		{
 			name: "PoolSpec",
-			pos:  position{line: 520, col: 1, offset: 15353},
+			pos:  position{line: 583, col: 1, offset: 17801},
 			expr: &choiceExpr{
-				pos: position{line: 521, col: 5, offset: 15366},
+				pos: position{line: 584, col: 5, offset: 17814},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 521, col: 5, offset: 15366},
+						pos: position{line: 584, col: 5, offset: 17814},
 						run: (*parser).callonPoolSpec2,
 						expr: &seqExpr{
-							pos: position{line: 521, col: 5, offset: 15366},
+							pos: position{line: 584, col: 5, offset: 17814},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 521, col: 5, offset: 15366},
+									pos:   position{line: 584, col: 5, offset: 17814},
 									label: "pool",
 									expr: &ruleRefExpr{
-										pos:  position{line: 521, col: 10, offset: 15371},
+										pos:  position{line: 584, col: 10, offset: 17819},
 										name: "PoolName",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 521, col: 19, offset: 15380},
+									pos:   position{line: 584, col: 19, offset: 17828},
 									label: "commit",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 521, col: 26, offset: 15387},
+										pos: position{line: 584, col: 26, offset: 17835},
 										expr: &ruleRefExpr{
-											pos:  position{line: 521, col: 26, offset: 15387},
+											pos:  position{line: 584, col: 26, offset: 17835},
 											name: "PoolCommit",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 521, col: 38, offset: 15399},
+									pos:   position{line: 584, col: 38, offset: 17847},
 									label: "meta",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 521, col: 43, offset: 15404},
+										pos: position{line: 584, col: 43, offset: 17852},
 										expr: &ruleRefExpr{
-											pos:  position{line: 521, col: 43, offset: 15404},
+											pos:  position{line: 584, col: 43, offset: 17852},
 											name: "PoolMeta",
 										},
 									},
@@ -3768,13 +4515,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 524, col: 5, offset: 15513},
+						pos: position{line: 587, col: 5, offset: 17961},
 						run: (*parser).callonPoolSpec12,
 						expr: &labeledExpr{
-							pos:   position{line: 524, col: 5, offset: 15513},
+							pos:   position{line: 587, col: 5, offset: 17961},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 524, col: 10, offset: 15518},
+								pos:  position{line: 587, col: 10, offset: 17966},
 								name: "PoolMeta",
 							},
 						},
@@ -3784,24 +4531,114 @@ var g = &grammar{
 		},
 		{
 			name: "PoolCommit",
-			pos:  position{line: 528, col: 1, offset: 15619},
+			pos:  position{line: 591, col: 1, offset: 18067},
+			expr: &choiceExpr{
+				pos: position{line: 592, col: 5, offset: 18082},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 592, col: 5, offset: 18082},
+						run: (*parser).callonPoolCommit2,
+						expr: &seqExpr{
+							pos: position{line: 592, col: 5, offset: 18082},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 592, col: 5, offset: 18082},
+									val:        "@",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 592, col: 9, offset: 18086},
+									label: "at",
+									expr: &ruleRefExpr{
+										pos:  position{line: 592, col: 12, offset: 18089},
+										name: "PoolTime",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 593, col: 5, offset: 18121},
+						run: (*parser).callonPoolCommit7,
+						expr: &seqExpr{
+							pos: position{line: 593, col: 5, offset: 18121},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 593, col: 5, offset: 18121},
+									val:        "@",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 593, col: 9, offset: 18125},
+									label: "commit",
+									expr: &ruleRefExpr{
+										pos:  position{line: 593, col: 16, offset: 18132},
+										name: "PoolNameString",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 593, col: 31, offset: 18147},
+									label: "at",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 593, col: 34, offset: 18150},
+										expr: &actionExpr{
+											pos: position{line: 593, col: 35, offset: 18151},
+											run: (*parser).callonPoolCommit14,
+											expr: &seqExpr{
+												pos: position{line: 593, col: 35, offset: 18151},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 593, col: 35, offset: 18151},
+														val:        "@",
+														ignoreCase: false,
+													},
+													&labeledExpr{
+														pos:   position{line: 593, col: 39, offset: 18155},
+														label: "t",
+														expr: &ruleRefExpr{
+															pos:  position{line: 593, col: 41, offset: 18157},
+															name: "PoolTime",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PoolTime",
+			pos:  position{line: 602, col: 1, offset: 18455},
 			expr: &actionExpr{
-				pos: position{line: 529, col: 5, offset: 15634},
-				run: (*parser).callonPoolCommit1,
-				expr: &seqExpr{
-					pos: position{line: 529, col: 5, offset: 15634},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 529, col: 5, offset: 15634},
-							val:        "@",
-							ignoreCase: false,
+				pos: position{line: 602, col: 12, offset: 18466},
+				run: (*parser).callonPoolTime1,
+				expr: &choiceExpr{
+					pos: position{line: 602, col: 13, offset: 18467},
+					alternatives: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 602, col: 13, offset: 18467},
+							name: "Time",
 						},
-						&labeledExpr{
-							pos:   position{line: 529, col: 9, offset: 15638},
-							label: "commit",
-							expr: &ruleRefExpr{
-								pos:  position{line: 529, col: 16, offset: 15645},
-								name: "PoolNameString",
+						&seqExpr{
+							pos: position{line: 602, col: 20, offset: 18474},
+							exprs: []interface{}{
+								&andExpr{
+									pos: position{line: 602, col: 20, offset: 18474},
+									expr: &litMatcher{
+										pos:        position{line: 602, col: 21, offset: 18475},
+										val:        "-",
+										ignoreCase: false,
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 602, col: 25, offset: 18479},
+									name: "Duration",
+								},
 							},
 						},
 					},
@@ -3810,23 +4647,23 @@ var g = &grammar{
 		},
 		{
 			name: "PoolMeta",
-			pos:  position{line: 531, col: 1, offset: 15684},
+			pos:  position{line: 604, col: 1, offset: 18521},
 			expr: &actionExpr{
-				pos: position{line: 532, col: 5, offset: 15697},
+				pos: position{line: 605, col: 5, offset: 18534},
 				run: (*parser).callonPoolMeta1,
 				expr: &seqExpr{
-					pos: position{line: 532, col: 5, offset: 15697},
+					pos: position{line: 605, col: 5, offset: 18534},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 532, col: 5, offset: 15697},
+							pos:        position{line: 605, col: 5, offset: 18534},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 532, col: 9, offset: 15701},
+							pos:   position{line: 605, col: 9, offset: 18538},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 532, col: 14, offset: 15706},
+								pos:  position{line: 605, col: 14, offset: 18543},
 								name: "PoolIdentifier",
 							},
 						},
@@ -3836,29 +4673,29 @@ var g = &grammar{
 		},
 		{
 			name: "PoolName",
-			pos:  position{line: 534, col: 1, offset: 15743},
+			pos:  position{line: 607, col: 1, offset: 18580},
 			expr: &choiceExpr{
-				pos: position{line: 535, col: 5, offset: 15756},
+				pos: position{line: 608, col: 5, offset: 18593},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 535, col: 5, offset: 15756},
+						pos:  position{line: 608, col: 5, offset: 18593},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 536, col: 5, offset: 15765},
+						pos: position{line: 609, col: 5, offset: 18602},
 						run: (*parser).callonPoolName3,
 						expr: &seqExpr{
-							pos: position{line: 536, col: 5, offset: 15765},
+							pos: position{line: 609, col: 5, offset: 18602},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 536, col: 5, offset: 15765},
+									pos:        position{line: 609, col: 5, offset: 18602},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 536, col: 9, offset: 15769},
+									pos: position{line: 609, col: 9, offset: 18606},
 									expr: &ruleRefExpr{
-										pos:  position{line: 536, col: 10, offset: 15770},
+										pos:  position{line: 609, col: 10, offset: 18607},
 										name: "ExprGuard",
 									},
 								},
@@ -3866,17 +4703,17 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 537, col: 5, offset: 15855},
+						pos:  position{line: 610, col: 5, offset: 18692},
 						name: "Regexp",
 					},
 					&actionExpr{
-						pos: position{line: 538, col: 5, offset: 15866},
+						pos: position{line: 611, col: 5, offset: 18703},
 						run: (*parser).callonPoolName9,
 						expr: &labeledExpr{
-							pos:   position{line: 538, col: 5, offset: 15866},
+							pos:   position{line: 611, col: 5, offset: 18703},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 538, col: 10, offset: 15871},
+								pos:  position{line: 611, col: 10, offset: 18708},
 								name: "PoolNameString",
 							},
 						},
@@ -3886,20 +4723,20 @@ var g = &grammar{
 		},
 		{
 			name: "PoolNameString",
-			pos:  position{line: 540, col: 1, offset: 15958},
+			pos:  position{line: 613, col: 1, offset: 18795},
 			expr: &choiceExpr{
-				pos: position{line: 541, col: 5, offset: 15977},
+				pos: position{line: 614, col: 5, offset: 18814},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 541, col: 5, offset: 15977},
+						pos:  position{line: 614, col: 5, offset: 18814},
 						name: "PoolIdentifier",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 542, col: 5, offset: 15996},
+						pos:  position{line: 615, col: 5, offset: 18833},
 						name: "KSUID",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 543, col: 5, offset: 16006},
+						pos:  position{line: 616, col: 5, offset: 18843},
 						name: "QuotedString",
 					},
 				},
@@ -3907,38 +4744,38 @@ var g = &grammar{
 		},
 		{
 			name: "PoolIdentifier",
-			pos:  position{line: 545, col: 1, offset: 16020},
+			pos:  position{line: 618, col: 1, offset: 18857},
 			expr: &actionExpr{
-				pos: position{line: 546, col: 5, offset: 16039},
+				pos: position{line: 619, col: 5, offset: 18876},
 				run: (*parser).callonPoolIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 546, col: 5, offset: 16039},
+					pos: position{line: 619, col: 5, offset: 18876},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 546, col: 6, offset: 16040},
+							pos: position{line: 619, col: 6, offset: 18877},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 546, col: 6, offset: 16040},
+									pos:  position{line: 619, col: 6, offset: 18877},
 									name: "IdentifierStart",
 								},
 								&litMatcher{
-									pos:        position{line: 546, col: 24, offset: 16058},
+									pos:        position{line: 619, col: 24, offset: 18895},
 									val:        ".",
 									ignoreCase: false,
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 546, col: 29, offset: 16063},
+							pos: position{line: 619, col: 29, offset: 18900},
 							expr: &choiceExpr{
-								pos: position{line: 546, col: 30, offset: 16064},
+								pos: position{line: 619, col: 30, offset: 18901},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 546, col: 30, offset: 16064},
+										pos:  position{line: 619, col: 30, offset: 18901},
 										name: "IdentifierRest",
 									},
 									&litMatcher{
-										pos:        position{line: 546, col: 47, offset: 16081},
+										pos:        position{line: 619, col: 47, offset: 18918},
 										val:        ".",
 										ignoreCase: false,
 									},
@@ -3951,39 +4788,39 @@ var g = &grammar{
 		},
 		{
 			name: "LayoutArg",
-			pos:  position{line: 548, col: 1, offset: 16120},
+			pos:  position{line: 621, col: 1, offset: 18957},
 			expr: &actionExpr{
-				pos: position{line: 549, col: 5, offset: 16134},
+				pos: position{line: 622, col: 5, offset: 18971},
 				run: (*parser).callonLayoutArg1,
 				expr: &seqExpr{
-					pos: position{line: 549, col: 5, offset: 16134},
+					pos: position{line: 622, col: 5, offset: 18971},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 549, col: 5, offset: 16134},
+							pos:  position{line: 622, col: 5, offset: 18971},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 549, col: 7, offset: 16136},
+							pos:        position{line: 622, col: 7, offset: 18973},
 							val:        "order",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 549, col: 15, offset: 16144},
+							pos:  position{line: 622, col: 15, offset: 18981},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 549, col: 17, offset: 16146},
+							pos:   position{line: 622, col: 17, offset: 18983},
 							label: "keys",
 							expr: &ruleRefExpr{
-								pos:  position{line: 549, col: 22, offset: 16151},
+								pos:  position{line: 622, col: 22, offset: 18988},
 								name: "FieldExprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 549, col: 33, offset: 16162},
+							pos:   position{line: 622, col: 33, offset: 18999},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 549, col: 39, offset: 16168},
+								pos:  position{line: 622, col: 39, offset: 19005},
 								name: "OrderSuffix",
 							},
 						},
@@ -3993,31 +4830,31 @@ var g = &grammar{
 		},
 		{
 			name: "FormatArg",
-			pos:  position{line: 553, col: 1, offset: 16278},
+			pos:  position{line: 626, col: 1, offset: 19115},
 			expr: &actionExpr{
-				pos: position{line: 554, col: 5, offset: 16292},
+				pos: position{line: 627, col: 5, offset: 19129},
 				run: (*parser).callonFormatArg1,
 				expr: &seqExpr{
-					pos: position{line: 554, col: 5, offset: 16292},
+					pos: position{line: 627, col: 5, offset: 19129},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 554, col: 5, offset: 16292},
+							pos:  position{line: 627, col: 5, offset: 19129},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 554, col: 7, offset: 16294},
+							pos:        position{line: 627, col: 7, offset: 19131},
 							val:        "format",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 554, col: 16, offset: 16303},
+							pos:  position{line: 627, col: 16, offset: 19140},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 554, col: 18, offset: 16305},
+							pos:   position{line: 627, col: 18, offset: 19142},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 554, col: 22, offset: 16309},
+								pos:  position{line: 627, col: 22, offset: 19146},
 								name: "IdentifierName",
 							},
 						},
@@ -4027,33 +4864,33 @@ var g = &grammar{
 		},
 		{
 			name: "OrderSuffix",
-			pos:  position{line: 556, col: 1, offset: 16345},
+			pos:  position{line: 629, col: 1, offset: 19182},
 			expr: &choiceExpr{
-				pos: position{line: 557, col: 5, offset: 16361},
+				pos: position{line: 630, col: 5, offset: 19198},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 557, col: 5, offset: 16361},
+						pos: position{line: 630, col: 5, offset: 19198},
 						run: (*parser).callonOrderSuffix2,
 						expr: &litMatcher{
-							pos:        position{line: 557, col: 5, offset: 16361},
+							pos:        position{line: 630, col: 5, offset: 19198},
 							val:        ":asc",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 558, col: 5, offset: 16395},
+						pos: position{line: 631, col: 5, offset: 19232},
 						run: (*parser).callonOrderSuffix4,
 						expr: &litMatcher{
-							pos:        position{line: 558, col: 5, offset: 16395},
+							pos:        position{line: 631, col: 5, offset: 19232},
 							val:        ":desc",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 559, col: 5, offset: 16431},
+						pos: position{line: 632, col: 5, offset: 19268},
 						run: (*parser).callonOrderSuffix6,
 						expr: &litMatcher{
-							pos:        position{line: 559, col: 5, offset: 16431},
+							pos:        position{line: 632, col: 5, offset: 19268},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -4063,31 +4900,31 @@ var g = &grammar{
 		},
 		{
 			name: "OrderArg",
-			pos:  position{line: 561, col: 1, offset: 16457},
+			pos:  position{line: 634, col: 1, offset: 19294},
 			expr: &choiceExpr{
-				pos: position{line: 562, col: 5, offset: 16470},
+				pos: position{line: 635, col: 5, offset: 19307},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 562, col: 5, offset: 16470},
+						pos: position{line: 635, col: 5, offset: 19307},
 						run: (*parser).callonOrderArg2,
 						expr: &seqExpr{
-							pos: position{line: 562, col: 5, offset: 16470},
+							pos: position{line: 635, col: 5, offset: 19307},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 562, col: 5, offset: 16470},
+									pos:  position{line: 635, col: 5, offset: 19307},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 562, col: 7, offset: 16472},
+									pos:        position{line: 635, col: 7, offset: 19309},
 									val:        "order",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 562, col: 15, offset: 16480},
+									pos:  position{line: 635, col: 15, offset: 19317},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 562, col: 17, offset: 16482},
+									pos:        position{line: 635, col: 17, offset: 19319},
 									val:        "asc",
 									ignoreCase: false,
 								},
@@ -4095,26 +4932,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 563, col: 5, offset: 16515},
+						pos: position{line: 636, col: 5, offset: 19352},
 						run: (*parser).callonOrderArg8,
 						expr: &seqExpr{
-							pos: position{line: 563, col: 5, offset: 16515},
+							pos: position{line: 636, col: 5, offset: 19352},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 563, col: 5, offset: 16515},
+									pos:  position{line: 636, col: 5, offset: 19352},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 563, col: 7, offset: 16517},
+									pos:        position{line: 636, col: 7, offset: 19354},
 									val:        "order",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 563, col: 15, offset: 16525},
+									pos:  position{line: 636, col: 15, offset: 19362},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 563, col: 17, offset: 16527},
+									pos:        position{line: 636, col: 17, offset: 19364},
 									val:        "desc",
 									ignoreCase: false,
 								},
@@ -4126,22 +4963,22 @@ var g = &grammar{
 		},
 		{
 			name: "PassOp",
-			pos:  position{line: 565, col: 1, offset: 16559},
+			pos:  position{line: 638, col: 1, offset: 19396},
 			expr: &actionExpr{
-				pos: position{line: 566, col: 5, offset: 16570},
+				pos: position{line: 639, col: 5, offset: 19407},
 				run: (*parser).callonPassOp1,
 				expr: &seqExpr{
-					pos: position{line: 566, col: 5, offset: 16570},
+					pos: position{line: 639, col: 5, offset: 19407},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 566, col: 5, offset: 16570},
+							pos:        position{line: 639, col: 5, offset: 19407},
 							val:        "pass",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 566, col: 12, offset: 16577},
+							pos: position{line: 639, col: 12, offset: 19414},
 							expr: &ruleRefExpr{
-								pos:  position{line: 566, col: 13, offset: 16578},
+								pos:  position{line: 639, col: 13, offset: 19415},
 								name: "EOKW",
 							},
 						},
@@ -4151,45 +4988,45 @@ var g = &grammar{
 		},
 		{
 			name: "ExplodeOp",
-			pos:  position{line: 572, col: 1, offset: 16770},
+			pos:  position{line: 645, col: 1, offset: 19607},
 			expr: &actionExpr{
-				pos: position{line: 573, col: 5, offset: 16784},
+				pos: position{line: 646, col: 5, offset: 19621},
 				run: (*parser).callonExplodeOp1,
 				expr: &seqExpr{
-					pos: position{line: 573, col: 5, offset: 16784},
+					pos: position{line: 646, col: 5, offset: 19621},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 573, col: 5, offset: 16784},
+							pos:        position{line: 646, col: 5, offset: 19621},
 							val:        "explode",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 573, col: 15, offset: 16794},
+							pos:  position{line: 646, col: 15, offset: 19631},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 17, offset: 16796},
+							pos:   position{line: 646, col: 17, offset: 19633},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 573, col: 22, offset: 16801},
+								pos:  position{line: 646, col: 22, offset: 19638},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 28, offset: 16807},
+							pos:   position{line: 646, col: 28, offset: 19644},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 573, col: 32, offset: 16811},
+								pos:  position{line: 646, col: 32, offset: 19648},
 								name: "TypeArg",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 40, offset: 16819},
+							pos:   position{line: 646, col: 40, offset: 19656},
 							label: "as",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 573, col: 43, offset: 16822},
+								pos: position{line: 646, col: 43, offset: 19659},
 								expr: &ruleRefExpr{
-									pos:  position{line: 573, col: 43, offset: 16822},
+									pos:  position{line: 646, col: 43, offset: 19659},
 									name: "AsArg",
 								},
 							},
@@ -4200,27 +5037,27 @@ var g = &grammar{
 		},
 		{
 			name: "MergeOp",
-			pos:  position{line: 577, col: 1, offset: 16934},
+			pos:  position{line: 650, col: 1, offset: 19771},
 			expr: &actionExpr{
-				pos: position{line: 578, col: 5, offset: 16946},
+				pos: position{line: 651, col: 5, offset: 19783},
 				run: (*parser).callonMergeOp1,
 				expr: &seqExpr{
-					pos: position{line: 578, col: 5, offset: 16946},
+					pos: position{line: 651, col: 5, offset: 19783},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 578, col: 5, offset: 16946},
+							pos:        position{line: 651, col: 5, offset: 19783},
 							val:        "merge",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 13, offset: 16954},
+							pos:  position{line: 651, col: 13, offset: 19791},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 15, offset: 16956},
+							pos:   position{line: 651, col: 15, offset: 19793},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 20, offset: 16961},
+								pos:  position{line: 651, col: 20, offset: 19798},
 								name: "Expr",
 							},
 						},
@@ -4230,48 +5067,48 @@ var g = &grammar{
 		},
 		{
 			name: "OverOp",
-			pos:  position{line: 582, col: 1, offset: 17042},
+			pos:  position{line: 655, col: 1, offset: 19879},
 			expr: &actionExpr{
-				pos: position{line: 583, col: 5, offset: 17053},
+				pos: position{line: 656, col: 5, offset: 19890},
 				run: (*parser).callonOverOp1,
 				expr: &seqExpr{
-					pos: position{line: 583, col: 5, offset: 17053},
+					pos: position{line: 656, col: 5, offset: 19890},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 583, col: 5, offset: 17053},
+							pos:        position{line: 656, col: 5, offset: 19890},
 							val:        "over",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 583, col: 12, offset: 17060},
+							pos:  position{line: 656, col: 12, offset: 19897},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 583, col: 14, offset: 17062},
+							pos:   position{line: 656, col: 14, offset: 19899},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 583, col: 20, offset: 17068},
+								pos:  position{line: 656, col: 20, offset: 19905},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 583, col: 26, offset: 17074},
+							pos:   position{line: 656, col: 26, offset: 19911},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 583, col: 33, offset: 17081},
+								pos: position{line: 656, col: 33, offset: 19918},
 								expr: &ruleRefExpr{
-									pos:  position{line: 583, col: 33, offset: 17081},
+									pos:  position{line: 656, col: 33, offset: 19918},
 									name: "Locals",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 583, col: 41, offset: 17089},
+							pos:   position{line: 656, col: 41, offset: 19926},
 							label: "scope",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 583, col: 47, offset: 17095},
+								pos: position{line: 656, col: 47, offset: 19932},
 								expr: &ruleRefExpr{
-									pos:  position{line: 583, col: 47, offset: 17095},
+									pos:  position{line: 656, col: 47, offset: 19932},
 									name: "Scope",
 								},
 							},
@@ -4282,49 +5119,49 @@ var g = &grammar{
 		},
 		{
 			name: "Scope",
-			pos:  position{line: 591, col: 1, offset: 17345},
+			pos:  position{line: 664, col: 1, offset: 20182},
 			expr: &actionExpr{
-				pos: position{line: 592, col: 5, offset: 17355},
+				pos: position{line: 665, col: 5, offset: 20192},
 				run: (*parser).callonScope1,
 				expr: &seqExpr{
-					pos: position{line: 592, col: 5, offset: 17355},
+					pos: position{line: 665, col: 5, offset: 20192},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 5, offset: 17355},
+							pos:  position{line: 665, col: 5, offset: 20192},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 592, col: 8, offset: 17358},
+							pos:        position{line: 665, col: 8, offset: 20195},
 							val:        "=>",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 13, offset: 17363},
+							pos:  position{line: 665, col: 13, offset: 20200},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 592, col: 16, offset: 17366},
+							pos:        position{line: 665, col: 16, offset: 20203},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 20, offset: 17370},
+							pos:  position{line: 665, col: 20, offset: 20207},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 592, col: 23, offset: 17373},
+							pos:   position{line: 665, col: 23, offset: 20210},
 							label: "seq",
 							expr: &ruleRefExpr{
-								pos:  position{line: 592, col: 27, offset: 17377},
+								pos:  position{line: 665, col: 27, offset: 20214},
 								name: "Sequential",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 38, offset: 17388},
+							pos:  position{line: 665, col: 38, offset: 20225},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 592, col: 41, offset: 17391},
+							pos:        position{line: 665, col: 41, offset: 20228},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -4334,63 +5171,63 @@ var g = &grammar{
 		},
 		{
 			name: "Locals",
-			pos:  position{line: 594, col: 1, offset: 17416},
+			pos:  position{line: 667, col: 1, offset: 20253},
 			expr: &actionExpr{
-				pos: position{line: 595, col: 5, offset: 17427},
+				pos: position{line: 668, col: 5, offset: 20264},
 				run: (*parser).callonLocals1,
 				expr: &seqExpr{
-					pos: position{line: 595, col: 5, offset: 17427},
+					pos: position{line: 668, col: 5, offset: 20264},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 5, offset: 17427},
+							pos:  position{line: 668, col: 5, offset: 20264},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 595, col: 7, offset: 17429},
+							pos:        position{line: 668, col: 7, offset: 20266},
 							val:        "with",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 14, offset: 17436},
+							pos:  position{line: 668, col: 14, offset: 20273},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 595, col: 16, offset: 17438},
+							pos:   position{line: 668, col: 16, offset: 20275},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 595, col: 22, offset: 17444},
+								pos:  position{line: 668, col: 22, offset: 20281},
 								name: "LocalsAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 595, col: 39, offset: 17461},
+							pos:   position{line: 668, col: 39, offset: 20298},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 595, col: 44, offset: 17466},
+								pos: position{line: 668, col: 44, offset: 20303},
 								expr: &actionExpr{
-									pos: position{line: 595, col: 45, offset: 17467},
+									pos: position{line: 668, col: 45, offset: 20304},
 									run: (*parser).callonLocals10,
 									expr: &seqExpr{
-										pos: position{line: 595, col: 45, offset: 17467},
+										pos: position{line: 668, col: 45, offset: 20304},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 595, col: 45, offset: 17467},
+												pos:  position{line: 668, col: 45, offset: 20304},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 595, col: 48, offset: 17470},
+												pos:        position{line: 668, col: 48, offset: 20307},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 595, col: 52, offset: 17474},
+												pos:  position{line: 668, col: 52, offset: 20311},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 595, col: 55, offset: 17477},
+												pos:   position{line: 668, col: 55, offset: 20314},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 595, col: 57, offset: 17479},
+													pos:  position{line: 668, col: 57, offset: 20316},
 													name: "LocalsAssignment",
 												},
 											},
@@ -4405,44 +5242,44 @@ var g = &grammar{
 		},
 		{
 			name: "LocalsAssignment",
-			pos:  position{line: 599, col: 1, offset: 17600},
+			pos:  position{line: 672, col: 1, offset: 20437},
 			expr: &actionExpr{
-				pos: position{line: 600, col: 5, offset: 17621},
+				pos: position{line: 673, col: 5, offset: 20458},
 				run: (*parser).callonLocalsAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 600, col: 5, offset: 17621},
+					pos: position{line: 673, col: 5, offset: 20458},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 600, col: 5, offset: 17621},
+							pos:   position{line: 673, col: 5, offset: 20458},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 600, col: 10, offset: 17626},
+								pos:  position{line: 673, col: 10, offset: 20463},
 								name: "IdentifierName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 600, col: 25, offset: 17641},
+							pos:   position{line: 673, col: 25, offset: 20478},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 600, col: 29, offset: 17645},
+								pos: position{line: 673, col: 29, offset: 20482},
 								expr: &seqExpr{
-									pos: position{line: 600, col: 30, offset: 17646},
+									pos: position{line: 673, col: 30, offset: 20483},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 600, col: 30, offset: 17646},
+											pos:  position{line: 673, col: 30, offset: 20483},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 600, col: 33, offset: 17649},
+											pos:        position{line: 673, col: 33, offset: 20486},
 											val:        "=",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 600, col: 37, offset: 17653},
+											pos:  position{line: 673, col: 37, offset: 20490},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 600, col: 40, offset: 17656},
+											pos:  position{line: 673, col: 40, offset: 20493},
 											name: "Expr",
 										},
 									},
@@ -4455,27 +5292,27 @@ var g = &grammar{
 		},
 		{
 			name: "YieldOp",
-			pos:  position{line: 608, col: 1, offset: 17877},
+			pos:  position{line: 681, col: 1, offset: 20714},
 			expr: &actionExpr{
-				pos: position{line: 609, col: 5, offset: 17889},
+				pos: position{line: 682, col: 5, offset: 20726},
 				run: (*parser).callonYieldOp1,
 				expr: &seqExpr{
-					pos: position{line: 609, col: 5, offset: 17889},
+					pos: position{line: 682, col: 5, offset: 20726},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 609, col: 5, offset: 17889},
+							pos:        position{line: 682, col: 5, offset: 20726},
 							val:        "yield",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 13, offset: 17897},
+							pos:  position{line: 682, col: 13, offset: 20734},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 609, col: 15, offset: 17899},
+							pos:   position{line: 682, col: 15, offset: 20736},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 609, col: 21, offset: 17905},
+								pos:  position{line: 682, col: 21, offset: 20742},
 								name: "Exprs",
 							},
 						},
@@ -4483,32 +5320,190 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "PivotOp",
+			pos:  position{line: 686, col: 1, offset: 20826},
+			expr: &actionExpr{
+				pos: position{line: 687, col: 5, offset: 20838},
+				run: (*parser).callonPivotOp1,
+				expr: &seqExpr{
+					pos: position{line: 687, col: 5, offset: 20838},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 687, col: 5, offset: 20838},
+							val:        "pivot",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 687, col: 13, offset: 20846},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 687, col: 15, offset: 20848},
+							label: "agg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 687, col: 19, offset: 20852},
+								name: "Agg",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 687, col: 23, offset: 20856},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 687, col: 25, offset: 20858},
+							val:        "for",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 687, col: 31, offset: 20864},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 687, col: 33, offset: 20866},
+							label: "column",
+							expr: &ruleRefExpr{
+								pos:  position{line: 687, col: 40, offset: 20873},
+								name: "Expr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 687, col: 45, offset: 20878},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 687, col: 50, offset: 20883},
+								expr: &seqExpr{
+									pos: position{line: 687, col: 51, offset: 20884},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 687, col: 51, offset: 20884},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 687, col: 53, offset: 20886},
+											name: "GroupByKeys",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UnpivotOp",
+			pos:  position{line: 695, col: 1, offset: 21104},
+			expr: &actionExpr{
+				pos: position{line: 696, col: 5, offset: 21118},
+				run: (*parser).callonUnpivotOp1,
+				expr: &seqExpr{
+					pos: position{line: 696, col: 5, offset: 21118},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 696, col: 5, offset: 21118},
+							val:        "unpivot",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 696, col: 15, offset: 21128},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 696, col: 17, offset: 21130},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 696, col: 22, offset: 21135},
+								name: "FieldExprs",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 696, col: 33, offset: 21146},
+							label: "as",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 696, col: 36, offset: 21149},
+								expr: &actionExpr{
+									pos: position{line: 696, col: 37, offset: 21150},
+									run: (*parser).callonUnpivotOp9,
+									expr: &seqExpr{
+										pos: position{line: 696, col: 37, offset: 21150},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 696, col: 37, offset: 21150},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 696, col: 39, offset: 21152},
+												name: "AS",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 696, col: 42, offset: 21155},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 696, col: 44, offset: 21157},
+												label: "name",
+												expr: &ruleRefExpr{
+													pos:  position{line: 696, col: 49, offset: 21162},
+													name: "Lval",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 696, col: 54, offset: 21167},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 696, col: 57, offset: 21170},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 696, col: 61, offset: 21174},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 696, col: 64, offset: 21177},
+												label: "value",
+												expr: &ruleRefExpr{
+													pos:  position{line: 696, col: 70, offset: 21183},
+													name: "Lval",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "TypeArg",
-			pos:  position{line: 613, col: 1, offset: 17989},
+			pos:  position{line: 705, col: 1, offset: 21477},
 			expr: &actionExpr{
-				pos: position{line: 614, col: 5, offset: 18001},
+				pos: position{line: 706, col: 5, offset: 21489},
 				run: (*parser).callonTypeArg1,
 				expr: &seqExpr{
-					pos: position{line: 614, col: 5, offset: 18001},
+					pos: position{line: 706, col: 5, offset: 21489},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 5, offset: 18001},
+							pos:  position{line: 706, col: 5, offset: 21489},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 7, offset: 18003},
+							pos:  position{line: 706, col: 7, offset: 21491},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 10, offset: 18006},
+							pos:  position{line: 706, col: 10, offset: 21494},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 614, col: 12, offset: 18008},
+							pos:   position{line: 706, col: 12, offset: 21496},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 614, col: 16, offset: 18012},
+								pos:  position{line: 706, col: 16, offset: 21500},
 								name: "Type",
 							},
 						},
@@ -4518,30 +5513,30 @@ var g = &grammar{
 		},
 		{
 			name: "AsArg",
-			pos:  position{line: 616, col: 1, offset: 18037},
+			pos:  position{line: 708, col: 1, offset: 21525},
 			expr: &actionExpr{
-				pos: position{line: 617, col: 5, offset: 18047},
+				pos: position{line: 709, col: 5, offset: 21535},
 				run: (*parser).callonAsArg1,
 				expr: &seqExpr{
-					pos: position{line: 617, col: 5, offset: 18047},
+					pos: position{line: 709, col: 5, offset: 21535},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 5, offset: 18047},
+							pos:  position{line: 709, col: 5, offset: 21535},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 7, offset: 18049},
+							pos:  position{line: 709, col: 7, offset: 21537},
 							name: "AS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 10, offset: 18052},
+							pos:  position{line: 709, col: 10, offset: 21540},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 617, col: 12, offset: 18054},
+							pos:   position{line: 709, col: 12, offset: 21542},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 617, col: 16, offset: 18058},
+								pos:  position{line: 709, col: 16, offset: 21546},
 								name: "Lval",
 							},
 						},
@@ -4551,58 +5546,58 @@ var g = &grammar{
 		},
 		{
 			name: "Lval",
-			pos:  position{line: 621, col: 1, offset: 18109},
+			pos:  position{line: 713, col: 1, offset: 21597},
 			expr: &ruleRefExpr{
-				pos:  position{line: 621, col: 8, offset: 18116},
+				pos:  position{line: 713, col: 8, offset: 21604},
 				name: "DerefExpr",
 			},
 		},
 		{
 			name: "Lvals",
-			pos:  position{line: 623, col: 1, offset: 18127},
+			pos:  position{line: 715, col: 1, offset: 21615},
 			expr: &actionExpr{
-				pos: position{line: 624, col: 5, offset: 18137},
+				pos: position{line: 716, col: 5, offset: 21625},
 				run: (*parser).callonLvals1,
 				expr: &seqExpr{
-					pos: position{line: 624, col: 5, offset: 18137},
+					pos: position{line: 716, col: 5, offset: 21625},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 624, col: 5, offset: 18137},
+							pos:   position{line: 716, col: 5, offset: 21625},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 624, col: 11, offset: 18143},
+								pos:  position{line: 716, col: 11, offset: 21631},
 								name: "Lval",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 624, col: 16, offset: 18148},
+							pos:   position{line: 716, col: 16, offset: 21636},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 624, col: 21, offset: 18153},
+								pos: position{line: 716, col: 21, offset: 21641},
 								expr: &actionExpr{
-									pos: position{line: 624, col: 22, offset: 18154},
+									pos: position{line: 716, col: 22, offset: 21642},
 									run: (*parser).callonLvals7,
 									expr: &seqExpr{
-										pos: position{line: 624, col: 22, offset: 18154},
+										pos: position{line: 716, col: 22, offset: 21642},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 624, col: 22, offset: 18154},
+												pos:  position{line: 716, col: 22, offset: 21642},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 624, col: 25, offset: 18157},
+												pos:        position{line: 716, col: 25, offset: 21645},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 624, col: 29, offset: 18161},
+												pos:  position{line: 716, col: 29, offset: 21649},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 624, col: 32, offset: 18164},
+												pos:   position{line: 716, col: 32, offset: 21652},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 624, col: 37, offset: 18169},
+													pos:  position{line: 716, col: 37, offset: 21657},
 													name: "Lval",
 												},
 											},
@@ -4617,52 +5612,52 @@ var g = &grammar{
 		},
 		{
 			name: "FieldExpr",
-			pos:  position{line: 628, col: 1, offset: 18281},
+			pos:  position{line: 720, col: 1, offset: 21769},
 			expr: &ruleRefExpr{
-				pos:  position{line: 628, col: 13, offset: 18293},
+				pos:  position{line: 720, col: 13, offset: 21781},
 				name: "Lval",
 			},
 		},
 		{
 			name: "FieldExprs",
-			pos:  position{line: 630, col: 1, offset: 18299},
+			pos:  position{line: 722, col: 1, offset: 21787},
 			expr: &actionExpr{
-				pos: position{line: 631, col: 5, offset: 18314},
+				pos: position{line: 723, col: 5, offset: 21802},
 				run: (*parser).callonFieldExprs1,
 				expr: &seqExpr{
-					pos: position{line: 631, col: 5, offset: 18314},
+					pos: position{line: 723, col: 5, offset: 21802},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 631, col: 5, offset: 18314},
+							pos:   position{line: 723, col: 5, offset: 21802},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 631, col: 11, offset: 18320},
+								pos:  position{line: 723, col: 11, offset: 21808},
 								name: "FieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 631, col: 21, offset: 18330},
+							pos:   position{line: 723, col: 21, offset: 21818},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 631, col: 26, offset: 18335},
+								pos: position{line: 723, col: 26, offset: 21823},
 								expr: &seqExpr{
-									pos: position{line: 631, col: 27, offset: 18336},
+									pos: position{line: 723, col: 27, offset: 21824},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 631, col: 27, offset: 18336},
+											pos:  position{line: 723, col: 27, offset: 21824},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 631, col: 30, offset: 18339},
+											pos:        position{line: 723, col: 30, offset: 21827},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 631, col: 34, offset: 18343},
+											pos:  position{line: 723, col: 34, offset: 21831},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 631, col: 37, offset: 18346},
+											pos:  position{line: 723, col: 37, offset: 21834},
 											name: "FieldExpr",
 										},
 									},
@@ -4675,50 +5670,50 @@ var g = &grammar{
 		},
 		{
 			name: "Assignments",
-			pos:  position{line: 641, col: 1, offset: 18545},
+			pos:  position{line: 733, col: 1, offset: 22033},
 			expr: &actionExpr{
-				pos: position{line: 642, col: 5, offset: 18561},
+				pos: position{line: 734, col: 5, offset: 22049},
 				run: (*parser).callonAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 642, col: 5, offset: 18561},
+					pos: position{line: 734, col: 5, offset: 22049},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 642, col: 5, offset: 18561},
+							pos:   position{line: 734, col: 5, offset: 22049},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 642, col: 11, offset: 18567},
+								pos:  position{line: 734, col: 11, offset: 22055},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 642, col: 22, offset: 18578},
+							pos:   position{line: 734, col: 22, offset: 22066},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 642, col: 27, offset: 18583},
+								pos: position{line: 734, col: 27, offset: 22071},
 								expr: &actionExpr{
-									pos: position{line: 642, col: 28, offset: 18584},
+									pos: position{line: 734, col: 28, offset: 22072},
 									run: (*parser).callonAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 642, col: 28, offset: 18584},
+										pos: position{line: 734, col: 28, offset: 22072},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 642, col: 28, offset: 18584},
+												pos:  position{line: 734, col: 28, offset: 22072},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 642, col: 31, offset: 18587},
+												pos:        position{line: 734, col: 31, offset: 22075},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 642, col: 35, offset: 18591},
+												pos:  position{line: 734, col: 35, offset: 22079},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 642, col: 38, offset: 18594},
+												pos:   position{line: 734, col: 38, offset: 22082},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 642, col: 40, offset: 18596},
+													pos:  position{line: 734, col: 40, offset: 22084},
 													name: "Assignment",
 												},
 											},
@@ -4733,39 +5728,39 @@ var g = &grammar{
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 646, col: 1, offset: 18707},
+			pos:  position{line: 738, col: 1, offset: 22195},
 			expr: &actionExpr{
-				pos: position{line: 647, col: 5, offset: 18722},
+				pos: position{line: 739, col: 5, offset: 22210},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 647, col: 5, offset: 18722},
+					pos: position{line: 739, col: 5, offset: 22210},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 647, col: 5, offset: 18722},
+							pos:   position{line: 739, col: 5, offset: 22210},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 647, col: 9, offset: 18726},
+								pos:  position{line: 739, col: 9, offset: 22214},
 								name: "Lval",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 647, col: 14, offset: 18731},
+							pos:  position{line: 739, col: 14, offset: 22219},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 647, col: 17, offset: 18734},
+							pos:        position{line: 739, col: 17, offset: 22222},
 							val:        ":=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 647, col: 22, offset: 18739},
+							pos:  position{line: 739, col: 22, offset: 22227},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 647, col: 25, offset: 18742},
+							pos:   position{line: 739, col: 25, offset: 22230},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 647, col: 29, offset: 18746},
+								pos:  position{line: 739, col: 29, offset: 22234},
 								name: "Expr",
 							},
 						},
@@ -4775,69 +5770,69 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 649, col: 1, offset: 18837},
+			pos:  position{line: 741, col: 1, offset: 22325},
 			expr: &ruleRefExpr{
-				pos:  position{line: 649, col: 8, offset: 18844},
+				pos:  position{line: 741, col: 8, offset: 22332},
 				name: "ConditionalExpr",
 			},
 		},
 		{
 			name: "ConditionalExpr",
-			pos:  position{line: 651, col: 1, offset: 18861},
+			pos:  position{line: 743, col: 1, offset: 22349},
 			expr: &actionExpr{
-				pos: position{line: 652, col: 5, offset: 18881},
+				pos: position{line: 744, col: 5, offset: 22369},
 				run: (*parser).callonConditionalExpr1,
 				expr: &seqExpr{
-					pos: position{line: 652, col: 5, offset: 18881},
+					pos: position{line: 744, col: 5, offset: 22369},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 652, col: 5, offset: 18881},
+							pos:   position{line: 744, col: 5, offset: 22369},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 652, col: 10, offset: 18886},
+								pos:  position{line: 744, col: 10, offset: 22374},
 								name: "LogicalOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 652, col: 24, offset: 18900},
+							pos:   position{line: 744, col: 24, offset: 22388},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 652, col: 28, offset: 18904},
+								pos: position{line: 744, col: 28, offset: 22392},
 								expr: &seqExpr{
-									pos: position{line: 652, col: 29, offset: 18905},
+									pos: position{line: 744, col: 29, offset: 22393},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 652, col: 29, offset: 18905},
+											pos:  position{line: 744, col: 29, offset: 22393},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 652, col: 32, offset: 18908},
+											pos:        position{line: 744, col: 32, offset: 22396},
 											val:        "?",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 652, col: 36, offset: 18912},
+											pos:  position{line: 744, col: 36, offset: 22400},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 652, col: 39, offset: 18915},
+											pos:  position{line: 744, col: 39, offset: 22403},
 											name: "Expr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 652, col: 44, offset: 18920},
+											pos:  position{line: 744, col: 44, offset: 22408},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 652, col: 47, offset: 18923},
+											pos:        position{line: 744, col: 47, offset: 22411},
 											val:        ":",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 652, col: 51, offset: 18927},
+											pos:  position{line: 744, col: 51, offset: 22415},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 652, col: 54, offset: 18930},
+											pos:  position{line: 744, col: 54, offset: 22418},
 											name: "Expr",
 										},
 									},
@@ -4850,53 +5845,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalOrExpr",
-			pos:  position{line: 661, col: 1, offset: 19191},
+			pos:  position{line: 753, col: 1, offset: 22679},
 			expr: &actionExpr{
-				pos: position{line: 662, col: 5, offset: 19209},
+				pos: position{line: 754, col: 5, offset: 22697},
 				run: (*parser).callonLogicalOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 662, col: 5, offset: 19209},
+					pos: position{line: 754, col: 5, offset: 22697},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 662, col: 5, offset: 19209},
+							pos:   position{line: 754, col: 5, offset: 22697},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 662, col: 11, offset: 19215},
+								pos:  position{line: 754, col: 11, offset: 22703},
 								name: "LogicalAndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 663, col: 5, offset: 19234},
+							pos:   position{line: 755, col: 5, offset: 22722},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 663, col: 10, offset: 19239},
+								pos: position{line: 755, col: 10, offset: 22727},
 								expr: &actionExpr{
-									pos: position{line: 663, col: 11, offset: 19240},
+									pos: position{line: 755, col: 11, offset: 22728},
 									run: (*parser).callonLogicalOrExpr7,
 									expr: &seqExpr{
-										pos: position{line: 663, col: 11, offset: 19240},
+										pos: position{line: 755, col: 11, offset: 22728},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 663, col: 11, offset: 19240},
+												pos:  position{line: 755, col: 11, offset: 22728},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 663, col: 14, offset: 19243},
+												pos:   position{line: 755, col: 14, offset: 22731},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 663, col: 17, offset: 19246},
+													pos:  position{line: 755, col: 17, offset: 22734},
 													name: "OrToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 663, col: 25, offset: 19254},
+												pos:  position{line: 755, col: 25, offset: 22742},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 663, col: 28, offset: 19257},
+												pos:   position{line: 755, col: 28, offset: 22745},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 663, col: 33, offset: 19262},
+													pos:  position{line: 755, col: 33, offset: 22750},
 													name: "LogicalAndExpr",
 												},
 											},
@@ -4911,53 +5906,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalAndExpr",
-			pos:  position{line: 667, col: 1, offset: 19380},
+			pos:  position{line: 759, col: 1, offset: 22868},
 			expr: &actionExpr{
-				pos: position{line: 668, col: 5, offset: 19399},
+				pos: position{line: 760, col: 5, offset: 22887},
 				run: (*parser).callonLogicalAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 668, col: 5, offset: 19399},
+					pos: position{line: 760, col: 5, offset: 22887},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 668, col: 5, offset: 19399},
+							pos:   position{line: 760, col: 5, offset: 22887},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 668, col: 11, offset: 19405},
+								pos:  position{line: 760, col: 11, offset: 22893},
 								name: "ComparisonExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 669, col: 5, offset: 19424},
+							pos:   position{line: 761, col: 5, offset: 22912},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 669, col: 10, offset: 19429},
+								pos: position{line: 761, col: 10, offset: 22917},
 								expr: &actionExpr{
-									pos: position{line: 669, col: 11, offset: 19430},
+									pos: position{line: 761, col: 11, offset: 22918},
 									run: (*parser).callonLogicalAndExpr7,
 									expr: &seqExpr{
-										pos: position{line: 669, col: 11, offset: 19430},
+										pos: position{line: 761, col: 11, offset: 22918},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 669, col: 11, offset: 19430},
+												pos:  position{line: 761, col: 11, offset: 22918},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 669, col: 14, offset: 19433},
+												pos:   position{line: 761, col: 14, offset: 22921},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 669, col: 17, offset: 19436},
+													pos:  position{line: 761, col: 17, offset: 22924},
 													name: "AndToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 669, col: 26, offset: 19445},
+												pos:  position{line: 761, col: 26, offset: 22933},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 669, col: 29, offset: 19448},
+												pos:   position{line: 761, col: 29, offset: 22936},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 669, col: 34, offset: 19453},
+													pos:  position{line: 761, col: 34, offset: 22941},
 													name: "ComparisonExpr",
 												},
 											},
@@ -4972,76 +5967,80 @@ var g = &grammar{
 		},
 		{
 			name: "ComparisonExpr",
-			pos:  position{line: 673, col: 1, offset: 19571},
+			pos:  position{line: 765, col: 1, offset: 23059},
 			expr: &actionExpr{
-				pos: position{line: 674, col: 5, offset: 19590},
+				pos: position{line: 766, col: 5, offset: 23078},
 				run: (*parser).callonComparisonExpr1,
 				expr: &seqExpr{
-					pos: position{line: 674, col: 5, offset: 19590},
+					pos: position{line: 766, col: 5, offset: 23078},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 674, col: 5, offset: 19590},
+							pos:   position{line: 766, col: 5, offset: 23078},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 674, col: 9, offset: 19594},
+								pos:  position{line: 766, col: 9, offset: 23082},
 								name: "AdditiveExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 674, col: 22, offset: 19607},
+							pos:   position{line: 766, col: 22, offset: 23095},
 							label: "opAndRHS",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 674, col: 31, offset: 19616},
+								pos: position{line: 766, col: 31, offset: 23104},
 								expr: &choiceExpr{
-									pos: position{line: 674, col: 32, offset: 19617},
+									pos: position{line: 766, col: 32, offset: 23105},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 674, col: 32, offset: 19617},
+											pos: position{line: 766, col: 32, offset: 23105},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 32, offset: 19617},
+													pos:  position{line: 766, col: 32, offset: 23105},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 35, offset: 19620},
+													pos:  position{line: 766, col: 35, offset: 23108},
 													name: "Comparator",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 46, offset: 19631},
+													pos:  position{line: 766, col: 46, offset: 23119},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 49, offset: 19634},
+													pos:  position{line: 766, col: 49, offset: 23122},
 													name: "AdditiveExpr",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 674, col: 64, offset: 19649},
+											pos: position{line: 766, col: 64, offset: 23137},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 64, offset: 19649},
+													pos:  position{line: 766, col: 64, offset: 23137},
 													name: "__",
 												},
 												&actionExpr{
-													pos: position{line: 674, col: 68, offset: 19653},
+													pos: position{line: 766, col: 68, offset: 23141},
 													run: (*parser).callonComparisonExpr15,
 													expr: &litMatcher{
-														pos:        position{line: 674, col: 68, offset: 19653},
+														pos:        position{line: 766, col: 68, offset: 23141},
 														val:        "~",
 														ignoreCase: false,
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 104, offset: 19689},
+													pos:  position{line: 766, col: 104, offset: 23177},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 674, col: 107, offset: 19692},
+													pos:  position{line: 766, col: 107, offset: 23180},
 													name: "Regexp",
 												},
 											},
 										},
+										&ruleRefExpr{
+											pos:  position{line: 766, col: 116, offset: 23189},
+											name: "SQLInQuery",
+										},
 									},
 								},
 							},
@@ -5050,55 +6049,126 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "SQLInQuery",
+			pos:  position{line: 782, col: 1, offset: 23789},
+			expr: &actionExpr{
+				pos: position{line: 783, col: 5, offset: 23804},
+				run: (*parser).callonSQLInQuery1,
+				expr: &seqExpr{
+					pos: position{line: 783, col: 5, offset: 23804},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 783, col: 5, offset: 23804},
+							label: "not",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 783, col: 9, offset: 23808},
+								expr: &seqExpr{
+									pos: position{line: 783, col: 10, offset: 23809},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 783, col: 10, offset: 23809},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 783, col: 12, offset: 23811},
+											name: "NotToken",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 783, col: 23, offset: 23822},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 783, col: 25, offset: 23824},
+							name: "IN",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 783, col: 28, offset: 23827},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 783, col: 31, offset: 23830},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 783, col: 35, offset: 23834},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 783, col: 38, offset: 23837},
+							label: "query",
+							expr: &ruleRefExpr{
+								pos:  position{line: 783, col: 44, offset: 23843},
+								name: "SQLOp",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 783, col: 50, offset: 23849},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 783, col: 53, offset: 23852},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "AdditiveExpr",
-			pos:  position{line: 683, col: 1, offset: 19953},
+			pos:  position{line: 791, col: 1, offset: 23996},
 			expr: &actionExpr{
-				pos: position{line: 684, col: 5, offset: 19970},
+				pos: position{line: 792, col: 5, offset: 24013},
 				run: (*parser).callonAdditiveExpr1,
 				expr: &seqExpr{
-					pos: position{line: 684, col: 5, offset: 19970},
+					pos: position{line: 792, col: 5, offset: 24013},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 684, col: 5, offset: 19970},
+							pos:   position{line: 792, col: 5, offset: 24013},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 684, col: 11, offset: 19976},
+								pos:  position{line: 792, col: 11, offset: 24019},
 								name: "MultiplicativeExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 685, col: 5, offset: 19999},
+							pos:   position{line: 793, col: 5, offset: 24042},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 685, col: 10, offset: 20004},
+								pos: position{line: 793, col: 10, offset: 24047},
 								expr: &actionExpr{
-									pos: position{line: 685, col: 11, offset: 20005},
+									pos: position{line: 793, col: 11, offset: 24048},
 									run: (*parser).callonAdditiveExpr7,
 									expr: &seqExpr{
-										pos: position{line: 685, col: 11, offset: 20005},
+										pos: position{line: 793, col: 11, offset: 24048},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 685, col: 11, offset: 20005},
+												pos:  position{line: 793, col: 11, offset: 24048},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 685, col: 14, offset: 20008},
+												pos:   position{line: 793, col: 14, offset: 24051},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 685, col: 17, offset: 20011},
+													pos:  position{line: 793, col: 17, offset: 24054},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 685, col: 34, offset: 20028},
+												pos:  position{line: 793, col: 34, offset: 24071},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 685, col: 37, offset: 20031},
+												pos:   position{line: 793, col: 37, offset: 24074},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 685, col: 42, offset: 20036},
+													pos:  position{line: 793, col: 42, offset: 24079},
 													name: "MultiplicativeExpr",
 												},
 											},
@@ -5113,20 +6183,20 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 689, col: 1, offset: 20158},
+			pos:  position{line: 797, col: 1, offset: 24201},
 			expr: &actionExpr{
-				pos: position{line: 689, col: 20, offset: 20177},
+				pos: position{line: 797, col: 20, offset: 24220},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 689, col: 21, offset: 20178},
+					pos: position{line: 797, col: 21, offset: 24221},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 689, col: 21, offset: 20178},
+							pos:        position{line: 797, col: 21, offset: 24221},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 689, col: 27, offset: 20184},
+							pos:        position{line: 797, col: 27, offset: 24227},
 							val:        "-",
 							ignoreCase: false,
 						},
@@ -5136,53 +6206,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpr",
-			pos:  position{line: 691, col: 1, offset: 20221},
+			pos:  position{line: 799, col: 1, offset: 24264},
 			expr: &actionExpr{
-				pos: position{line: 692, col: 5, offset: 20244},
+				pos: position{line: 800, col: 5, offset: 24287},
 				run: (*parser).callonMultiplicativeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 692, col: 5, offset: 20244},
+					pos: position{line: 800, col: 5, offset: 24287},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 692, col: 5, offset: 20244},
+							pos:   position{line: 800, col: 5, offset: 24287},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 692, col: 11, offset: 20250},
+								pos:  position{line: 800, col: 11, offset: 24293},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 693, col: 5, offset: 20262},
+							pos:   position{line: 801, col: 5, offset: 24305},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 693, col: 10, offset: 20267},
+								pos: position{line: 801, col: 10, offset: 24310},
 								expr: &actionExpr{
-									pos: position{line: 693, col: 11, offset: 20268},
+									pos: position{line: 801, col: 11, offset: 24311},
 									run: (*parser).callonMultiplicativeExpr7,
 									expr: &seqExpr{
-										pos: position{line: 693, col: 11, offset: 20268},
+										pos: position{line: 801, col: 11, offset: 24311},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 693, col: 11, offset: 20268},
+												pos:  position{line: 801, col: 11, offset: 24311},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 693, col: 14, offset: 20271},
+												pos:   position{line: 801, col: 14, offset: 24314},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 693, col: 17, offset: 20274},
+													pos:  position{line: 801, col: 17, offset: 24317},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 693, col: 40, offset: 20297},
+												pos:  position{line: 801, col: 40, offset: 24340},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 693, col: 43, offset: 20300},
+												pos:   position{line: 801, col: 43, offset: 24343},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 693, col: 48, offset: 20305},
+													pos:  position{line: 801, col: 48, offset: 24348},
 													name: "NotExpr",
 												},
 											},
@@ -5197,25 +6267,25 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 697, col: 1, offset: 20416},
+			pos:  position{line: 805, col: 1, offset: 24459},
 			expr: &actionExpr{
-				pos: position{line: 697, col: 26, offset: 20441},
+				pos: position{line: 805, col: 26, offset: 24484},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 697, col: 27, offset: 20442},
+					pos: position{line: 805, col: 27, offset: 24485},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 697, col: 27, offset: 20442},
+							pos:        position{line: 805, col: 27, offset: 24485},
 							val:        "*",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 697, col: 33, offset: 20448},
+							pos:        position{line: 805, col: 33, offset: 24491},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 697, col: 39, offset: 20454},
+							pos:        position{line: 805, col: 39, offset: 24497},
 							val:        "%",
 							ignoreCase: false,
 						},
@@ -5225,30 +6295,30 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 699, col: 1, offset: 20491},
+			pos:  position{line: 807, col: 1, offset: 24534},
 			expr: &choiceExpr{
-				pos: position{line: 700, col: 5, offset: 20503},
+				pos: position{line: 808, col: 5, offset: 24546},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 700, col: 5, offset: 20503},
+						pos: position{line: 808, col: 5, offset: 24546},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 700, col: 5, offset: 20503},
+							pos: position{line: 808, col: 5, offset: 24546},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 700, col: 5, offset: 20503},
+									pos:        position{line: 808, col: 5, offset: 24546},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 700, col: 9, offset: 20507},
+									pos:  position{line: 808, col: 9, offset: 24550},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 700, col: 12, offset: 20510},
+									pos:   position{line: 808, col: 12, offset: 24553},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 700, col: 14, offset: 20512},
+										pos:  position{line: 808, col: 14, offset: 24555},
 										name: "NotExpr",
 									},
 								},
@@ -5256,7 +6326,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 703, col: 5, offset: 20621},
+						pos:  position{line: 811, col: 5, offset: 24664},
 						name: "NegationExpr",
 					},
 				},
@@ -5264,37 +6334,37 @@ var g = &grammar{
 		},
 		{
 			name: "NegationExpr",
-			pos:  position{line: 705, col: 1, offset: 20635},
+			pos:  position{line: 813, col: 1, offset: 24678},
 			expr: &choiceExpr{
-				pos: position{line: 706, col: 5, offset: 20652},
+				pos: position{line: 814, col: 5, offset: 24695},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 706, col: 5, offset: 20652},
+						pos: position{line: 814, col: 5, offset: 24695},
 						run: (*parser).callonNegationExpr2,
 						expr: &seqExpr{
-							pos: position{line: 706, col: 5, offset: 20652},
+							pos: position{line: 814, col: 5, offset: 24695},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 706, col: 5, offset: 20652},
+									pos: position{line: 814, col: 5, offset: 24695},
 									expr: &ruleRefExpr{
-										pos:  position{line: 706, col: 6, offset: 20653},
+										pos:  position{line: 814, col: 6, offset: 24696},
 										name: "Literal",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 706, col: 14, offset: 20661},
+									pos:        position{line: 814, col: 14, offset: 24704},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 706, col: 18, offset: 20665},
+									pos:  position{line: 814, col: 18, offset: 24708},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 706, col: 21, offset: 20668},
+									pos:   position{line: 814, col: 21, offset: 24711},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 706, col: 23, offset: 20670},
+										pos:  position{line: 814, col: 23, offset: 24713},
 										name: "FuncExpr",
 									},
 								},
@@ -5302,7 +6372,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 709, col: 5, offset: 20780},
+						pos:  position{line: 817, col: 5, offset: 24823},
 						name: "FuncExpr",
 					},
 				},
@@ -5310,31 +6380,35 @@ var g = &grammar{
 		},
 		{
 			name: "FuncExpr",
-			pos:  position{line: 711, col: 1, offset: 20790},
+			pos:  position{line: 819, col: 1, offset: 24833},
 			expr: &choiceExpr{
-				pos: position{line: 712, col: 5, offset: 20803},
+				pos: position{line: 820, col: 5, offset: 24846},
 				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 820, col: 5, offset: 24846},
+						name: "CaseExpr",
+					},
 					&actionExpr{
-						pos: position{line: 712, col: 5, offset: 20803},
-						run: (*parser).callonFuncExpr2,
+						pos: position{line: 821, col: 5, offset: 24859},
+						run: (*parser).callonFuncExpr3,
 						expr: &seqExpr{
-							pos: position{line: 712, col: 5, offset: 20803},
+							pos: position{line: 821, col: 5, offset: 24859},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 712, col: 5, offset: 20803},
+									pos:   position{line: 821, col: 5, offset: 24859},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 712, col: 11, offset: 20809},
+										pos:  position{line: 821, col: 11, offset: 24865},
 										name: "Cast",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 712, col: 16, offset: 20814},
+									pos:   position{line: 821, col: 16, offset: 24870},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 712, col: 21, offset: 20819},
+										pos: position{line: 821, col: 21, offset: 24875},
 										expr: &ruleRefExpr{
-											pos:  position{line: 712, col: 22, offset: 20820},
+											pos:  position{line: 821, col: 22, offset: 24876},
 											name: "Deref",
 										},
 									},
@@ -5343,26 +6417,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 715, col: 5, offset: 20891},
-						run: (*parser).callonFuncExpr9,
+						pos: position{line: 824, col: 5, offset: 24947},
+						run: (*parser).callonFuncExpr10,
 						expr: &seqExpr{
-							pos: position{line: 715, col: 5, offset: 20891},
+							pos: position{line: 824, col: 5, offset: 24947},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 715, col: 5, offset: 20891},
+									pos:   position{line: 824, col: 5, offset: 24947},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 715, col: 11, offset: 20897},
+										pos:  position{line: 824, col: 11, offset: 24953},
 										name: "Function",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 715, col: 20, offset: 20906},
+									pos:   position{line: 824, col: 20, offset: 24962},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 715, col: 25, offset: 20911},
+										pos: position{line: 824, col: 25, offset: 24967},
 										expr: &ruleRefExpr{
-											pos:  position{line: 715, col: 26, offset: 20912},
+											pos:  position{line: 824, col: 26, offset: 24968},
 											name: "Deref",
 										},
 									},
@@ -5371,11 +6445,11 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 718, col: 5, offset: 20983},
+						pos:  position{line: 827, col: 5, offset: 25039},
 						name: "DerefExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 719, col: 5, offset: 20997},
+						pos:  position{line: 828, col: 5, offset: 25053},
 						name: "Primary",
 					},
 				},
@@ -5383,20 +6457,20 @@ var g = &grammar{
 		},
 		{
 			name: "FuncGuard",
-			pos:  position{line: 721, col: 1, offset: 21006},
+			pos:  position{line: 830, col: 1, offset: 25062},
 			expr: &seqExpr{
-				pos: position{line: 721, col: 13, offset: 21018},
+				pos: position{line: 830, col: 13, offset: 25074},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 721, col: 13, offset: 21018},
+						pos:  position{line: 830, col: 13, offset: 25074},
 						name: "NotFuncs",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 721, col: 22, offset: 21027},
+						pos:  position{line: 830, col: 22, offset: 25083},
 						name: "__",
 					},
 					&litMatcher{
-						pos:        position{line: 721, col: 25, offset: 21030},
+						pos:        position{line: 830, col: 25, offset: 25086},
 						val:        "(",
 						ignoreCase: false,
 					},
@@ -5405,17 +6479,17 @@ var g = &grammar{
 		},
 		{
 			name: "NotFuncs",
-			pos:  position{line: 723, col: 1, offset: 21035},
+			pos:  position{line: 832, col: 1, offset: 25091},
 			expr: &choiceExpr{
-				pos: position{line: 724, col: 5, offset: 21048},
+				pos: position{line: 833, col: 5, offset: 25104},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 724, col: 5, offset: 21048},
+						pos:        position{line: 833, col: 5, offset: 25104},
 						val:        "not",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 725, col: 5, offset: 21058},
+						pos:        position{line: 834, col: 5, offset: 25114},
 						val:        "select",
 						ignoreCase: false,
 					},
@@ -5424,57 +6498,57 @@ var g = &grammar{
 		},
 		{
 			name: "Cast",
-			pos:  position{line: 727, col: 1, offset: 21068},
+			pos:  position{line: 836, col: 1, offset: 25124},
 			expr: &actionExpr{
-				pos: position{line: 728, col: 5, offset: 21077},
+				pos: position{line: 837, col: 5, offset: 25133},
 				run: (*parser).callonCast1,
 				expr: &seqExpr{
-					pos: position{line: 728, col: 5, offset: 21077},
+					pos: position{line: 837, col: 5, offset: 25133},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 728, col: 5, offset: 21077},
+							pos:   position{line: 837, col: 5, offset: 25133},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 728, col: 9, offset: 21081},
+								pos:  position{line: 837, col: 9, offset: 25137},
 								name: "CastType",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 728, col: 18, offset: 21090},
+							pos:  position{line: 837, col: 18, offset: 25146},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 728, col: 21, offset: 21093},
+							pos:        position{line: 837, col: 21, offset: 25149},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 728, col: 25, offset: 21097},
+							pos:  position{line: 837, col: 25, offset: 25153},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 728, col: 28, offset: 21100},
+							pos:   position{line: 837, col: 28, offset: 25156},
 							label: "expr",
 							expr: &choiceExpr{
-								pos: position{line: 728, col: 34, offset: 21106},
+								pos: position{line: 837, col: 34, offset: 25162},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 728, col: 34, offset: 21106},
+										pos:  position{line: 837, col: 34, offset: 25162},
 										name: "OverExpr",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 728, col: 45, offset: 21117},
+										pos:  position{line: 837, col: 45, offset: 25173},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 728, col: 51, offset: 21123},
+							pos:  position{line: 837, col: 51, offset: 25179},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 728, col: 54, offset: 21126},
+							pos:        position{line: 837, col: 54, offset: 25182},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -5484,83 +6558,83 @@ var g = &grammar{
 		},
 		{
 			name: "Function",
-			pos:  position{line: 732, col: 1, offset: 21223},
+			pos:  position{line: 841, col: 1, offset: 25279},
 			expr: &choiceExpr{
-				pos: position{line: 733, col: 5, offset: 21236},
+				pos: position{line: 842, col: 5, offset: 25292},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 733, col: 5, offset: 21236},
+						pos:  position{line: 842, col: 5, offset: 25292},
 						name: "Grep",
 					},
 					&actionExpr{
-						pos: position{line: 735, col: 5, offset: 21291},
+						pos: position{line: 844, col: 5, offset: 25347},
 						run: (*parser).callonFunction3,
 						expr: &seqExpr{
-							pos: position{line: 735, col: 5, offset: 21291},
+							pos: position{line: 844, col: 5, offset: 25347},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 735, col: 5, offset: 21291},
+									pos:        position{line: 844, col: 5, offset: 25347},
 									val:        "regexp",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 735, col: 14, offset: 21300},
+									pos:  position{line: 844, col: 14, offset: 25356},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 735, col: 17, offset: 21303},
+									pos:        position{line: 844, col: 17, offset: 25359},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 735, col: 21, offset: 21307},
+									pos:  position{line: 844, col: 21, offset: 25363},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 735, col: 24, offset: 21310},
+									pos:   position{line: 844, col: 24, offset: 25366},
 									label: "arg0Text",
 									expr: &ruleRefExpr{
-										pos:  position{line: 735, col: 33, offset: 21319},
+										pos:  position{line: 844, col: 33, offset: 25375},
 										name: "RegexpPattern",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 735, col: 47, offset: 21333},
+									pos:  position{line: 844, col: 47, offset: 25389},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 735, col: 50, offset: 21336},
+									pos:        position{line: 844, col: 50, offset: 25392},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 735, col: 54, offset: 21340},
+									pos:  position{line: 844, col: 54, offset: 25396},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 735, col: 57, offset: 21343},
+									pos:   position{line: 844, col: 57, offset: 25399},
 									label: "arg1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 735, col: 62, offset: 21348},
+										pos:  position{line: 844, col: 62, offset: 25404},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 735, col: 67, offset: 21353},
+									pos:  position{line: 844, col: 67, offset: 25409},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 735, col: 70, offset: 21356},
+									pos:        position{line: 844, col: 70, offset: 25412},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 735, col: 74, offset: 21360},
+									pos:   position{line: 844, col: 74, offset: 25416},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 735, col: 80, offset: 21366},
+										pos: position{line: 844, col: 80, offset: 25422},
 										expr: &ruleRefExpr{
-											pos:  position{line: 735, col: 80, offset: 21366},
+											pos:  position{line: 844, col: 80, offset: 25422},
 											name: "WhereClause",
 										},
 									},
@@ -5569,67 +6643,151 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 739, col: 5, offset: 21614},
+						pos: position{line: 848, col: 5, offset: 25670},
 						run: (*parser).callonFunction21,
 						expr: &seqExpr{
-							pos: position{line: 739, col: 5, offset: 21614},
+							pos: position{line: 848, col: 5, offset: 25670},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 848, col: 5, offset: 25670},
+									expr: &ruleRefExpr{
+										pos:  position{line: 848, col: 6, offset: 25671},
+										name: "FuncGuard",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 848, col: 16, offset: 25681},
+									label: "fn",
+									expr: &ruleRefExpr{
+										pos:  position{line: 848, col: 19, offset: 25684},
+										name: "IdentifierName",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 848, col: 34, offset: 25699},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 848, col: 37, offset: 25702},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 848, col: 41, offset: 25706},
+									name: "__",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 848, col: 44, offset: 25709},
+									name: "DISTINCT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 848, col: 53, offset: 25718},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 848, col: 55, offset: 25720},
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 848, col: 60, offset: 25725},
+										name: "Expr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 848, col: 65, offset: 25730},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 848, col: 68, offset: 25733},
+									val:        ")",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 848, col: 72, offset: 25737},
+									label: "where",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 848, col: 78, offset: 25743},
+										expr: &ruleRefExpr{
+											pos:  position{line: 848, col: 78, offset: 25743},
+											name: "WhereClause",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 851, col: 5, offset: 25884},
+						run: (*parser).callonFunction39,
+						expr: &seqExpr{
+							pos: position{line: 851, col: 5, offset: 25884},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 739, col: 5, offset: 21614},
+									pos: position{line: 851, col: 5, offset: 25884},
 									expr: &ruleRefExpr{
-										pos:  position{line: 739, col: 6, offset: 21615},
+										pos:  position{line: 851, col: 6, offset: 25885},
 										name: "FuncGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 739, col: 16, offset: 21625},
+									pos:   position{line: 851, col: 16, offset: 25895},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 739, col: 19, offset: 21628},
+										pos:  position{line: 851, col: 19, offset: 25898},
 										name: "IdentifierName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 739, col: 34, offset: 21643},
+									pos:  position{line: 851, col: 34, offset: 25913},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 739, col: 37, offset: 21646},
+									pos:        position{line: 851, col: 37, offset: 25916},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 739, col: 41, offset: 21650},
+									pos:  position{line: 851, col: 41, offset: 25920},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 739, col: 44, offset: 21653},
+									pos:   position{line: 851, col: 44, offset: 25923},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 739, col: 49, offset: 21658},
+										pos:  position{line: 851, col: 49, offset: 25928},
 										name: "FunctionArgs",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 739, col: 62, offset: 21671},
+									pos:  position{line: 851, col: 62, offset: 25941},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 739, col: 65, offset: 21674},
+									pos:        position{line: 851, col: 65, offset: 25944},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 739, col: 69, offset: 21678},
+									pos:   position{line: 851, col: 69, offset: 25948},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 739, col: 75, offset: 21684},
+										pos: position{line: 851, col: 75, offset: 25954},
 										expr: &ruleRefExpr{
-											pos:  position{line: 739, col: 75, offset: 21684},
+											pos:  position{line: 851, col: 75, offset: 25954},
 											name: "WhereClause",
 										},
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 851, col: 88, offset: 25967},
+									label: "over",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 851, col: 93, offset: 25972},
+										expr: &ruleRefExpr{
+											pos:  position{line: 851, col: 93, offset: 25972},
+											name: "SQLOverClause",
+										},
+									},
+								},
 							},
 						},
 					},
@@ -5638,24 +6796,24 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionArgs",
-			pos:  position{line: 743, col: 1, offset: 21805},
+			pos:  position{line: 859, col: 1, offset: 26240},
 			expr: &choiceExpr{
-				pos: position{line: 744, col: 5, offset: 21822},
+				pos: position{line: 860, col: 5, offset: 26257},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 744, col: 5, offset: 21822},
+						pos: position{line: 860, col: 5, offset: 26257},
 						run: (*parser).callonFunctionArgs2,
 						expr: &labeledExpr{
-							pos:   position{line: 744, col: 5, offset: 21822},
+							pos:   position{line: 860, col: 5, offset: 26257},
 							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 744, col: 7, offset: 21824},
+								pos:  position{line: 860, col: 7, offset: 26259},
 								name: "OverExpr",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 745, col: 5, offset: 21870},
+						pos:  position{line: 861, col: 5, offset: 26305},
 						name: "OptionalExprs",
 					},
 				},
@@ -5663,75 +6821,75 @@ var g = &grammar{
 		},
 		{
 			name: "Grep",
-			pos:  position{line: 747, col: 1, offset: 21885},
+			pos:  position{line: 863, col: 1, offset: 26320},
 			expr: &actionExpr{
-				pos: position{line: 748, col: 5, offset: 21894},
+				pos: position{line: 864, col: 5, offset: 26329},
 				run: (*parser).callonGrep1,
 				expr: &seqExpr{
-					pos: position{line: 748, col: 5, offset: 21894},
+					pos: position{line: 864, col: 5, offset: 26329},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 748, col: 5, offset: 21894},
+							pos:        position{line: 864, col: 5, offset: 26329},
 							val:        "grep",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 748, col: 12, offset: 21901},
+							pos:  position{line: 864, col: 12, offset: 26336},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 748, col: 15, offset: 21904},
+							pos:        position{line: 864, col: 15, offset: 26339},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 748, col: 19, offset: 21908},
+							pos:  position{line: 864, col: 19, offset: 26343},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 748, col: 22, offset: 21911},
+							pos:   position{line: 864, col: 22, offset: 26346},
 							label: "pattern",
 							expr: &ruleRefExpr{
-								pos:  position{line: 748, col: 30, offset: 21919},
+								pos:  position{line: 864, col: 30, offset: 26354},
 								name: "Pattern",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 748, col: 38, offset: 21927},
+							pos:  position{line: 864, col: 38, offset: 26362},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 748, col: 42, offset: 21931},
+							pos:   position{line: 864, col: 42, offset: 26366},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 748, col: 46, offset: 21935},
+								pos: position{line: 864, col: 46, offset: 26370},
 								expr: &seqExpr{
-									pos: position{line: 748, col: 47, offset: 21936},
+									pos: position{line: 864, col: 47, offset: 26371},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 748, col: 47, offset: 21936},
+											pos:        position{line: 864, col: 47, offset: 26371},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 748, col: 51, offset: 21940},
+											pos:  position{line: 864, col: 51, offset: 26375},
 											name: "__",
 										},
 										&choiceExpr{
-											pos: position{line: 748, col: 56, offset: 21945},
+											pos: position{line: 864, col: 56, offset: 26380},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 748, col: 56, offset: 21945},
+													pos:  position{line: 864, col: 56, offset: 26380},
 													name: "OverExpr",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 748, col: 67, offset: 21956},
+													pos:  position{line: 864, col: 67, offset: 26391},
 													name: "Expr",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 748, col: 73, offset: 21962},
+											pos:  position{line: 864, col: 73, offset: 26397},
 											name: "__",
 										},
 									},
@@ -5739,7 +6897,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 748, col: 78, offset: 21967},
+							pos:        position{line: 864, col: 78, offset: 26402},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -5749,26 +6907,26 @@ var g = &grammar{
 		},
 		{
 			name: "Pattern",
-			pos:  position{line: 756, col: 1, offset: 22208},
+			pos:  position{line: 872, col: 1, offset: 26643},
 			expr: &choiceExpr{
-				pos: position{line: 757, col: 5, offset: 22220},
+				pos: position{line: 873, col: 5, offset: 26655},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 757, col: 5, offset: 22220},
+						pos:  position{line: 873, col: 5, offset: 26655},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 758, col: 5, offset: 22231},
+						pos:  position{line: 874, col: 5, offset: 26666},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 759, col: 5, offset: 22240},
+						pos: position{line: 875, col: 5, offset: 26675},
 						run: (*parser).callonPattern4,
 						expr: &labeledExpr{
-							pos:   position{line: 759, col: 5, offset: 22240},
+							pos:   position{line: 875, col: 5, offset: 26675},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 759, col: 7, offset: 22242},
+								pos:  position{line: 875, col: 7, offset: 26677},
 								name: "QuotedString",
 							},
 						},
@@ -5778,19 +6936,19 @@ var g = &grammar{
 		},
 		{
 			name: "OptionalExprs",
-			pos:  position{line: 763, col: 1, offset: 22334},
+			pos:  position{line: 879, col: 1, offset: 26769},
 			expr: &choiceExpr{
-				pos: position{line: 764, col: 5, offset: 22352},
+				pos: position{line: 880, col: 5, offset: 26787},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 764, col: 5, offset: 22352},
+						pos:  position{line: 880, col: 5, offset: 26787},
 						name: "Exprs",
 					},
 					&actionExpr{
-						pos: position{line: 765, col: 5, offset: 22362},
+						pos: position{line: 881, col: 5, offset: 26797},
 						run: (*parser).callonOptionalExprs3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 765, col: 5, offset: 22362},
+							pos:  position{line: 881, col: 5, offset: 26797},
 							name: "__",
 						},
 					},
@@ -5799,50 +6957,50 @@ var g = &grammar{
 		},
 		{
 			name: "Exprs",
-			pos:  position{line: 767, col: 1, offset: 22398},
+			pos:  position{line: 883, col: 1, offset: 26833},
 			expr: &actionExpr{
-				pos: position{line: 768, col: 5, offset: 22408},
+				pos: position{line: 884, col: 5, offset: 26843},
 				run: (*parser).callonExprs1,
 				expr: &seqExpr{
-					pos: position{line: 768, col: 5, offset: 22408},
+					pos: position{line: 884, col: 5, offset: 26843},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 768, col: 5, offset: 22408},
+							pos:   position{line: 884, col: 5, offset: 26843},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 768, col: 11, offset: 22414},
+								pos:  position{line: 884, col: 11, offset: 26849},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 768, col: 16, offset: 22419},
+							pos:   position{line: 884, col: 16, offset: 26854},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 768, col: 21, offset: 22424},
+								pos: position{line: 884, col: 21, offset: 26859},
 								expr: &actionExpr{
-									pos: position{line: 768, col: 22, offset: 22425},
+									pos: position{line: 884, col: 22, offset: 26860},
 									run: (*parser).callonExprs7,
 									expr: &seqExpr{
-										pos: position{line: 768, col: 22, offset: 22425},
+										pos: position{line: 884, col: 22, offset: 26860},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 768, col: 22, offset: 22425},
+												pos:  position{line: 884, col: 22, offset: 26860},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 768, col: 25, offset: 22428},
+												pos:        position{line: 884, col: 25, offset: 26863},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 768, col: 29, offset: 22432},
+												pos:  position{line: 884, col: 29, offset: 26867},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 768, col: 32, offset: 22435},
+												pos:   position{line: 884, col: 32, offset: 26870},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 768, col: 34, offset: 22437},
+													pos:  position{line: 884, col: 34, offset: 26872},
 													name: "Expr",
 												},
 											},
@@ -5857,35 +7015,35 @@ var g = &grammar{
 		},
 		{
 			name: "DerefExpr",
-			pos:  position{line: 772, col: 1, offset: 22546},
+			pos:  position{line: 888, col: 1, offset: 26981},
 			expr: &actionExpr{
-				pos: position{line: 773, col: 5, offset: 22560},
+				pos: position{line: 889, col: 5, offset: 26995},
 				run: (*parser).callonDerefExpr1,
 				expr: &seqExpr{
-					pos: position{line: 773, col: 5, offset: 22560},
+					pos: position{line: 889, col: 5, offset: 26995},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 773, col: 5, offset: 22560},
+							pos: position{line: 889, col: 5, offset: 26995},
 							expr: &ruleRefExpr{
-								pos:  position{line: 773, col: 6, offset: 22561},
+								pos:  position{line: 889, col: 6, offset: 26996},
 								name: "IP6",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 773, col: 10, offset: 22565},
+							pos:   position{line: 889, col: 10, offset: 27000},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 773, col: 16, offset: 22571},
+								pos:  position{line: 889, col: 16, offset: 27006},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 773, col: 27, offset: 22582},
+							pos:   position{line: 889, col: 27, offset: 27017},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 773, col: 32, offset: 22587},
+								pos: position{line: 889, col: 32, offset: 27022},
 								expr: &ruleRefExpr{
-									pos:  position{line: 773, col: 33, offset: 22588},
+									pos:  position{line: 889, col: 33, offset: 27023},
 									name: "Deref",
 								},
 							},
@@ -5896,55 +7054,55 @@ var g = &grammar{
 		},
 		{
 			name: "Deref",
-			pos:  position{line: 777, col: 1, offset: 22656},
+			pos:  position{line: 893, col: 1, offset: 27091},
 			expr: &choiceExpr{
-				pos: position{line: 778, col: 5, offset: 22666},
+				pos: position{line: 894, col: 5, offset: 27101},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 778, col: 5, offset: 22666},
+						pos: position{line: 894, col: 5, offset: 27101},
 						run: (*parser).callonDeref2,
 						expr: &seqExpr{
-							pos: position{line: 778, col: 5, offset: 22666},
+							pos: position{line: 894, col: 5, offset: 27101},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 778, col: 5, offset: 22666},
+									pos:        position{line: 894, col: 5, offset: 27101},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 778, col: 9, offset: 22670},
+									pos:   position{line: 894, col: 9, offset: 27105},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 778, col: 14, offset: 22675},
+										pos:  position{line: 894, col: 14, offset: 27110},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 778, col: 27, offset: 22688},
+									pos:  position{line: 894, col: 27, offset: 27123},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 778, col: 30, offset: 22691},
+									pos:        position{line: 894, col: 30, offset: 27126},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 778, col: 34, offset: 22695},
+									pos:  position{line: 894, col: 34, offset: 27130},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 778, col: 37, offset: 22698},
+									pos:   position{line: 894, col: 37, offset: 27133},
 									label: "to",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 778, col: 40, offset: 22701},
+										pos: position{line: 894, col: 40, offset: 27136},
 										expr: &ruleRefExpr{
-											pos:  position{line: 778, col: 40, offset: 22701},
+											pos:  position{line: 894, col: 40, offset: 27136},
 											name: "AdditiveExpr",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 778, col: 54, offset: 22715},
+									pos:        position{line: 894, col: 54, offset: 27150},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -5952,39 +7110,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 784, col: 5, offset: 22886},
+						pos: position{line: 900, col: 5, offset: 27321},
 						run: (*parser).callonDeref14,
 						expr: &seqExpr{
-							pos: position{line: 784, col: 5, offset: 22886},
+							pos: position{line: 900, col: 5, offset: 27321},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 784, col: 5, offset: 22886},
+									pos:        position{line: 900, col: 5, offset: 27321},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 784, col: 9, offset: 22890},
+									pos:  position{line: 900, col: 9, offset: 27325},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 784, col: 12, offset: 22893},
+									pos:        position{line: 900, col: 12, offset: 27328},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 784, col: 16, offset: 22897},
+									pos:  position{line: 900, col: 16, offset: 27332},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 784, col: 19, offset: 22900},
+									pos:   position{line: 900, col: 19, offset: 27335},
 									label: "to",
 									expr: &ruleRefExpr{
-										pos:  position{line: 784, col: 22, offset: 22903},
+										pos:  position{line: 900, col: 22, offset: 27338},
 										name: "AdditiveExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 784, col: 35, offset: 22916},
+									pos:        position{line: 900, col: 35, offset: 27351},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -5992,26 +7150,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 790, col: 5, offset: 23087},
+						pos: position{line: 906, col: 5, offset: 27522},
 						run: (*parser).callonDeref23,
 						expr: &seqExpr{
-							pos: position{line: 790, col: 5, offset: 23087},
+							pos: position{line: 906, col: 5, offset: 27522},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 790, col: 5, offset: 23087},
+									pos:        position{line: 906, col: 5, offset: 27522},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 790, col: 9, offset: 23091},
+									pos:   position{line: 906, col: 9, offset: 27526},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 790, col: 14, offset: 23096},
+										pos:  position{line: 906, col: 14, offset: 27531},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 790, col: 19, offset: 23101},
+									pos:        position{line: 906, col: 19, offset: 27536},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -6019,21 +7177,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 791, col: 5, offset: 23150},
+						pos: position{line: 907, col: 5, offset: 27585},
 						run: (*parser).callonDeref29,
 						expr: &seqExpr{
-							pos: position{line: 791, col: 5, offset: 23150},
+							pos: position{line: 907, col: 5, offset: 27585},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 791, col: 5, offset: 23150},
+									pos:        position{line: 907, col: 5, offset: 27585},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 791, col: 9, offset: 23154},
+									pos:   position{line: 907, col: 9, offset: 27589},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 791, col: 12, offset: 23157},
+										pos:  position{line: 907, col: 12, offset: 27592},
 										name: "Identifier",
 									},
 								},
@@ -6045,59 +7203,59 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 793, col: 1, offset: 23208},
+			pos:  position{line: 909, col: 1, offset: 27643},
 			expr: &choiceExpr{
-				pos: position{line: 794, col: 5, offset: 23220},
+				pos: position{line: 910, col: 5, offset: 27655},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 794, col: 5, offset: 23220},
+						pos:  position{line: 910, col: 5, offset: 27655},
 						name: "Record",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 795, col: 5, offset: 23231},
+						pos:  position{line: 911, col: 5, offset: 27666},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 796, col: 5, offset: 23241},
+						pos:  position{line: 912, col: 5, offset: 27676},
 						name: "Set",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 797, col: 5, offset: 23249},
+						pos:  position{line: 913, col: 5, offset: 27684},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 798, col: 5, offset: 23257},
+						pos:  position{line: 914, col: 5, offset: 27692},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 799, col: 5, offset: 23269},
+						pos: position{line: 915, col: 5, offset: 27704},
 						run: (*parser).callonPrimary7,
 						expr: &seqExpr{
-							pos: position{line: 799, col: 5, offset: 23269},
+							pos: position{line: 915, col: 5, offset: 27704},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 799, col: 5, offset: 23269},
+									pos:        position{line: 915, col: 5, offset: 27704},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 799, col: 9, offset: 23273},
+									pos:  position{line: 915, col: 9, offset: 27708},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 799, col: 12, offset: 23276},
+									pos:   position{line: 915, col: 12, offset: 27711},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 799, col: 17, offset: 23281},
+										pos:  position{line: 915, col: 17, offset: 27716},
 										name: "OverExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 799, col: 26, offset: 23290},
+									pos:  position{line: 915, col: 26, offset: 27725},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 799, col: 29, offset: 23293},
+									pos:        position{line: 915, col: 29, offset: 27728},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -6105,34 +7263,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 800, col: 5, offset: 23323},
+						pos: position{line: 916, col: 5, offset: 27758},
 						run: (*parser).callonPrimary15,
 						expr: &seqExpr{
-							pos: position{line: 800, col: 5, offset: 23323},
+							pos: position{line: 916, col: 5, offset: 27758},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 800, col: 5, offset: 23323},
+									pos:        position{line: 916, col: 5, offset: 27758},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 800, col: 9, offset: 23327},
+									pos:  position{line: 916, col: 9, offset: 27762},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 800, col: 12, offset: 23330},
+									pos:   position{line: 916, col: 12, offset: 27765},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 800, col: 17, offset: 23335},
+										pos:  position{line: 916, col: 17, offset: 27770},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 800, col: 22, offset: 23340},
+									pos:  position{line: 916, col: 22, offset: 27775},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 800, col: 25, offset: 23343},
+									pos:        position{line: 916, col: 25, offset: 27778},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -6144,59 +7302,59 @@ var g = &grammar{
 		},
 		{
 			name: "OverExpr",
-			pos:  position{line: 802, col: 1, offset: 23369},
+			pos:  position{line: 918, col: 1, offset: 27804},
 			expr: &actionExpr{
-				pos: position{line: 803, col: 5, offset: 23382},
+				pos: position{line: 919, col: 5, offset: 27817},
 				run: (*parser).callonOverExpr1,
 				expr: &seqExpr{
-					pos: position{line: 803, col: 5, offset: 23382},
+					pos: position{line: 919, col: 5, offset: 27817},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 803, col: 5, offset: 23382},
+							pos:        position{line: 919, col: 5, offset: 27817},
 							val:        "over",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 12, offset: 23389},
+							pos:  position{line: 919, col: 12, offset: 27824},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 803, col: 14, offset: 23391},
+							pos:   position{line: 919, col: 14, offset: 27826},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 803, col: 20, offset: 23397},
+								pos:  position{line: 919, col: 20, offset: 27832},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 803, col: 26, offset: 23403},
+							pos:   position{line: 919, col: 26, offset: 27838},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 803, col: 33, offset: 23410},
+								pos: position{line: 919, col: 33, offset: 27845},
 								expr: &ruleRefExpr{
-									pos:  position{line: 803, col: 33, offset: 23410},
+									pos:  position{line: 919, col: 33, offset: 27845},
 									name: "Locals",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 41, offset: 23418},
+							pos:  position{line: 919, col: 41, offset: 27853},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 803, col: 44, offset: 23421},
+							pos:        position{line: 919, col: 44, offset: 27856},
 							val:        "|",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 48, offset: 23425},
+							pos:  position{line: 919, col: 48, offset: 27860},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 803, col: 51, offset: 23428},
+							pos:   position{line: 919, col: 51, offset: 27863},
 							label: "scope",
 							expr: &ruleRefExpr{
-								pos:  position{line: 803, col: 57, offset: 23434},
+								pos:  position{line: 919, col: 57, offset: 27869},
 								name: "Sequential",
 							},
 						},
@@ -6206,36 +7364,36 @@ var g = &grammar{
 		},
 		{
 			name: "Record",
-			pos:  position{line: 807, col: 1, offset: 23565},
+			pos:  position{line: 923, col: 1, offset: 28000},
 			expr: &actionExpr{
-				pos: position{line: 808, col: 5, offset: 23576},
+				pos: position{line: 924, col: 5, offset: 28011},
 				run: (*parser).callonRecord1,
 				expr: &seqExpr{
-					pos: position{line: 808, col: 5, offset: 23576},
+					pos: position{line: 924, col: 5, offset: 28011},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 808, col: 5, offset: 23576},
+							pos:        position{line: 924, col: 5, offset: 28011},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 808, col: 9, offset: 23580},
+							pos:  position{line: 924, col: 9, offset: 28015},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 808, col: 12, offset: 23583},
+							pos:   position{line: 924, col: 12, offset: 28018},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 808, col: 18, offset: 23589},
+								pos:  position{line: 924, col: 18, offset: 28024},
 								name: "RecordElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 808, col: 30, offset: 23601},
+							pos:  position{line: 924, col: 30, offset: 28036},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 808, col: 33, offset: 23604},
+							pos:        position{line: 924, col: 33, offset: 28039},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -6245,31 +7403,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElems",
-			pos:  position{line: 812, col: 1, offset: 23694},
+			pos:  position{line: 928, col: 1, offset: 28129},
 			expr: &choiceExpr{
-				pos: position{line: 813, col: 5, offset: 23710},
+				pos: position{line: 929, col: 5, offset: 28145},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 813, col: 5, offset: 23710},
+						pos: position{line: 929, col: 5, offset: 28145},
 						run: (*parser).callonRecordElems2,
 						expr: &seqExpr{
-							pos: position{line: 813, col: 5, offset: 23710},
+							pos: position{line: 929, col: 5, offset: 28145},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 813, col: 5, offset: 23710},
+									pos:   position{line: 929, col: 5, offset: 28145},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 813, col: 11, offset: 23716},
+										pos:  position{line: 929, col: 11, offset: 28151},
 										name: "RecordElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 813, col: 22, offset: 23727},
+									pos:   position{line: 929, col: 22, offset: 28162},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 813, col: 27, offset: 23732},
+										pos: position{line: 929, col: 27, offset: 28167},
 										expr: &ruleRefExpr{
-											pos:  position{line: 813, col: 27, offset: 23732},
+											pos:  position{line: 929, col: 27, offset: 28167},
 											name: "RecordElemTail",
 										},
 									},
@@ -6278,10 +7436,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 816, col: 5, offset: 23831},
+						pos: position{line: 932, col: 5, offset: 28266},
 						run: (*parser).callonRecordElems9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 816, col: 5, offset: 23831},
+							pos:  position{line: 932, col: 5, offset: 28266},
 							name: "__",
 						},
 					},
@@ -6290,31 +7448,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElemTail",
-			pos:  position{line: 818, col: 1, offset: 23867},
+			pos:  position{line: 934, col: 1, offset: 28302},
 			expr: &actionExpr{
-				pos: position{line: 818, col: 18, offset: 23884},
+				pos: position{line: 934, col: 18, offset: 28319},
 				run: (*parser).callonRecordElemTail1,
 				expr: &seqExpr{
-					pos: position{line: 818, col: 18, offset: 23884},
+					pos: position{line: 934, col: 18, offset: 28319},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 818, col: 18, offset: 23884},
+							pos:  position{line: 934, col: 18, offset: 28319},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 818, col: 21, offset: 23887},
+							pos:        position{line: 934, col: 21, offset: 28322},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 818, col: 25, offset: 23891},
+							pos:  position{line: 934, col: 25, offset: 28326},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 818, col: 28, offset: 23894},
+							pos:   position{line: 934, col: 28, offset: 28329},
 							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 818, col: 33, offset: 23899},
+								pos:  position{line: 934, col: 33, offset: 28334},
 								name: "RecordElem",
 							},
 						},
@@ -6324,20 +7482,20 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElem",
-			pos:  position{line: 820, col: 1, offset: 23932},
+			pos:  position{line: 936, col: 1, offset: 28367},
 			expr: &choiceExpr{
-				pos: position{line: 821, col: 5, offset: 23947},
+				pos: position{line: 937, col: 5, offset: 28382},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 821, col: 5, offset: 23947},
+						pos:  position{line: 937, col: 5, offset: 28382},
 						name: "Spread",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 822, col: 5, offset: 23958},
+						pos:  position{line: 938, col: 5, offset: 28393},
 						name: "Field",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 823, col: 5, offset: 23968},
+						pos:  position{line: 939, col: 5, offset: 28403},
 						name: "Identifier",
 					},
 				},
@@ -6345,27 +7503,27 @@ var g = &grammar{
 		},
 		{
 			name: "Spread",
-			pos:  position{line: 825, col: 1, offset: 23980},
+			pos:  position{line: 941, col: 1, offset: 28415},
 			expr: &actionExpr{
-				pos: position{line: 826, col: 5, offset: 23991},
+				pos: position{line: 942, col: 5, offset: 28426},
 				run: (*parser).callonSpread1,
 				expr: &seqExpr{
-					pos: position{line: 826, col: 5, offset: 23991},
+					pos: position{line: 942, col: 5, offset: 28426},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 826, col: 5, offset: 23991},
+							pos:        position{line: 942, col: 5, offset: 28426},
 							val:        "...",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 826, col: 11, offset: 23997},
+							pos:  position{line: 942, col: 11, offset: 28432},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 826, col: 14, offset: 24000},
+							pos:   position{line: 942, col: 14, offset: 28435},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 826, col: 19, offset: 24005},
+								pos:  position{line: 942, col: 19, offset: 28440},
 								name: "Expr",
 							},
 						},
@@ -6375,39 +7533,39 @@ var g = &grammar{
 		},
 		{
 			name: "Field",
-			pos:  position{line: 830, col: 1, offset: 24091},
+			pos:  position{line: 946, col: 1, offset: 28526},
 			expr: &actionExpr{
-				pos: position{line: 831, col: 5, offset: 24101},
+				pos: position{line: 947, col: 5, offset: 28536},
 				run: (*parser).callonField1,
 				expr: &seqExpr{
-					pos: position{line: 831, col: 5, offset: 24101},
+					pos: position{line: 947, col: 5, offset: 28536},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 831, col: 5, offset: 24101},
+							pos:   position{line: 947, col: 5, offset: 28536},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 831, col: 10, offset: 24106},
+								pos:  position{line: 947, col: 10, offset: 28541},
 								name: "FieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 831, col: 20, offset: 24116},
+							pos:  position{line: 947, col: 20, offset: 28551},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 831, col: 23, offset: 24119},
+							pos:        position{line: 947, col: 23, offset: 28554},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 831, col: 27, offset: 24123},
+							pos:  position{line: 947, col: 27, offset: 28558},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 831, col: 30, offset: 24126},
+							pos:   position{line: 947, col: 30, offset: 28561},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 831, col: 36, offset: 24132},
+								pos:  position{line: 947, col: 36, offset: 28567},
 								name: "Expr",
 							},
 						},
@@ -6417,36 +7575,36 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 835, col: 1, offset: 24232},
+			pos:  position{line: 951, col: 1, offset: 28667},
 			expr: &actionExpr{
-				pos: position{line: 836, col: 5, offset: 24242},
+				pos: position{line: 952, col: 5, offset: 28677},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 836, col: 5, offset: 24242},
+					pos: position{line: 952, col: 5, offset: 28677},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 836, col: 5, offset: 24242},
+							pos:        position{line: 952, col: 5, offset: 28677},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 836, col: 9, offset: 24246},
+							pos:  position{line: 952, col: 9, offset: 28681},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 836, col: 12, offset: 24249},
+							pos:   position{line: 952, col: 12, offset: 28684},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 836, col: 18, offset: 24255},
+								pos:  position{line: 952, col: 18, offset: 28690},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 836, col: 30, offset: 24267},
+							pos:  position{line: 952, col: 30, offset: 28702},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 836, col: 33, offset: 24270},
+							pos:        position{line: 952, col: 33, offset: 28705},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -6456,36 +7614,36 @@ var g = &grammar{
 		},
 		{
 			name: "Set",
-			pos:  position{line: 840, col: 1, offset: 24360},
+			pos:  position{line: 956, col: 1, offset: 28795},
 			expr: &actionExpr{
-				pos: position{line: 841, col: 5, offset: 24368},
+				pos: position{line: 957, col: 5, offset: 28803},
 				run: (*parser).callonSet1,
 				expr: &seqExpr{
-					pos: position{line: 841, col: 5, offset: 24368},
+					pos: position{line: 957, col: 5, offset: 28803},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 841, col: 5, offset: 24368},
+							pos:        position{line: 957, col: 5, offset: 28803},
 							val:        "|[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 841, col: 10, offset: 24373},
+							pos:  position{line: 957, col: 10, offset: 28808},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 841, col: 13, offset: 24376},
+							pos:   position{line: 957, col: 13, offset: 28811},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 841, col: 19, offset: 24382},
+								pos:  position{line: 957, col: 19, offset: 28817},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 841, col: 31, offset: 24394},
+							pos:  position{line: 957, col: 31, offset: 28829},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 841, col: 34, offset: 24397},
+							pos:        position{line: 957, col: 34, offset: 28832},
 							val:        "]|",
 							ignoreCase: false,
 						},
@@ -6495,53 +7653,53 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElems",
-			pos:  position{line: 845, col: 1, offset: 24486},
+			pos:  position{line: 961, col: 1, offset: 28921},
 			expr: &choiceExpr{
-				pos: position{line: 846, col: 5, offset: 24502},
+				pos: position{line: 962, col: 5, offset: 28937},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 846, col: 5, offset: 24502},
+						pos: position{line: 962, col: 5, offset: 28937},
 						run: (*parser).callonVectorElems2,
 						expr: &seqExpr{
-							pos: position{line: 846, col: 5, offset: 24502},
+							pos: position{line: 962, col: 5, offset: 28937},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 846, col: 5, offset: 24502},
+									pos:   position{line: 962, col: 5, offset: 28937},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 846, col: 11, offset: 24508},
+										pos:  position{line: 962, col: 11, offset: 28943},
 										name: "VectorElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 846, col: 22, offset: 24519},
+									pos:   position{line: 962, col: 22, offset: 28954},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 846, col: 27, offset: 24524},
+										pos: position{line: 962, col: 27, offset: 28959},
 										expr: &actionExpr{
-											pos: position{line: 846, col: 28, offset: 24525},
+											pos: position{line: 962, col: 28, offset: 28960},
 											run: (*parser).callonVectorElems8,
 											expr: &seqExpr{
-												pos: position{line: 846, col: 28, offset: 24525},
+												pos: position{line: 962, col: 28, offset: 28960},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 846, col: 28, offset: 24525},
+														pos:  position{line: 962, col: 28, offset: 28960},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 846, col: 31, offset: 24528},
+														pos:        position{line: 962, col: 31, offset: 28963},
 														val:        ",",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 846, col: 35, offset: 24532},
+														pos:  position{line: 962, col: 35, offset: 28967},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 846, col: 38, offset: 24535},
+														pos:   position{line: 962, col: 38, offset: 28970},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 846, col: 40, offset: 24537},
+															pos:  position{line: 962, col: 40, offset: 28972},
 															name: "VectorElem",
 														},
 													},
@@ -6554,10 +7712,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 849, col: 5, offset: 24655},
+						pos: position{line: 965, col: 5, offset: 29090},
 						run: (*parser).callonVectorElems15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 849, col: 5, offset: 24655},
+							pos:  position{line: 965, col: 5, offset: 29090},
 							name: "__",
 						},
 					},
@@ -6566,22 +7724,22 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElem",
-			pos:  position{line: 851, col: 1, offset: 24691},
+			pos:  position{line: 967, col: 1, offset: 29126},
 			expr: &choiceExpr{
-				pos: position{line: 852, col: 5, offset: 24706},
+				pos: position{line: 968, col: 5, offset: 29141},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 852, col: 5, offset: 24706},
+						pos:  position{line: 968, col: 5, offset: 29141},
 						name: "Spread",
 					},
 					&actionExpr{
-						pos: position{line: 853, col: 5, offset: 24717},
+						pos: position{line: 969, col: 5, offset: 29152},
 						run: (*parser).callonVectorElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 853, col: 5, offset: 24717},
+							pos:   position{line: 969, col: 5, offset: 29152},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 853, col: 7, offset: 24719},
+								pos:  position{line: 969, col: 7, offset: 29154},
 								name: "Expr",
 							},
 						},
@@ -6591,36 +7749,36 @@ var g = &grammar{
 		},
 		{
 			name: "Map",
-			pos:  position{line: 855, col: 1, offset: 24795},
+			pos:  position{line: 971, col: 1, offset: 29230},
 			expr: &actionExpr{
-				pos: position{line: 856, col: 5, offset: 24803},
+				pos: position{line: 972, col: 5, offset: 29238},
 				run: (*parser).callonMap1,
 				expr: &seqExpr{
-					pos: position{line: 856, col: 5, offset: 24803},
+					pos: position{line: 972, col: 5, offset: 29238},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 856, col: 5, offset: 24803},
+							pos:        position{line: 972, col: 5, offset: 29238},
 							val:        "|{",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 856, col: 10, offset: 24808},
+							pos:  position{line: 972, col: 10, offset: 29243},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 856, col: 13, offset: 24811},
+							pos:   position{line: 972, col: 13, offset: 29246},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 856, col: 19, offset: 24817},
+								pos:  position{line: 972, col: 19, offset: 29252},
 								name: "Entries",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 856, col: 27, offset: 24825},
+							pos:  position{line: 972, col: 27, offset: 29260},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 856, col: 30, offset: 24828},
+							pos:        position{line: 972, col: 30, offset: 29263},
 							val:        "}|",
 							ignoreCase: false,
 						},
@@ -6630,31 +7788,31 @@ var g = &grammar{
 		},
 		{
 			name: "Entries",
-			pos:  position{line: 860, col: 1, offset: 24919},
+			pos:  position{line: 976, col: 1, offset: 29354},
 			expr: &choiceExpr{
-				pos: position{line: 861, col: 5, offset: 24931},
+				pos: position{line: 977, col: 5, offset: 29366},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 861, col: 5, offset: 24931},
+						pos: position{line: 977, col: 5, offset: 29366},
 						run: (*parser).callonEntries2,
 						expr: &seqExpr{
-							pos: position{line: 861, col: 5, offset: 24931},
+							pos: position{line: 977, col: 5, offset: 29366},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 861, col: 5, offset: 24931},
+									pos:   position{line: 977, col: 5, offset: 29366},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 861, col: 11, offset: 24937},
+										pos:  position{line: 977, col: 11, offset: 29372},
 										name: "Entry",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 861, col: 17, offset: 24943},
+									pos:   position{line: 977, col: 17, offset: 29378},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 861, col: 22, offset: 24948},
+										pos: position{line: 977, col: 22, offset: 29383},
 										expr: &ruleRefExpr{
-											pos:  position{line: 861, col: 22, offset: 24948},
+											pos:  position{line: 977, col: 22, offset: 29383},
 											name: "EntryTail",
 										},
 									},
@@ -6663,10 +7821,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 864, col: 5, offset: 25042},
+						pos: position{line: 980, col: 5, offset: 29477},
 						run: (*parser).callonEntries9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 864, col: 5, offset: 25042},
+							pos:  position{line: 980, col: 5, offset: 29477},
 							name: "__",
 						},
 					},
@@ -6675,31 +7833,31 @@ var g = &grammar{
 		},
 		{
 			name: "EntryTail",
-			pos:  position{line: 867, col: 1, offset: 25079},
+			pos:  position{line: 983, col: 1, offset: 29514},
 			expr: &actionExpr{
-				pos: position{line: 867, col: 13, offset: 25091},
+				pos: position{line: 983, col: 13, offset: 29526},
 				run: (*parser).callonEntryTail1,
 				expr: &seqExpr{
-					pos: position{line: 867, col: 13, offset: 25091},
+					pos: position{line: 983, col: 13, offset: 29526},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 867, col: 13, offset: 25091},
+							pos:  position{line: 983, col: 13, offset: 29526},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 867, col: 16, offset: 25094},
+							pos:        position{line: 983, col: 16, offset: 29529},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 867, col: 20, offset: 25098},
+							pos:  position{line: 983, col: 20, offset: 29533},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 867, col: 23, offset: 25101},
+							pos:   position{line: 983, col: 23, offset: 29536},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 867, col: 25, offset: 25103},
+								pos:  position{line: 983, col: 25, offset: 29538},
 								name: "Entry",
 							},
 						},
@@ -6709,39 +7867,39 @@ var g = &grammar{
 		},
 		{
 			name: "Entry",
-			pos:  position{line: 869, col: 1, offset: 25128},
+			pos:  position{line: 985, col: 1, offset: 29563},
 			expr: &actionExpr{
-				pos: position{line: 870, col: 5, offset: 25138},
+				pos: position{line: 986, col: 5, offset: 29573},
 				run: (*parser).callonEntry1,
 				expr: &seqExpr{
-					pos: position{line: 870, col: 5, offset: 25138},
+					pos: position{line: 986, col: 5, offset: 29573},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 870, col: 5, offset: 25138},
+							pos:   position{line: 986, col: 5, offset: 29573},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 870, col: 9, offset: 25142},
+								pos:  position{line: 986, col: 9, offset: 29577},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 870, col: 14, offset: 25147},
+							pos:  position{line: 986, col: 14, offset: 29582},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 870, col: 17, offset: 25150},
+							pos:        position{line: 986, col: 17, offset: 29585},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 870, col: 21, offset: 25154},
+							pos:  position{line: 986, col: 21, offset: 29589},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 870, col: 24, offset: 25157},
+							pos:   position{line: 986, col: 24, offset: 29592},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 870, col: 30, offset: 25163},
+								pos:  position{line: 986, col: 30, offset: 29598},
 								name: "Expr",
 							},
 						},
@@ -6751,147 +7909,261 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOp",
-			pos:  position{line: 876, col: 1, offset: 25270},
+			pos:  position{line: 992, col: 1, offset: 29705},
 			expr: &actionExpr{
-				pos: position{line: 877, col: 5, offset: 25280},
+				pos: position{line: 993, col: 5, offset: 29715},
 				run: (*parser).callonSQLOp1,
 				expr: &seqExpr{
-					pos: position{line: 877, col: 5, offset: 25280},
+					pos: position{line: 993, col: 5, offset: 29715},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 993, col: 5, offset: 29715},
+							label: "ctes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 993, col: 10, offset: 29720},
+								expr: &actionExpr{
+									pos: position{line: 993, col: 11, offset: 29721},
+									run: (*parser).callonSQLOp5,
+									expr: &seqExpr{
+										pos: position{line: 993, col: 11, offset: 29721},
+										exprs: []interface{}{
+											&labeledExpr{
+												pos:   position{line: 993, col: 11, offset: 29721},
+												label: "w",
+												expr: &ruleRefExpr{
+													pos:  position{line: 993, col: 13, offset: 29723},
+													name: "SQLWith",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 993, col: 21, offset: 29731},
+												name: "_",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 994, col: 5, offset: 29757},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 994, col: 11, offset: 29763},
+								name: "SQLSelectCore",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 995, col: 5, offset: 29781},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 995, col: 10, offset: 29786},
+								expr: &actionExpr{
+									pos: position{line: 995, col: 11, offset: 29787},
+									run: (*parser).callonSQLOp14,
+									expr: &seqExpr{
+										pos: position{line: 995, col: 11, offset: 29787},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 995, col: 11, offset: 29787},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 995, col: 13, offset: 29789},
+												name: "UNION",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 995, col: 19, offset: 29795},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 995, col: 21, offset: 29797},
+												name: "ALL",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 995, col: 25, offset: 29801},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 995, col: 27, offset: 29803},
+												label: "s",
+												expr: &ruleRefExpr{
+													pos:  position{line: 995, col: 29, offset: 29805},
+													name: "SQLSelectCore",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 996, col: 5, offset: 29843},
+							label: "orderby",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 996, col: 13, offset: 29851},
+								expr: &ruleRefExpr{
+									pos:  position{line: 996, col: 13, offset: 29851},
+									name: "SQLOrderBy",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 997, col: 5, offset: 29867},
+							label: "limit",
+							expr: &ruleRefExpr{
+								pos:  position{line: 997, col: 11, offset: 29873},
+								name: "SQLLimit",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SQLSelectCore",
+			pos:  position{line: 1009, col: 1, offset: 30115},
+			expr: &actionExpr{
+				pos: position{line: 1010, col: 5, offset: 30133},
+				run: (*parser).callonSQLSelectCore1,
+				expr: &seqExpr{
+					pos: position{line: 1010, col: 5, offset: 30133},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 877, col: 5, offset: 25280},
+							pos:   position{line: 1010, col: 5, offset: 30133},
 							label: "selection",
 							expr: &ruleRefExpr{
-								pos:  position{line: 877, col: 15, offset: 25290},
+								pos:  position{line: 1010, col: 15, offset: 30143},
 								name: "SQLSelect",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 878, col: 5, offset: 25304},
+							pos:   position{line: 1011, col: 5, offset: 30157},
 							label: "from",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 878, col: 10, offset: 25309},
+								pos: position{line: 1011, col: 10, offset: 30162},
 								expr: &ruleRefExpr{
-									pos:  position{line: 878, col: 10, offset: 25309},
+									pos:  position{line: 1011, col: 10, offset: 30162},
 									name: "SQLFrom",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 879, col: 5, offset: 25322},
+							pos:   position{line: 1012, col: 5, offset: 30175},
 							label: "joins",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 879, col: 11, offset: 25328},
+								pos: position{line: 1012, col: 11, offset: 30181},
 								expr: &ruleRefExpr{
-									pos:  position{line: 879, col: 11, offset: 25328},
+									pos:  position{line: 1012, col: 11, offset: 30181},
 									name: "SQLJoins",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 880, col: 5, offset: 25342},
+							pos:   position{line: 1013, col: 5, offset: 30195},
 							label: "where",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 880, col: 11, offset: 25348},
+								pos: position{line: 1013, col: 11, offset: 30201},
 								expr: &ruleRefExpr{
-									pos:  position{line: 880, col: 11, offset: 25348},
+									pos:  position{line: 1013, col: 11, offset: 30201},
 									name: "SQLWhere",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 881, col: 5, offset: 25362},
+							pos:   position{line: 1014, col: 5, offset: 30215},
 							label: "groupby",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 881, col: 13, offset: 25370},
+								pos: position{line: 1014, col: 13, offset: 30223},
 								expr: &ruleRefExpr{
-									pos:  position{line: 881, col: 13, offset: 25370},
+									pos:  position{line: 1014, col: 13, offset: 30223},
 									name: "SQLGroupBy",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 882, col: 5, offset: 25386},
+							pos:   position{line: 1015, col: 5, offset: 30239},
 							label: "having",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 882, col: 12, offset: 25393},
+								pos: position{line: 1015, col: 12, offset: 30246},
 								expr: &ruleRefExpr{
-									pos:  position{line: 882, col: 12, offset: 25393},
+									pos:  position{line: 1015, col: 12, offset: 30246},
 									name: "SQLHaving",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 883, col: 5, offset: 25408},
-							label: "orderby",
+							pos:   position{line: 1016, col: 5, offset: 30261},
+							label: "windows",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 883, col: 13, offset: 25416},
+								pos: position{line: 1016, col: 13, offset: 30269},
 								expr: &ruleRefExpr{
-									pos:  position{line: 883, col: 13, offset: 25416},
-									name: "SQLOrderBy",
+									pos:  position{line: 1016, col: 13, offset: 30269},
+									name: "SQLWindows",
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 884, col: 5, offset: 25432},
-							label: "limit",
-							expr: &ruleRefExpr{
-								pos:  position{line: 884, col: 11, offset: 25438},
-								name: "SQLLimit",
-							},
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "SQLSelect",
-			pos:  position{line: 908, col: 1, offset: 25805},
-			expr: &choiceExpr{
-				pos: position{line: 909, col: 5, offset: 25819},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 909, col: 5, offset: 25819},
-						run: (*parser).callonSQLSelect2,
-						expr: &seqExpr{
-							pos: position{line: 909, col: 5, offset: 25819},
-							exprs: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 909, col: 5, offset: 25819},
-									name: "SELECT",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 909, col: 12, offset: 25826},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 909, col: 14, offset: 25828},
-									val:        "*",
-									ignoreCase: false,
-								},
+			name: "SQLWith",
+			pos:  position{line: 1046, col: 1, offset: 30723},
+			expr: &actionExpr{
+				pos: position{line: 1047, col: 5, offset: 30735},
+				run: (*parser).callonSQLWith1,
+				expr: &seqExpr{
+					pos: position{line: 1047, col: 5, offset: 30735},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1047, col: 5, offset: 30735},
+							name: "WITH",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1047, col: 10, offset: 30740},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 1047, col: 12, offset: 30742},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1047, col: 18, offset: 30748},
+								name: "SQLCTE",
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 910, col: 5, offset: 25856},
-						run: (*parser).callonSQLSelect7,
-						expr: &seqExpr{
-							pos: position{line: 910, col: 5, offset: 25856},
-							exprs: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 910, col: 5, offset: 25856},
-									name: "SELECT",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 910, col: 12, offset: 25863},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 910, col: 14, offset: 25865},
-									label: "assignments",
-									expr: &ruleRefExpr{
-										pos:  position{line: 910, col: 26, offset: 25877},
-										name: "SQLAssignments",
+						&labeledExpr{
+							pos:   position{line: 1047, col: 25, offset: 30755},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1047, col: 30, offset: 30760},
+								expr: &actionExpr{
+									pos: position{line: 1047, col: 31, offset: 30761},
+									run: (*parser).callonSQLWith9,
+									expr: &seqExpr{
+										pos: position{line: 1047, col: 31, offset: 30761},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1047, col: 31, offset: 30761},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 1047, col: 34, offset: 30764},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1047, col: 38, offset: 30768},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 1047, col: 41, offset: 30771},
+												label: "cte",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1047, col: 45, offset: 30775},
+													name: "SQLCTE",
+												},
+											},
+										},
 									},
 								},
 							},
@@ -6901,44 +8173,159 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SQLAssignment",
-			pos:  position{line: 912, col: 1, offset: 25921},
+			name: "SQLCTE",
+			pos:  position{line: 1051, col: 1, offset: 30888},
 			expr: &actionExpr{
-				pos: position{line: 913, col: 5, offset: 25939},
-				run: (*parser).callonSQLAssignment1,
+				pos: position{line: 1052, col: 5, offset: 30899},
+				run: (*parser).callonSQLCTE1,
 				expr: &seqExpr{
-					pos: position{line: 913, col: 5, offset: 25939},
+					pos: position{line: 1052, col: 5, offset: 30899},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 913, col: 5, offset: 25939},
-							label: "rhs",
+							pos:   position{line: 1052, col: 5, offset: 30899},
+							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 913, col: 9, offset: 25943},
+								pos:  position{line: 1052, col: 10, offset: 30904},
+								name: "IdentifierName",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 25, offset: 30919},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 27, offset: 30921},
+							name: "AS",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 30, offset: 30924},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 1052, col: 33, offset: 30927},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 37, offset: 30931},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 1052, col: 40, offset: 30934},
+							label: "query",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1052, col: 46, offset: 30940},
+								name: "SQLOp",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 52, offset: 30946},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 1052, col: 55, offset: 30949},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SQLSelect",
+			pos:  position{line: 1056, col: 1, offset: 31033},
+			expr: &choiceExpr{
+				pos: position{line: 1057, col: 5, offset: 31047},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1057, col: 5, offset: 31047},
+						run: (*parser).callonSQLSelect2,
+						expr: &seqExpr{
+							pos: position{line: 1057, col: 5, offset: 31047},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1057, col: 5, offset: 31047},
+									name: "SELECT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1057, col: 12, offset: 31054},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 1057, col: 14, offset: 31056},
+									val:        "*",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1058, col: 5, offset: 31084},
+						run: (*parser).callonSQLSelect7,
+						expr: &seqExpr{
+							pos: position{line: 1058, col: 5, offset: 31084},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1058, col: 5, offset: 31084},
+									name: "SELECT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1058, col: 12, offset: 31091},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1058, col: 14, offset: 31093},
+									label: "assignments",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1058, col: 26, offset: 31105},
+										name: "SQLAssignments",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SQLAssignment",
+			pos:  position{line: 1060, col: 1, offset: 31149},
+			expr: &actionExpr{
+				pos: position{line: 1061, col: 5, offset: 31167},
+				run: (*parser).callonSQLAssignment1,
+				expr: &seqExpr{
+					pos: position{line: 1061, col: 5, offset: 31167},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1061, col: 5, offset: 31167},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1061, col: 9, offset: 31171},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 913, col: 14, offset: 25948},
+							pos:   position{line: 1061, col: 14, offset: 31176},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 913, col: 18, offset: 25952},
+								pos: position{line: 1061, col: 18, offset: 31180},
 								expr: &seqExpr{
-									pos: position{line: 913, col: 19, offset: 25953},
+									pos: position{line: 1061, col: 19, offset: 31181},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 913, col: 19, offset: 25953},
+											pos:  position{line: 1061, col: 19, offset: 31181},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 913, col: 21, offset: 25955},
+											pos:  position{line: 1061, col: 21, offset: 31183},
 											name: "AS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 913, col: 24, offset: 25958},
+											pos:  position{line: 1061, col: 24, offset: 31186},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 913, col: 26, offset: 25960},
+											pos:  position{line: 1061, col: 26, offset: 31188},
 											name: "Lval",
 										},
 									},
@@ -6951,50 +8338,50 @@ var g = &grammar{
 		},
 		{
 			name: "SQLAssignments",
-			pos:  position{line: 921, col: 1, offset: 26151},
+			pos:  position{line: 1069, col: 1, offset: 31379},
 			expr: &actionExpr{
-				pos: position{line: 922, col: 5, offset: 26170},
+				pos: position{line: 1070, col: 5, offset: 31398},
 				run: (*parser).callonSQLAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 922, col: 5, offset: 26170},
+					pos: position{line: 1070, col: 5, offset: 31398},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 922, col: 5, offset: 26170},
+							pos:   position{line: 1070, col: 5, offset: 31398},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 922, col: 11, offset: 26176},
+								pos:  position{line: 1070, col: 11, offset: 31404},
 								name: "SQLAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 922, col: 25, offset: 26190},
+							pos:   position{line: 1070, col: 25, offset: 31418},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 922, col: 30, offset: 26195},
+								pos: position{line: 1070, col: 30, offset: 31423},
 								expr: &actionExpr{
-									pos: position{line: 922, col: 31, offset: 26196},
+									pos: position{line: 1070, col: 31, offset: 31424},
 									run: (*parser).callonSQLAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 922, col: 31, offset: 26196},
+										pos: position{line: 1070, col: 31, offset: 31424},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 922, col: 31, offset: 26196},
+												pos:  position{line: 1070, col: 31, offset: 31424},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 922, col: 34, offset: 26199},
+												pos:        position{line: 1070, col: 34, offset: 31427},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 922, col: 38, offset: 26203},
+												pos:  position{line: 1070, col: 38, offset: 31431},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 922, col: 41, offset: 26206},
+												pos:   position{line: 1070, col: 41, offset: 31434},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 922, col: 46, offset: 26211},
+													pos:  position{line: 1070, col: 46, offset: 31439},
 													name: "SQLAssignment",
 												},
 											},
@@ -7009,43 +8396,43 @@ var g = &grammar{
 		},
 		{
 			name: "SQLFrom",
-			pos:  position{line: 926, col: 1, offset: 26332},
+			pos:  position{line: 1074, col: 1, offset: 31560},
 			expr: &choiceExpr{
-				pos: position{line: 927, col: 5, offset: 26344},
+				pos: position{line: 1075, col: 5, offset: 31572},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 927, col: 5, offset: 26344},
+						pos: position{line: 1075, col: 5, offset: 31572},
 						run: (*parser).callonSQLFrom2,
 						expr: &seqExpr{
-							pos: position{line: 927, col: 5, offset: 26344},
+							pos: position{line: 1075, col: 5, offset: 31572},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 927, col: 5, offset: 26344},
+									pos:  position{line: 1075, col: 5, offset: 31572},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 927, col: 7, offset: 26346},
+									pos:  position{line: 1075, col: 7, offset: 31574},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 927, col: 12, offset: 26351},
+									pos:  position{line: 1075, col: 12, offset: 31579},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 927, col: 14, offset: 26353},
+									pos:   position{line: 1075, col: 14, offset: 31581},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 927, col: 20, offset: 26359},
+										pos:  position{line: 1075, col: 20, offset: 31587},
 										name: "SQLTable",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 927, col: 29, offset: 26368},
+									pos:   position{line: 1075, col: 29, offset: 31596},
 									label: "alias",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 927, col: 35, offset: 26374},
+										pos: position{line: 1075, col: 35, offset: 31602},
 										expr: &ruleRefExpr{
-											pos:  position{line: 927, col: 35, offset: 26374},
+											pos:  position{line: 1075, col: 35, offset: 31602},
 											name: "SQLAlias",
 										},
 									},
@@ -7054,25 +8441,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 930, col: 5, offset: 26469},
+						pos: position{line: 1078, col: 5, offset: 31697},
 						run: (*parser).callonSQLFrom12,
 						expr: &seqExpr{
-							pos: position{line: 930, col: 5, offset: 26469},
+							pos: position{line: 1078, col: 5, offset: 31697},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 930, col: 5, offset: 26469},
+									pos:  position{line: 1078, col: 5, offset: 31697},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 930, col: 7, offset: 26471},
+									pos:  position{line: 1078, col: 7, offset: 31699},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 930, col: 12, offset: 26476},
+									pos:  position{line: 1078, col: 12, offset: 31704},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 930, col: 14, offset: 26478},
+									pos:        position{line: 1078, col: 14, offset: 31706},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -7084,33 +8471,33 @@ var g = &grammar{
 		},
 		{
 			name: "SQLAlias",
-			pos:  position{line: 932, col: 1, offset: 26503},
+			pos:  position{line: 1080, col: 1, offset: 31731},
 			expr: &choiceExpr{
-				pos: position{line: 933, col: 5, offset: 26516},
+				pos: position{line: 1081, col: 5, offset: 31744},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 933, col: 5, offset: 26516},
+						pos: position{line: 1081, col: 5, offset: 31744},
 						run: (*parser).callonSQLAlias2,
 						expr: &seqExpr{
-							pos: position{line: 933, col: 5, offset: 26516},
+							pos: position{line: 1081, col: 5, offset: 31744},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 933, col: 5, offset: 26516},
+									pos:  position{line: 1081, col: 5, offset: 31744},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 933, col: 7, offset: 26518},
+									pos:  position{line: 1081, col: 7, offset: 31746},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 933, col: 10, offset: 26521},
+									pos:  position{line: 1081, col: 10, offset: 31749},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 933, col: 12, offset: 26523},
+									pos:   position{line: 1081, col: 12, offset: 31751},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 933, col: 15, offset: 26526},
+										pos:  position{line: 1081, col: 15, offset: 31754},
 										name: "Lval",
 									},
 								},
@@ -7118,36 +8505,36 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 934, col: 5, offset: 26554},
+						pos: position{line: 1082, col: 5, offset: 31782},
 						run: (*parser).callonSQLAlias9,
 						expr: &seqExpr{
-							pos: position{line: 934, col: 5, offset: 26554},
+							pos: position{line: 1082, col: 5, offset: 31782},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 934, col: 5, offset: 26554},
+									pos:  position{line: 1082, col: 5, offset: 31782},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 934, col: 7, offset: 26556},
+									pos: position{line: 1082, col: 7, offset: 31784},
 									expr: &seqExpr{
-										pos: position{line: 934, col: 9, offset: 26558},
+										pos: position{line: 1082, col: 9, offset: 31786},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 934, col: 9, offset: 26558},
+												pos:  position{line: 1082, col: 9, offset: 31786},
 												name: "SQLTokenSentinels",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 934, col: 27, offset: 26576},
+												pos:  position{line: 1082, col: 27, offset: 31804},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 934, col: 30, offset: 26579},
+									pos:   position{line: 1082, col: 30, offset: 31807},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 934, col: 33, offset: 26582},
+										pos:  position{line: 1082, col: 33, offset: 31810},
 										name: "Lval",
 									},
 								},
@@ -7159,42 +8546,42 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTable",
-			pos:  position{line: 936, col: 1, offset: 26607},
+			pos:  position{line: 1084, col: 1, offset: 31835},
 			expr: &ruleRefExpr{
-				pos:  position{line: 937, col: 5, offset: 26620},
+				pos:  position{line: 1085, col: 5, offset: 31848},
 				name: "Expr",
 			},
 		},
 		{
 			name: "SQLJoins",
-			pos:  position{line: 939, col: 1, offset: 26626},
+			pos:  position{line: 1087, col: 1, offset: 31854},
 			expr: &actionExpr{
-				pos: position{line: 940, col: 5, offset: 26639},
+				pos: position{line: 1088, col: 5, offset: 31867},
 				run: (*parser).callonSQLJoins1,
 				expr: &seqExpr{
-					pos: position{line: 940, col: 5, offset: 26639},
+					pos: position{line: 1088, col: 5, offset: 31867},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 940, col: 5, offset: 26639},
+							pos:   position{line: 1088, col: 5, offset: 31867},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 940, col: 11, offset: 26645},
+								pos:  position{line: 1088, col: 11, offset: 31873},
 								name: "SQLJoin",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 940, col: 19, offset: 26653},
+							pos:   position{line: 1088, col: 19, offset: 31881},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 940, col: 24, offset: 26658},
+								pos: position{line: 1088, col: 24, offset: 31886},
 								expr: &actionExpr{
-									pos: position{line: 940, col: 25, offset: 26659},
+									pos: position{line: 1088, col: 25, offset: 31887},
 									run: (*parser).callonSQLJoins7,
 									expr: &labeledExpr{
-										pos:   position{line: 940, col: 25, offset: 26659},
+										pos:   position{line: 1088, col: 25, offset: 31887},
 										label: "join",
 										expr: &ruleRefExpr{
-											pos:  position{line: 940, col: 30, offset: 26664},
+											pos:  position{line: 1088, col: 30, offset: 31892},
 											name: "SQLJoin",
 										},
 									},
@@ -7207,90 +8594,90 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoin",
-			pos:  position{line: 944, col: 1, offset: 26779},
+			pos:  position{line: 1092, col: 1, offset: 32007},
 			expr: &actionExpr{
-				pos: position{line: 945, col: 5, offset: 26791},
+				pos: position{line: 1093, col: 5, offset: 32019},
 				run: (*parser).callonSQLJoin1,
 				expr: &seqExpr{
-					pos: position{line: 945, col: 5, offset: 26791},
+					pos: position{line: 1093, col: 5, offset: 32019},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 945, col: 5, offset: 26791},
+							pos:   position{line: 1093, col: 5, offset: 32019},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 945, col: 11, offset: 26797},
+								pos:  position{line: 1093, col: 11, offset: 32025},
 								name: "SQLJoinStyle",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 24, offset: 26810},
+							pos:  position{line: 1093, col: 24, offset: 32038},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 26, offset: 26812},
+							pos:  position{line: 1093, col: 26, offset: 32040},
 							name: "JOIN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 31, offset: 26817},
+							pos:  position{line: 1093, col: 31, offset: 32045},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 945, col: 33, offset: 26819},
+							pos:   position{line: 1093, col: 33, offset: 32047},
 							label: "table",
 							expr: &ruleRefExpr{
-								pos:  position{line: 945, col: 39, offset: 26825},
+								pos:  position{line: 1093, col: 39, offset: 32053},
 								name: "SQLTable",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 945, col: 48, offset: 26834},
+							pos:   position{line: 1093, col: 48, offset: 32062},
 							label: "alias",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 945, col: 54, offset: 26840},
+								pos: position{line: 1093, col: 54, offset: 32068},
 								expr: &ruleRefExpr{
-									pos:  position{line: 945, col: 54, offset: 26840},
+									pos:  position{line: 1093, col: 54, offset: 32068},
 									name: "SQLAlias",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 64, offset: 26850},
+							pos:  position{line: 1093, col: 64, offset: 32078},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 66, offset: 26852},
+							pos:  position{line: 1093, col: 66, offset: 32080},
 							name: "ON",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 69, offset: 26855},
+							pos:  position{line: 1093, col: 69, offset: 32083},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 945, col: 71, offset: 26857},
+							pos:   position{line: 1093, col: 71, offset: 32085},
 							label: "leftKey",
 							expr: &ruleRefExpr{
-								pos:  position{line: 945, col: 79, offset: 26865},
+								pos:  position{line: 1093, col: 79, offset: 32093},
 								name: "JoinKey",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 87, offset: 26873},
+							pos:  position{line: 1093, col: 87, offset: 32101},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 945, col: 90, offset: 26876},
+							pos:        position{line: 1093, col: 90, offset: 32104},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 945, col: 94, offset: 26880},
+							pos:  position{line: 1093, col: 94, offset: 32108},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 945, col: 97, offset: 26883},
+							pos:   position{line: 1093, col: 97, offset: 32111},
 							label: "rightKey",
 							expr: &ruleRefExpr{
-								pos:  position{line: 945, col: 106, offset: 26892},
+								pos:  position{line: 1093, col: 106, offset: 32120},
 								name: "JoinKey",
 							},
 						},
@@ -7300,40 +8687,40 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoinStyle",
-			pos:  position{line: 960, col: 1, offset: 27123},
+			pos:  position{line: 1108, col: 1, offset: 32351},
 			expr: &choiceExpr{
-				pos: position{line: 961, col: 5, offset: 27140},
+				pos: position{line: 1109, col: 5, offset: 32368},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 961, col: 5, offset: 27140},
+						pos: position{line: 1109, col: 5, offset: 32368},
 						run: (*parser).callonSQLJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 961, col: 5, offset: 27140},
+							pos: position{line: 1109, col: 5, offset: 32368},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 961, col: 5, offset: 27140},
+									pos:  position{line: 1109, col: 5, offset: 32368},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 961, col: 7, offset: 27142},
+									pos:   position{line: 1109, col: 7, offset: 32370},
 									label: "style",
 									expr: &choiceExpr{
-										pos: position{line: 961, col: 14, offset: 27149},
+										pos: position{line: 1109, col: 14, offset: 32377},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 961, col: 14, offset: 27149},
+												pos:  position{line: 1109, col: 14, offset: 32377},
 												name: "ANTI",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 961, col: 21, offset: 27156},
+												pos:  position{line: 1109, col: 21, offset: 32384},
 												name: "INNER",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 961, col: 29, offset: 27164},
+												pos:  position{line: 1109, col: 29, offset: 32392},
 												name: "LEFT",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 961, col: 36, offset: 27171},
+												pos:  position{line: 1109, col: 36, offset: 32399},
 												name: "RIGHT",
 											},
 										},
@@ -7343,10 +8730,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 962, col: 5, offset: 27204},
+						pos: position{line: 1110, col: 5, offset: 32432},
 						run: (*parser).callonSQLJoinStyle11,
 						expr: &litMatcher{
-							pos:        position{line: 962, col: 5, offset: 27204},
+							pos:        position{line: 1110, col: 5, offset: 32432},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -7356,30 +8743,30 @@ var g = &grammar{
 		},
 		{
 			name: "SQLWhere",
-			pos:  position{line: 964, col: 1, offset: 27232},
+			pos:  position{line: 1112, col: 1, offset: 32460},
 			expr: &actionExpr{
-				pos: position{line: 965, col: 5, offset: 27245},
+				pos: position{line: 1113, col: 5, offset: 32473},
 				run: (*parser).callonSQLWhere1,
 				expr: &seqExpr{
-					pos: position{line: 965, col: 5, offset: 27245},
+					pos: position{line: 1113, col: 5, offset: 32473},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 965, col: 5, offset: 27245},
+							pos:  position{line: 1113, col: 5, offset: 32473},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 965, col: 7, offset: 27247},
+							pos:  position{line: 1113, col: 7, offset: 32475},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 965, col: 13, offset: 27253},
+							pos:  position{line: 1113, col: 13, offset: 32481},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 965, col: 15, offset: 27255},
+							pos:   position{line: 1113, col: 15, offset: 32483},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 965, col: 20, offset: 27260},
+								pos:  position{line: 1113, col: 20, offset: 32488},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -7389,38 +8776,38 @@ var g = &grammar{
 		},
 		{
 			name: "SQLGroupBy",
-			pos:  position{line: 967, col: 1, offset: 27296},
+			pos:  position{line: 1115, col: 1, offset: 32524},
 			expr: &actionExpr{
-				pos: position{line: 968, col: 5, offset: 27311},
+				pos: position{line: 1116, col: 5, offset: 32539},
 				run: (*parser).callonSQLGroupBy1,
 				expr: &seqExpr{
-					pos: position{line: 968, col: 5, offset: 27311},
+					pos: position{line: 1116, col: 5, offset: 32539},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 968, col: 5, offset: 27311},
+							pos:  position{line: 1116, col: 5, offset: 32539},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 968, col: 7, offset: 27313},
+							pos:  position{line: 1116, col: 7, offset: 32541},
 							name: "GROUP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 968, col: 13, offset: 27319},
+							pos:  position{line: 1116, col: 13, offset: 32547},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 968, col: 15, offset: 27321},
+							pos:  position{line: 1116, col: 15, offset: 32549},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 968, col: 18, offset: 27324},
+							pos:  position{line: 1116, col: 18, offset: 32552},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 968, col: 20, offset: 27326},
+							pos:   position{line: 1116, col: 20, offset: 32554},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 968, col: 28, offset: 27334},
+								pos:  position{line: 1116, col: 28, offset: 32562},
 								name: "FieldExprs",
 							},
 						},
@@ -7430,30 +8817,30 @@ var g = &grammar{
 		},
 		{
 			name: "SQLHaving",
-			pos:  position{line: 970, col: 1, offset: 27370},
+			pos:  position{line: 1118, col: 1, offset: 32598},
 			expr: &actionExpr{
-				pos: position{line: 971, col: 5, offset: 27384},
+				pos: position{line: 1119, col: 5, offset: 32612},
 				run: (*parser).callonSQLHaving1,
 				expr: &seqExpr{
-					pos: position{line: 971, col: 5, offset: 27384},
+					pos: position{line: 1119, col: 5, offset: 32612},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 971, col: 5, offset: 27384},
+							pos:  position{line: 1119, col: 5, offset: 32612},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 971, col: 7, offset: 27386},
+							pos:  position{line: 1119, col: 7, offset: 32614},
 							name: "HAVING",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 971, col: 14, offset: 27393},
+							pos:  position{line: 1119, col: 14, offset: 32621},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 971, col: 16, offset: 27395},
+							pos:   position{line: 1119, col: 16, offset: 32623},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 971, col: 21, offset: 27400},
+								pos:  position{line: 1119, col: 21, offset: 32628},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -7463,46 +8850,46 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOrderBy",
-			pos:  position{line: 973, col: 1, offset: 27436},
+			pos:  position{line: 1121, col: 1, offset: 32664},
 			expr: &actionExpr{
-				pos: position{line: 974, col: 5, offset: 27451},
+				pos: position{line: 1122, col: 5, offset: 32679},
 				run: (*parser).callonSQLOrderBy1,
 				expr: &seqExpr{
-					pos: position{line: 974, col: 5, offset: 27451},
+					pos: position{line: 1122, col: 5, offset: 32679},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 974, col: 5, offset: 27451},
+							pos:  position{line: 1122, col: 5, offset: 32679},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 974, col: 7, offset: 27453},
+							pos:  position{line: 1122, col: 7, offset: 32681},
 							name: "ORDER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 974, col: 13, offset: 27459},
+							pos:  position{line: 1122, col: 13, offset: 32687},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 974, col: 15, offset: 27461},
+							pos:  position{line: 1122, col: 15, offset: 32689},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 974, col: 18, offset: 27464},
+							pos:  position{line: 1122, col: 18, offset: 32692},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 974, col: 20, offset: 27466},
+							pos:   position{line: 1122, col: 20, offset: 32694},
 							label: "keys",
 							expr: &ruleRefExpr{
-								pos:  position{line: 974, col: 25, offset: 27471},
+								pos:  position{line: 1122, col: 25, offset: 32699},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 974, col: 31, offset: 27477},
+							pos:   position{line: 1122, col: 31, offset: 32705},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 974, col: 37, offset: 27483},
+								pos:  position{line: 1122, col: 37, offset: 32711},
 								name: "SQLOrder",
 							},
 						},
@@ -7512,32 +8899,32 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOrder",
-			pos:  position{line: 978, col: 1, offset: 27593},
+			pos:  position{line: 1126, col: 1, offset: 32821},
 			expr: &choiceExpr{
-				pos: position{line: 979, col: 5, offset: 27606},
+				pos: position{line: 1127, col: 5, offset: 32834},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 979, col: 5, offset: 27606},
+						pos: position{line: 1127, col: 5, offset: 32834},
 						run: (*parser).callonSQLOrder2,
 						expr: &seqExpr{
-							pos: position{line: 979, col: 5, offset: 27606},
+							pos: position{line: 1127, col: 5, offset: 32834},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 979, col: 5, offset: 27606},
+									pos:  position{line: 1127, col: 5, offset: 32834},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 979, col: 7, offset: 27608},
+									pos:   position{line: 1127, col: 7, offset: 32836},
 									label: "dir",
 									expr: &choiceExpr{
-										pos: position{line: 979, col: 12, offset: 27613},
+										pos: position{line: 1127, col: 12, offset: 32841},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 979, col: 12, offset: 27613},
+												pos:  position{line: 1127, col: 12, offset: 32841},
 												name: "ASC",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 979, col: 18, offset: 27619},
+												pos:  position{line: 1127, col: 18, offset: 32847},
 												name: "DESC",
 											},
 										},
@@ -7547,10 +8934,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 980, col: 5, offset: 27649},
+						pos: position{line: 1128, col: 5, offset: 32877},
 						run: (*parser).callonSQLOrder9,
 						expr: &litMatcher{
-							pos:        position{line: 980, col: 5, offset: 27649},
+							pos:        position{line: 1128, col: 5, offset: 32877},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -7560,33 +8947,33 @@ var g = &grammar{
 		},
 		{
 			name: "SQLLimit",
-			pos:  position{line: 982, col: 1, offset: 27675},
+			pos:  position{line: 1130, col: 1, offset: 32903},
 			expr: &choiceExpr{
-				pos: position{line: 983, col: 5, offset: 27688},
+				pos: position{line: 1131, col: 5, offset: 32916},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 983, col: 5, offset: 27688},
+						pos: position{line: 1131, col: 5, offset: 32916},
 						run: (*parser).callonSQLLimit2,
 						expr: &seqExpr{
-							pos: position{line: 983, col: 5, offset: 27688},
+							pos: position{line: 1131, col: 5, offset: 32916},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 983, col: 5, offset: 27688},
+									pos:  position{line: 1131, col: 5, offset: 32916},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 983, col: 7, offset: 27690},
+									pos:  position{line: 1131, col: 7, offset: 32918},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 983, col: 13, offset: 27696},
+									pos:  position{line: 1131, col: 13, offset: 32924},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 983, col: 15, offset: 27698},
+									pos:   position{line: 1131, col: 15, offset: 32926},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 983, col: 21, offset: 27704},
+										pos:  position{line: 1131, col: 21, offset: 32932},
 										name: "UInt",
 									},
 								},
@@ -7594,10 +8981,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 984, col: 5, offset: 27735},
+						pos: position{line: 1132, col: 5, offset: 32963},
 						run: (*parser).callonSQLLimit9,
 						expr: &litMatcher{
-							pos:        position{line: 984, col: 5, offset: 27735},
+							pos:        position{line: 1132, col: 5, offset: 32963},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -7606,104 +8993,907 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SELECT",
-			pos:  position{line: 986, col: 1, offset: 27757},
-			expr: &actionExpr{
-				pos: position{line: 986, col: 10, offset: 27766},
-				run: (*parser).callonSELECT1,
-				expr: &litMatcher{
-					pos:        position{line: 986, col: 10, offset: 27766},
-					val:        "select",
-					ignoreCase: true,
-				},
-			},
-		},
-		{
-			name: "AS",
-			pos:  position{line: 987, col: 1, offset: 27801},
-			expr: &actionExpr{
-				pos: position{line: 987, col: 6, offset: 27806},
-				run: (*parser).callonAS1,
-				expr: &litMatcher{
-					pos:        position{line: 987, col: 6, offset: 27806},
-					val:        "as",
-					ignoreCase: true,
-				},
-			},
-		},
-		{
-			name: "FROM",
-			pos:  position{line: 988, col: 1, offset: 27833},
+			name: "SQLWindows",
+			pos:  position{line: 1134, col: 1, offset: 32985},
 			expr: &actionExpr{
-				pos: position{line: 988, col: 8, offset: 27840},
-				run: (*parser).callonFROM1,
-				expr: &litMatcher{
-					pos:        position{line: 988, col: 8, offset: 27840},
-					val:        "from",
-					ignoreCase: true,
+				pos: position{line: 1135, col: 5, offset: 33000},
+				run: (*parser).callonSQLWindows1,
+				expr: &seqExpr{
+					pos: position{line: 1135, col: 5, offset: 33000},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1135, col: 5, offset: 33000},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1135, col: 7, offset: 33002},
+							name: "WINDOW",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1135, col: 14, offset: 33009},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 1135, col: 16, offset: 33011},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1135, col: 22, offset: 33017},
+								name: "SQLNamedWindow",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1135, col: 37, offset: 33032},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1135, col: 42, offset: 33037},
+								expr: &actionExpr{
+									pos: position{line: 1135, col: 43, offset: 33038},
+									run: (*parser).callonSQLWindows10,
+									expr: &seqExpr{
+										pos: position{line: 1135, col: 43, offset: 33038},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1135, col: 43, offset: 33038},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 1135, col: 46, offset: 33041},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1135, col: 50, offset: 33045},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 1135, col: 53, offset: 33048},
+												label: "w",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1135, col: 55, offset: 33050},
+													name: "SQLNamedWindow",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "JOIN",
-			pos:  position{line: 989, col: 1, offset: 27871},
+			name: "SQLNamedWindow",
+			pos:  position{line: 1139, col: 1, offset: 33169},
 			expr: &actionExpr{
-				pos: position{line: 989, col: 8, offset: 27878},
-				run: (*parser).callonJOIN1,
-				expr: &litMatcher{
-					pos:        position{line: 989, col: 8, offset: 27878},
-					val:        "join",
-					ignoreCase: true,
+				pos: position{line: 1140, col: 5, offset: 33188},
+				run: (*parser).callonSQLNamedWindow1,
+				expr: &seqExpr{
+					pos: position{line: 1140, col: 5, offset: 33188},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1140, col: 5, offset: 33188},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1140, col: 10, offset: 33193},
+								name: "IdentifierName",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1140, col: 25, offset: 33208},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1140, col: 27, offset: 33210},
+							name: "AS",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1140, col: 30, offset: 33213},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 1140, col: 33, offset: 33216},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1140, col: 37, offset: 33220},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 1140, col: 40, offset: 33223},
+							label: "w",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1140, col: 42, offset: 33225},
+								name: "SQLWindowSpec",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1140, col: 56, offset: 33239},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 1140, col: 59, offset: 33242},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "WHERE",
-			pos:  position{line: 990, col: 1, offset: 27909},
+			name: "SQLOverClause",
+			pos:  position{line: 1146, col: 1, offset: 33339},
 			expr: &actionExpr{
-				pos: position{line: 990, col: 9, offset: 27917},
-				run: (*parser).callonWHERE1,
-				expr: &litMatcher{
-					pos:        position{line: 990, col: 9, offset: 27917},
-					val:        "where",
-					ignoreCase: true,
+				pos: position{line: 1147, col: 5, offset: 33357},
+				run: (*parser).callonSQLOverClause1,
+				expr: &seqExpr{
+					pos: position{line: 1147, col: 5, offset: 33357},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1147, col: 5, offset: 33357},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1147, col: 7, offset: 33359},
+							name: "OVER",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1147, col: 12, offset: 33364},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 1147, col: 14, offset: 33366},
+							label: "w",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1147, col: 16, offset: 33368},
+								name: "SQLWindowRef",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "GROUP",
-			pos:  position{line: 991, col: 1, offset: 27950},
-			expr: &actionExpr{
-				pos: position{line: 991, col: 9, offset: 27958},
-				run: (*parser).callonGROUP1,
-				expr: &litMatcher{
-					pos:        position{line: 991, col: 9, offset: 27958},
-					val:        "group",
-					ignoreCase: true,
+			name: "SQLWindowRef",
+			pos:  position{line: 1149, col: 1, offset: 33400},
+			expr: &choiceExpr{
+				pos: position{line: 1150, col: 5, offset: 33417},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1150, col: 5, offset: 33417},
+						run: (*parser).callonSQLWindowRef2,
+						expr: &seqExpr{
+							pos: position{line: 1150, col: 5, offset: 33417},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1150, col: 5, offset: 33417},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1150, col: 9, offset: 33421},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 1150, col: 12, offset: 33424},
+									label: "w",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1150, col: 14, offset: 33426},
+										name: "SQLWindowSpec",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1150, col: 28, offset: 33440},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 1150, col: 31, offset: 33443},
+									val:        ")",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1151, col: 5, offset: 33469},
+						run: (*parser).callonSQLWindowRef10,
+						expr: &labeledExpr{
+							pos:   position{line: 1151, col: 5, offset: 33469},
+							label: "base",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1151, col: 10, offset: 33474},
+								name: "IdentifierName",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "BY",
-			pos:  position{line: 992, col: 1, offset: 27991},
+			name: "SQLWindowSpec",
+			pos:  position{line: 1155, col: 1, offset: 33638},
 			expr: &actionExpr{
-				pos: position{line: 992, col: 6, offset: 27996},
-				run: (*parser).callonBY1,
-				expr: &litMatcher{
-					pos:        position{line: 992, col: 6, offset: 27996},
-					val:        "by",
+				pos: position{line: 1156, col: 5, offset: 33656},
+				run: (*parser).callonSQLWindowSpec1,
+				expr: &seqExpr{
+					pos: position{line: 1156, col: 5, offset: 33656},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1156, col: 5, offset: 33656},
+							label: "base",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1156, col: 10, offset: 33661},
+								expr: &actionExpr{
+									pos: position{line: 1156, col: 11, offset: 33662},
+									run: (*parser).callonSQLWindowSpec5,
+									expr: &seqExpr{
+										pos: position{line: 1156, col: 11, offset: 33662},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 1156, col: 11, offset: 33662},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1156, col: 12, offset: 33663},
+													name: "SQLWindowKeyword",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 1156, col: 29, offset: 33680},
+												label: "id",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1156, col: 32, offset: 33683},
+													name: "IdentifierName",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1156, col: 47, offset: 33698},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1157, col: 5, offset: 33726},
+							label: "partition",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1157, col: 15, offset: 33736},
+								expr: &actionExpr{
+									pos: position{line: 1157, col: 16, offset: 33737},
+									run: (*parser).callonSQLWindowSpec14,
+									expr: &seqExpr{
+										pos: position{line: 1157, col: 16, offset: 33737},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1157, col: 16, offset: 33737},
+												name: "PARTITION",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1157, col: 26, offset: 33747},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1157, col: 28, offset: 33749},
+												name: "BY",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1157, col: 31, offset: 33752},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1157, col: 33, offset: 33754},
+												label: "keys",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1157, col: 38, offset: 33759},
+													name: "Exprs",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1157, col: 44, offset: 33765},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1158, col: 5, offset: 33795},
+							label: "orderby",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1158, col: 13, offset: 33803},
+								expr: &actionExpr{
+									pos: position{line: 1158, col: 14, offset: 33804},
+									run: (*parser).callonSQLWindowSpec25,
+									expr: &seqExpr{
+										pos: position{line: 1158, col: 14, offset: 33804},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1158, col: 14, offset: 33804},
+												name: "ORDER",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1158, col: 20, offset: 33810},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1158, col: 22, offset: 33812},
+												name: "BY",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1158, col: 25, offset: 33815},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1158, col: 27, offset: 33817},
+												label: "keys",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1158, col: 32, offset: 33822},
+													name: "Exprs",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 1158, col: 38, offset: 33828},
+												label: "order",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1158, col: 44, offset: 33834},
+													name: "SQLOrder",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1158, col: 53, offset: 33843},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1161, col: 5, offset: 33953},
+							label: "frame",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1161, col: 11, offset: 33959},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1161, col: 11, offset: 33959},
+									name: "SQLFrame",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SQLWindowKeyword",
+			pos:  position{line: 1169, col: 1, offset: 34200},
+			expr: &seqExpr{
+				pos: position{line: 1169, col: 20, offset: 34219},
+				exprs: []interface{}{
+					&choiceExpr{
+						pos: position{line: 1169, col: 21, offset: 34220},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 1169, col: 21, offset: 34220},
+								name: "PARTITION",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1169, col: 33, offset: 34232},
+								name: "ORDER",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1169, col: 41, offset: 34240},
+								name: "ROWS",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1169, col: 48, offset: 34247},
+								name: "RANGE",
+							},
+						},
+					},
+					&notExpr{
+						pos: position{line: 1169, col: 55, offset: 34254},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1169, col: 56, offset: 34255},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SQLFrame",
+			pos:  position{line: 1171, col: 1, offset: 34271},
+			expr: &choiceExpr{
+				pos: position{line: 1172, col: 5, offset: 34284},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1172, col: 5, offset: 34284},
+						run: (*parser).callonSQLFrame2,
+						expr: &seqExpr{
+							pos: position{line: 1172, col: 5, offset: 34284},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1172, col: 5, offset: 34284},
+									label: "units",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1172, col: 11, offset: 34290},
+										name: "SQLFrameUnits",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1172, col: 25, offset: 34304},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1172, col: 27, offset: 34306},
+									name: "BETWEEN",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1172, col: 35, offset: 34314},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1172, col: 37, offset: 34316},
+									label: "start",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1172, col: 43, offset: 34322},
+										name: "SQLFrameBound",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1172, col: 57, offset: 34336},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1172, col: 59, offset: 34338},
+									name: "AndToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1172, col: 68, offset: 34347},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1172, col: 70, offset: 34349},
+									label: "end",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1172, col: 74, offset: 34353},
+										name: "SQLFrameBound",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1175, col: 5, offset: 34464},
+						run: (*parser).callonSQLFrame16,
+						expr: &seqExpr{
+							pos: position{line: 1175, col: 5, offset: 34464},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1175, col: 5, offset: 34464},
+									label: "units",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1175, col: 11, offset: 34470},
+										name: "SQLFrameUnits",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1175, col: 25, offset: 34484},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1175, col: 27, offset: 34486},
+									label: "start",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1175, col: 33, offset: 34492},
+										name: "SQLFrameBound",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SQLFrameUnits",
+			pos:  position{line: 1179, col: 1, offset: 34655},
+			expr: &choiceExpr{
+				pos: position{line: 1179, col: 17, offset: 34671},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 1179, col: 17, offset: 34671},
+						name: "ROWS",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1179, col: 24, offset: 34678},
+						name: "RANGE",
+					},
+				},
+			},
+		},
+		{
+			name: "SQLFrameBound",
+			pos:  position{line: 1181, col: 1, offset: 34685},
+			expr: &choiceExpr{
+				pos: position{line: 1182, col: 5, offset: 34703},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1182, col: 5, offset: 34703},
+						run: (*parser).callonSQLFrameBound2,
+						expr: &seqExpr{
+							pos: position{line: 1182, col: 5, offset: 34703},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1182, col: 5, offset: 34703},
+									name: "UNBOUNDED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1182, col: 15, offset: 34713},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1182, col: 17, offset: 34715},
+									name: "PRECEDING",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1183, col: 5, offset: 34812},
+						run: (*parser).callonSQLFrameBound7,
+						expr: &seqExpr{
+							pos: position{line: 1183, col: 5, offset: 34812},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1183, col: 5, offset: 34812},
+									name: "UNBOUNDED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1183, col: 15, offset: 34822},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1183, col: 17, offset: 34824},
+									name: "FOLLOWING",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1184, col: 5, offset: 34921},
+						run: (*parser).callonSQLFrameBound12,
+						expr: &seqExpr{
+							pos: position{line: 1184, col: 5, offset: 34921},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1184, col: 5, offset: 34921},
+									name: "CURRENT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1184, col: 13, offset: 34929},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1184, col: 15, offset: 34931},
+									name: "ROW",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1185, col: 5, offset: 35014},
+						run: (*parser).callonSQLFrameBound17,
+						expr: &seqExpr{
+							pos: position{line: 1185, col: 5, offset: 35014},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1185, col: 5, offset: 35014},
+									label: "n",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1185, col: 7, offset: 35016},
+										name: "UInt",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1185, col: 12, offset: 35021},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1185, col: 14, offset: 35023},
+									name: "PRECEDING",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1186, col: 5, offset: 35110},
+						run: (*parser).callonSQLFrameBound23,
+						expr: &seqExpr{
+							pos: position{line: 1186, col: 5, offset: 35110},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1186, col: 5, offset: 35110},
+									label: "n",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1186, col: 7, offset: 35112},
+										name: "UInt",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1186, col: 12, offset: 35117},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1186, col: 14, offset: 35119},
+									name: "FOLLOWING",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CaseExpr",
+			pos:  position{line: 1188, col: 1, offset: 35203},
+			expr: &actionExpr{
+				pos: position{line: 1189, col: 5, offset: 35216},
+				run: (*parser).callonCaseExpr1,
+				expr: &seqExpr{
+					pos: position{line: 1189, col: 5, offset: 35216},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 1189, col: 5, offset: 35216},
+							name: "CASE",
+						},
+						&labeledExpr{
+							pos:   position{line: 1189, col: 10, offset: 35221},
+							label: "subject",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1189, col: 18, offset: 35229},
+								expr: &actionExpr{
+									pos: position{line: 1189, col: 19, offset: 35230},
+									run: (*parser).callonCaseExpr6,
+									expr: &seqExpr{
+										pos: position{line: 1189, col: 19, offset: 35230},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1189, col: 19, offset: 35230},
+												name: "_",
+											},
+											&notExpr{
+												pos: position{line: 1189, col: 21, offset: 35232},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1189, col: 22, offset: 35233},
+													name: "WHEN",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 1189, col: 27, offset: 35238},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1189, col: 29, offset: 35240},
+													name: "Expr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1190, col: 5, offset: 35269},
+							label: "whens",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1190, col: 11, offset: 35275},
+								expr: &actionExpr{
+									pos: position{line: 1190, col: 12, offset: 35276},
+									run: (*parser).callonCaseExpr15,
+									expr: &seqExpr{
+										pos: position{line: 1190, col: 12, offset: 35276},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1190, col: 12, offset: 35276},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1190, col: 14, offset: 35278},
+												name: "WHEN",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1190, col: 19, offset: 35283},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1190, col: 21, offset: 35285},
+												label: "cond",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1190, col: 26, offset: 35290},
+													name: "Expr",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1190, col: 31, offset: 35295},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1190, col: 33, offset: 35297},
+												name: "THEN",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1190, col: 38, offset: 35302},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1190, col: 40, offset: 35304},
+												label: "value",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1190, col: 46, offset: 35310},
+													name: "Expr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1191, col: 5, offset: 35364},
+							label: "otherwise",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1191, col: 15, offset: 35374},
+								expr: &actionExpr{
+									pos: position{line: 1191, col: 16, offset: 35375},
+									run: (*parser).callonCaseExpr29,
+									expr: &seqExpr{
+										pos: position{line: 1191, col: 16, offset: 35375},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1191, col: 16, offset: 35375},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1191, col: 18, offset: 35377},
+												name: "ELSE",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1191, col: 23, offset: 35382},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1191, col: 25, offset: 35384},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1191, col: 27, offset: 35386},
+													name: "Expr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1192, col: 5, offset: 35415},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1192, col: 7, offset: 35417},
+							name: "END",
+						},
+						&notExpr{
+							pos: position{line: 1192, col: 11, offset: 35421},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1192, col: 12, offset: 35422},
+								name: "IdentifierRest",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SELECT",
+			pos:  position{line: 1196, col: 1, offset: 35504},
+			expr: &actionExpr{
+				pos: position{line: 1196, col: 10, offset: 35513},
+				run: (*parser).callonSELECT1,
+				expr: &litMatcher{
+					pos:        position{line: 1196, col: 10, offset: 35513},
+					val:        "select",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "AS",
+			pos:  position{line: 1197, col: 1, offset: 35548},
+			expr: &actionExpr{
+				pos: position{line: 1197, col: 6, offset: 35553},
+				run: (*parser).callonAS1,
+				expr: &litMatcher{
+					pos:        position{line: 1197, col: 6, offset: 35553},
+					val:        "as",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "FROM",
+			pos:  position{line: 1198, col: 1, offset: 35580},
+			expr: &actionExpr{
+				pos: position{line: 1198, col: 8, offset: 35587},
+				run: (*parser).callonFROM1,
+				expr: &litMatcher{
+					pos:        position{line: 1198, col: 8, offset: 35587},
+					val:        "from",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "JOIN",
+			pos:  position{line: 1199, col: 1, offset: 35618},
+			expr: &actionExpr{
+				pos: position{line: 1199, col: 8, offset: 35625},
+				run: (*parser).callonJOIN1,
+				expr: &litMatcher{
+					pos:        position{line: 1199, col: 8, offset: 35625},
+					val:        "join",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "WHERE",
+			pos:  position{line: 1200, col: 1, offset: 35656},
+			expr: &actionExpr{
+				pos: position{line: 1200, col: 9, offset: 35664},
+				run: (*parser).callonWHERE1,
+				expr: &litMatcher{
+					pos:        position{line: 1200, col: 9, offset: 35664},
+					val:        "where",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "GROUP",
+			pos:  position{line: 1201, col: 1, offset: 35697},
+			expr: &actionExpr{
+				pos: position{line: 1201, col: 9, offset: 35705},
+				run: (*parser).callonGROUP1,
+				expr: &litMatcher{
+					pos:        position{line: 1201, col: 9, offset: 35705},
+					val:        "group",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "BY",
+			pos:  position{line: 1202, col: 1, offset: 35738},
+			expr: &actionExpr{
+				pos: position{line: 1202, col: 6, offset: 35743},
+				run: (*parser).callonBY1,
+				expr: &litMatcher{
+					pos:        position{line: 1202, col: 6, offset: 35743},
+					val:        "by",
 					ignoreCase: true,
 				},
 			},
 		},
 		{
 			name: "HAVING",
-			pos:  position{line: 993, col: 1, offset: 28023},
+			pos:  position{line: 1203, col: 1, offset: 35770},
 			expr: &actionExpr{
-				pos: position{line: 993, col: 10, offset: 28032},
+				pos: position{line: 1203, col: 10, offset: 35779},
 				run: (*parser).callonHAVING1,
 				expr: &litMatcher{
-					pos:        position{line: 993, col: 10, offset: 28032},
+					pos:        position{line: 1203, col: 10, offset: 35779},
 					val:        "having",
 					ignoreCase: true,
 				},
@@ -7711,12 +9901,12 @@ var g = &grammar{
 		},
 		{
 			name: "ORDER",
-			pos:  position{line: 994, col: 1, offset: 28067},
+			pos:  position{line: 1204, col: 1, offset: 35814},
 			expr: &actionExpr{
-				pos: position{line: 994, col: 9, offset: 28075},
+				pos: position{line: 1204, col: 9, offset: 35822},
 				run: (*parser).callonORDER1,
 				expr: &litMatcher{
-					pos:        position{line: 994, col: 9, offset: 28075},
+					pos:        position{line: 1204, col: 9, offset: 35822},
 					val:        "order",
 					ignoreCase: true,
 				},
@@ -7724,12 +9914,12 @@ var g = &grammar{
 		},
 		{
 			name: "ON",
-			pos:  position{line: 995, col: 1, offset: 28108},
+			pos:  position{line: 1205, col: 1, offset: 35855},
 			expr: &actionExpr{
-				pos: position{line: 995, col: 6, offset: 28113},
+				pos: position{line: 1205, col: 6, offset: 35860},
 				run: (*parser).callonON1,
 				expr: &litMatcher{
-					pos:        position{line: 995, col: 6, offset: 28113},
+					pos:        position{line: 1205, col: 6, offset: 35860},
 					val:        "on",
 					ignoreCase: true,
 				},
@@ -7737,12 +9927,12 @@ var g = &grammar{
 		},
 		{
 			name: "LIMIT",
-			pos:  position{line: 996, col: 1, offset: 28140},
+			pos:  position{line: 1206, col: 1, offset: 35887},
 			expr: &actionExpr{
-				pos: position{line: 996, col: 9, offset: 28148},
+				pos: position{line: 1206, col: 9, offset: 35895},
 				run: (*parser).callonLIMIT1,
 				expr: &litMatcher{
-					pos:        position{line: 996, col: 9, offset: 28148},
+					pos:        position{line: 1206, col: 9, offset: 35895},
 					val:        "limit",
 					ignoreCase: true,
 				},
@@ -7750,12 +9940,12 @@ var g = &grammar{
 		},
 		{
 			name: "ASC",
-			pos:  position{line: 997, col: 1, offset: 28181},
+			pos:  position{line: 1207, col: 1, offset: 35928},
 			expr: &actionExpr{
-				pos: position{line: 997, col: 7, offset: 28187},
+				pos: position{line: 1207, col: 7, offset: 35934},
 				run: (*parser).callonASC1,
 				expr: &litMatcher{
-					pos:        position{line: 997, col: 7, offset: 28187},
+					pos:        position{line: 1207, col: 7, offset: 35934},
 					val:        "asc",
 					ignoreCase: true,
 				},
@@ -7763,166 +9953,646 @@ var g = &grammar{
 		},
 		{
 			name: "DESC",
-			pos:  position{line: 998, col: 1, offset: 28216},
+			pos:  position{line: 1208, col: 1, offset: 35963},
 			expr: &actionExpr{
-				pos: position{line: 998, col: 8, offset: 28223},
+				pos: position{line: 1208, col: 8, offset: 35970},
 				run: (*parser).callonDESC1,
 				expr: &litMatcher{
-					pos:        position{line: 998, col: 8, offset: 28223},
+					pos:        position{line: 1208, col: 8, offset: 35970},
 					val:        "desc",
 					ignoreCase: true,
 				},
 			},
 		},
 		{
-			name: "ANTI",
-			pos:  position{line: 999, col: 1, offset: 28254},
+			name: "ANTI",
+			pos:  position{line: 1209, col: 1, offset: 36001},
+			expr: &actionExpr{
+				pos: position{line: 1209, col: 8, offset: 36008},
+				run: (*parser).callonANTI1,
+				expr: &litMatcher{
+					pos:        position{line: 1209, col: 8, offset: 36008},
+					val:        "anti",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "LEFT",
+			pos:  position{line: 1210, col: 1, offset: 36039},
+			expr: &actionExpr{
+				pos: position{line: 1210, col: 8, offset: 36046},
+				run: (*parser).callonLEFT1,
+				expr: &litMatcher{
+					pos:        position{line: 1210, col: 8, offset: 36046},
+					val:        "left",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "RIGHT",
+			pos:  position{line: 1211, col: 1, offset: 36077},
+			expr: &actionExpr{
+				pos: position{line: 1211, col: 9, offset: 36085},
+				run: (*parser).callonRIGHT1,
+				expr: &litMatcher{
+					pos:        position{line: 1211, col: 9, offset: 36085},
+					val:        "right",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "INNER",
+			pos:  position{line: 1212, col: 1, offset: 36118},
+			expr: &actionExpr{
+				pos: position{line: 1212, col: 9, offset: 36126},
+				run: (*parser).callonINNER1,
+				expr: &litMatcher{
+					pos:        position{line: 1212, col: 9, offset: 36126},
+					val:        "inner",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "WITH",
+			pos:  position{line: 1213, col: 1, offset: 36159},
+			expr: &actionExpr{
+				pos: position{line: 1213, col: 8, offset: 36166},
+				run: (*parser).callonWITH1,
+				expr: &litMatcher{
+					pos:        position{line: 1213, col: 8, offset: 36166},
+					val:        "with",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "UNION",
+			pos:  position{line: 1214, col: 1, offset: 36197},
+			expr: &actionExpr{
+				pos: position{line: 1214, col: 9, offset: 36205},
+				run: (*parser).callonUNION1,
+				expr: &litMatcher{
+					pos:        position{line: 1214, col: 9, offset: 36205},
+					val:        "union",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "ALL",
+			pos:  position{line: 1215, col: 1, offset: 36238},
+			expr: &actionExpr{
+				pos: position{line: 1215, col: 7, offset: 36244},
+				run: (*parser).callonALL1,
+				expr: &litMatcher{
+					pos:        position{line: 1215, col: 7, offset: 36244},
+					val:        "all",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "IN",
+			pos:  position{line: 1216, col: 1, offset: 36273},
+			expr: &actionExpr{
+				pos: position{line: 1216, col: 6, offset: 36278},
+				run: (*parser).callonIN1,
+				expr: &seqExpr{
+					pos: position{line: 1216, col: 6, offset: 36278},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1216, col: 6, offset: 36278},
+							val:        "in",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1216, col: 12, offset: 36284},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1216, col: 13, offset: 36285},
+								name: "IdentifierRest",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DISTINCT",
+			pos:  position{line: 1217, col: 1, offset: 36321},
+			expr: &actionExpr{
+				pos: position{line: 1217, col: 12, offset: 36332},
+				run: (*parser).callonDISTINCT1,
+				expr: &litMatcher{
+					pos:        position{line: 1217, col: 12, offset: 36332},
+					val:        "distinct",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "CASE",
+			pos:  position{line: 1218, col: 1, offset: 36371},
+			expr: &actionExpr{
+				pos: position{line: 1218, col: 8, offset: 36378},
+				run: (*parser).callonCASE1,
+				expr: &litMatcher{
+					pos:        position{line: 1218, col: 8, offset: 36378},
+					val:        "case",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "WHEN",
+			pos:  position{line: 1219, col: 1, offset: 36409},
+			expr: &actionExpr{
+				pos: position{line: 1219, col: 8, offset: 36416},
+				run: (*parser).callonWHEN1,
+				expr: &litMatcher{
+					pos:        position{line: 1219, col: 8, offset: 36416},
+					val:        "when",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "THEN",
+			pos:  position{line: 1220, col: 1, offset: 36447},
+			expr: &actionExpr{
+				pos: position{line: 1220, col: 8, offset: 36454},
+				run: (*parser).callonTHEN1,
+				expr: &litMatcher{
+					pos:        position{line: 1220, col: 8, offset: 36454},
+					val:        "then",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "ELSE",
+			pos:  position{line: 1221, col: 1, offset: 36485},
+			expr: &actionExpr{
+				pos: position{line: 1221, col: 8, offset: 36492},
+				run: (*parser).callonELSE1,
+				expr: &litMatcher{
+					pos:        position{line: 1221, col: 8, offset: 36492},
+					val:        "else",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "END",
+			pos:  position{line: 1222, col: 1, offset: 36523},
+			expr: &actionExpr{
+				pos: position{line: 1222, col: 7, offset: 36529},
+				run: (*parser).callonEND1,
+				expr: &litMatcher{
+					pos:        position{line: 1222, col: 7, offset: 36529},
+					val:        "end",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "OVER",
+			pos:  position{line: 1223, col: 1, offset: 36558},
+			expr: &actionExpr{
+				pos: position{line: 1223, col: 8, offset: 36565},
+				run: (*parser).callonOVER1,
+				expr: &litMatcher{
+					pos:        position{line: 1223, col: 8, offset: 36565},
+					val:        "over",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "WINDOW",
+			pos:  position{line: 1224, col: 1, offset: 36596},
+			expr: &actionExpr{
+				pos: position{line: 1224, col: 10, offset: 36605},
+				run: (*parser).callonWINDOW1,
+				expr: &litMatcher{
+					pos:        position{line: 1224, col: 10, offset: 36605},
+					val:        "window",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "PARTITION",
+			pos:  position{line: 1225, col: 1, offset: 36640},
+			expr: &actionExpr{
+				pos: position{line: 1225, col: 13, offset: 36652},
+				run: (*parser).callonPARTITION1,
+				expr: &litMatcher{
+					pos:        position{line: 1225, col: 13, offset: 36652},
+					val:        "partition",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "ROWS",
+			pos:  position{line: 1226, col: 1, offset: 36693},
+			expr: &actionExpr{
+				pos: position{line: 1226, col: 8, offset: 36700},
+				run: (*parser).callonROWS1,
+				expr: &litMatcher{
+					pos:        position{line: 1226, col: 8, offset: 36700},
+					val:        "rows",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "RANGE",
+			pos:  position{line: 1227, col: 1, offset: 36731},
+			expr: &actionExpr{
+				pos: position{line: 1227, col: 9, offset: 36739},
+				run: (*parser).callonRANGE1,
+				expr: &litMatcher{
+					pos:        position{line: 1227, col: 9, offset: 36739},
+					val:        "range",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "BETWEEN",
+			pos:  position{line: 1228, col: 1, offset: 36772},
+			expr: &actionExpr{
+				pos: position{line: 1228, col: 11, offset: 36782},
+				run: (*parser).callonBETWEEN1,
+				expr: &litMatcher{
+					pos:        position{line: 1228, col: 11, offset: 36782},
+					val:        "between",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "UNBOUNDED",
+			pos:  position{line: 1229, col: 1, offset: 36819},
+			expr: &actionExpr{
+				pos: position{line: 1229, col: 13, offset: 36831},
+				run: (*parser).callonUNBOUNDED1,
+				expr: &litMatcher{
+					pos:        position{line: 1229, col: 13, offset: 36831},
+					val:        "unbounded",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "PRECEDING",
+			pos:  position{line: 1230, col: 1, offset: 36872},
+			expr: &actionExpr{
+				pos: position{line: 1230, col: 13, offset: 36884},
+				run: (*parser).callonPRECEDING1,
+				expr: &litMatcher{
+					pos:        position{line: 1230, col: 13, offset: 36884},
+					val:        "preceding",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "FOLLOWING",
+			pos:  position{line: 1231, col: 1, offset: 36925},
+			expr: &actionExpr{
+				pos: position{line: 1231, col: 13, offset: 36937},
+				run: (*parser).callonFOLLOWING1,
+				expr: &litMatcher{
+					pos:        position{line: 1231, col: 13, offset: 36937},
+					val:        "following",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "CURRENT",
+			pos:  position{line: 1232, col: 1, offset: 36978},
+			expr: &actionExpr{
+				pos: position{line: 1232, col: 11, offset: 36988},
+				run: (*parser).callonCURRENT1,
+				expr: &litMatcher{
+					pos:        position{line: 1232, col: 11, offset: 36988},
+					val:        "current",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "ROW",
+			pos:  position{line: 1233, col: 1, offset: 37025},
+			expr: &actionExpr{
+				pos: position{line: 1233, col: 7, offset: 37031},
+				run: (*parser).callonROW1,
+				expr: &seqExpr{
+					pos: position{line: 1233, col: 7, offset: 37031},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1233, col: 7, offset: 37031},
+							val:        "row",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1233, col: 14, offset: 37038},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1233, col: 15, offset: 37039},
+								name: "IdentifierRest",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CREATE",
+			pos:  position{line: 1234, col: 1, offset: 37076},
+			expr: &actionExpr{
+				pos: position{line: 1234, col: 10, offset: 37085},
+				run: (*parser).callonCREATE1,
+				expr: &seqExpr{
+					pos: position{line: 1234, col: 10, offset: 37085},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1234, col: 10, offset: 37085},
+							val:        "create",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1234, col: 20, offset: 37095},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1234, col: 21, offset: 37096},
+								name: "IdentifierRest",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DROP",
+			pos:  position{line: 1235, col: 1, offset: 37136},
+			expr: &actionExpr{
+				pos: position{line: 1235, col: 8, offset: 37143},
+				run: (*parser).callonDROP1,
+				expr: &seqExpr{
+					pos: position{line: 1235, col: 8, offset: 37143},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1235, col: 8, offset: 37143},
+							val:        "drop",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1235, col: 16, offset: 37151},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1235, col: 17, offset: 37152},
+								name: "IdentifierRest",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "POOL",
+			pos:  position{line: 1236, col: 1, offset: 37190},
+			expr: &actionExpr{
+				pos: position{line: 1236, col: 8, offset: 37197},
+				run: (*parser).callonPOOL1,
+				expr: &seqExpr{
+					pos: position{line: 1236, col: 8, offset: 37197},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1236, col: 8, offset: 37197},
+							val:        "pool",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1236, col: 16, offset: 37205},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1236, col: 17, offset: 37206},
+								name: "IdentifierRest",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BRANCH",
+			pos:  position{line: 1237, col: 1, offset: 37244},
 			expr: &actionExpr{
-				pos: position{line: 999, col: 8, offset: 28261},
-				run: (*parser).callonANTI1,
-				expr: &litMatcher{
-					pos:        position{line: 999, col: 8, offset: 28261},
-					val:        "anti",
-					ignoreCase: true,
+				pos: position{line: 1237, col: 10, offset: 37253},
+				run: (*parser).callonBRANCH1,
+				expr: &seqExpr{
+					pos: position{line: 1237, col: 10, offset: 37253},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1237, col: 10, offset: 37253},
+							val:        "branch",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1237, col: 20, offset: 37263},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1237, col: 21, offset: 37264},
+								name: "IdentifierRest",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "LEFT",
-			pos:  position{line: 1000, col: 1, offset: 28292},
+			name: "INSERT",
+			pos:  position{line: 1238, col: 1, offset: 37304},
 			expr: &actionExpr{
-				pos: position{line: 1000, col: 8, offset: 28299},
-				run: (*parser).callonLEFT1,
-				expr: &litMatcher{
-					pos:        position{line: 1000, col: 8, offset: 28299},
-					val:        "left",
-					ignoreCase: true,
+				pos: position{line: 1238, col: 10, offset: 37313},
+				run: (*parser).callonINSERT1,
+				expr: &seqExpr{
+					pos: position{line: 1238, col: 10, offset: 37313},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1238, col: 10, offset: 37313},
+							val:        "insert",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1238, col: 20, offset: 37323},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1238, col: 21, offset: 37324},
+								name: "IdentifierRest",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "RIGHT",
-			pos:  position{line: 1001, col: 1, offset: 28330},
+			name: "INTO",
+			pos:  position{line: 1239, col: 1, offset: 37364},
 			expr: &actionExpr{
-				pos: position{line: 1001, col: 9, offset: 28338},
-				run: (*parser).callonRIGHT1,
-				expr: &litMatcher{
-					pos:        position{line: 1001, col: 9, offset: 28338},
-					val:        "right",
-					ignoreCase: true,
+				pos: position{line: 1239, col: 8, offset: 37371},
+				run: (*parser).callonINTO1,
+				expr: &seqExpr{
+					pos: position{line: 1239, col: 8, offset: 37371},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1239, col: 8, offset: 37371},
+							val:        "into",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1239, col: 16, offset: 37379},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1239, col: 17, offset: 37380},
+								name: "IdentifierRest",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "INNER",
-			pos:  position{line: 1002, col: 1, offset: 28371},
+			name: "DELETE",
+			pos:  position{line: 1240, col: 1, offset: 37418},
 			expr: &actionExpr{
-				pos: position{line: 1002, col: 9, offset: 28379},
-				run: (*parser).callonINNER1,
-				expr: &litMatcher{
-					pos:        position{line: 1002, col: 9, offset: 28379},
-					val:        "inner",
-					ignoreCase: true,
+				pos: position{line: 1240, col: 10, offset: 37427},
+				run: (*parser).callonDELETE1,
+				expr: &seqExpr{
+					pos: position{line: 1240, col: 10, offset: 37427},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1240, col: 10, offset: 37427},
+							val:        "delete",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 1240, col: 20, offset: 37437},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1240, col: 21, offset: 37438},
+								name: "IdentifierRest",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "SQLTokenSentinels",
-			pos:  position{line: 1004, col: 1, offset: 28413},
+			pos:  position{line: 1242, col: 1, offset: 37479},
 			expr: &choiceExpr{
-				pos: position{line: 1005, col: 5, offset: 28435},
+				pos: position{line: 1243, col: 5, offset: 37501},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 5, offset: 28435},
+						pos:  position{line: 1243, col: 5, offset: 37501},
 						name: "SELECT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 14, offset: 28444},
+						pos:  position{line: 1243, col: 14, offset: 37510},
 						name: "AS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 19, offset: 28449},
+						pos:  position{line: 1243, col: 19, offset: 37515},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 27, offset: 28457},
+						pos:  position{line: 1243, col: 27, offset: 37523},
 						name: "JOIN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 34, offset: 28464},
+						pos:  position{line: 1243, col: 34, offset: 37530},
 						name: "WHERE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 42, offset: 28472},
+						pos:  position{line: 1243, col: 42, offset: 37538},
 						name: "GROUP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 50, offset: 28480},
+						pos:  position{line: 1243, col: 50, offset: 37546},
 						name: "HAVING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 59, offset: 28489},
+						pos:  position{line: 1243, col: 59, offset: 37555},
 						name: "ORDER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 67, offset: 28497},
+						pos:  position{line: 1243, col: 67, offset: 37563},
 						name: "LIMIT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 75, offset: 28505},
+						pos:  position{line: 1243, col: 75, offset: 37571},
 						name: "ON",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 1243, col: 80, offset: 37576},
+						name: "UNION",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1243, col: 88, offset: 37584},
+						name: "WINDOW",
+					},
 				},
 			},
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 1009, col: 1, offset: 28531},
+			pos:  position{line: 1247, col: 1, offset: 37614},
 			expr: &choiceExpr{
-				pos: position{line: 1010, col: 5, offset: 28543},
+				pos: position{line: 1248, col: 5, offset: 37626},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1010, col: 5, offset: 28543},
+						pos:  position{line: 1248, col: 5, offset: 37626},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1011, col: 5, offset: 28559},
+						pos:  position{line: 1249, col: 5, offset: 37642},
 						name: "TemplateLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1012, col: 5, offset: 28579},
+						pos:  position{line: 1250, col: 5, offset: 37662},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1013, col: 5, offset: 28597},
+						pos:  position{line: 1251, col: 5, offset: 37680},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1014, col: 5, offset: 28616},
+						pos:  position{line: 1252, col: 5, offset: 37699},
 						name: "BytesLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1015, col: 5, offset: 28633},
+						pos:  position{line: 1253, col: 5, offset: 37716},
 						name: "Duration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1016, col: 5, offset: 28646},
+						pos:  position{line: 1254, col: 5, offset: 37729},
 						name: "Time",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1017, col: 5, offset: 28655},
+						pos:  position{line: 1255, col: 5, offset: 37738},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1018, col: 5, offset: 28672},
+						pos:  position{line: 1256, col: 5, offset: 37755},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1019, col: 5, offset: 28691},
+						pos:  position{line: 1257, col: 5, offset: 37774},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1020, col: 5, offset: 28710},
+						pos:  position{line: 1258, col: 5, offset: 37793},
 						name: "NullLiteral",
 					},
 				},
@@ -7930,28 +10600,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 1022, col: 1, offset: 28723},
+			pos:  position{line: 1260, col: 1, offset: 37806},
 			expr: &choiceExpr{
-				pos: position{line: 1023, col: 5, offset: 28741},
+				pos: position{line: 1261, col: 5, offset: 37824},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1023, col: 5, offset: 28741},
+						pos: position{line: 1261, col: 5, offset: 37824},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1023, col: 5, offset: 28741},
+							pos: position{line: 1261, col: 5, offset: 37824},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1023, col: 5, offset: 28741},
+									pos:   position{line: 1261, col: 5, offset: 37824},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1023, col: 7, offset: 28743},
+										pos:  position{line: 1261, col: 7, offset: 37826},
 										name: "IP6Net",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1023, col: 14, offset: 28750},
+									pos: position{line: 1261, col: 14, offset: 37833},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1023, col: 15, offset: 28751},
+										pos:  position{line: 1261, col: 15, offset: 37834},
 										name: "IdentifierRest",
 									},
 								},
@@ -7959,13 +10629,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1026, col: 5, offset: 28866},
+						pos: position{line: 1264, col: 5, offset: 37949},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1026, col: 5, offset: 28866},
+							pos:   position{line: 1264, col: 5, offset: 37949},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1026, col: 7, offset: 28868},
+								pos:  position{line: 1264, col: 7, offset: 37951},
 								name: "IP4Net",
 							},
 						},
@@ -7975,28 +10645,28 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 1030, col: 1, offset: 28972},
+			pos:  position{line: 1268, col: 1, offset: 38055},
 			expr: &choiceExpr{
-				pos: position{line: 1031, col: 5, offset: 28991},
+				pos: position{line: 1269, col: 5, offset: 38074},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1031, col: 5, offset: 28991},
+						pos: position{line: 1269, col: 5, offset: 38074},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1031, col: 5, offset: 28991},
+							pos: position{line: 1269, col: 5, offset: 38074},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1031, col: 5, offset: 28991},
+									pos:   position{line: 1269, col: 5, offset: 38074},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1031, col: 7, offset: 28993},
+										pos:  position{line: 1269, col: 7, offset: 38076},
 										name: "IP6",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1031, col: 11, offset: 28997},
+									pos: position{line: 1269, col: 11, offset: 38080},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1031, col: 12, offset: 28998},
+										pos:  position{line: 1269, col: 12, offset: 38081},
 										name: "IdentifierRest",
 									},
 								},
@@ -8004,13 +10674,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1034, col: 5, offset: 29112},
+						pos: position{line: 1272, col: 5, offset: 38195},
 						run: (*parser).callonAddressLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1034, col: 5, offset: 29112},
+							pos:   position{line: 1272, col: 5, offset: 38195},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1034, col: 7, offset: 29114},
+								pos:  position{line: 1272, col: 7, offset: 38197},
 								name: "IP",
 							},
 						},
@@ -8020,15 +10690,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 1038, col: 1, offset: 29213},
+			pos:  position{line: 1276, col: 1, offset: 38296},
 			expr: &actionExpr{
-				pos: position{line: 1039, col: 5, offset: 29230},
+				pos: position{line: 1277, col: 5, offset: 38313},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1039, col: 5, offset: 29230},
+					pos:   position{line: 1277, col: 5, offset: 38313},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1039, col: 7, offset: 29232},
+						pos:  position{line: 1277, col: 7, offset: 38315},
 						name: "FloatString",
 					},
 				},
@@ -8036,15 +10706,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 1043, col: 1, offset: 29345},
+			pos:  position{line: 1281, col: 1, offset: 38428},
 			expr: &actionExpr{
-				pos: position{line: 1044, col: 5, offset: 29364},
+				pos: position{line: 1282, col: 5, offset: 38447},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1044, col: 5, offset: 29364},
+					pos:   position{line: 1282, col: 5, offset: 38447},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1044, col: 7, offset: 29366},
+						pos:  position{line: 1282, col: 7, offset: 38449},
 						name: "IntString",
 					},
 				},
@@ -8052,24 +10722,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 1048, col: 1, offset: 29475},
+			pos:  position{line: 1286, col: 1, offset: 38558},
 			expr: &choiceExpr{
-				pos: position{line: 1049, col: 5, offset: 29494},
+				pos: position{line: 1287, col: 5, offset: 38577},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1049, col: 5, offset: 29494},
+						pos: position{line: 1287, col: 5, offset: 38577},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 1049, col: 5, offset: 29494},
+							pos:        position{line: 1287, col: 5, offset: 38577},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1050, col: 5, offset: 29607},
+						pos: position{line: 1288, col: 5, offset: 38690},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 1050, col: 5, offset: 29607},
+							pos:        position{line: 1288, col: 5, offset: 38690},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -8079,12 +10749,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 1052, col: 1, offset: 29718},
+			pos:  position{line: 1290, col: 1, offset: 38801},
 			expr: &actionExpr{
-				pos: position{line: 1053, col: 5, offset: 29734},
+				pos: position{line: 1291, col: 5, offset: 38817},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 1053, col: 5, offset: 29734},
+					pos:        position{line: 1291, col: 5, offset: 38817},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -8092,22 +10762,22 @@ var g = &grammar{
 		},
 		{
 			name: "BytesLiteral",
-			pos:  position{line: 1055, col: 1, offset: 29840},
+			pos:  position{line: 1293, col: 1, offset: 38923},
 			expr: &actionExpr{
-				pos: position{line: 1056, col: 5, offset: 29857},
+				pos: position{line: 1294, col: 5, offset: 38940},
 				run: (*parser).callonBytesLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1056, col: 5, offset: 29857},
+					pos: position{line: 1294, col: 5, offset: 38940},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1056, col: 5, offset: 29857},
+							pos:        position{line: 1294, col: 5, offset: 38940},
 							val:        "0x",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1056, col: 10, offset: 29862},
+							pos: position{line: 1294, col: 10, offset: 38945},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1056, col: 10, offset: 29862},
+								pos:  position{line: 1294, col: 10, offset: 38945},
 								name: "HexDigit",
 							},
 						},
@@ -8117,28 +10787,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeLiteral",
-			pos:  position{line: 1060, col: 1, offset: 29977},
+			pos:  position{line: 1298, col: 1, offset: 39060},
 			expr: &actionExpr{
-				pos: position{line: 1061, col: 5, offset: 29993},
+				pos: position{line: 1299, col: 5, offset: 39076},
 				run: (*parser).callonTypeLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1061, col: 5, offset: 29993},
+					pos: position{line: 1299, col: 5, offset: 39076},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1061, col: 5, offset: 29993},
+							pos:        position{line: 1299, col: 5, offset: 39076},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1061, col: 9, offset: 29997},
+							pos:   position{line: 1299, col: 9, offset: 39080},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1061, col: 13, offset: 30001},
+								pos:  position{line: 1299, col: 13, offset: 39084},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1061, col: 18, offset: 30006},
+							pos:        position{line: 1299, col: 18, offset: 39089},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -8148,22 +10818,22 @@ var g = &grammar{
 		},
 		{
 			name: "CastType",
-			pos:  position{line: 1065, col: 1, offset: 30095},
+			pos:  position{line: 1303, col: 1, offset: 39178},
 			expr: &choiceExpr{
-				pos: position{line: 1066, col: 5, offset: 30108},
+				pos: position{line: 1304, col: 5, offset: 39191},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1066, col: 5, offset: 30108},
+						pos:  position{line: 1304, col: 5, offset: 39191},
 						name: "TypeLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 1067, col: 5, offset: 30124},
+						pos: position{line: 1305, col: 5, offset: 39207},
 						run: (*parser).callonCastType3,
 						expr: &labeledExpr{
-							pos:   position{line: 1067, col: 5, offset: 30124},
+							pos:   position{line: 1305, col: 5, offset: 39207},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1067, col: 9, offset: 30128},
+								pos:  position{line: 1305, col: 9, offset: 39211},
 								name: "PrimitiveType",
 							},
 						},
@@ -8173,20 +10843,20 @@ var g = &grammar{
 		},
 		{
 			name: "Type",
-			pos:  position{line: 1071, col: 1, offset: 30227},
+			pos:  position{line: 1309, col: 1, offset: 39310},
 			expr: &choiceExpr{
-				pos: position{line: 1072, col: 5, offset: 30236},
+				pos: position{line: 1310, col: 5, offset: 39319},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1072, col: 5, offset: 30236},
+						pos:  position{line: 1310, col: 5, offset: 39319},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1073, col: 5, offset: 30252},
+						pos:  position{line: 1311, col: 5, offset: 39335},
 						name: "AmbiguousType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1074, col: 5, offset: 30270},
+						pos:  position{line: 1312, col: 5, offset: 39353},
 						name: "ComplexType",
 					},
 				},
@@ -8194,28 +10864,28 @@ var g = &grammar{
 		},
 		{
 			name: "AmbiguousType",
-			pos:  position{line: 1076, col: 1, offset: 30283},
+			pos:  position{line: 1314, col: 1, offset: 39366},
 			expr: &choiceExpr{
-				pos: position{line: 1077, col: 5, offset: 30301},
+				pos: position{line: 1315, col: 5, offset: 39384},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1077, col: 5, offset: 30301},
+						pos: position{line: 1315, col: 5, offset: 39384},
 						run: (*parser).callonAmbiguousType2,
 						expr: &seqExpr{
-							pos: position{line: 1077, col: 5, offset: 30301},
+							pos: position{line: 1315, col: 5, offset: 39384},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1077, col: 5, offset: 30301},
+									pos:   position{line: 1315, col: 5, offset: 39384},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1077, col: 10, offset: 30306},
+										pos:  position{line: 1315, col: 10, offset: 39389},
 										name: "PrimitiveType",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1077, col: 24, offset: 30320},
+									pos: position{line: 1315, col: 24, offset: 39403},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1077, col: 25, offset: 30321},
+										pos:  position{line: 1315, col: 25, offset: 39404},
 										name: "IdentifierRest",
 									},
 								},
@@ -8223,42 +10893,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1078, col: 5, offset: 30361},
+						pos: position{line: 1316, col: 5, offset: 39444},
 						run: (*parser).callonAmbiguousType8,
 						expr: &seqExpr{
-							pos: position{line: 1078, col: 5, offset: 30361},
+							pos: position{line: 1316, col: 5, offset: 39444},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1078, col: 5, offset: 30361},
+									pos:   position{line: 1316, col: 5, offset: 39444},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1078, col: 10, offset: 30366},
+										pos:  position{line: 1316, col: 10, offset: 39449},
 										name: "IdentifierName",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1078, col: 25, offset: 30381},
+									pos:   position{line: 1316, col: 25, offset: 39464},
 									label: "opt",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1078, col: 29, offset: 30385},
+										pos: position{line: 1316, col: 29, offset: 39468},
 										expr: &seqExpr{
-											pos: position{line: 1078, col: 30, offset: 30386},
+											pos: position{line: 1316, col: 30, offset: 39469},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1078, col: 30, offset: 30386},
+													pos:  position{line: 1316, col: 30, offset: 39469},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 1078, col: 33, offset: 30389},
+													pos:        position{line: 1316, col: 33, offset: 39472},
 													val:        "=",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1078, col: 37, offset: 30393},
+													pos:  position{line: 1316, col: 37, offset: 39476},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1078, col: 40, offset: 30396},
+													pos:  position{line: 1316, col: 40, offset: 39479},
 													name: "Type",
 												},
 											},
@@ -8269,42 +10939,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1084, col: 5, offset: 30628},
+						pos: position{line: 1322, col: 5, offset: 39711},
 						run: (*parser).callonAmbiguousType19,
 						expr: &labeledExpr{
-							pos:   position{line: 1084, col: 5, offset: 30628},
+							pos:   position{line: 1322, col: 5, offset: 39711},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1084, col: 10, offset: 30633},
+								pos:  position{line: 1322, col: 10, offset: 39716},
 								name: "QuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1087, col: 5, offset: 30733},
+						pos: position{line: 1325, col: 5, offset: 39816},
 						run: (*parser).callonAmbiguousType22,
 						expr: &seqExpr{
-							pos: position{line: 1087, col: 5, offset: 30733},
+							pos: position{line: 1325, col: 5, offset: 39816},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1087, col: 5, offset: 30733},
+									pos:        position{line: 1325, col: 5, offset: 39816},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1087, col: 9, offset: 30737},
+									pos:  position{line: 1325, col: 9, offset: 39820},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1087, col: 12, offset: 30740},
+									pos:   position{line: 1325, col: 12, offset: 39823},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1087, col: 14, offset: 30742},
+										pos:  position{line: 1325, col: 14, offset: 39825},
 										name: "TypeUnion",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1087, col: 25, offset: 30753},
+									pos:        position{line: 1325, col: 25, offset: 39836},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -8316,15 +10986,15 @@ var g = &grammar{
 		},
 		{
 			name: "TypeUnion",
-			pos:  position{line: 1089, col: 1, offset: 30776},
+			pos:  position{line: 1327, col: 1, offset: 39859},
 			expr: &actionExpr{
-				pos: position{line: 1090, col: 5, offset: 30790},
+				pos: position{line: 1328, col: 5, offset: 39873},
 				run: (*parser).callonTypeUnion1,
 				expr: &labeledExpr{
-					pos:   position{line: 1090, col: 5, offset: 30790},
+					pos:   position{line: 1328, col: 5, offset: 39873},
 					label: "types",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1090, col: 11, offset: 30796},
+						pos:  position{line: 1328, col: 11, offset: 39879},
 						name: "TypeList",
 					},
 				},
@@ -8332,28 +11002,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeList",
-			pos:  position{line: 1094, col: 1, offset: 30892},
+			pos:  position{line: 1332, col: 1, offset: 39975},
 			expr: &actionExpr{
-				pos: position{line: 1095, col: 5, offset: 30905},
+				pos: position{line: 1333, col: 5, offset: 39988},
 				run: (*parser).callonTypeList1,
 				expr: &seqExpr{
-					pos: position{line: 1095, col: 5, offset: 30905},
+					pos: position{line: 1333, col: 5, offset: 39988},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1095, col: 5, offset: 30905},
+							pos:   position{line: 1333, col: 5, offset: 39988},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1095, col: 11, offset: 30911},
+								pos:  position{line: 1333, col: 11, offset: 39994},
 								name: "Type",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1095, col: 16, offset: 30916},
+							pos:   position{line: 1333, col: 16, offset: 39999},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1095, col: 21, offset: 30921},
+								pos: position{line: 1333, col: 21, offset: 40004},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1095, col: 21, offset: 30921},
+									pos:  position{line: 1333, col: 21, offset: 40004},
 									name: "TypeListTail",
 								},
 							},
@@ -8364,31 +11034,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeListTail",
-			pos:  position{line: 1099, col: 1, offset: 31015},
+			pos:  position{line: 1337, col: 1, offset: 40098},
 			expr: &actionExpr{
-				pos: position{line: 1099, col: 16, offset: 31030},
+				pos: position{line: 1337, col: 16, offset: 40113},
 				run: (*parser).callonTypeListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1099, col: 16, offset: 31030},
+					pos: position{line: 1337, col: 16, offset: 40113},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1099, col: 16, offset: 31030},
+							pos:  position{line: 1337, col: 16, offset: 40113},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1099, col: 19, offset: 31033},
+							pos:        position{line: 1337, col: 19, offset: 40116},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1099, col: 23, offset: 31037},
+							pos:  position{line: 1337, col: 23, offset: 40120},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1099, col: 26, offset: 31040},
+							pos:   position{line: 1337, col: 26, offset: 40123},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1099, col: 30, offset: 31044},
+								pos:  position{line: 1337, col: 30, offset: 40127},
 								name: "Type",
 							},
 						},
@@ -8398,39 +11068,39 @@ var g = &grammar{
 		},
 		{
 			name: "ComplexType",
-			pos:  position{line: 1101, col: 1, offset: 31070},
+			pos:  position{line: 1339, col: 1, offset: 40153},
 			expr: &choiceExpr{
-				pos: position{line: 1102, col: 5, offset: 31086},
+				pos: position{line: 1340, col: 5, offset: 40169},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1102, col: 5, offset: 31086},
+						pos: position{line: 1340, col: 5, offset: 40169},
 						run: (*parser).callonComplexType2,
 						expr: &seqExpr{
-							pos: position{line: 1102, col: 5, offset: 31086},
+							pos: position{line: 1340, col: 5, offset: 40169},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1102, col: 5, offset: 31086},
+									pos:        position{line: 1340, col: 5, offset: 40169},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1102, col: 9, offset: 31090},
+									pos:  position{line: 1340, col: 9, offset: 40173},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1102, col: 12, offset: 31093},
+									pos:   position{line: 1340, col: 12, offset: 40176},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1102, col: 19, offset: 31100},
+										pos:  position{line: 1340, col: 19, offset: 40183},
 										name: "TypeFieldList",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1102, col: 33, offset: 31114},
+									pos:  position{line: 1340, col: 33, offset: 40197},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1102, col: 36, offset: 31117},
+									pos:        position{line: 1340, col: 36, offset: 40200},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -8438,34 +11108,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1105, col: 5, offset: 31212},
+						pos: position{line: 1343, col: 5, offset: 40295},
 						run: (*parser).callonComplexType10,
 						expr: &seqExpr{
-							pos: position{line: 1105, col: 5, offset: 31212},
+							pos: position{line: 1343, col: 5, offset: 40295},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1105, col: 5, offset: 31212},
+									pos:        position{line: 1343, col: 5, offset: 40295},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 9, offset: 31216},
+									pos:  position{line: 1343, col: 9, offset: 40299},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1105, col: 12, offset: 31219},
+									pos:   position{line: 1343, col: 12, offset: 40302},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1105, col: 16, offset: 31223},
+										pos:  position{line: 1343, col: 16, offset: 40306},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 21, offset: 31228},
+									pos:  position{line: 1343, col: 21, offset: 40311},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1105, col: 24, offset: 31231},
+									pos:        position{line: 1343, col: 24, offset: 40314},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -8473,34 +11143,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1108, col: 5, offset: 31320},
+						pos: position{line: 1346, col: 5, offset: 40403},
 						run: (*parser).callonComplexType18,
 						expr: &seqExpr{
-							pos: position{line: 1108, col: 5, offset: 31320},
+							pos: position{line: 1346, col: 5, offset: 40403},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1108, col: 5, offset: 31320},
+									pos:        position{line: 1346, col: 5, offset: 40403},
 									val:        "|[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1108, col: 10, offset: 31325},
+									pos:  position{line: 1346, col: 10, offset: 40408},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1108, col: 14, offset: 31329},
+									pos:   position{line: 1346, col: 14, offset: 40412},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1108, col: 18, offset: 31333},
+										pos:  position{line: 1346, col: 18, offset: 40416},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1108, col: 23, offset: 31338},
+									pos:  position{line: 1346, col: 23, offset: 40421},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1108, col: 26, offset: 31341},
+									pos:        position{line: 1346, col: 26, offset: 40424},
 									val:        "]|",
 									ignoreCase: false,
 								},
@@ -8508,55 +11178,55 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1111, col: 5, offset: 31429},
+						pos: position{line: 1349, col: 5, offset: 40512},
 						run: (*parser).callonComplexType26,
 						expr: &seqExpr{
-							pos: position{line: 1111, col: 5, offset: 31429},
+							pos: position{line: 1349, col: 5, offset: 40512},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1111, col: 5, offset: 31429},
+									pos:        position{line: 1349, col: 5, offset: 40512},
 									val:        "|{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1111, col: 10, offset: 31434},
+									pos:  position{line: 1349, col: 10, offset: 40517},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1111, col: 13, offset: 31437},
+									pos:   position{line: 1349, col: 13, offset: 40520},
 									label: "keyType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1111, col: 21, offset: 31445},
+										pos:  position{line: 1349, col: 21, offset: 40528},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1111, col: 26, offset: 31450},
+									pos:  position{line: 1349, col: 26, offset: 40533},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1111, col: 29, offset: 31453},
+									pos:        position{line: 1349, col: 29, offset: 40536},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1111, col: 33, offset: 31457},
+									pos:  position{line: 1349, col: 33, offset: 40540},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1111, col: 36, offset: 31460},
+									pos:   position{line: 1349, col: 36, offset: 40543},
 									label: "valType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1111, col: 44, offset: 31468},
+										pos:  position{line: 1349, col: 44, offset: 40551},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1111, col: 49, offset: 31473},
+									pos:  position{line: 1349, col: 49, offset: 40556},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1111, col: 52, offset: 31476},
+									pos:        position{line: 1349, col: 52, offset: 40559},
 									val:        "}|",
 									ignoreCase: false,
 								},
@@ -8568,15 +11238,15 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateLiteral",
-			pos:  position{line: 1115, col: 1, offset: 31590},
+			pos:  position{line: 1353, col: 1, offset: 40673},
 			expr: &actionExpr{
-				pos: position{line: 1116, col: 5, offset: 31610},
+				pos: position{line: 1354, col: 5, offset: 40693},
 				run: (*parser).callonTemplateLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1116, col: 5, offset: 31610},
+					pos:   position{line: 1354, col: 5, offset: 40693},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1116, col: 7, offset: 31612},
+						pos:  position{line: 1354, col: 7, offset: 40695},
 						name: "TemplateLiteralParts",
 					},
 				},
@@ -8584,34 +11254,34 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateLiteralParts",
-			pos:  position{line: 1123, col: 1, offset: 31828},
+			pos:  position{line: 1361, col: 1, offset: 40911},
 			expr: &choiceExpr{
-				pos: position{line: 1124, col: 5, offset: 31853},
+				pos: position{line: 1362, col: 5, offset: 40936},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1124, col: 5, offset: 31853},
+						pos: position{line: 1362, col: 5, offset: 40936},
 						run: (*parser).callonTemplateLiteralParts2,
 						expr: &seqExpr{
-							pos: position{line: 1124, col: 5, offset: 31853},
+							pos: position{line: 1362, col: 5, offset: 40936},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1124, col: 5, offset: 31853},
+									pos:        position{line: 1362, col: 5, offset: 40936},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1124, col: 9, offset: 31857},
+									pos:   position{line: 1362, col: 9, offset: 40940},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1124, col: 11, offset: 31859},
+										pos: position{line: 1362, col: 11, offset: 40942},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1124, col: 11, offset: 31859},
+											pos:  position{line: 1362, col: 11, offset: 40942},
 											name: "TemplateDoubleQuotedPart",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1124, col: 37, offset: 31885},
+									pos:        position{line: 1362, col: 37, offset: 40968},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -8619,29 +11289,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1125, col: 5, offset: 31911},
+						pos: position{line: 1363, col: 5, offset: 40994},
 						run: (*parser).callonTemplateLiteralParts9,
 						expr: &seqExpr{
-							pos: position{line: 1125, col: 5, offset: 31911},
+							pos: position{line: 1363, col: 5, offset: 40994},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1125, col: 5, offset: 31911},
+									pos:        position{line: 1363, col: 5, offset: 40994},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1125, col: 9, offset: 31915},
+									pos:   position{line: 1363, col: 9, offset: 40998},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1125, col: 11, offset: 31917},
+										pos: position{line: 1363, col: 11, offset: 41000},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1125, col: 11, offset: 31917},
+											pos:  position{line: 1363, col: 11, offset: 41000},
 											name: "TemplateSingleQuotedPart",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1125, col: 37, offset: 31943},
+									pos:        position{line: 1363, col: 37, offset: 41026},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -8653,24 +11323,24 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateDoubleQuotedPart",
-			pos:  position{line: 1127, col: 1, offset: 31966},
+			pos:  position{line: 1365, col: 1, offset: 41049},
 			expr: &choiceExpr{
-				pos: position{line: 1128, col: 5, offset: 31995},
+				pos: position{line: 1366, col: 5, offset: 41078},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1128, col: 5, offset: 31995},
+						pos:  position{line: 1366, col: 5, offset: 41078},
 						name: "TemplateExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1129, col: 5, offset: 32012},
+						pos: position{line: 1367, col: 5, offset: 41095},
 						run: (*parser).callonTemplateDoubleQuotedPart3,
 						expr: &labeledExpr{
-							pos:   position{line: 1129, col: 5, offset: 32012},
+							pos:   position{line: 1367, col: 5, offset: 41095},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1129, col: 7, offset: 32014},
+								pos: position{line: 1367, col: 7, offset: 41097},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1129, col: 7, offset: 32014},
+									pos:  position{line: 1367, col: 7, offset: 41097},
 									name: "TemplateDoubleQuotedChar",
 								},
 							},
@@ -8681,26 +11351,26 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateDoubleQuotedChar",
-			pos:  position{line: 1133, col: 1, offset: 32151},
+			pos:  position{line: 1371, col: 1, offset: 41234},
 			expr: &choiceExpr{
-				pos: position{line: 1134, col: 5, offset: 32180},
+				pos: position{line: 1372, col: 5, offset: 41263},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1134, col: 5, offset: 32180},
+						pos: position{line: 1372, col: 5, offset: 41263},
 						run: (*parser).callonTemplateDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1134, col: 5, offset: 32180},
+							pos: position{line: 1372, col: 5, offset: 41263},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1134, col: 5, offset: 32180},
+									pos:        position{line: 1372, col: 5, offset: 41263},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1134, col: 10, offset: 32185},
+									pos:   position{line: 1372, col: 10, offset: 41268},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1134, col: 12, offset: 32187},
+										pos:        position{line: 1372, col: 12, offset: 41270},
 										val:        "${",
 										ignoreCase: false,
 									},
@@ -8709,24 +11379,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1135, col: 5, offset: 32214},
+						pos: position{line: 1373, col: 5, offset: 41297},
 						run: (*parser).callonTemplateDoubleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1135, col: 5, offset: 32214},
+							pos: position{line: 1373, col: 5, offset: 41297},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1135, col: 5, offset: 32214},
+									pos: position{line: 1373, col: 5, offset: 41297},
 									expr: &litMatcher{
-										pos:        position{line: 1135, col: 8, offset: 32217},
+										pos:        position{line: 1373, col: 8, offset: 41300},
 										val:        "${",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1135, col: 15, offset: 32224},
+									pos:   position{line: 1373, col: 15, offset: 41307},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1135, col: 17, offset: 32226},
+										pos:  position{line: 1373, col: 17, offset: 41309},
 										name: "DoubleQuotedChar",
 									},
 								},
@@ -8738,24 +11408,24 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateSingleQuotedPart",
-			pos:  position{line: 1137, col: 1, offset: 32262},
+			pos:  position{line: 1375, col: 1, offset: 41345},
 			expr: &choiceExpr{
-				pos: position{line: 1138, col: 5, offset: 32291},
+				pos: position{line: 1376, col: 5, offset: 41374},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1138, col: 5, offset: 32291},
+						pos:  position{line: 1376, col: 5, offset: 41374},
 						name: "TemplateExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1139, col: 5, offset: 32308},
+						pos: position{line: 1377, col: 5, offset: 41391},
 						run: (*parser).callonTemplateSingleQuotedPart3,
 						expr: &labeledExpr{
-							pos:   position{line: 1139, col: 5, offset: 32308},
+							pos:   position{line: 1377, col: 5, offset: 41391},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1139, col: 7, offset: 32310},
+								pos: position{line: 1377, col: 7, offset: 41393},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1139, col: 7, offset: 32310},
+									pos:  position{line: 1377, col: 7, offset: 41393},
 									name: "TemplateSingleQuotedChar",
 								},
 							},
@@ -8766,26 +11436,26 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateSingleQuotedChar",
-			pos:  position{line: 1143, col: 1, offset: 32447},
+			pos:  position{line: 1381, col: 1, offset: 41530},
 			expr: &choiceExpr{
-				pos: position{line: 1144, col: 5, offset: 32476},
+				pos: position{line: 1382, col: 5, offset: 41559},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1144, col: 5, offset: 32476},
+						pos: position{line: 1382, col: 5, offset: 41559},
 						run: (*parser).callonTemplateSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1144, col: 5, offset: 32476},
+							pos: position{line: 1382, col: 5, offset: 41559},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1144, col: 5, offset: 32476},
+									pos:        position{line: 1382, col: 5, offset: 41559},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1144, col: 10, offset: 32481},
+									pos:   position{line: 1382, col: 10, offset: 41564},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1144, col: 12, offset: 32483},
+										pos:        position{line: 1382, col: 12, offset: 41566},
 										val:        "${",
 										ignoreCase: false,
 									},
@@ -8794,24 +11464,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1145, col: 5, offset: 32510},
+						pos: position{line: 1383, col: 5, offset: 41593},
 						run: (*parser).callonTemplateSingleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1145, col: 5, offset: 32510},
+							pos: position{line: 1383, col: 5, offset: 41593},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1145, col: 5, offset: 32510},
+									pos: position{line: 1383, col: 5, offset: 41593},
 									expr: &litMatcher{
-										pos:        position{line: 1145, col: 8, offset: 32513},
+										pos:        position{line: 1383, col: 8, offset: 41596},
 										val:        "${",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1145, col: 15, offset: 32520},
+									pos:   position{line: 1383, col: 15, offset: 41603},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1145, col: 17, offset: 32522},
+										pos:  position{line: 1383, col: 17, offset: 41605},
 										name: "SingleQuotedChar",
 									},
 								},
@@ -8823,36 +11493,36 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateExpr",
-			pos:  position{line: 1147, col: 1, offset: 32558},
+			pos:  position{line: 1385, col: 1, offset: 41641},
 			expr: &actionExpr{
-				pos: position{line: 1148, col: 5, offset: 32575},
+				pos: position{line: 1386, col: 5, offset: 41658},
 				run: (*parser).callonTemplateExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1148, col: 5, offset: 32575},
+					pos: position{line: 1386, col: 5, offset: 41658},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1148, col: 5, offset: 32575},
+							pos:        position{line: 1386, col: 5, offset: 41658},
 							val:        "${",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1148, col: 10, offset: 32580},
+							pos:  position{line: 1386, col: 10, offset: 41663},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1148, col: 13, offset: 32583},
+							pos:   position{line: 1386, col: 13, offset: 41666},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1148, col: 15, offset: 32585},
+								pos:  position{line: 1386, col: 15, offset: 41668},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1148, col: 20, offset: 32590},
+							pos:  position{line: 1386, col: 20, offset: 41673},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1148, col: 23, offset: 32593},
+							pos:        position{line: 1386, col: 23, offset: 41676},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -8862,110 +11532,110 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 1163, col: 1, offset: 32889},
+			pos:  position{line: 1401, col: 1, offset: 41972},
 			expr: &actionExpr{
-				pos: position{line: 1164, col: 5, offset: 32907},
+				pos: position{line: 1402, col: 5, offset: 41990},
 				run: (*parser).callonPrimitiveType1,
 				expr: &choiceExpr{
-					pos: position{line: 1164, col: 9, offset: 32911},
+					pos: position{line: 1402, col: 9, offset: 41994},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1164, col: 9, offset: 32911},
+							pos:        position{line: 1402, col: 9, offset: 41994},
 							val:        "uint8",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1164, col: 19, offset: 32921},
+							pos:        position{line: 1402, col: 19, offset: 42004},
 							val:        "uint16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1164, col: 30, offset: 32932},
+							pos:        position{line: 1402, col: 30, offset: 42015},
 							val:        "uint32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1164, col: 41, offset: 32943},
+							pos:        position{line: 1402, col: 41, offset: 42026},
 							val:        "uint64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1165, col: 9, offset: 32960},
+							pos:        position{line: 1403, col: 9, offset: 42043},
 							val:        "int8",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1165, col: 18, offset: 32969},
+							pos:        position{line: 1403, col: 18, offset: 42052},
 							val:        "int16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1165, col: 28, offset: 32979},
+							pos:        position{line: 1403, col: 28, offset: 42062},
 							val:        "int32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1165, col: 38, offset: 32989},
+							pos:        position{line: 1403, col: 38, offset: 42072},
 							val:        "int64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1166, col: 9, offset: 33005},
+							pos:        position{line: 1404, col: 9, offset: 42088},
 							val:        "float16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1166, col: 21, offset: 33017},
+							pos:        position{line: 1404, col: 21, offset: 42100},
 							val:        "float32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1166, col: 33, offset: 33029},
+							pos:        position{line: 1404, col: 33, offset: 42112},
 							val:        "float64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1167, col: 9, offset: 33047},
+							pos:        position{line: 1405, col: 9, offset: 42130},
 							val:        "bool",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1167, col: 18, offset: 33056},
+							pos:        position{line: 1405, col: 18, offset: 42139},
 							val:        "string",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1168, col: 9, offset: 33073},
+							pos:        position{line: 1406, col: 9, offset: 42156},
 							val:        "duration",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1168, col: 22, offset: 33086},
+							pos:        position{line: 1406, col: 22, offset: 42169},
 							val:        "time",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1169, col: 9, offset: 33101},
+							pos:        position{line: 1407, col: 9, offset: 42184},
 							val:        "bytes",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1170, col: 9, offset: 33117},
+							pos:        position{line: 1408, col: 9, offset: 42200},
 							val:        "ip",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1170, col: 16, offset: 33124},
+							pos:        position{line: 1408, col: 16, offset: 42207},
 							val:        "net",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1171, col: 9, offset: 33138},
+							pos:        position{line: 1409, col: 9, offset: 42221},
 							val:        "type",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1171, col: 18, offset: 33147},
+							pos:        position{line: 1409, col: 18, offset: 42230},
 							val:        "null",
 							ignoreCase: false,
 						},
@@ -8975,31 +11645,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldList",
-			pos:  position{line: 1175, col: 1, offset: 33263},
+			pos:  position{line: 1413, col: 1, offset: 42346},
 			expr: &choiceExpr{
-				pos: position{line: 1176, col: 5, offset: 33281},
+				pos: position{line: 1414, col: 5, offset: 42364},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1176, col: 5, offset: 33281},
+						pos: position{line: 1414, col: 5, offset: 42364},
 						run: (*parser).callonTypeFieldList2,
 						expr: &seqExpr{
-							pos: position{line: 1176, col: 5, offset: 33281},
+							pos: position{line: 1414, col: 5, offset: 42364},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1176, col: 5, offset: 33281},
+									pos:   position{line: 1414, col: 5, offset: 42364},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1176, col: 11, offset: 33287},
+										pos:  position{line: 1414, col: 11, offset: 42370},
 										name: "TypeField",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1176, col: 21, offset: 33297},
+									pos:   position{line: 1414, col: 21, offset: 42380},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1176, col: 26, offset: 33302},
+										pos: position{line: 1414, col: 26, offset: 42385},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1176, col: 26, offset: 33302},
+											pos:  position{line: 1414, col: 26, offset: 42385},
 											name: "TypeFieldListTail",
 										},
 									},
@@ -9008,10 +11678,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1179, col: 5, offset: 33404},
+						pos: position{line: 1417, col: 5, offset: 42487},
 						run: (*parser).callonTypeFieldList9,
 						expr: &litMatcher{
-							pos:        position{line: 1179, col: 5, offset: 33404},
+							pos:        position{line: 1417, col: 5, offset: 42487},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -9021,31 +11691,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldListTail",
-			pos:  position{line: 1181, col: 1, offset: 33428},
+			pos:  position{line: 1419, col: 1, offset: 42511},
 			expr: &actionExpr{
-				pos: position{line: 1181, col: 21, offset: 33448},
+				pos: position{line: 1419, col: 21, offset: 42531},
 				run: (*parser).callonTypeFieldListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1181, col: 21, offset: 33448},
+					pos: position{line: 1419, col: 21, offset: 42531},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1181, col: 21, offset: 33448},
+							pos:  position{line: 1419, col: 21, offset: 42531},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1181, col: 24, offset: 33451},
+							pos:        position{line: 1419, col: 24, offset: 42534},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1181, col: 28, offset: 33455},
+							pos:  position{line: 1419, col: 28, offset: 42538},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1181, col: 31, offset: 33458},
+							pos:   position{line: 1419, col: 31, offset: 42541},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1181, col: 35, offset: 33462},
+								pos:  position{line: 1419, col: 35, offset: 42545},
 								name: "TypeField",
 							},
 						},
@@ -9055,39 +11725,39 @@ var g = &grammar{
 		},
 		{
 			name: "TypeField",
-			pos:  position{line: 1183, col: 1, offset: 33493},
+			pos:  position{line: 1421, col: 1, offset: 42576},
 			expr: &actionExpr{
-				pos: position{line: 1184, col: 5, offset: 33507},
+				pos: position{line: 1422, col: 5, offset: 42590},
 				run: (*parser).callonTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 1184, col: 5, offset: 33507},
+					pos: position{line: 1422, col: 5, offset: 42590},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1184, col: 5, offset: 33507},
+							pos:   position{line: 1422, col: 5, offset: 42590},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1184, col: 10, offset: 33512},
+								pos:  position{line: 1422, col: 10, offset: 42595},
 								name: "FieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1184, col: 20, offset: 33522},
+							pos:  position{line: 1422, col: 20, offset: 42605},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1184, col: 23, offset: 33525},
+							pos:        position{line: 1422, col: 23, offset: 42608},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1184, col: 27, offset: 33529},
+							pos:  position{line: 1422, col: 27, offset: 42612},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1184, col: 30, offset: 33532},
+							pos:   position{line: 1422, col: 30, offset: 42615},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1184, col: 34, offset: 33536},
+								pos:  position{line: 1422, col: 34, offset: 42619},
 								name: "Type",
 							},
 						},
@@ -9097,16 +11767,16 @@ var g = &grammar{
 		},
 		{
 			name: "FieldName",
-			pos:  position{line: 1188, col: 1, offset: 33618},
+			pos:  position{line: 1426, col: 1, offset: 42701},
 			expr: &choiceExpr{
-				pos: position{line: 1189, col: 5, offset: 33632},
+				pos: position{line: 1427, col: 5, offset: 42715},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1189, col: 5, offset: 33632},
+						pos:  position{line: 1427, col: 5, offset: 42715},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1190, col: 5, offset: 33651},
+						pos:  position{line: 1428, col: 5, offset: 42734},
 						name: "QuotedString",
 					},
 				},
@@ -9114,32 +11784,32 @@ var g = &grammar{
 		},
 		{
 			name: "AndToken",
-			pos:  position{line: 1192, col: 1, offset: 33665},
+			pos:  position{line: 1430, col: 1, offset: 42748},
 			expr: &actionExpr{
-				pos: position{line: 1192, col: 12, offset: 33676},
+				pos: position{line: 1430, col: 12, offset: 42759},
 				run: (*parser).callonAndToken1,
 				expr: &seqExpr{
-					pos: position{line: 1192, col: 12, offset: 33676},
+					pos: position{line: 1430, col: 12, offset: 42759},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 1192, col: 13, offset: 33677},
+							pos: position{line: 1430, col: 13, offset: 42760},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1192, col: 13, offset: 33677},
+									pos:        position{line: 1430, col: 13, offset: 42760},
 									val:        "and",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1192, col: 21, offset: 33685},
+									pos:        position{line: 1430, col: 21, offset: 42768},
 									val:        "AND",
 									ignoreCase: false,
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1192, col: 28, offset: 33692},
+							pos: position{line: 1430, col: 28, offset: 42775},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1192, col: 29, offset: 33693},
+								pos:  position{line: 1430, col: 29, offset: 42776},
 								name: "IdentifierRest",
 							},
 						},
@@ -9149,32 +11819,32 @@ var g = &grammar{
 		},
 		{
 			name: "OrToken",
-			pos:  position{line: 1193, col: 1, offset: 33730},
+			pos:  position{line: 1431, col: 1, offset: 42813},
 			expr: &actionExpr{
-				pos: position{line: 1193, col: 11, offset: 33740},
+				pos: position{line: 1431, col: 11, offset: 42823},
 				run: (*parser).callonOrToken1,
 				expr: &seqExpr{
-					pos: position{line: 1193, col: 11, offset: 33740},
+					pos: position{line: 1431, col: 11, offset: 42823},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 1193, col: 12, offset: 33741},
+							pos: position{line: 1431, col: 12, offset: 42824},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1193, col: 12, offset: 33741},
+									pos:        position{line: 1431, col: 12, offset: 42824},
 									val:        "or",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1193, col: 19, offset: 33748},
+									pos:        position{line: 1431, col: 19, offset: 42831},
 									val:        "OR",
 									ignoreCase: false,
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1193, col: 25, offset: 33754},
+							pos: position{line: 1431, col: 25, offset: 42837},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1193, col: 26, offset: 33755},
+								pos:  position{line: 1431, col: 26, offset: 42838},
 								name: "IdentifierRest",
 							},
 						},
@@ -9184,22 +11854,22 @@ var g = &grammar{
 		},
 		{
 			name: "InToken",
-			pos:  position{line: 1194, col: 1, offset: 33791},
+			pos:  position{line: 1432, col: 1, offset: 42874},
 			expr: &actionExpr{
-				pos: position{line: 1194, col: 11, offset: 33801},
+				pos: position{line: 1432, col: 11, offset: 42884},
 				run: (*parser).callonInToken1,
 				expr: &seqExpr{
-					pos: position{line: 1194, col: 11, offset: 33801},
+					pos: position{line: 1432, col: 11, offset: 42884},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1194, col: 11, offset: 33801},
+							pos:        position{line: 1432, col: 11, offset: 42884},
 							val:        "in",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1194, col: 16, offset: 33806},
+							pos: position{line: 1432, col: 16, offset: 42889},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1194, col: 17, offset: 33807},
+								pos:  position{line: 1432, col: 17, offset: 42890},
 								name: "IdentifierRest",
 							},
 						},
@@ -9209,32 +11879,32 @@ var g = &grammar{
 		},
 		{
 			name: "NotToken",
-			pos:  position{line: 1195, col: 1, offset: 33843},
+			pos:  position{line: 1433, col: 1, offset: 42926},
 			expr: &actionExpr{
-				pos: position{line: 1195, col: 12, offset: 33854},
+				pos: position{line: 1433, col: 12, offset: 42937},
 				run: (*parser).callonNotToken1,
 				expr: &seqExpr{
-					pos: position{line: 1195, col: 12, offset: 33854},
+					pos: position{line: 1433, col: 12, offset: 42937},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 1195, col: 13, offset: 33855},
+							pos: position{line: 1433, col: 13, offset: 42938},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1195, col: 13, offset: 33855},
+									pos:        position{line: 1433, col: 13, offset: 42938},
 									val:        "not",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1195, col: 21, offset: 33863},
+									pos:        position{line: 1433, col: 21, offset: 42946},
 									val:        "NOT",
 									ignoreCase: false,
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1195, col: 28, offset: 33870},
+							pos: position{line: 1433, col: 28, offset: 42953},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1195, col: 29, offset: 33871},
+								pos:  position{line: 1433, col: 29, offset: 42954},
 								name: "IdentifierRest",
 							},
 						},
@@ -9244,22 +11914,22 @@ var g = &grammar{
 		},
 		{
 			name: "ByToken",
-			pos:  position{line: 1196, col: 1, offset: 33908},
+			pos:  position{line: 1434, col: 1, offset: 42991},
 			expr: &actionExpr{
-				pos: position{line: 1196, col: 11, offset: 33918},
+				pos: position{line: 1434, col: 11, offset: 43001},
 				run: (*parser).callonByToken1,
 				expr: &seqExpr{
-					pos: position{line: 1196, col: 11, offset: 33918},
+					pos: position{line: 1434, col: 11, offset: 43001},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1196, col: 11, offset: 33918},
+							pos:        position{line: 1434, col: 11, offset: 43001},
 							val:        "by",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1196, col: 16, offset: 33923},
+							pos: position{line: 1434, col: 16, offset: 43006},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1196, col: 17, offset: 33924},
+								pos:  position{line: 1434, col: 17, offset: 43007},
 								name: "IdentifierRest",
 							},
 						},
@@ -9269,9 +11939,9 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 1198, col: 1, offset: 33961},
+			pos:  position{line: 1436, col: 1, offset: 43044},
 			expr: &charClassMatcher{
-				pos:        position{line: 1198, col: 19, offset: 33979},
+				pos:        position{line: 1436, col: 19, offset: 43062},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -9281,16 +11951,16 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierRest",
-			pos:  position{line: 1200, col: 1, offset: 33991},
+			pos:  position{line: 1438, col: 1, offset: 43074},
 			expr: &choiceExpr{
-				pos: position{line: 1200, col: 18, offset: 34008},
+				pos: position{line: 1438, col: 18, offset: 43091},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1200, col: 18, offset: 34008},
+						pos:  position{line: 1438, col: 18, offset: 43091},
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1200, col: 36, offset: 34026},
+						pos:        position{line: 1438, col: 36, offset: 43109},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -9301,15 +11971,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 1202, col: 1, offset: 34033},
+			pos:  position{line: 1440, col: 1, offset: 43116},
 			expr: &actionExpr{
-				pos: position{line: 1203, col: 5, offset: 34048},
+				pos: position{line: 1441, col: 5, offset: 43131},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 1203, col: 5, offset: 34048},
+					pos:   position{line: 1441, col: 5, offset: 43131},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1203, col: 8, offset: 34051},
+						pos:  position{line: 1441, col: 8, offset: 43134},
 						name: "IdentifierName",
 					},
 				},
@@ -9317,29 +11987,29 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 1205, col: 1, offset: 34132},
+			pos:  position{line: 1443, col: 1, offset: 43215},
 			expr: &choiceExpr{
-				pos: position{line: 1206, col: 5, offset: 34151},
+				pos: position{line: 1444, col: 5, offset: 43234},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1206, col: 5, offset: 34151},
+						pos: position{line: 1444, col: 5, offset: 43234},
 						run: (*parser).callonIdentifierName2,
 						expr: &seqExpr{
-							pos: position{line: 1206, col: 5, offset: 34151},
+							pos: position{line: 1444, col: 5, offset: 43234},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1206, col: 5, offset: 34151},
+									pos: position{line: 1444, col: 5, offset: 43234},
 									expr: &seqExpr{
-										pos: position{line: 1206, col: 7, offset: 34153},
+										pos: position{line: 1444, col: 7, offset: 43236},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1206, col: 7, offset: 34153},
+												pos:  position{line: 1444, col: 7, offset: 43236},
 												name: "IDGuard",
 											},
 											&notExpr{
-												pos: position{line: 1206, col: 15, offset: 34161},
+												pos: position{line: 1444, col: 15, offset: 43244},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1206, col: 16, offset: 34162},
+													pos:  position{line: 1444, col: 16, offset: 43245},
 													name: "IdentifierRest",
 												},
 											},
@@ -9347,13 +12017,13 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1206, col: 32, offset: 34178},
+									pos:  position{line: 1444, col: 32, offset: 43261},
 									name: "IdentifierStart",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1206, col: 48, offset: 34194},
+									pos: position{line: 1444, col: 48, offset: 43277},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1206, col: 48, offset: 34194},
+										pos:  position{line: 1444, col: 48, offset: 43277},
 										name: "IdentifierRest",
 									},
 								},
@@ -9361,30 +12031,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1207, col: 5, offset: 34246},
+						pos: position{line: 1445, col: 5, offset: 43329},
 						run: (*parser).callonIdentifierName12,
 						expr: &litMatcher{
-							pos:        position{line: 1207, col: 5, offset: 34246},
+							pos:        position{line: 1445, col: 5, offset: 43329},
 							val:        "$",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1208, col: 5, offset: 34285},
+						pos: position{line: 1446, col: 5, offset: 43368},
 						run: (*parser).callonIdentifierName14,
 						expr: &seqExpr{
-							pos: position{line: 1208, col: 5, offset: 34285},
+							pos: position{line: 1446, col: 5, offset: 43368},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1208, col: 5, offset: 34285},
+									pos:        position{line: 1446, col: 5, offset: 43368},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1208, col: 10, offset: 34290},
+									pos:   position{line: 1446, col: 10, offset: 43373},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1208, col: 13, offset: 34293},
+										pos:  position{line: 1446, col: 13, offset: 43376},
 										name: "IDGuard",
 									},
 								},
@@ -9392,39 +12062,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1210, col: 5, offset: 34384},
+						pos: position{line: 1448, col: 5, offset: 43467},
 						run: (*parser).callonIdentifierName19,
 						expr: &litMatcher{
-							pos:        position{line: 1210, col: 5, offset: 34384},
+							pos:        position{line: 1448, col: 5, offset: 43467},
 							val:        "type",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1211, col: 5, offset: 34426},
+						pos: position{line: 1449, col: 5, offset: 43509},
 						run: (*parser).callonIdentifierName21,
 						expr: &seqExpr{
-							pos: position{line: 1211, col: 5, offset: 34426},
+							pos: position{line: 1449, col: 5, offset: 43509},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1211, col: 5, offset: 34426},
+									pos:   position{line: 1449, col: 5, offset: 43509},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1211, col: 8, offset: 34429},
+										pos:  position{line: 1449, col: 8, offset: 43512},
 										name: "SQLTokenSentinels",
 									},
 								},
 								&andExpr{
-									pos: position{line: 1211, col: 26, offset: 34447},
+									pos: position{line: 1449, col: 26, offset: 43530},
 									expr: &seqExpr{
-										pos: position{line: 1211, col: 28, offset: 34449},
+										pos: position{line: 1449, col: 28, offset: 43532},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1211, col: 28, offset: 34449},
+												pos:  position{line: 1449, col: 28, offset: 43532},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1211, col: 31, offset: 34452},
+												pos:        position{line: 1449, col: 31, offset: 43535},
 												val:        "(",
 												ignoreCase: false,
 											},
@@ -9439,50 +12109,50 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierNames",
-			pos:  position{line: 1213, col: 1, offset: 34477},
+			pos:  position{line: 1451, col: 1, offset: 43560},
 			expr: &actionExpr{
-				pos: position{line: 1214, col: 5, offset: 34497},
+				pos: position{line: 1452, col: 5, offset: 43580},
 				run: (*parser).callonIdentifierNames1,
 				expr: &seqExpr{
-					pos: position{line: 1214, col: 5, offset: 34497},
+					pos: position{line: 1452, col: 5, offset: 43580},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1214, col: 5, offset: 34497},
+							pos:   position{line: 1452, col: 5, offset: 43580},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1214, col: 11, offset: 34503},
+								pos:  position{line: 1452, col: 11, offset: 43586},
 								name: "IdentifierName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1214, col: 26, offset: 34518},
+							pos:   position{line: 1452, col: 26, offset: 43601},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1214, col: 31, offset: 34523},
+								pos: position{line: 1452, col: 31, offset: 43606},
 								expr: &actionExpr{
-									pos: position{line: 1214, col: 32, offset: 34524},
+									pos: position{line: 1452, col: 32, offset: 43607},
 									run: (*parser).callonIdentifierNames7,
 									expr: &seqExpr{
-										pos: position{line: 1214, col: 32, offset: 34524},
+										pos: position{line: 1452, col: 32, offset: 43607},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1214, col: 32, offset: 34524},
+												pos:  position{line: 1452, col: 32, offset: 43607},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1214, col: 35, offset: 34527},
+												pos:        position{line: 1452, col: 35, offset: 43610},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1214, col: 39, offset: 34531},
+												pos:  position{line: 1452, col: 39, offset: 43614},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1214, col: 42, offset: 34534},
+												pos:   position{line: 1452, col: 42, offset: 43617},
 												label: "id",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1214, col: 45, offset: 34537},
+													pos:  position{line: 1452, col: 45, offset: 43620},
 													name: "IdentifierName",
 												},
 											},
@@ -9497,24 +12167,24 @@ var g = &grammar{
 		},
 		{
 			name: "IDGuard",
-			pos:  position{line: 1218, col: 1, offset: 34652},
+			pos:  position{line: 1456, col: 1, offset: 43735},
 			expr: &choiceExpr{
-				pos: position{line: 1219, col: 5, offset: 34664},
+				pos: position{line: 1457, col: 5, offset: 43747},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1219, col: 5, offset: 34664},
+						pos:  position{line: 1457, col: 5, offset: 43747},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1220, col: 5, offset: 34683},
+						pos:  position{line: 1458, col: 5, offset: 43766},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1221, col: 5, offset: 34699},
+						pos:  position{line: 1459, col: 5, offset: 43782},
 						name: "NaN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1222, col: 5, offset: 34707},
+						pos:  position{line: 1460, col: 5, offset: 43790},
 						name: "Infinity",
 					},
 				},
@@ -9522,24 +12192,24 @@ var g = &grammar{
 		},
 		{
 			name: "Time",
-			pos:  position{line: 1224, col: 1, offset: 34717},
+			pos:  position{line: 1462, col: 1, offset: 43800},
 			expr: &actionExpr{
-				pos: position{line: 1225, col: 5, offset: 34726},
+				pos: position{line: 1463, col: 5, offset: 43809},
 				run: (*parser).callonTime1,
 				expr: &seqExpr{
-					pos: position{line: 1225, col: 5, offset: 34726},
+					pos: position{line: 1463, col: 5, offset: 43809},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1225, col: 5, offset: 34726},
+							pos:  position{line: 1463, col: 5, offset: 43809},
 							name: "FullDate",
 						},
 						&litMatcher{
-							pos:        position{line: 1225, col: 14, offset: 34735},
+							pos:        position{line: 1463, col: 14, offset: 43818},
 							val:        "T",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1225, col: 18, offset: 34739},
+							pos:  position{line: 1463, col: 18, offset: 43822},
 							name: "FullTime",
 						},
 					},
@@ -9548,30 +12218,30 @@ var g = &grammar{
 		},
 		{
 			name: "FullDate",
-			pos:  position{line: 1229, col: 1, offset: 34859},
+			pos:  position{line: 1467, col: 1, offset: 43942},
 			expr: &seqExpr{
-				pos: position{line: 1229, col: 12, offset: 34870},
+				pos: position{line: 1467, col: 12, offset: 43953},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1229, col: 12, offset: 34870},
+						pos:  position{line: 1467, col: 12, offset: 43953},
 						name: "D4",
 					},
 					&litMatcher{
-						pos:        position{line: 1229, col: 15, offset: 34873},
+						pos:        position{line: 1467, col: 15, offset: 43956},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1229, col: 19, offset: 34877},
+						pos:  position{line: 1467, col: 19, offset: 43960},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1229, col: 22, offset: 34880},
+						pos:        position{line: 1467, col: 22, offset: 43963},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1229, col: 26, offset: 34884},
+						pos:  position{line: 1467, col: 26, offset: 43967},
 						name: "D2",
 					},
 				},
@@ -9579,33 +12249,33 @@ var g = &grammar{
 		},
 		{
 			name: "D4",
-			pos:  position{line: 1231, col: 1, offset: 34888},
+			pos:  position{line: 1469, col: 1, offset: 43971},
 			expr: &seqExpr{
-				pos: position{line: 1231, col: 6, offset: 34893},
+				pos: position{line: 1469, col: 6, offset: 43976},
 				exprs: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1231, col: 6, offset: 34893},
+						pos:        position{line: 1469, col: 6, offset: 43976},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1231, col: 11, offset: 34898},
+						pos:        position{line: 1469, col: 11, offset: 43981},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1231, col: 16, offset: 34903},
+						pos:        position{line: 1469, col: 16, offset: 43986},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1231, col: 21, offset: 34908},
+						pos:        position{line: 1469, col: 21, offset: 43991},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -9616,19 +12286,19 @@ var g = &grammar{
 		},
 		{
 			name: "D2",
-			pos:  position{line: 1232, col: 1, offset: 34914},
+			pos:  position{line: 1470, col: 1, offset: 43997},
 			expr: &seqExpr{
-				pos: position{line: 1232, col: 6, offset: 34919},
+				pos: position{line: 1470, col: 6, offset: 44002},
 				exprs: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1232, col: 6, offset: 34919},
+						pos:        position{line: 1470, col: 6, offset: 44002},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1232, col: 11, offset: 34924},
+						pos:        position{line: 1470, col: 11, offset: 44007},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -9639,16 +12309,16 @@ var g = &grammar{
 		},
 		{
 			name: "FullTime",
-			pos:  position{line: 1234, col: 1, offset: 34931},
+			pos:  position{line: 1472, col: 1, offset: 44014},
 			expr: &seqExpr{
-				pos: position{line: 1234, col: 12, offset: 34942},
+				pos: position{line: 1472, col: 12, offset: 44025},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1234, col: 12, offset: 34942},
+						pos:  position{line: 1472, col: 12, offset: 44025},
 						name: "PartialTime",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1234, col: 24, offset: 34954},
+						pos:  position{line: 1472, col: 24, offset: 44037},
 						name: "TimeOffset",
 					},
 				},
@@ -9656,46 +12326,46 @@ var g = &grammar{
 		},
 		{
 			name: "PartialTime",
-			pos:  position{line: 1236, col: 1, offset: 34966},
+			pos:  position{line: 1474, col: 1, offset: 44049},
 			expr: &seqExpr{
-				pos: position{line: 1236, col: 15, offset: 34980},
+				pos: position{line: 1474, col: 15, offset: 44063},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1236, col: 15, offset: 34980},
+						pos:  position{line: 1474, col: 15, offset: 44063},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1236, col: 18, offset: 34983},
+						pos:        position{line: 1474, col: 18, offset: 44066},
 						val:        ":",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1236, col: 22, offset: 34987},
+						pos:  position{line: 1474, col: 22, offset: 44070},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1236, col: 25, offset: 34990},
+						pos:        position{line: 1474, col: 25, offset: 44073},
 						val:        ":",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1236, col: 29, offset: 34994},
+						pos:  position{line: 1474, col: 29, offset: 44077},
 						name: "D2",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1236, col: 32, offset: 34997},
+						pos: position{line: 1474, col: 32, offset: 44080},
 						expr: &seqExpr{
-							pos: position{line: 1236, col: 33, offset: 34998},
+							pos: position{line: 1474, col: 33, offset: 44081},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1236, col: 33, offset: 34998},
+									pos:        position{line: 1474, col: 33, offset: 44081},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1236, col: 37, offset: 35002},
+									pos: position{line: 1474, col: 37, offset: 44085},
 									expr: &charClassMatcher{
-										pos:        position{line: 1236, col: 37, offset: 35002},
+										pos:        position{line: 1474, col: 37, offset: 44085},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -9710,60 +12380,60 @@ var g = &grammar{
 		},
 		{
 			name: "TimeOffset",
-			pos:  position{line: 1238, col: 1, offset: 35012},
+			pos:  position{line: 1476, col: 1, offset: 44095},
 			expr: &choiceExpr{
-				pos: position{line: 1239, col: 5, offset: 35027},
+				pos: position{line: 1477, col: 5, offset: 44110},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1239, col: 5, offset: 35027},
+						pos:        position{line: 1477, col: 5, offset: 44110},
 						val:        "Z",
 						ignoreCase: false,
 					},
 					&seqExpr{
-						pos: position{line: 1240, col: 5, offset: 35035},
+						pos: position{line: 1478, col: 5, offset: 44118},
 						exprs: []interface{}{
 							&choiceExpr{
-								pos: position{line: 1240, col: 6, offset: 35036},
+								pos: position{line: 1478, col: 6, offset: 44119},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 1240, col: 6, offset: 35036},
+										pos:        position{line: 1478, col: 6, offset: 44119},
 										val:        "+",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 1240, col: 12, offset: 35042},
+										pos:        position{line: 1478, col: 12, offset: 44125},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1240, col: 17, offset: 35047},
+								pos:  position{line: 1478, col: 17, offset: 44130},
 								name: "D2",
 							},
 							&litMatcher{
-								pos:        position{line: 1240, col: 20, offset: 35050},
+								pos:        position{line: 1478, col: 20, offset: 44133},
 								val:        ":",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1240, col: 24, offset: 35054},
+								pos:  position{line: 1478, col: 24, offset: 44137},
 								name: "D2",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1240, col: 27, offset: 35057},
+								pos: position{line: 1478, col: 27, offset: 44140},
 								expr: &seqExpr{
-									pos: position{line: 1240, col: 28, offset: 35058},
+									pos: position{line: 1478, col: 28, offset: 44141},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1240, col: 28, offset: 35058},
+											pos:        position{line: 1478, col: 28, offset: 44141},
 											val:        ".",
 											ignoreCase: false,
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1240, col: 32, offset: 35062},
+											pos: position{line: 1478, col: 32, offset: 44145},
 											expr: &charClassMatcher{
-												pos:        position{line: 1240, col: 32, offset: 35062},
+												pos:        position{line: 1478, col: 32, offset: 44145},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -9780,32 +12450,32 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 1242, col: 1, offset: 35072},
+			pos:  position{line: 1480, col: 1, offset: 44155},
 			expr: &actionExpr{
-				pos: position{line: 1243, col: 5, offset: 35085},
+				pos: position{line: 1481, col: 5, offset: 44168},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 1243, col: 5, offset: 35085},
+					pos: position{line: 1481, col: 5, offset: 44168},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1243, col: 5, offset: 35085},
+							pos: position{line: 1481, col: 5, offset: 44168},
 							expr: &litMatcher{
-								pos:        position{line: 1243, col: 5, offset: 35085},
+								pos:        position{line: 1481, col: 5, offset: 44168},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1243, col: 10, offset: 35090},
+							pos: position{line: 1481, col: 10, offset: 44173},
 							expr: &seqExpr{
-								pos: position{line: 1243, col: 11, offset: 35091},
+								pos: position{line: 1481, col: 11, offset: 44174},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1243, col: 11, offset: 35091},
+										pos:  position{line: 1481, col: 11, offset: 44174},
 										name: "Decimal",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1243, col: 19, offset: 35099},
+										pos:  position{line: 1481, col: 19, offset: 44182},
 										name: "TimeUnit",
 									},
 								},
@@ -9817,26 +12487,26 @@ var g = &grammar{
 		},
 		{
 			name: "Decimal",
-			pos:  position{line: 1247, col: 1, offset: 35225},
+			pos:  position{line: 1485, col: 1, offset: 44308},
 			expr: &seqExpr{
-				pos: position{line: 1247, col: 11, offset: 35235},
+				pos: position{line: 1485, col: 11, offset: 44318},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 11, offset: 35235},
+						pos:  position{line: 1485, col: 11, offset: 44318},
 						name: "UInt",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1247, col: 16, offset: 35240},
+						pos: position{line: 1485, col: 16, offset: 44323},
 						expr: &seqExpr{
-							pos: position{line: 1247, col: 17, offset: 35241},
+							pos: position{line: 1485, col: 17, offset: 44324},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1247, col: 17, offset: 35241},
+									pos:        position{line: 1485, col: 17, offset: 44324},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1247, col: 21, offset: 35245},
+									pos:  position{line: 1485, col: 21, offset: 44328},
 									name: "UInt",
 								},
 							},
@@ -9847,52 +12517,52 @@ var g = &grammar{
 		},
 		{
 			name: "TimeUnit",
-			pos:  position{line: 1249, col: 1, offset: 35253},
+			pos:  position{line: 1487, col: 1, offset: 44336},
 			expr: &choiceExpr{
-				pos: position{line: 1250, col: 5, offset: 35266},
+				pos: position{line: 1488, col: 5, offset: 44349},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1250, col: 5, offset: 35266},
+						pos:        position{line: 1488, col: 5, offset: 44349},
 						val:        "ns",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1251, col: 5, offset: 35275},
+						pos:        position{line: 1489, col: 5, offset: 44358},
 						val:        "us",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1252, col: 5, offset: 35284},
+						pos:        position{line: 1490, col: 5, offset: 44367},
 						val:        "ms",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1253, col: 5, offset: 35293},
+						pos:        position{line: 1491, col: 5, offset: 44376},
 						val:        "s",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1254, col: 5, offset: 35301},
+						pos:        position{line: 1492, col: 5, offset: 44384},
 						val:        "m",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1255, col: 5, offset: 35309},
+						pos:        position{line: 1493, col: 5, offset: 44392},
 						val:        "h",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1256, col: 5, offset: 35317},
+						pos:        position{line: 1494, col: 5, offset: 44400},
 						val:        "d",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1257, col: 5, offset: 35325},
+						pos:        position{line: 1495, col: 5, offset: 44408},
 						val:        "w",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1258, col: 5, offset: 35333},
+						pos:        position{line: 1496, col: 5, offset: 44416},
 						val:        "y",
 						ignoreCase: false,
 					},
@@ -9901,42 +12571,42 @@ var g = &grammar{
 		},
 		{
 			name: "IP",
-			pos:  position{line: 1260, col: 1, offset: 35338},
+			pos:  position{line: 1498, col: 1, offset: 44421},
 			expr: &actionExpr{
-				pos: position{line: 1261, col: 5, offset: 35345},
+				pos: position{line: 1499, col: 5, offset: 44428},
 				run: (*parser).callonIP1,
 				expr: &seqExpr{
-					pos: position{line: 1261, col: 5, offset: 35345},
+					pos: position{line: 1499, col: 5, offset: 44428},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1261, col: 5, offset: 35345},
+							pos:  position{line: 1499, col: 5, offset: 44428},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1261, col: 10, offset: 35350},
+							pos:        position{line: 1499, col: 10, offset: 44433},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1261, col: 14, offset: 35354},
+							pos:  position{line: 1499, col: 14, offset: 44437},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1261, col: 19, offset: 35359},
+							pos:        position{line: 1499, col: 19, offset: 44442},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1261, col: 23, offset: 35363},
+							pos:  position{line: 1499, col: 23, offset: 44446},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1261, col: 28, offset: 35368},
+							pos:        position{line: 1499, col: 28, offset: 44451},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1261, col: 32, offset: 35372},
+							pos:  position{line: 1499, col: 32, offset: 44455},
 							name: "UInt",
 						},
 					},
@@ -9945,42 +12615,42 @@ var g = &grammar{
 		},
 		{
 			name: "IP6",
-			pos:  position{line: 1263, col: 1, offset: 35409},
+			pos:  position{line: 1501, col: 1, offset: 44492},
 			expr: &actionExpr{
-				pos: position{line: 1264, col: 5, offset: 35417},
+				pos: position{line: 1502, col: 5, offset: 44500},
 				run: (*parser).callonIP61,
 				expr: &seqExpr{
-					pos: position{line: 1264, col: 5, offset: 35417},
+					pos: position{line: 1502, col: 5, offset: 44500},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1264, col: 5, offset: 35417},
+							pos: position{line: 1502, col: 5, offset: 44500},
 							expr: &seqExpr{
-								pos: position{line: 1264, col: 8, offset: 35420},
+								pos: position{line: 1502, col: 8, offset: 44503},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1264, col: 8, offset: 35420},
+										pos:  position{line: 1502, col: 8, offset: 44503},
 										name: "Hex",
 									},
 									&litMatcher{
-										pos:        position{line: 1264, col: 12, offset: 35424},
+										pos:        position{line: 1502, col: 12, offset: 44507},
 										val:        ":",
 										ignoreCase: false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1264, col: 16, offset: 35428},
+										pos:  position{line: 1502, col: 16, offset: 44511},
 										name: "Hex",
 									},
 									&notExpr{
-										pos: position{line: 1264, col: 20, offset: 35432},
+										pos: position{line: 1502, col: 20, offset: 44515},
 										expr: &choiceExpr{
-											pos: position{line: 1264, col: 22, offset: 35434},
+											pos: position{line: 1502, col: 22, offset: 44517},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1264, col: 22, offset: 35434},
+													pos:  position{line: 1502, col: 22, offset: 44517},
 													name: "HexDigit",
 												},
 												&litMatcher{
-													pos:        position{line: 1264, col: 33, offset: 35445},
+													pos:        position{line: 1502, col: 33, offset: 44528},
 													val:        ":",
 													ignoreCase: false,
 												},
@@ -9991,10 +12661,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1264, col: 39, offset: 35451},
+							pos:   position{line: 1502, col: 39, offset: 44534},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1264, col: 41, offset: 35453},
+								pos:  position{line: 1502, col: 41, offset: 44536},
 								name: "IP6Variations",
 							},
 						},
@@ -10004,32 +12674,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Variations",
-			pos:  position{line: 1268, col: 1, offset: 35617},
+			pos:  position{line: 1506, col: 1, offset: 44700},
 			expr: &choiceExpr{
-				pos: position{line: 1269, col: 5, offset: 35635},
+				pos: position{line: 1507, col: 5, offset: 44718},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1269, col: 5, offset: 35635},
+						pos: position{line: 1507, col: 5, offset: 44718},
 						run: (*parser).callonIP6Variations2,
 						expr: &seqExpr{
-							pos: position{line: 1269, col: 5, offset: 35635},
+							pos: position{line: 1507, col: 5, offset: 44718},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1269, col: 5, offset: 35635},
+									pos:   position{line: 1507, col: 5, offset: 44718},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1269, col: 7, offset: 35637},
+										pos: position{line: 1507, col: 7, offset: 44720},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1269, col: 7, offset: 35637},
+											pos:  position{line: 1507, col: 7, offset: 44720},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1269, col: 17, offset: 35647},
+									pos:   position{line: 1507, col: 17, offset: 44730},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1269, col: 19, offset: 35649},
+										pos:  position{line: 1507, col: 19, offset: 44732},
 										name: "IP6Tail",
 									},
 								},
@@ -10037,51 +12707,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1272, col: 5, offset: 35713},
+						pos: position{line: 1510, col: 5, offset: 44796},
 						run: (*parser).callonIP6Variations9,
 						expr: &seqExpr{
-							pos: position{line: 1272, col: 5, offset: 35713},
+							pos: position{line: 1510, col: 5, offset: 44796},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1272, col: 5, offset: 35713},
+									pos:   position{line: 1510, col: 5, offset: 44796},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1272, col: 7, offset: 35715},
+										pos:  position{line: 1510, col: 7, offset: 44798},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1272, col: 11, offset: 35719},
+									pos:   position{line: 1510, col: 11, offset: 44802},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1272, col: 13, offset: 35721},
+										pos: position{line: 1510, col: 13, offset: 44804},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1272, col: 13, offset: 35721},
+											pos:  position{line: 1510, col: 13, offset: 44804},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1272, col: 23, offset: 35731},
+									pos:        position{line: 1510, col: 23, offset: 44814},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1272, col: 28, offset: 35736},
+									pos:   position{line: 1510, col: 28, offset: 44819},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1272, col: 30, offset: 35738},
+										pos: position{line: 1510, col: 30, offset: 44821},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1272, col: 30, offset: 35738},
+											pos:  position{line: 1510, col: 30, offset: 44821},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1272, col: 40, offset: 35748},
+									pos:   position{line: 1510, col: 40, offset: 44831},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1272, col: 42, offset: 35750},
+										pos:  position{line: 1510, col: 42, offset: 44833},
 										name: "IP6Tail",
 									},
 								},
@@ -10089,32 +12759,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1275, col: 5, offset: 35849},
+						pos: position{line: 1513, col: 5, offset: 44932},
 						run: (*parser).callonIP6Variations22,
 						expr: &seqExpr{
-							pos: position{line: 1275, col: 5, offset: 35849},
+							pos: position{line: 1513, col: 5, offset: 44932},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1275, col: 5, offset: 35849},
+									pos:        position{line: 1513, col: 5, offset: 44932},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1275, col: 10, offset: 35854},
+									pos:   position{line: 1513, col: 10, offset: 44937},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1275, col: 12, offset: 35856},
+										pos: position{line: 1513, col: 12, offset: 44939},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1275, col: 12, offset: 35856},
+											pos:  position{line: 1513, col: 12, offset: 44939},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1275, col: 22, offset: 35866},
+									pos:   position{line: 1513, col: 22, offset: 44949},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1275, col: 24, offset: 35868},
+										pos:  position{line: 1513, col: 24, offset: 44951},
 										name: "IP6Tail",
 									},
 								},
@@ -10122,32 +12792,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1278, col: 5, offset: 35939},
+						pos: position{line: 1516, col: 5, offset: 45022},
 						run: (*parser).callonIP6Variations30,
 						expr: &seqExpr{
-							pos: position{line: 1278, col: 5, offset: 35939},
+							pos: position{line: 1516, col: 5, offset: 45022},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1278, col: 5, offset: 35939},
+									pos:   position{line: 1516, col: 5, offset: 45022},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1278, col: 7, offset: 35941},
+										pos:  position{line: 1516, col: 7, offset: 45024},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1278, col: 11, offset: 35945},
+									pos:   position{line: 1516, col: 11, offset: 45028},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1278, col: 13, offset: 35947},
+										pos: position{line: 1516, col: 13, offset: 45030},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1278, col: 13, offset: 35947},
+											pos:  position{line: 1516, col: 13, offset: 45030},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1278, col: 23, offset: 35957},
+									pos:        position{line: 1516, col: 23, offset: 45040},
 									val:        "::",
 									ignoreCase: false,
 								},
@@ -10155,10 +12825,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1281, col: 5, offset: 36025},
+						pos: position{line: 1519, col: 5, offset: 45108},
 						run: (*parser).callonIP6Variations38,
 						expr: &litMatcher{
-							pos:        position{line: 1281, col: 5, offset: 36025},
+							pos:        position{line: 1519, col: 5, offset: 45108},
 							val:        "::",
 							ignoreCase: false,
 						},
@@ -10168,16 +12838,16 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Tail",
-			pos:  position{line: 1285, col: 1, offset: 36062},
+			pos:  position{line: 1523, col: 1, offset: 45145},
 			expr: &choiceExpr{
-				pos: position{line: 1286, col: 5, offset: 36074},
+				pos: position{line: 1524, col: 5, offset: 45157},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1286, col: 5, offset: 36074},
+						pos:  position{line: 1524, col: 5, offset: 45157},
 						name: "IP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1287, col: 5, offset: 36081},
+						pos:  position{line: 1525, col: 5, offset: 45164},
 						name: "Hex",
 					},
 				},
@@ -10185,23 +12855,23 @@ var g = &grammar{
 		},
 		{
 			name: "ColonHex",
-			pos:  position{line: 1289, col: 1, offset: 36086},
+			pos:  position{line: 1527, col: 1, offset: 45169},
 			expr: &actionExpr{
-				pos: position{line: 1289, col: 12, offset: 36097},
+				pos: position{line: 1527, col: 12, offset: 45180},
 				run: (*parser).callonColonHex1,
 				expr: &seqExpr{
-					pos: position{line: 1289, col: 12, offset: 36097},
+					pos: position{line: 1527, col: 12, offset: 45180},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1289, col: 12, offset: 36097},
+							pos:        position{line: 1527, col: 12, offset: 45180},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1289, col: 16, offset: 36101},
+							pos:   position{line: 1527, col: 16, offset: 45184},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1289, col: 18, offset: 36103},
+								pos:  position{line: 1527, col: 18, offset: 45186},
 								name: "Hex",
 							},
 						},
@@ -10211,23 +12881,23 @@ var g = &grammar{
 		},
 		{
 			name: "HexColon",
-			pos:  position{line: 1291, col: 1, offset: 36141},
+			pos:  position{line: 1529, col: 1, offset: 45224},
 			expr: &actionExpr{
-				pos: position{line: 1291, col: 12, offset: 36152},
+				pos: position{line: 1529, col: 12, offset: 45235},
 				run: (*parser).callonHexColon1,
 				expr: &seqExpr{
-					pos: position{line: 1291, col: 12, offset: 36152},
+					pos: position{line: 1529, col: 12, offset: 45235},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1291, col: 12, offset: 36152},
+							pos:   position{line: 1529, col: 12, offset: 45235},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1291, col: 14, offset: 36154},
+								pos:  position{line: 1529, col: 14, offset: 45237},
 								name: "Hex",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1291, col: 18, offset: 36158},
+							pos:        position{line: 1529, col: 18, offset: 45241},
 							val:        ":",
 							ignoreCase: false,
 						},
@@ -10237,31 +12907,31 @@ var g = &grammar{
 		},
 		{
 			name: "IP4Net",
-			pos:  position{line: 1293, col: 1, offset: 36196},
+			pos:  position{line: 1531, col: 1, offset: 45279},
 			expr: &actionExpr{
-				pos: position{line: 1294, col: 5, offset: 36207},
+				pos: position{line: 1532, col: 5, offset: 45290},
 				run: (*parser).callonIP4Net1,
 				expr: &seqExpr{
-					pos: position{line: 1294, col: 5, offset: 36207},
+					pos: position{line: 1532, col: 5, offset: 45290},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1294, col: 5, offset: 36207},
+							pos:   position{line: 1532, col: 5, offset: 45290},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1294, col: 7, offset: 36209},
+								pos:  position{line: 1532, col: 7, offset: 45292},
 								name: "IP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1294, col: 10, offset: 36212},
+							pos:        position{line: 1532, col: 10, offset: 45295},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1294, col: 14, offset: 36216},
+							pos:   position{line: 1532, col: 14, offset: 45299},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1294, col: 16, offset: 36218},
+								pos:  position{line: 1532, col: 16, offset: 45301},
 								name: "UInt",
 							},
 						},
@@ -10271,31 +12941,31 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Net",
-			pos:  position{line: 1298, col: 1, offset: 36291},
+			pos:  position{line: 1536, col: 1, offset: 45374},
 			expr: &actionExpr{
-				pos: position{line: 1299, col: 5, offset: 36302},
+				pos: position{line: 1537, col: 5, offset: 45385},
 				run: (*parser).callonIP6Net1,
 				expr: &seqExpr{
-					pos: position{line: 1299, col: 5, offset: 36302},
+					pos: position{line: 1537, col: 5, offset: 45385},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1299, col: 5, offset: 36302},
+							pos:   position{line: 1537, col: 5, offset: 45385},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1299, col: 7, offset: 36304},
+								pos:  position{line: 1537, col: 7, offset: 45387},
 								name: "IP6",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1299, col: 11, offset: 36308},
+							pos:        position{line: 1537, col: 11, offset: 45391},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1299, col: 15, offset: 36312},
+							pos:   position{line: 1537, col: 15, offset: 45395},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1299, col: 17, offset: 36314},
+								pos:  position{line: 1537, col: 17, offset: 45397},
 								name: "UInt",
 							},
 						},
@@ -10305,15 +12975,15 @@ var g = &grammar{
 		},
 		{
 			name: "UInt",
-			pos:  position{line: 1303, col: 1, offset: 36377},
+			pos:  position{line: 1541, col: 1, offset: 45460},
 			expr: &actionExpr{
-				pos: position{line: 1304, col: 4, offset: 36385},
+				pos: position{line: 1542, col: 4, offset: 45468},
 				run: (*parser).callonUInt1,
 				expr: &labeledExpr{
-					pos:   position{line: 1304, col: 4, offset: 36385},
+					pos:   position{line: 1542, col: 4, offset: 45468},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1304, col: 6, offset: 36387},
+						pos:  position{line: 1542, col: 6, offset: 45470},
 						name: "UIntString",
 					},
 				},
@@ -10321,16 +12991,16 @@ var g = &grammar{
 		},
 		{
 			name: "IntString",
-			pos:  position{line: 1306, col: 1, offset: 36427},
+			pos:  position{line: 1544, col: 1, offset: 45510},
 			expr: &choiceExpr{
-				pos: position{line: 1307, col: 5, offset: 36441},
+				pos: position{line: 1545, col: 5, offset: 45524},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1307, col: 5, offset: 36441},
+						pos:  position{line: 1545, col: 5, offset: 45524},
 						name: "UIntString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1308, col: 5, offset: 36456},
+						pos:  position{line: 1546, col: 5, offset: 45539},
 						name: "MinusIntString",
 					},
 				},
@@ -10338,14 +13008,14 @@ var g = &grammar{
 		},
 		{
 			name: "UIntString",
-			pos:  position{line: 1310, col: 1, offset: 36472},
+			pos:  position{line: 1548, col: 1, offset: 45555},
 			expr: &actionExpr{
-				pos: position{line: 1310, col: 14, offset: 36485},
+				pos: position{line: 1548, col: 14, offset: 45568},
 				run: (*parser).callonUIntString1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1310, col: 14, offset: 36485},
+					pos: position{line: 1548, col: 14, offset: 45568},
 					expr: &charClassMatcher{
-						pos:        position{line: 1310, col: 14, offset: 36485},
+						pos:        position{line: 1548, col: 14, offset: 45568},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10356,20 +13026,20 @@ var g = &grammar{
 		},
 		{
 			name: "MinusIntString",
-			pos:  position{line: 1312, col: 1, offset: 36524},
+			pos:  position{line: 1550, col: 1, offset: 45607},
 			expr: &actionExpr{
-				pos: position{line: 1313, col: 5, offset: 36543},
+				pos: position{line: 1551, col: 5, offset: 45626},
 				run: (*parser).callonMinusIntString1,
 				expr: &seqExpr{
-					pos: position{line: 1313, col: 5, offset: 36543},
+					pos: position{line: 1551, col: 5, offset: 45626},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1313, col: 5, offset: 36543},
+							pos:        position{line: 1551, col: 5, offset: 45626},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1313, col: 9, offset: 36547},
+							pos:  position{line: 1551, col: 9, offset: 45630},
 							name: "UIntString",
 						},
 					},
@@ -10378,28 +13048,28 @@ var g = &grammar{
 		},
 		{
 			name: "FloatString",
-			pos:  position{line: 1315, col: 1, offset: 36590},
+			pos:  position{line: 1553, col: 1, offset: 45673},
 			expr: &choiceExpr{
-				pos: position{line: 1316, col: 5, offset: 36606},
+				pos: position{line: 1554, col: 5, offset: 45689},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1316, col: 5, offset: 36606},
+						pos: position{line: 1554, col: 5, offset: 45689},
 						run: (*parser).callonFloatString2,
 						expr: &seqExpr{
-							pos: position{line: 1316, col: 5, offset: 36606},
+							pos: position{line: 1554, col: 5, offset: 45689},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 1316, col: 5, offset: 36606},
+									pos: position{line: 1554, col: 5, offset: 45689},
 									expr: &litMatcher{
-										pos:        position{line: 1316, col: 5, offset: 36606},
+										pos:        position{line: 1554, col: 5, offset: 45689},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1316, col: 10, offset: 36611},
+									pos: position{line: 1554, col: 10, offset: 45694},
 									expr: &charClassMatcher{
-										pos:        position{line: 1316, col: 10, offset: 36611},
+										pos:        position{line: 1554, col: 10, offset: 45694},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10407,14 +13077,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1316, col: 17, offset: 36618},
+									pos:        position{line: 1554, col: 17, offset: 45701},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1316, col: 21, offset: 36622},
+									pos: position{line: 1554, col: 21, offset: 45705},
 									expr: &charClassMatcher{
-										pos:        position{line: 1316, col: 21, offset: 36622},
+										pos:        position{line: 1554, col: 21, offset: 45705},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10422,9 +13092,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1316, col: 28, offset: 36629},
+									pos: position{line: 1554, col: 28, offset: 45712},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1316, col: 28, offset: 36629},
+										pos:  position{line: 1554, col: 28, offset: 45712},
 										name: "ExponentPart",
 									},
 								},
@@ -10432,28 +13102,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1319, col: 5, offset: 36688},
+						pos: position{line: 1557, col: 5, offset: 45771},
 						run: (*parser).callonFloatString13,
 						expr: &seqExpr{
-							pos: position{line: 1319, col: 5, offset: 36688},
+							pos: position{line: 1557, col: 5, offset: 45771},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 1319, col: 5, offset: 36688},
+									pos: position{line: 1557, col: 5, offset: 45771},
 									expr: &litMatcher{
-										pos:        position{line: 1319, col: 5, offset: 36688},
+										pos:        position{line: 1557, col: 5, offset: 45771},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1319, col: 10, offset: 36693},
+									pos:        position{line: 1557, col: 10, offset: 45776},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1319, col: 14, offset: 36697},
+									pos: position{line: 1557, col: 14, offset: 45780},
 									expr: &charClassMatcher{
-										pos:        position{line: 1319, col: 14, offset: 36697},
+										pos:        position{line: 1557, col: 14, offset: 45780},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10461,9 +13131,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1319, col: 21, offset: 36704},
+									pos: position{line: 1557, col: 21, offset: 45787},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1319, col: 21, offset: 36704},
+										pos:  position{line: 1557, col: 21, offset: 45787},
 										name: "ExponentPart",
 									},
 								},
@@ -10471,17 +13141,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1322, col: 5, offset: 36763},
+						pos: position{line: 1560, col: 5, offset: 45846},
 						run: (*parser).callonFloatString22,
 						expr: &choiceExpr{
-							pos: position{line: 1322, col: 7, offset: 36765},
+							pos: position{line: 1560, col: 7, offset: 45848},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1322, col: 7, offset: 36765},
+									pos:  position{line: 1560, col: 7, offset: 45848},
 									name: "NaN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1322, col: 13, offset: 36771},
+									pos:  position{line: 1560, col: 13, offset: 45854},
 									name: "Infinity",
 								},
 							},
@@ -10492,19 +13162,19 @@ var g = &grammar{
 		},
 		{
 			name: "ExponentPart",
-			pos:  position{line: 1325, col: 1, offset: 36815},
+			pos:  position{line: 1563, col: 1, offset: 45898},
 			expr: &seqExpr{
-				pos: position{line: 1325, col: 16, offset: 36830},
+				pos: position{line: 1563, col: 16, offset: 45913},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1325, col: 16, offset: 36830},
+						pos:        position{line: 1563, col: 16, offset: 45913},
 						val:        "e",
 						ignoreCase: true,
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1325, col: 21, offset: 36835},
+						pos: position{line: 1563, col: 21, offset: 45918},
 						expr: &charClassMatcher{
-							pos:        position{line: 1325, col: 21, offset: 36835},
+							pos:        position{line: 1563, col: 21, offset: 45918},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -10512,7 +13182,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1325, col: 27, offset: 36841},
+						pos:  position{line: 1563, col: 27, offset: 45924},
 						name: "UIntString",
 					},
 				},
@@ -10520,31 +13190,31 @@ var g = &grammar{
 		},
 		{
 			name: "NaN",
-			pos:  position{line: 1327, col: 1, offset: 36853},
+			pos:  position{line: 1565, col: 1, offset: 45936},
 			expr: &litMatcher{
-				pos:        position{line: 1327, col: 7, offset: 36859},
+				pos:        position{line: 1565, col: 7, offset: 45942},
 				val:        "NaN",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "Infinity",
-			pos:  position{line: 1329, col: 1, offset: 36866},
+			pos:  position{line: 1567, col: 1, offset: 45949},
 			expr: &seqExpr{
-				pos: position{line: 1329, col: 12, offset: 36877},
+				pos: position{line: 1567, col: 12, offset: 45960},
 				exprs: []interface{}{
 					&zeroOrOneExpr{
-						pos: position{line: 1329, col: 12, offset: 36877},
+						pos: position{line: 1567, col: 12, offset: 45960},
 						expr: &choiceExpr{
-							pos: position{line: 1329, col: 13, offset: 36878},
+							pos: position{line: 1567, col: 13, offset: 45961},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1329, col: 13, offset: 36878},
+									pos:        position{line: 1567, col: 13, offset: 45961},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1329, col: 19, offset: 36884},
+									pos:        position{line: 1567, col: 19, offset: 45967},
 									val:        "+",
 									ignoreCase: false,
 								},
@@ -10552,7 +13222,7 @@ var g = &grammar{
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1329, col: 25, offset: 36890},
+						pos:        position{line: 1567, col: 25, offset: 45973},
 						val:        "Inf",
 						ignoreCase: false,
 					},
@@ -10561,14 +13231,14 @@ var g = &grammar{
 		},
 		{
 			name: "Hex",
-			pos:  position{line: 1331, col: 1, offset: 36897},
+			pos:  position{line: 1569, col: 1, offset: 45980},
 			expr: &actionExpr{
-				pos: position{line: 1331, col: 7, offset: 36903},
+				pos: position{line: 1569, col: 7, offset: 45986},
 				run: (*parser).callonHex1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1331, col: 7, offset: 36903},
+					pos: position{line: 1569, col: 7, offset: 45986},
 					expr: &ruleRefExpr{
-						pos:  position{line: 1331, col: 7, offset: 36903},
+						pos:  position{line: 1569, col: 7, offset: 45986},
 						name: "HexDigit",
 					},
 				},
@@ -10576,9 +13246,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 1333, col: 1, offset: 36945},
+			pos:  position{line: 1571, col: 1, offset: 46028},
 			expr: &charClassMatcher{
-				pos:        position{line: 1333, col: 12, offset: 36956},
+				pos:        position{line: 1571, col: 12, offset: 46039},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -10587,34 +13257,34 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedString",
-			pos:  position{line: 1335, col: 1, offset: 36969},
+			pos:  position{line: 1573, col: 1, offset: 46052},
 			expr: &choiceExpr{
-				pos: position{line: 1336, col: 5, offset: 36986},
+				pos: position{line: 1574, col: 5, offset: 46069},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1336, col: 5, offset: 36986},
+						pos: position{line: 1574, col: 5, offset: 46069},
 						run: (*parser).callonQuotedString2,
 						expr: &seqExpr{
-							pos: position{line: 1336, col: 5, offset: 36986},
+							pos: position{line: 1574, col: 5, offset: 46069},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1336, col: 5, offset: 36986},
+									pos:        position{line: 1574, col: 5, offset: 46069},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1336, col: 9, offset: 36990},
+									pos:   position{line: 1574, col: 9, offset: 46073},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1336, col: 11, offset: 36992},
+										pos: position{line: 1574, col: 11, offset: 46075},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1336, col: 11, offset: 36992},
+											pos:  position{line: 1574, col: 11, offset: 46075},
 											name: "DoubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1336, col: 29, offset: 37010},
+									pos:        position{line: 1574, col: 29, offset: 46093},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -10622,29 +13292,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1337, col: 5, offset: 37047},
+						pos: position{line: 1575, col: 5, offset: 46130},
 						run: (*parser).callonQuotedString9,
 						expr: &seqExpr{
-							pos: position{line: 1337, col: 5, offset: 37047},
+							pos: position{line: 1575, col: 5, offset: 46130},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1337, col: 5, offset: 37047},
+									pos:        position{line: 1575, col: 5, offset: 46130},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1337, col: 9, offset: 37051},
+									pos:   position{line: 1575, col: 9, offset: 46134},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1337, col: 11, offset: 37053},
+										pos: position{line: 1575, col: 11, offset: 46136},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1337, col: 11, offset: 37053},
+											pos:  position{line: 1575, col: 11, offset: 46136},
 											name: "SingleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1337, col: 29, offset: 37071},
+									pos:        position{line: 1575, col: 29, offset: 46154},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -10656,55 +13326,55 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedChar",
-			pos:  position{line: 1339, col: 1, offset: 37105},
+			pos:  position{line: 1577, col: 1, offset: 46188},
 			expr: &choiceExpr{
-				pos: position{line: 1340, col: 5, offset: 37126},
+				pos: position{line: 1578, col: 5, offset: 46209},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1340, col: 5, offset: 37126},
+						pos: position{line: 1578, col: 5, offset: 46209},
 						run: (*parser).callonDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1340, col: 5, offset: 37126},
+							pos: position{line: 1578, col: 5, offset: 46209},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1340, col: 5, offset: 37126},
+									pos: position{line: 1578, col: 5, offset: 46209},
 									expr: &choiceExpr{
-										pos: position{line: 1340, col: 7, offset: 37128},
+										pos: position{line: 1578, col: 7, offset: 46211},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1340, col: 7, offset: 37128},
+												pos:        position{line: 1578, col: 7, offset: 46211},
 												val:        "\"",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1340, col: 13, offset: 37134},
+												pos:  position{line: 1578, col: 13, offset: 46217},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1340, col: 26, offset: 37147,
+									line: 1578, col: 26, offset: 46230,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1341, col: 5, offset: 37184},
+						pos: position{line: 1579, col: 5, offset: 46267},
 						run: (*parser).callonDoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1341, col: 5, offset: 37184},
+							pos: position{line: 1579, col: 5, offset: 46267},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1341, col: 5, offset: 37184},
+									pos:        position{line: 1579, col: 5, offset: 46267},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1341, col: 10, offset: 37189},
+									pos:   position{line: 1579, col: 10, offset: 46272},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1341, col: 12, offset: 37191},
+										pos:  position{line: 1579, col: 12, offset: 46274},
 										name: "EscapeSequence",
 									},
 								},
@@ -10716,28 +13386,28 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWord",
-			pos:  position{line: 1343, col: 1, offset: 37225},
+			pos:  position{line: 1581, col: 1, offset: 46308},
 			expr: &actionExpr{
-				pos: position{line: 1344, col: 5, offset: 37237},
+				pos: position{line: 1582, col: 5, offset: 46320},
 				run: (*parser).callonKeyWord1,
 				expr: &seqExpr{
-					pos: position{line: 1344, col: 5, offset: 37237},
+					pos: position{line: 1582, col: 5, offset: 46320},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1344, col: 5, offset: 37237},
+							pos:   position{line: 1582, col: 5, offset: 46320},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1344, col: 10, offset: 37242},
+								pos:  position{line: 1582, col: 10, offset: 46325},
 								name: "KeyWordStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1344, col: 23, offset: 37255},
+							pos:   position{line: 1582, col: 23, offset: 46338},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1344, col: 28, offset: 37260},
+								pos: position{line: 1582, col: 28, offset: 46343},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1344, col: 28, offset: 37260},
+									pos:  position{line: 1582, col: 28, offset: 46343},
 									name: "KeyWordRest",
 								},
 							},
@@ -10748,16 +13418,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordStart",
-			pos:  position{line: 1346, col: 1, offset: 37322},
+			pos:  position{line: 1584, col: 1, offset: 46405},
 			expr: &choiceExpr{
-				pos: position{line: 1347, col: 5, offset: 37339},
+				pos: position{line: 1585, col: 5, offset: 46422},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1347, col: 5, offset: 37339},
+						pos:  position{line: 1585, col: 5, offset: 46422},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1348, col: 5, offset: 37356},
+						pos:  position{line: 1586, col: 5, offset: 46439},
 						name: "KeyWordEsc",
 					},
 				},
@@ -10765,12 +13435,12 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordChars",
-			pos:  position{line: 1350, col: 1, offset: 37368},
+			pos:  position{line: 1588, col: 1, offset: 46451},
 			expr: &actionExpr{
-				pos: position{line: 1350, col: 16, offset: 37383},
+				pos: position{line: 1588, col: 16, offset: 46466},
 				run: (*parser).callonKeyWordChars1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1350, col: 16, offset: 37383},
+					pos:        position{line: 1588, col: 16, offset: 46466},
 					val:        "[a-zA-Z_.:/%#@~]",
 					chars:      []rune{'_', '.', ':', '/', '%', '#', '@', '~'},
 					ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -10781,16 +13451,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordRest",
-			pos:  position{line: 1352, col: 1, offset: 37432},
+			pos:  position{line: 1590, col: 1, offset: 46515},
 			expr: &choiceExpr{
-				pos: position{line: 1353, col: 5, offset: 37448},
+				pos: position{line: 1591, col: 5, offset: 46531},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1353, col: 5, offset: 37448},
+						pos:  position{line: 1591, col: 5, offset: 46531},
 						name: "KeyWordStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1354, col: 5, offset: 37465},
+						pos:        position{line: 1592, col: 5, offset: 46548},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10801,30 +13471,30 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordEsc",
-			pos:  position{line: 1356, col: 1, offset: 37472},
+			pos:  position{line: 1594, col: 1, offset: 46555},
 			expr: &actionExpr{
-				pos: position{line: 1356, col: 14, offset: 37485},
+				pos: position{line: 1594, col: 14, offset: 46568},
 				run: (*parser).callonKeyWordEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1356, col: 14, offset: 37485},
+					pos: position{line: 1594, col: 14, offset: 46568},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1356, col: 14, offset: 37485},
+							pos:        position{line: 1594, col: 14, offset: 46568},
 							val:        "\\",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1356, col: 19, offset: 37490},
+							pos:   position{line: 1594, col: 19, offset: 46573},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1356, col: 22, offset: 37493},
+								pos: position{line: 1594, col: 22, offset: 46576},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1356, col: 22, offset: 37493},
+										pos:  position{line: 1594, col: 22, offset: 46576},
 										name: "KeywordEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1356, col: 38, offset: 37509},
+										pos:  position{line: 1594, col: 38, offset: 46592},
 										name: "EscapeSequence",
 									},
 								},
@@ -10836,42 +13506,42 @@ var g = &grammar{
 		},
 		{
 			name: "GlobPattern",
-			pos:  position{line: 1358, col: 1, offset: 37545},
+			pos:  position{line: 1596, col: 1, offset: 46628},
 			expr: &actionExpr{
-				pos: position{line: 1359, col: 5, offset: 37561},
+				pos: position{line: 1597, col: 5, offset: 46644},
 				run: (*parser).callonGlobPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1359, col: 5, offset: 37561},
+					pos: position{line: 1597, col: 5, offset: 46644},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 1359, col: 5, offset: 37561},
+							pos: position{line: 1597, col: 5, offset: 46644},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1359, col: 6, offset: 37562},
+								pos:  position{line: 1597, col: 6, offset: 46645},
 								name: "GlobProperStart",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1359, col: 22, offset: 37578},
+							pos: position{line: 1597, col: 22, offset: 46661},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1359, col: 23, offset: 37579},
+								pos:  position{line: 1597, col: 23, offset: 46662},
 								name: "GlobHasStar",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1359, col: 35, offset: 37591},
+							pos:   position{line: 1597, col: 35, offset: 46674},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1359, col: 40, offset: 37596},
+								pos:  position{line: 1597, col: 40, offset: 46679},
 								name: "GlobStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1359, col: 50, offset: 37606},
+							pos:   position{line: 1597, col: 50, offset: 46689},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1359, col: 55, offset: 37611},
+								pos: position{line: 1597, col: 55, offset: 46694},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1359, col: 55, offset: 37611},
+									pos:  position{line: 1597, col: 55, offset: 46694},
 									name: "GlobRest",
 								},
 							},
@@ -10882,27 +13552,27 @@ var g = &grammar{
 		},
 		{
 			name: "GlobProperStart",
-			pos:  position{line: 1363, col: 1, offset: 37680},
+			pos:  position{line: 1601, col: 1, offset: 46763},
 			expr: &choiceExpr{
-				pos: position{line: 1363, col: 19, offset: 37698},
+				pos: position{line: 1601, col: 19, offset: 46781},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1363, col: 19, offset: 37698},
+						pos:  position{line: 1601, col: 19, offset: 46781},
 						name: "KeyWordStart",
 					},
 					&seqExpr{
-						pos: position{line: 1363, col: 34, offset: 37713},
+						pos: position{line: 1601, col: 34, offset: 46796},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1363, col: 34, offset: 37713},
+								pos: position{line: 1601, col: 34, offset: 46796},
 								expr: &litMatcher{
-									pos:        position{line: 1363, col: 34, offset: 37713},
+									pos:        position{line: 1601, col: 34, offset: 46796},
 									val:        "*",
 									ignoreCase: false,
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1363, col: 39, offset: 37718},
+								pos:  position{line: 1601, col: 39, offset: 46801},
 								name: "KeyWordRest",
 							},
 						},
@@ -10912,19 +13582,19 @@ var g = &grammar{
 		},
 		{
 			name: "GlobHasStar",
-			pos:  position{line: 1364, col: 1, offset: 37730},
+			pos:  position{line: 1602, col: 1, offset: 46813},
 			expr: &seqExpr{
-				pos: position{line: 1364, col: 15, offset: 37744},
+				pos: position{line: 1602, col: 15, offset: 46827},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1364, col: 15, offset: 37744},
+						pos: position{line: 1602, col: 15, offset: 46827},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1364, col: 15, offset: 37744},
+							pos:  position{line: 1602, col: 15, offset: 46827},
 							name: "KeyWordRest",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1364, col: 28, offset: 37757},
+						pos:        position{line: 1602, col: 28, offset: 46840},
 						val:        "*",
 						ignoreCase: false,
 					},
@@ -10933,23 +13603,23 @@ var g = &grammar{
 		},
 		{
 			name: "GlobStart",
-			pos:  position{line: 1366, col: 1, offset: 37762},
+			pos:  position{line: 1604, col: 1, offset: 46845},
 			expr: &choiceExpr{
-				pos: position{line: 1367, col: 5, offset: 37776},
+				pos: position{line: 1605, col: 5, offset: 46859},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1367, col: 5, offset: 37776},
+						pos:  position{line: 1605, col: 5, offset: 46859},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1368, col: 5, offset: 37793},
+						pos:  position{line: 1606, col: 5, offset: 46876},
 						name: "GlobEsc",
 					},
 					&actionExpr{
-						pos: position{line: 1369, col: 5, offset: 37805},
+						pos: position{line: 1607, col: 5, offset: 46888},
 						run: (*parser).callonGlobStart4,
 						expr: &litMatcher{
-							pos:        position{line: 1369, col: 5, offset: 37805},
+							pos:        position{line: 1607, col: 5, offset: 46888},
 							val:        "*",
 							ignoreCase: false,
 						},
@@ -10959,16 +13629,16 @@ var g = &grammar{
 		},
 		{
 			name: "GlobRest",
-			pos:  position{line: 1371, col: 1, offset: 37829},
+			pos:  position{line: 1609, col: 1, offset: 46912},
 			expr: &choiceExpr{
-				pos: position{line: 1372, col: 5, offset: 37842},
+				pos: position{line: 1610, col: 5, offset: 46925},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1372, col: 5, offset: 37842},
+						pos:  position{line: 1610, col: 5, offset: 46925},
 						name: "GlobStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1373, col: 5, offset: 37856},
+						pos:        position{line: 1611, col: 5, offset: 46939},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10979,30 +13649,30 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEsc",
-			pos:  position{line: 1375, col: 1, offset: 37863},
+			pos:  position{line: 1613, col: 1, offset: 46946},
 			expr: &actionExpr{
-				pos: position{line: 1375, col: 11, offset: 37873},
+				pos: position{line: 1613, col: 11, offset: 46956},
 				run: (*parser).callonGlobEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1375, col: 11, offset: 37873},
+					pos: position{line: 1613, col: 11, offset: 46956},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1375, col: 11, offset: 37873},
+							pos:        position{line: 1613, col: 11, offset: 46956},
 							val:        "\\",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1375, col: 16, offset: 37878},
+							pos:   position{line: 1613, col: 16, offset: 46961},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1375, col: 19, offset: 37881},
+								pos: position{line: 1613, col: 19, offset: 46964},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1375, col: 19, offset: 37881},
+										pos:  position{line: 1613, col: 19, offset: 46964},
 										name: "GlobEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1375, col: 32, offset: 37894},
+										pos:  position{line: 1613, col: 32, offset: 46977},
 										name: "EscapeSequence",
 									},
 								},
@@ -11014,30 +13684,30 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEscape",
-			pos:  position{line: 1377, col: 1, offset: 37930},
+			pos:  position{line: 1615, col: 1, offset: 47013},
 			expr: &choiceExpr{
-				pos: position{line: 1378, col: 5, offset: 37945},
+				pos: position{line: 1616, col: 5, offset: 47028},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1378, col: 5, offset: 37945},
+						pos: position{line: 1616, col: 5, offset: 47028},
 						run: (*parser).callonGlobEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1378, col: 5, offset: 37945},
+							pos:        position{line: 1616, col: 5, offset: 47028},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1379, col: 5, offset: 37973},
+						pos: position{line: 1617, col: 5, offset: 47056},
 						run: (*parser).callonGlobEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1379, col: 5, offset: 37973},
+							pos:        position{line: 1617, col: 5, offset: 47056},
 							val:        "*",
 							ignoreCase: false,
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1380, col: 5, offset: 38003},
+						pos:        position{line: 1618, col: 5, offset: 47086},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -11048,55 +13718,55 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedChar",
-			pos:  position{line: 1383, col: 1, offset: 38010},
+			pos:  position{line: 1621, col: 1, offset: 47093},
 			expr: &choiceExpr{
-				pos: position{line: 1384, col: 5, offset: 38031},
+				pos: position{line: 1622, col: 5, offset: 47114},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1384, col: 5, offset: 38031},
+						pos: position{line: 1622, col: 5, offset: 47114},
 						run: (*parser).callonSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1384, col: 5, offset: 38031},
+							pos: position{line: 1622, col: 5, offset: 47114},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1384, col: 5, offset: 38031},
+									pos: position{line: 1622, col: 5, offset: 47114},
 									expr: &choiceExpr{
-										pos: position{line: 1384, col: 7, offset: 38033},
+										pos: position{line: 1622, col: 7, offset: 47116},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1384, col: 7, offset: 38033},
+												pos:        position{line: 1622, col: 7, offset: 47116},
 												val:        "'",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1384, col: 13, offset: 38039},
+												pos:  position{line: 1622, col: 13, offset: 47122},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1384, col: 26, offset: 38052,
+									line: 1622, col: 26, offset: 47135,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1385, col: 5, offset: 38089},
+						pos: position{line: 1623, col: 5, offset: 47172},
 						run: (*parser).callonSingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1385, col: 5, offset: 38089},
+							pos: position{line: 1623, col: 5, offset: 47172},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1385, col: 5, offset: 38089},
+									pos:        position{line: 1623, col: 5, offset: 47172},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1385, col: 10, offset: 38094},
+									pos:   position{line: 1623, col: 10, offset: 47177},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1385, col: 12, offset: 38096},
+										pos:  position{line: 1623, col: 12, offset: 47179},
 										name: "EscapeSequence",
 									},
 								},
@@ -11108,16 +13778,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 1387, col: 1, offset: 38130},
+			pos:  position{line: 1625, col: 1, offset: 47213},
 			expr: &choiceExpr{
-				pos: position{line: 1388, col: 5, offset: 38149},
+				pos: position{line: 1626, col: 5, offset: 47232},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1388, col: 5, offset: 38149},
+						pos:  position{line: 1626, col: 5, offset: 47232},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1389, col: 5, offset: 38170},
+						pos:  position{line: 1627, col: 5, offset: 47253},
 						name: "UnicodeEscape",
 					},
 				},
@@ -11125,79 +13795,79 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 1391, col: 1, offset: 38185},
+			pos:  position{line: 1629, col: 1, offset: 47268},
 			expr: &choiceExpr{
-				pos: position{line: 1392, col: 5, offset: 38206},
+				pos: position{line: 1630, col: 5, offset: 47289},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1392, col: 5, offset: 38206},
+						pos:        position{line: 1630, col: 5, offset: 47289},
 						val:        "'",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1393, col: 5, offset: 38214},
+						pos: position{line: 1631, col: 5, offset: 47297},
 						run: (*parser).callonSingleCharEscape3,
 						expr: &litMatcher{
-							pos:        position{line: 1393, col: 5, offset: 38214},
+							pos:        position{line: 1631, col: 5, offset: 47297},
 							val:        "\"",
 							ignoreCase: false,
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1394, col: 5, offset: 38254},
+						pos:        position{line: 1632, col: 5, offset: 47337},
 						val:        "\\",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1395, col: 5, offset: 38263},
+						pos: position{line: 1633, col: 5, offset: 47346},
 						run: (*parser).callonSingleCharEscape6,
 						expr: &litMatcher{
-							pos:        position{line: 1395, col: 5, offset: 38263},
+							pos:        position{line: 1633, col: 5, offset: 47346},
 							val:        "b",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1396, col: 5, offset: 38292},
+						pos: position{line: 1634, col: 5, offset: 47375},
 						run: (*parser).callonSingleCharEscape8,
 						expr: &litMatcher{
-							pos:        position{line: 1396, col: 5, offset: 38292},
+							pos:        position{line: 1634, col: 5, offset: 47375},
 							val:        "f",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1397, col: 5, offset: 38321},
+						pos: position{line: 1635, col: 5, offset: 47404},
 						run: (*parser).callonSingleCharEscape10,
 						expr: &litMatcher{
-							pos:        position{line: 1397, col: 5, offset: 38321},
+							pos:        position{line: 1635, col: 5, offset: 47404},
 							val:        "n",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1398, col: 5, offset: 38350},
+						pos: position{line: 1636, col: 5, offset: 47433},
 						run: (*parser).callonSingleCharEscape12,
 						expr: &litMatcher{
-							pos:        position{line: 1398, col: 5, offset: 38350},
+							pos:        position{line: 1636, col: 5, offset: 47433},
 							val:        "r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1399, col: 5, offset: 38379},
+						pos: position{line: 1637, col: 5, offset: 47462},
 						run: (*parser).callonSingleCharEscape14,
 						expr: &litMatcher{
-							pos:        position{line: 1399, col: 5, offset: 38379},
+							pos:        position{line: 1637, col: 5, offset: 47462},
 							val:        "t",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1400, col: 5, offset: 38408},
+						pos: position{line: 1638, col: 5, offset: 47491},
 						run: (*parser).callonSingleCharEscape16,
 						expr: &litMatcher{
-							pos:        position{line: 1400, col: 5, offset: 38408},
+							pos:        position{line: 1638, col: 5, offset: 47491},
 							val:        "v",
 							ignoreCase: false,
 						},
@@ -11207,30 +13877,30 @@ var g = &grammar{
 		},
 		{
 			name: "KeywordEscape",
-			pos:  position{line: 1402, col: 1, offset: 38434},
+			pos:  position{line: 1640, col: 1, offset: 47517},
 			expr: &choiceExpr{
-				pos: position{line: 1403, col: 5, offset: 38452},
+				pos: position{line: 1641, col: 5, offset: 47535},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1403, col: 5, offset: 38452},
+						pos: position{line: 1641, col: 5, offset: 47535},
 						run: (*parser).callonKeywordEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1403, col: 5, offset: 38452},
+							pos:        position{line: 1641, col: 5, offset: 47535},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1404, col: 5, offset: 38480},
+						pos: position{line: 1642, col: 5, offset: 47563},
 						run: (*parser).callonKeywordEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1404, col: 5, offset: 38480},
+							pos:        position{line: 1642, col: 5, offset: 47563},
 							val:        "*",
 							ignoreCase: false,
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1405, col: 5, offset: 38508},
+						pos:        position{line: 1643, col: 5, offset: 47591},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -11241,41 +13911,41 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 1407, col: 1, offset: 38514},
+			pos:  position{line: 1645, col: 1, offset: 47597},
 			expr: &choiceExpr{
-				pos: position{line: 1408, col: 5, offset: 38532},
+				pos: position{line: 1646, col: 5, offset: 47615},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1408, col: 5, offset: 38532},
+						pos: position{line: 1646, col: 5, offset: 47615},
 						run: (*parser).callonUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 1408, col: 5, offset: 38532},
+							pos: position{line: 1646, col: 5, offset: 47615},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1408, col: 5, offset: 38532},
+									pos:        position{line: 1646, col: 5, offset: 47615},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1408, col: 9, offset: 38536},
+									pos:   position{line: 1646, col: 9, offset: 47619},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1408, col: 16, offset: 38543},
+										pos: position{line: 1646, col: 16, offset: 47626},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1408, col: 16, offset: 38543},
+												pos:  position{line: 1646, col: 16, offset: 47626},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1408, col: 25, offset: 38552},
+												pos:  position{line: 1646, col: 25, offset: 47635},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1408, col: 34, offset: 38561},
+												pos:  position{line: 1646, col: 34, offset: 47644},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1408, col: 43, offset: 38570},
+												pos:  position{line: 1646, col: 43, offset: 47653},
 												name: "HexDigit",
 											},
 										},
@@ -11285,63 +13955,63 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1411, col: 5, offset: 38633},
+						pos: position{line: 1649, col: 5, offset: 47716},
 						run: (*parser).callonUnicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 1411, col: 5, offset: 38633},
+							pos: position{line: 1649, col: 5, offset: 47716},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1411, col: 5, offset: 38633},
+									pos:        position{line: 1649, col: 5, offset: 47716},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1411, col: 9, offset: 38637},
+									pos:        position{line: 1649, col: 9, offset: 47720},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1411, col: 13, offset: 38641},
+									pos:   position{line: 1649, col: 13, offset: 47724},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1411, col: 20, offset: 38648},
+										pos: position{line: 1649, col: 20, offset: 47731},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1411, col: 20, offset: 38648},
+												pos:  position{line: 1649, col: 20, offset: 47731},
 												name: "HexDigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1411, col: 29, offset: 38657},
+												pos: position{line: 1649, col: 29, offset: 47740},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1411, col: 29, offset: 38657},
+													pos:  position{line: 1649, col: 29, offset: 47740},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1411, col: 39, offset: 38667},
+												pos: position{line: 1649, col: 39, offset: 47750},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1411, col: 39, offset: 38667},
+													pos:  position{line: 1649, col: 39, offset: 47750},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1411, col: 49, offset: 38677},
+												pos: position{line: 1649, col: 49, offset: 47760},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1411, col: 49, offset: 38677},
+													pos:  position{line: 1649, col: 49, offset: 47760},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1411, col: 59, offset: 38687},
+												pos: position{line: 1649, col: 59, offset: 47770},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1411, col: 59, offset: 38687},
+													pos:  position{line: 1649, col: 59, offset: 47770},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1411, col: 69, offset: 38697},
+												pos: position{line: 1649, col: 69, offset: 47780},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1411, col: 69, offset: 38697},
+													pos:  position{line: 1649, col: 69, offset: 47780},
 													name: "HexDigit",
 												},
 											},
@@ -11349,7 +14019,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1411, col: 80, offset: 38708},
+									pos:        position{line: 1649, col: 80, offset: 47791},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -11361,35 +14031,35 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpPattern",
-			pos:  position{line: 1415, col: 1, offset: 38762},
+			pos:  position{line: 1653, col: 1, offset: 47845},
 			expr: &actionExpr{
-				pos: position{line: 1416, col: 5, offset: 38780},
+				pos: position{line: 1654, col: 5, offset: 47863},
 				run: (*parser).callonRegexpPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1416, col: 5, offset: 38780},
+					pos: position{line: 1654, col: 5, offset: 47863},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1416, col: 5, offset: 38780},
+							pos:        position{line: 1654, col: 5, offset: 47863},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1416, col: 9, offset: 38784},
+							pos:   position{line: 1654, col: 9, offset: 47867},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1416, col: 14, offset: 38789},
+								pos:  position{line: 1654, col: 14, offset: 47872},
 								name: "RegexpBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1416, col: 25, offset: 38800},
+							pos:        position{line: 1654, col: 25, offset: 47883},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1416, col: 29, offset: 38804},
+							pos: position{line: 1654, col: 29, offset: 47887},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1416, col: 30, offset: 38805},
+								pos:  position{line: 1654, col: 30, offset: 47888},
 								name: "KeyWordStart",
 							},
 						},
@@ -11399,32 +14069,32 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpBody",
-			pos:  position{line: 1418, col: 1, offset: 38840},
+			pos:  position{line: 1656, col: 1, offset: 47923},
 			expr: &actionExpr{
-				pos: position{line: 1419, col: 5, offset: 38855},
+				pos: position{line: 1657, col: 5, offset: 47938},
 				run: (*parser).callonRegexpBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1419, col: 5, offset: 38855},
+					pos: position{line: 1657, col: 5, offset: 47938},
 					expr: &choiceExpr{
-						pos: position{line: 1419, col: 6, offset: 38856},
+						pos: position{line: 1657, col: 6, offset: 47939},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 1419, col: 6, offset: 38856},
+								pos:        position{line: 1657, col: 6, offset: 47939},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&seqExpr{
-								pos: position{line: 1419, col: 15, offset: 38865},
+								pos: position{line: 1657, col: 15, offset: 47948},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 1419, col: 15, offset: 38865},
+										pos:        position{line: 1657, col: 15, offset: 47948},
 										val:        "\\",
 										ignoreCase: false,
 									},
 									&anyMatcher{
-										line: 1419, col: 20, offset: 38870,
+										line: 1657, col: 20, offset: 47953,
 									},
 								},
 							},
@@ -11435,9 +14105,9 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 1421, col: 1, offset: 38906},
+			pos:  position{line: 1659, col: 1, offset: 47989},
 			expr: &charClassMatcher{
-				pos:        position{line: 1422, col: 5, offset: 38922},
+				pos:        position{line: 1660, col: 5, offset: 48005},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -11447,42 +14117,42 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 1424, col: 1, offset: 38937},
+			pos:  position{line: 1662, col: 1, offset: 48020},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1424, col: 6, offset: 38942},
+				pos: position{line: 1662, col: 6, offset: 48025},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1424, col: 6, offset: 38942},
+					pos:  position{line: 1662, col: 6, offset: 48025},
 					name: "AnySpace",
 				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 1426, col: 1, offset: 38953},
+			pos:  position{line: 1664, col: 1, offset: 48036},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1426, col: 6, offset: 38958},
+				pos: position{line: 1664, col: 6, offset: 48041},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1426, col: 6, offset: 38958},
+					pos:  position{line: 1664, col: 6, offset: 48041},
 					name: "AnySpace",
 				},
 			},
 		},
 		{
 			name: "AnySpace",
-			pos:  position{line: 1428, col: 1, offset: 38969},
+			pos:  position{line: 1666, col: 1, offset: 48052},
 			expr: &choiceExpr{
-				pos: position{line: 1429, col: 5, offset: 38982},
+				pos: position{line: 1667, col: 5, offset: 48065},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1429, col: 5, offset: 38982},
+						pos:  position{line: 1667, col: 5, offset: 48065},
 						name: "WhiteSpace",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1430, col: 5, offset: 38997},
+						pos:  position{line: 1668, col: 5, offset: 48080},
 						name: "LineTerminator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1431, col: 5, offset: 39016},
+						pos:  position{line: 1669, col: 5, offset: 48099},
 						name: "Comment",
 					},
 				},
@@ -11490,45 +14160,45 @@ var g = &grammar{
 		},
 		{
 			name: "SourceCharacter",
-			pos:  position{line: 1433, col: 1, offset: 39025},
+			pos:  position{line: 1671, col: 1, offset: 48108},
 			expr: &anyMatcher{
-				line: 1434, col: 5, offset: 39045,
+				line: 1672, col: 5, offset: 48128,
 			},
 		},
 		{
 			name:        "WhiteSpace",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 1436, col: 1, offset: 39048},
+			pos:         position{line: 1674, col: 1, offset: 48131},
 			expr: &choiceExpr{
-				pos: position{line: 1437, col: 5, offset: 39076},
+				pos: position{line: 1675, col: 5, offset: 48159},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1437, col: 5, offset: 39076},
+						pos:        position{line: 1675, col: 5, offset: 48159},
 						val:        "\t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1438, col: 5, offset: 39085},
+						pos:        position{line: 1676, col: 5, offset: 48168},
 						val:        "\v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1439, col: 5, offset: 39094},
+						pos:        position{line: 1677, col: 5, offset: 48177},
 						val:        "\f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1440, col: 5, offset: 39103},
+						pos:        position{line: 1678, col: 5, offset: 48186},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1441, col: 5, offset: 39111},
+						pos:        position{line: 1679, col: 5, offset: 48194},
 						val:        "\u00a0",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1442, col: 5, offset: 39124},
+						pos:        position{line: 1680, col: 5, offset: 48207},
 						val:        "\ufeff",
 						ignoreCase: false,
 					},
@@ -11537,9 +14207,9 @@ var g = &grammar{
 		},
 		{
 			name: "LineTerminator",
-			pos:  position{line: 1444, col: 1, offset: 39134},
+			pos:  position{line: 1682, col: 1, offset: 48217},
 			expr: &charClassMatcher{
-				pos:        position{line: 1445, col: 5, offset: 39153},
+				pos:        position{line: 1683, col: 5, offset: 48236},
 				val:        "[\\n\\r\\u2028\\u2029]",
 				chars:      []rune{'\n', '\r', '\u2028', '\u2029'},
 				ignoreCase: false,
@@ -11549,45 +14219,45 @@ var g = &grammar{
 		{
 			name:        "Comment",
 			displayName: "\"comment\"",
-			pos:         position{line: 1451, col: 1, offset: 39483},
+			pos:         position{line: 1689, col: 1, offset: 48566},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1454, col: 5, offset: 39554},
+				pos:  position{line: 1692, col: 5, offset: 48637},
 				name: "SingleLineComment",
 			},
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 1456, col: 1, offset: 39573},
+			pos:  position{line: 1694, col: 1, offset: 48656},
 			expr: &seqExpr{
-				pos: position{line: 1457, col: 5, offset: 39594},
+				pos: position{line: 1695, col: 5, offset: 48677},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1457, col: 5, offset: 39594},
+						pos:        position{line: 1695, col: 5, offset: 48677},
 						val:        "/*",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1457, col: 10, offset: 39599},
+						pos: position{line: 1695, col: 10, offset: 48682},
 						expr: &seqExpr{
-							pos: position{line: 1457, col: 11, offset: 39600},
+							pos: position{line: 1695, col: 11, offset: 48683},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1457, col: 11, offset: 39600},
+									pos: position{line: 1695, col: 11, offset: 48683},
 									expr: &litMatcher{
-										pos:        position{line: 1457, col: 12, offset: 39601},
+										pos:        position{line: 1695, col: 12, offset: 48684},
 										val:        "*/",
 										ignoreCase: false,
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1457, col: 17, offset: 39606},
+									pos:  position{line: 1695, col: 17, offset: 48689},
 									name: "SourceCharacter",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1457, col: 35, offset: 39624},
+						pos:        position{line: 1695, col: 35, offset: 48707},
 						val:        "*/",
 						ignoreCase: false,
 					},
@@ -11596,29 +14266,29 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1459, col: 1, offset: 39630},
+			pos:  position{line: 1697, col: 1, offset: 48713},
 			expr: &seqExpr{
-				pos: position{line: 1460, col: 5, offset: 39652},
+				pos: position{line: 1698, col: 5, offset: 48735},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1460, col: 5, offset: 39652},
+						pos:        position{line: 1698, col: 5, offset: 48735},
 						val:        "//",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1460, col: 10, offset: 39657},
+						pos: position{line: 1698, col: 10, offset: 48740},
 						expr: &seqExpr{
-							pos: position{line: 1460, col: 11, offset: 39658},
+							pos: position{line: 1698, col: 11, offset: 48741},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1460, col: 11, offset: 39658},
+									pos: position{line: 1698, col: 11, offset: 48741},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1460, col: 12, offset: 39659},
+										pos:  position{line: 1698, col: 12, offset: 48742},
 										name: "LineTerminator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1460, col: 27, offset: 39674},
+									pos:  position{line: 1698, col: 27, offset: 48757},
 									name: "SourceCharacter",
 								},
 							},
@@ -11629,19 +14299,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1462, col: 1, offset: 39693},
+			pos:  position{line: 1700, col: 1, offset: 48776},
 			expr: &seqExpr{
-				pos: position{line: 1462, col: 7, offset: 39699},
+				pos: position{line: 1700, col: 7, offset: 48782},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1462, col: 7, offset: 39699},
+						pos: position{line: 1700, col: 7, offset: 48782},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1462, col: 7, offset: 39699},
+							pos:  position{line: 1700, col: 7, offset: 48782},
 							name: "WhiteSpace",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1462, col: 19, offset: 39711},
+						pos:  position{line: 1700, col: 19, offset: 48794},
 						name: "LineTerminator",
 					},
 				},
@@ -11649,16 +14319,16 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 1464, col: 1, offset: 39727},
+			pos:  position{line: 1702, col: 1, offset: 48810},
 			expr: &choiceExpr{
-				pos: position{line: 1464, col: 7, offset: 39733},
+				pos: position{line: 1702, col: 7, offset: 48816},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1464, col: 7, offset: 39733},
+						pos:  position{line: 1702, col: 7, offset: 48816},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1464, col: 11, offset: 39737},
+						pos:  position{line: 1702, col: 11, offset: 48820},
 						name: "EOF",
 					},
 				},
@@ -11666,21 +14336,21 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1466, col: 1, offset: 39742},
+			pos:  position{line: 1704, col: 1, offset: 48825},
 			expr: &notExpr{
-				pos: position{line: 1466, col: 7, offset: 39748},
+				pos: position{line: 1704, col: 7, offset: 48831},
 				expr: &anyMatcher{
-					line: 1466, col: 8, offset: 39749,
+					line: 1704, col: 8, offset: 48832,
 				},
 			},
 		},
 		{
 			name: "EOKW",
-			pos:  position{line: 1468, col: 1, offset: 39752},
+			pos:  position{line: 1706, col: 1, offset: 48835},
 			expr: &notExpr{
-				pos: position{line: 1468, col: 8, offset: 39759},
+				pos: position{line: 1706, col: 8, offset: 48842},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1468, col: 9, offset: 39760},
+					pos:  position{line: 1706, col: 9, offset: 48843},
 					name: "KeyWordChars",
 				},
 			},
@@ -11688,14 +14358,102 @@ var g = &grammar{
 	},
 }
 
-func (c *current) onstart1(ast interface{}) (interface{}, error) {
+func (c *current) onstart2(stmt interface{}) (interface{}, error) {
+	return stmt, nil
+}
+
+func (p *parser) callonstart2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onstart2(stack["stmt"])
+}
+
+func (c *current) onstart9(ast interface{}) (interface{}, error) {
 	return ast, nil
 }
 
-func (p *parser) callonstart1() (interface{}, error) {
+func (p *parser) callonstart9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onstart9(stack["ast"])
+}
+
+func (c *current) onStatement2(name, layout interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "CreatePool", "name": name, "layout": layout}, nil
+
+}
+
+func (p *parser) callonStatement2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStatement2(stack["name"], stack["layout"])
+}
+
+func (c *current) onStatement13(name interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "DropPool", "name": name}, nil
+
+}
+
+func (p *parser) callonStatement13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStatement13(stack["name"])
+}
+
+func (c *current) onStatement21(name, pool, at interface{}) (interface{}, error) {
+	var m = map[string]interface{}{"kind": "CreateBranch", "name": name, "pool": pool, "from": ""}
+	if at != nil {
+		m["from"] = at
+	}
+	return m, nil
+
+}
+
+func (p *parser) callonStatement21() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStatement21(stack["name"], stack["pool"], stack["at"])
+}
+
+func (c *current) onStatement37(pool, branch, query interface{}) (interface{}, error) {
+	var m = map[string]interface{}{"kind": "InsertInto", "pool": pool, "branch": "", "query": query}
+	if branch != nil {
+		m["branch"] = branch
+	}
+	return m, nil
+
+}
+
+func (p *parser) callonStatement37() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStatement37(stack["pool"], stack["branch"], stack["query"])
+}
+
+func (c *current) onStatement51(pool, branch, where interface{}) (interface{}, error) {
+	var m = map[string]interface{}{"kind": "DeleteFrom", "pool": pool, "branch": "", "where": where}
+	if branch != nil {
+		m["branch"] = branch
+	}
+	return m, nil
+
+}
+
+func (p *parser) callonStatement51() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStatement51(stack["pool"], stack["branch"], stack["where"])
+}
+
+func (c *current) onStatementLayout1(keys, order interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Layout", "keys": keys, "order": order}, nil
+
+}
+
+func (p *parser) callonStatementLayout1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onstart1(stack["ast"])
+	return p.cur.onStatementLayout1(stack["keys"], stack["order"])
 }
 
 func (c *current) onSequential1(decls, first, rest interface{}) (interface{}, error) {
@@ -11740,7 +14498,7 @@ func (p *parser) callonDecl1() (interface{}, error) {
 }
 
 func (c *current) onConstDecl2(id, expr interface{}) (interface{}, error) {
-	return map[string]interface{}{"kind": "ConstDecl", "name": id, "expr": expr}, nil
+	return c.loc(map[string]interface{}{"kind": "ConstDecl", "name": id, "expr": expr}), nil
 
 }
 
@@ -11751,13 +14509,13 @@ func (p *parser) callonConstDecl2() (interface{}, error) {
 }
 
 func (c *current) onConstDecl13(id, typ interface{}) (interface{}, error) {
-	return map[string]interface{}{
+	return c.loc(map[string]interface{}{
 
 		"kind": "ConstDecl",
 
 		"name": id,
 
-		"expr": map[string]interface{}{"kind": "TypeValue", "value": map[string]interface{}{"kind": "TypeDef", "name": id, "type": typ}}}, nil
+		"expr": map[string]interface{}{"kind": "TypeValue", "value": map[string]interface{}{"kind": "TypeDef", "name": id, "type": typ}}}), nil
 
 }
 
@@ -11768,7 +14526,7 @@ func (p *parser) callonConstDecl13() (interface{}, error) {
 }
 
 func (c *current) onFuncDecl1(id, params, expr interface{}) (interface{}, error) {
-	return map[string]interface{}{
+	return c.loc(map[string]interface{}{
 
 		"kind": "FuncDecl",
 
@@ -11776,7 +14534,7 @@ func (c *current) onFuncDecl1(id, params, expr interface{}) (interface{}, error)
 
 		"params": params,
 
-		"expr": expr}, nil
+		"expr": expr}), nil
 
 }
 
@@ -11786,133 +14544,174 @@ func (p *parser) callonFuncDecl1() (interface{}, error) {
 	return p.cur.onFuncDecl1(stack["id"], stack["params"], stack["expr"])
 }
 
-func (c *current) onOperation2(ops interface{}) (interface{}, error) {
+func (c *current) onOperation1(op interface{}) (interface{}, error) {
+	return c.loc(op), nil
+}
+
+func (p *parser) callonOperation1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOperation1(stack["op"])
+}
+
+func (c *current) onOperationBody2(ops interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Parallel", "ops": ops}, nil
 
 }
 
-func (p *parser) callonOperation2() (interface{}, error) {
+func (p *parser) callonOperationBody2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation2(stack["ops"])
+	return p.cur.onOperationBody2(stack["ops"])
 }
 
-func (c *current) onOperation12(expr, cases interface{}) (interface{}, error) {
+func (c *current) onOperationBody12(expr, cases interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Switch", "expr": expr, "cases": cases}, nil
 
 }
 
-func (p *parser) callonOperation12() (interface{}, error) {
+func (p *parser) callonOperationBody12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation12(stack["expr"], stack["cases"])
+	return p.cur.onOperationBody12(stack["expr"], stack["cases"])
 }
 
-func (c *current) onOperation25(cases interface{}) (interface{}, error) {
+func (c *current) onOperationBody25(cases interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Switch", "expr": nil, "cases": cases}, nil
 
 }
 
-func (p *parser) callonOperation25() (interface{}, error) {
+func (p *parser) callonOperationBody25() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation25(stack["cases"])
+	return p.cur.onOperationBody25(stack["cases"])
 }
 
-func (c *current) onOperation35(trunks interface{}) (interface{}, error) {
+func (c *current) onOperationBody35(trunks interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "From", "trunks": trunks}, nil
 
 }
 
-func (p *parser) callonOperation35() (interface{}, error) {
+func (p *parser) callonOperationBody35() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOperationBody35(stack["trunks"])
+}
+
+func (c *current) onOperationBody45(body, handler interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Try", "body": body, "catch": handler}, nil
+
+}
+
+func (p *parser) callonOperationBody45() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation35(stack["trunks"])
+	return p.cur.onOperationBody45(stack["body"], stack["handler"])
 }
 
-func (c *current) onOperation47(a interface{}) (interface{}, error) {
+func (c *current) onOperationBody66(a interface{}) (interface{}, error) {
 	return a, nil
 }
 
-func (p *parser) callonOperation47() (interface{}, error) {
+func (p *parser) callonOperationBody66() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation47(stack["a"])
+	return p.cur.onOperationBody66(stack["a"])
 }
 
-func (c *current) onOperation53(a interface{}) (interface{}, error) {
+func (c *current) onOperationBody72(a interface{}) (interface{}, error) {
 	return a, nil
 }
 
-func (p *parser) callonOperation53() (interface{}, error) {
+func (p *parser) callonOperationBody72() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation53(stack["a"])
+	return p.cur.onOperationBody72(stack["a"])
 }
 
-func (c *current) onOperation63(expr interface{}) (interface{}, error) {
+func (c *current) onOperationBody82(expr interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Search", "expr": expr}, nil
 
 }
 
-func (p *parser) callonOperation63() (interface{}, error) {
+func (p *parser) callonOperationBody82() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation63(stack["expr"])
+	return p.cur.onOperationBody82(stack["expr"])
 }
 
-func (c *current) onOperation69(expr interface{}) (interface{}, error) {
+func (c *current) onOperationBody88(expr interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "OpExpr", "expr": expr}, nil
 
 }
 
-func (p *parser) callonOperation69() (interface{}, error) {
+func (p *parser) callonOperationBody88() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation69(stack["expr"])
+	return p.cur.onOperationBody88(stack["expr"])
 }
 
-func (c *current) onOperation72(expr interface{}) (interface{}, error) {
+func (c *current) onOperationBody91(expr interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "OpExpr", "expr": expr}, nil
 
 }
 
-func (p *parser) callonOperation72() (interface{}, error) {
+func (p *parser) callonOperationBody91() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation72(stack["expr"])
+	return p.cur.onOperationBody91(stack["expr"])
 }
 
-func (c *current) onOperation75(expr interface{}) (interface{}, error) {
+func (c *current) onOperationBody94(expr interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "OpExpr", "expr": expr}, nil
 
 }
 
-func (p *parser) callonOperation75() (interface{}, error) {
+func (p *parser) callonOperationBody94() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOperation75(stack["expr"])
+	return p.cur.onOperationBody94(stack["expr"])
 }
 
-func (c *current) onLeg1(s interface{}) (interface{}, error) {
-	return s, nil
+func (c *current) onLeg5(s interface{}) (interface{}, error) {
+	return c.loc(s), nil
+}
+
+func (p *parser) callonLeg5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLeg5(stack["s"])
+}
+
+func (c *current) onLeg1(leg interface{}) (interface{}, error) {
+	return leg, nil
 }
 
 func (p *parser) callonLeg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLeg1(stack["s"])
+	return p.cur.onLeg1(stack["leg"])
+}
+
+func (c *current) onSwitchLeg5(expr, op interface{}) (interface{}, error) {
+	return c.loc(map[string]interface{}{"expr": expr, "op": op}), nil
+}
+
+func (p *parser) callonSwitchLeg5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSwitchLeg5(stack["expr"], stack["op"])
 }
 
-func (c *current) onSwitchLeg1(expr, op interface{}) (interface{}, error) {
-	return map[string]interface{}{"expr": expr, "op": op}, nil
+func (c *current) onSwitchLeg1(leg interface{}) (interface{}, error) {
+	return leg, nil
 
 }
 
 func (p *parser) callonSwitchLeg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSwitchLeg1(stack["expr"], stack["op"])
+	return p.cur.onSwitchLeg1(stack["leg"])
 }
 
 func (c *current) onCase2(expr interface{}) (interface{}, error) {
@@ -11935,19 +14734,29 @@ func (p *parser) callonCase8() (interface{}, error) {
 	return p.cur.onCase8()
 }
 
-func (c *current) onFromLeg1(source, opt interface{}) (interface{}, error) {
+func (c *current) onFromLeg1(trunk interface{}) (interface{}, error) {
+	return trunk, nil
+}
+
+func (p *parser) callonFromLeg1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFromLeg1(stack["trunk"])
+}
+
+func (c *current) onFromTrunk1(source, opt interface{}) (interface{}, error) {
 	var m = map[string]interface{}{"kind": "Trunk", "source": source, "seq": nil}
 	if opt != nil {
 		m["seq"] = opt.([]interface{})[3]
 	}
-	return m, nil
+	return c.loc(m), nil
 
 }
 
-func (p *parser) callonFromLeg1() (interface{}, error) {
+func (p *parser) callonFromTrunk1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromLeg1(stack["source"], stack["opt"])
+	return p.cur.onFromTrunk1(stack["source"], stack["opt"])
 }
 
 func (c *current) onComparator1() (interface{}, error) {
@@ -12209,8 +15018,11 @@ func (p *parser) callonAggAssignment11() (interface{}, error) {
 	return p.cur.onAggAssignment11(stack["agg"])
 }
 
-func (c *current) onAgg1(op, expr, where interface{}) (interface{}, error) {
+func (c *current) onAgg1(op, distinct, expr, where interface{}) (interface{}, error) {
 	var r = map[string]interface{}{"kind": "Agg", "name": op, "expr": nil, "where": where}
+	if distinct != nil {
+		r["distinct"] = true
+	}
 	if expr != nil {
 		r["expr"] = expr
 	}
@@ -12221,7 +15033,7 @@ func (c *current) onAgg1(op, expr, where interface{}) (interface{}, error) {
 func (p *parser) callonAgg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAgg1(stack["op"], stack["expr"], stack["where"])
+	return p.cur.onAgg1(stack["op"], stack["distinct"], stack["expr"], stack["where"])
 }
 
 func (c *current) onWhereClause1(expr interface{}) (interface{}, error) {
@@ -12402,8 +15214,8 @@ func (p *parser) callonTopOp20() (interface{}, error) {
 	return p.cur.onTopOp20(stack["f"])
 }
 
-func (c *current) onTopOp1(limit, flush, fields interface{}) (interface{}, error) {
-	var op = map[string]interface{}{"kind": "Top", "limit": 0, "args": nil, "flush": false}
+func (c *current) onTopOp1(limit, flush, fields, keys interface{}) (interface{}, error) {
+	var op = map[string]interface{}{"kind": "Top", "limit": 0, "args": nil, "flush": false, "keys": keys}
 	if limit != nil {
 		op["limit"] = limit
 	}
@@ -12420,7 +15232,17 @@ func (c *current) onTopOp1(limit, flush, fields interface{}) (interface{}, error
 func (p *parser) callonTopOp1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTopOp1(stack["limit"], stack["flush"], stack["fields"])
+	return p.cur.onTopOp1(stack["limit"], stack["flush"], stack["fields"], stack["keys"])
+}
+
+func (c *current) onPartitionKeys1(keys interface{}) (interface{}, error) {
+	return keys, nil
+}
+
+func (p *parser) callonPartitionKeys1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPartitionKeys1(stack["keys"])
 }
 
 func (c *current) onCutOp1(args interface{}) (interface{}, error) {
@@ -12445,44 +15267,44 @@ func (p *parser) callonDropOp1() (interface{}, error) {
 	return p.cur.onDropOp1(stack["args"])
 }
 
-func (c *current) onHeadOp2(count interface{}) (interface{}, error) {
-	return map[string]interface{}{"kind": "Head", "count": count}, nil
+func (c *current) onHeadOp2(count, keys interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Head", "count": count, "keys": keys}, nil
 }
 
 func (p *parser) callonHeadOp2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHeadOp2(stack["count"])
+	return p.cur.onHeadOp2(stack["count"], stack["keys"])
 }
 
-func (c *current) onHeadOp8() (interface{}, error) {
-	return map[string]interface{}{"kind": "Head", "count": 1}, nil
+func (c *current) onHeadOp11(keys interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Head", "count": 1, "keys": keys}, nil
 }
 
-func (p *parser) callonHeadOp8() (interface{}, error) {
+func (p *parser) callonHeadOp11() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHeadOp8()
+	return p.cur.onHeadOp11(stack["keys"])
 }
 
-func (c *current) onTailOp2(count interface{}) (interface{}, error) {
-	return map[string]interface{}{"kind": "Tail", "count": count}, nil
+func (c *current) onTailOp2(count, keys interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Tail", "count": count, "keys": keys}, nil
 }
 
 func (p *parser) callonTailOp2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTailOp2(stack["count"])
+	return p.cur.onTailOp2(stack["count"], stack["keys"])
 }
 
-func (c *current) onTailOp8() (interface{}, error) {
-	return map[string]interface{}{"kind": "Tail", "count": 1}, nil
+func (c *current) onTailOp11(keys interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Tail", "count": 1, "keys": keys}, nil
 }
 
-func (p *parser) callonTailOp8() (interface{}, error) {
+func (p *parser) callonTailOp11() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTailOp8()
+	return p.cur.onTailOp11(stack["keys"])
 }
 
 func (c *current) onWhereOp1(expr interface{}) (interface{}, error) {
@@ -12650,7 +15472,39 @@ func (p *parser) callonJoinKey3() (interface{}, error) {
 	return p.cur.onJoinKey3(stack["expr"])
 }
 
-func (c *current) onSampleOp1(e interface{}) (interface{}, error) {
+func (c *current) onOutputOp1(name interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Output", "name": name}, nil
+
+}
+
+func (p *parser) callonOutputOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOutputOp1(stack["name"])
+}
+
+func (c *current) onSampleOp12(n interface{}) (interface{}, error) {
+	return n, nil
+}
+
+func (p *parser) callonSampleOp12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSampleOp12(stack["n"])
+}
+
+func (c *current) onSampleOp2(limit, seed, keys interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Sample", "limit": limit, "seed": seed, "keys": keys}, nil
+
+}
+
+func (p *parser) callonSampleOp2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSampleOp2(stack["limit"], stack["seed"], stack["keys"])
+}
+
+func (c *current) onSampleOp22(e interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Sequential", "decls": []interface{}{}, "ops": []interface{}{
 
 		map[string]interface{}{"kind": "Summarize",
@@ -12687,10 +15541,10 @@ func (c *current) onSampleOp1(e interface{}) (interface{}, error) {
 
 }
 
-func (p *parser) callonSampleOp1() (interface{}, error) {
+func (p *parser) callonSampleOp22() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSampleOp1(stack["e"])
+	return p.cur.onSampleOp22(stack["e"])
 }
 
 func (c *current) onOpAssignment1(a interface{}) (interface{}, error) {
@@ -12871,14 +15725,48 @@ func (p *parser) callonPoolSpec12() (interface{}, error) {
 	return p.cur.onPoolSpec12(stack["meta"])
 }
 
-func (c *current) onPoolCommit1(commit interface{}) (interface{}, error) {
+func (c *current) onPoolCommit2(at interface{}) (interface{}, error) {
+	return at, nil
+}
+
+func (p *parser) callonPoolCommit2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPoolCommit2(stack["at"])
+}
+
+func (c *current) onPoolCommit14(t interface{}) (interface{}, error) {
+	return t, nil
+}
+
+func (p *parser) callonPoolCommit14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPoolCommit14(stack["t"])
+}
+
+func (c *current) onPoolCommit7(commit, at interface{}) (interface{}, error) {
+	if at != nil {
+		return commit.(string) + "@" + at.(string), nil
+	}
 	return commit, nil
+
+}
+
+func (p *parser) callonPoolCommit7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPoolCommit7(stack["commit"], stack["at"])
+}
+
+func (c *current) onPoolTime1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonPoolCommit1() (interface{}, error) {
+func (p *parser) callonPoolTime1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPoolCommit1(stack["commit"])
+	return p.cur.onPoolTime1()
 }
 
 func (c *current) onPoolMeta1(meta interface{}) (interface{}, error) {
@@ -13097,6 +15985,47 @@ func (p *parser) callonYieldOp1() (interface{}, error) {
 	return p.cur.onYieldOp1(stack["exprs"])
 }
 
+func (c *current) onPivotOp1(agg, column, keys interface{}) (interface{}, error) {
+	var op = map[string]interface{}{"kind": "Pivot", "agg": agg, "column": column, "keys": nil}
+	if keys != nil {
+		op["keys"] = keys.([]interface{})[1]
+	}
+	return op, nil
+
+}
+
+func (p *parser) callonPivotOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPivotOp1(stack["agg"], stack["column"], stack["keys"])
+}
+
+func (c *current) onUnpivotOp9(name, value interface{}) (interface{}, error) {
+	return []interface{}{name, value}, nil
+}
+
+func (p *parser) callonUnpivotOp9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnpivotOp9(stack["name"], stack["value"])
+}
+
+func (c *current) onUnpivotOp1(args, as interface{}) (interface{}, error) {
+	var op = map[string]interface{}{"kind": "Unpivot", "args": args, "name": nil, "value": nil}
+	if as != nil {
+		op["name"] = as.([]interface{})[0]
+		op["value"] = as.([]interface{})[1]
+	}
+	return op, nil
+
+}
+
+func (p *parser) callonUnpivotOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnpivotOp1(stack["args"], stack["as"])
+}
+
 func (c *current) onTypeArg1(typ interface{}) (interface{}, error) {
 	return typ, nil
 }
@@ -13260,7 +16189,11 @@ func (c *current) onComparisonExpr1(lhs, opAndRHS interface{}) (interface{}, err
 	}
 	var op = opAndRHS.([]interface{})[1]
 	var rhs = opAndRHS.([]interface{})[3]
-	return map[string]interface{}{"kind": "BinaryExpr", "op": op, "lhs": lhs, "rhs": rhs}, nil
+	var m = map[string]interface{}{"kind": "BinaryExpr", "op": op, "lhs": lhs, "rhs": rhs}
+	if len(opAndRHS.([]interface{})) > 4 {
+		return map[string]interface{}{"kind": "UnaryExpr", "op": "!", "operand": m}, nil
+	}
+	return m, nil
 
 }
 
@@ -13270,6 +16203,21 @@ func (p *parser) callonComparisonExpr1() (interface{}, error) {
 	return p.cur.onComparisonExpr1(stack["lhs"], stack["opAndRHS"])
 }
 
+func (c *current) onSQLInQuery1(not, query interface{}) (interface{}, error) {
+	var r = []interface{}{nil, "in", nil, query}
+	if not != nil {
+		r = append(r, "not")
+	}
+	return r, nil
+
+}
+
+func (p *parser) callonSQLInQuery1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLInQuery1(stack["not"], stack["query"])
+}
+
 func (c *current) onAdditiveExpr7(op, expr interface{}) (interface{}, error) {
 	return []interface{}{op, expr}, nil
 }
@@ -13354,26 +16302,26 @@ func (p *parser) callonNegationExpr2() (interface{}, error) {
 	return p.cur.onNegationExpr2(stack["e"])
 }
 
-func (c *current) onFuncExpr2(first, rest interface{}) (interface{}, error) {
+func (c *current) onFuncExpr3(first, rest interface{}) (interface{}, error) {
 	return makeBinaryExprChain(first, rest), nil
 
 }
 
-func (p *parser) callonFuncExpr2() (interface{}, error) {
+func (p *parser) callonFuncExpr3() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFuncExpr2(stack["first"], stack["rest"])
+	return p.cur.onFuncExpr3(stack["first"], stack["rest"])
 }
 
-func (c *current) onFuncExpr9(first, rest interface{}) (interface{}, error) {
+func (c *current) onFuncExpr10(first, rest interface{}) (interface{}, error) {
 	return makeBinaryExprChain(first, rest), nil
 
 }
 
-func (p *parser) callonFuncExpr9() (interface{}, error) {
+func (p *parser) callonFuncExpr10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFuncExpr9(stack["first"], stack["rest"])
+	return p.cur.onFuncExpr10(stack["first"], stack["rest"])
 }
 
 func (c *current) onCast1(typ, expr interface{}) (interface{}, error) {
@@ -13393,21 +16341,36 @@ func (c *current) onFunction3(arg0Text, arg1, where interface{}) (interface{}, e
 
 }
 
-func (p *parser) callonFunction3() (interface{}, error) {
+func (p *parser) callonFunction3() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunction3(stack["arg0Text"], stack["arg1"], stack["where"])
+}
+
+func (c *current) onFunction21(fn, expr, where interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Agg", "name": fn, "distinct": true, "expr": expr, "where": where}, nil
+
+}
+
+func (p *parser) callonFunction21() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFunction3(stack["arg0Text"], stack["arg1"], stack["where"])
+	return p.cur.onFunction21(stack["fn"], stack["expr"], stack["where"])
 }
 
-func (c *current) onFunction21(fn, args, where interface{}) (interface{}, error) {
-	return map[string]interface{}{"kind": "Call", "name": fn, "args": args, "where": where}, nil
+func (c *current) onFunction39(fn, args, where, over interface{}) (interface{}, error) {
+	var call = map[string]interface{}{"kind": "Call", "name": fn, "args": args, "where": where}
+	if over != nil {
+		return map[string]interface{}{"kind": "SQLOver", "func": call, "window": over}, nil
+	}
+	return call, nil
 
 }
 
-func (p *parser) callonFunction21() (interface{}, error) {
+func (p *parser) callonFunction39() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFunction21(stack["fn"], stack["args"], stack["where"])
+	return p.cur.onFunction39(stack["fn"], stack["args"], stack["where"], stack["over"])
 }
 
 func (c *current) onFunctionArgs2(o interface{}) (interface{}, error) {
@@ -13745,11 +16708,51 @@ func (p *parser) callonEntry1() (interface{}, error) {
 	return p.cur.onEntry1(stack["key"], stack["value"])
 }
 
-func (c *current) onSQLOp1(selection, from, joins, where, groupby, having, orderby, limit interface{}) (interface{}, error) {
+func (c *current) onSQLOp5(w interface{}) (interface{}, error) {
+	return w, nil
+}
+
+func (p *parser) callonSQLOp5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOp5(stack["w"])
+}
+
+func (c *current) onSQLOp14(s interface{}) (interface{}, error) {
+	return s, nil
+}
+
+func (p *parser) callonSQLOp14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOp14(stack["s"])
+}
+
+func (c *current) onSQLOp1(ctes, first, rest, orderby, limit interface{}) (interface{}, error) {
+	var m = first.(map[string]interface{})
+	m["with"] = ctes
+	if len(rest.([]interface{})) > 0 {
+		m["union"] = rest
+	}
+	m["order_by"] = orderby
+	m["limit"] = limit
+	return m, nil
+
+}
+
+func (p *parser) callonSQLOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOp1(stack["ctes"], stack["first"], stack["rest"], stack["orderby"], stack["limit"])
+}
+
+func (c *current) onSQLSelectCore1(selection, from, joins, where, groupby, having, windows interface{}) (interface{}, error) {
 	return map[string]interface{}{
 
 		"kind": "SQLExpr",
 
+		"with": nil,
+
 		"select": selection,
 
 		"from": from,
@@ -13762,16 +16765,52 @@ func (c *current) onSQLOp1(selection, from, joins, where, groupby, having, order
 
 		"having": having,
 
-		"order_by": orderby,
+		"windows": windows,
+
+		"union": nil,
 
-		"limit": limit}, nil
+		"order_by": nil,
+
+		"limit": 0}, nil
 
 }
 
-func (p *parser) callonSQLOp1() (interface{}, error) {
+func (p *parser) callonSQLSelectCore1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLSelectCore1(stack["selection"], stack["from"], stack["joins"], stack["where"], stack["groupby"], stack["having"], stack["windows"])
+}
+
+func (c *current) onSQLWith9(cte interface{}) (interface{}, error) {
+	return cte, nil
+}
+
+func (p *parser) callonSQLWith9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLWith9(stack["cte"])
+}
+
+func (c *current) onSQLWith1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+
+}
+
+func (p *parser) callonSQLWith1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLOp1(stack["selection"], stack["from"], stack["joins"], stack["where"], stack["groupby"], stack["having"], stack["orderby"], stack["limit"])
+	return p.cur.onSQLWith1(stack["first"], stack["rest"])
+}
+
+func (c *current) onSQLCTE1(name, query interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": name, "query": query}, nil
+
+}
+
+func (p *parser) callonSQLCTE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLCTE1(stack["name"], stack["query"])
 }
 
 func (c *current) onSQLSelect2() (interface{}, error) {
@@ -13835,183 +16874,407 @@ func (c *current) onSQLFrom2(table, alias interface{}) (interface{}, error) {
 
 }
 
-func (p *parser) callonSQLFrom2() (interface{}, error) {
+func (p *parser) callonSQLFrom2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLFrom2(stack["table"], stack["alias"])
+}
+
+func (c *current) onSQLFrom12() (interface{}, error) {
+	return nil, nil
+}
+
+func (p *parser) callonSQLFrom12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLFrom12()
+}
+
+func (c *current) onSQLAlias2(id interface{}) (interface{}, error) {
+	return id, nil
+}
+
+func (p *parser) callonSQLAlias2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLAlias2(stack["id"])
+}
+
+func (c *current) onSQLAlias9(id interface{}) (interface{}, error) {
+	return id, nil
+}
+
+func (p *parser) callonSQLAlias9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLAlias9(stack["id"])
+}
+
+func (c *current) onSQLJoins7(join interface{}) (interface{}, error) {
+	return join, nil
+}
+
+func (p *parser) callonSQLJoins7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoins7(stack["join"])
+}
+
+func (c *current) onSQLJoins1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+
+}
+
+func (p *parser) callonSQLJoins1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoins1(stack["first"], stack["rest"])
+}
+
+func (c *current) onSQLJoin1(style, table, alias, leftKey, rightKey interface{}) (interface{}, error) {
+	return map[string]interface{}{
+
+		"table": table,
+
+		"style": style,
+
+		"left_key": leftKey,
+
+		"right_key": rightKey,
+
+		"alias": alias}, nil
+
+}
+
+func (p *parser) callonSQLJoin1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoin1(stack["style"], stack["table"], stack["alias"], stack["leftKey"], stack["rightKey"])
+}
+
+func (c *current) onSQLJoinStyle2(style interface{}) (interface{}, error) {
+	return style, nil
+}
+
+func (p *parser) callonSQLJoinStyle2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoinStyle2(stack["style"])
+}
+
+func (c *current) onSQLJoinStyle11() (interface{}, error) {
+	return "inner", nil
+}
+
+func (p *parser) callonSQLJoinStyle11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoinStyle11()
+}
+
+func (c *current) onSQLWhere1(expr interface{}) (interface{}, error) {
+	return expr, nil
+}
+
+func (p *parser) callonSQLWhere1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLWhere1(stack["expr"])
+}
+
+func (c *current) onSQLGroupBy1(columns interface{}) (interface{}, error) {
+	return columns, nil
+}
+
+func (p *parser) callonSQLGroupBy1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLGroupBy1(stack["columns"])
+}
+
+func (c *current) onSQLHaving1(expr interface{}) (interface{}, error) {
+	return expr, nil
+}
+
+func (p *parser) callonSQLHaving1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLHaving1(stack["expr"])
+}
+
+func (c *current) onSQLOrderBy1(keys, order interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "SQLOrderBy", "keys": keys, "order": order}, nil
+
+}
+
+func (p *parser) callonSQLOrderBy1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOrderBy1(stack["keys"], stack["order"])
+}
+
+func (c *current) onSQLOrder2(dir interface{}) (interface{}, error) {
+	return dir, nil
+}
+
+func (p *parser) callonSQLOrder2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOrder2(stack["dir"])
+}
+
+func (c *current) onSQLOrder9() (interface{}, error) {
+	return "asc", nil
+}
+
+func (p *parser) callonSQLOrder9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOrder9()
+}
+
+func (c *current) onSQLLimit2(count interface{}) (interface{}, error) {
+	return count, nil
+}
+
+func (p *parser) callonSQLLimit2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLLimit2(stack["count"])
+}
+
+func (c *current) onSQLLimit9() (interface{}, error) {
+	return 0, nil
+}
+
+func (p *parser) callonSQLLimit9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLLimit9()
+}
+
+func (c *current) onSQLWindows10(w interface{}) (interface{}, error) {
+	return w, nil
+}
+
+func (p *parser) callonSQLWindows10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLWindows10(stack["w"])
+}
+
+func (c *current) onSQLWindows1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+
+}
+
+func (p *parser) callonSQLWindows1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLWindows1(stack["first"], stack["rest"])
+}
+
+func (c *current) onSQLNamedWindow1(name, w interface{}) (interface{}, error) {
+	var m = w.(map[string]interface{})
+	m["name"] = name
+	return m, nil
+
+}
+
+func (p *parser) callonSQLNamedWindow1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLNamedWindow1(stack["name"], stack["w"])
+}
+
+func (c *current) onSQLOverClause1(w interface{}) (interface{}, error) {
+	return w, nil
+}
+
+func (p *parser) callonSQLOverClause1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLFrom2(stack["table"], stack["alias"])
+	return p.cur.onSQLOverClause1(stack["w"])
 }
 
-func (c *current) onSQLFrom12() (interface{}, error) {
-	return nil, nil
+func (c *current) onSQLWindowRef2(w interface{}) (interface{}, error) {
+	return w, nil
 }
 
-func (p *parser) callonSQLFrom12() (interface{}, error) {
+func (p *parser) callonSQLWindowRef2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLFrom12()
+	return p.cur.onSQLWindowRef2(stack["w"])
 }
 
-func (c *current) onSQLAlias2(id interface{}) (interface{}, error) {
-	return id, nil
+func (c *current) onSQLWindowRef10(base interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "SQLWindow", "name": "", "base": base, "partition_by": nil, "order_by": nil, "frame": nil}, nil
+
 }
 
-func (p *parser) callonSQLAlias2() (interface{}, error) {
+func (p *parser) callonSQLWindowRef10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLAlias2(stack["id"])
+	return p.cur.onSQLWindowRef10(stack["base"])
 }
 
-func (c *current) onSQLAlias9(id interface{}) (interface{}, error) {
+func (c *current) onSQLWindowSpec5(id interface{}) (interface{}, error) {
 	return id, nil
 }
 
-func (p *parser) callonSQLAlias9() (interface{}, error) {
+func (p *parser) callonSQLWindowSpec5() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLAlias9(stack["id"])
+	return p.cur.onSQLWindowSpec5(stack["id"])
 }
 
-func (c *current) onSQLJoins7(join interface{}) (interface{}, error) {
-	return join, nil
+func (c *current) onSQLWindowSpec14(keys interface{}) (interface{}, error) {
+	return keys, nil
 }
 
-func (p *parser) callonSQLJoins7() (interface{}, error) {
+func (p *parser) callonSQLWindowSpec14() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoins7(stack["join"])
+	return p.cur.onSQLWindowSpec14(stack["keys"])
 }
 
-func (c *current) onSQLJoins1(first, rest interface{}) (interface{}, error) {
-	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+func (c *current) onSQLWindowSpec25(keys, order interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "SQLOrderBy", "keys": keys, "order": order}, nil
 
 }
 
-func (p *parser) callonSQLJoins1() (interface{}, error) {
+func (p *parser) callonSQLWindowSpec25() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoins1(stack["first"], stack["rest"])
+	return p.cur.onSQLWindowSpec25(stack["keys"], stack["order"])
 }
 
-func (c *current) onSQLJoin1(style, table, alias, leftKey, rightKey interface{}) (interface{}, error) {
-	return map[string]interface{}{
-
-		"table": table,
-
-		"style": style,
+func (c *current) onSQLWindowSpec1(base, partition, orderby, frame interface{}) (interface{}, error) {
+	var m = map[string]interface{}{"kind": "SQLWindow", "name": "", "base": "", "partition_by": partition, "order_by": orderby, "frame": frame}
+	if base != nil {
+		m["base"] = base
+	}
+	return m, nil
 
-		"left_key": leftKey,
+}
 
-		"right_key": rightKey,
+func (p *parser) callonSQLWindowSpec1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLWindowSpec1(stack["base"], stack["partition"], stack["orderby"], stack["frame"])
+}
 
-		"alias": alias}, nil
+func (c *current) onSQLFrame2(units, start, end interface{}) (interface{}, error) {
+	return map[string]interface{}{"units": units, "start": start, "end": end}, nil
 
 }
 
-func (p *parser) callonSQLJoin1() (interface{}, error) {
+func (p *parser) callonSQLFrame2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoin1(stack["style"], stack["table"], stack["alias"], stack["leftKey"], stack["rightKey"])
+	return p.cur.onSQLFrame2(stack["units"], stack["start"], stack["end"])
 }
 
-func (c *current) onSQLJoinStyle2(style interface{}) (interface{}, error) {
-	return style, nil
+func (c *current) onSQLFrame16(units, start interface{}) (interface{}, error) {
+	return map[string]interface{}{"units": units, "start": start, "end": map[string]interface{}{"type": "current_row", "offset": 0}}, nil
+
 }
 
-func (p *parser) callonSQLJoinStyle2() (interface{}, error) {
+func (p *parser) callonSQLFrame16() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoinStyle2(stack["style"])
+	return p.cur.onSQLFrame16(stack["units"], stack["start"])
 }
 
-func (c *current) onSQLJoinStyle11() (interface{}, error) {
-	return "inner", nil
+func (c *current) onSQLFrameBound2() (interface{}, error) {
+	return map[string]interface{}{"type": "unbounded_preceding", "offset": 0}, nil
 }
 
-func (p *parser) callonSQLJoinStyle11() (interface{}, error) {
+func (p *parser) callonSQLFrameBound2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoinStyle11()
+	return p.cur.onSQLFrameBound2()
 }
 
-func (c *current) onSQLWhere1(expr interface{}) (interface{}, error) {
-	return expr, nil
+func (c *current) onSQLFrameBound7() (interface{}, error) {
+	return map[string]interface{}{"type": "unbounded_following", "offset": 0}, nil
 }
 
-func (p *parser) callonSQLWhere1() (interface{}, error) {
+func (p *parser) callonSQLFrameBound7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLWhere1(stack["expr"])
+	return p.cur.onSQLFrameBound7()
 }
 
-func (c *current) onSQLGroupBy1(columns interface{}) (interface{}, error) {
-	return columns, nil
+func (c *current) onSQLFrameBound12() (interface{}, error) {
+	return map[string]interface{}{"type": "current_row", "offset": 0}, nil
 }
 
-func (p *parser) callonSQLGroupBy1() (interface{}, error) {
+func (p *parser) callonSQLFrameBound12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLGroupBy1(stack["columns"])
+	return p.cur.onSQLFrameBound12()
 }
 
-func (c *current) onSQLHaving1(expr interface{}) (interface{}, error) {
-	return expr, nil
+func (c *current) onSQLFrameBound17(n interface{}) (interface{}, error) {
+	return map[string]interface{}{"type": "preceding", "offset": n}, nil
 }
 
-func (p *parser) callonSQLHaving1() (interface{}, error) {
+func (p *parser) callonSQLFrameBound17() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLHaving1(stack["expr"])
+	return p.cur.onSQLFrameBound17(stack["n"])
 }
 
-func (c *current) onSQLOrderBy1(keys, order interface{}) (interface{}, error) {
-	return map[string]interface{}{"kind": "SQLOrderBy", "keys": keys, "order": order}, nil
-
+func (c *current) onSQLFrameBound23(n interface{}) (interface{}, error) {
+	return map[string]interface{}{"type": "following", "offset": n}, nil
 }
 
-func (p *parser) callonSQLOrderBy1() (interface{}, error) {
+func (p *parser) callonSQLFrameBound23() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLOrderBy1(stack["keys"], stack["order"])
+	return p.cur.onSQLFrameBound23(stack["n"])
 }
 
-func (c *current) onSQLOrder2(dir interface{}) (interface{}, error) {
-	return dir, nil
+func (c *current) onCaseExpr6(e interface{}) (interface{}, error) {
+	return e, nil
 }
 
-func (p *parser) callonSQLOrder2() (interface{}, error) {
+func (p *parser) callonCaseExpr6() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLOrder2(stack["dir"])
+	return p.cur.onCaseExpr6(stack["e"])
 }
 
-func (c *current) onSQLOrder9() (interface{}, error) {
-	return "asc", nil
+func (c *current) onCaseExpr15(cond, value interface{}) (interface{}, error) {
+	return []interface{}{cond, value}, nil
 }
 
-func (p *parser) callonSQLOrder9() (interface{}, error) {
+func (p *parser) callonCaseExpr15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLOrder9()
+	return p.cur.onCaseExpr15(stack["cond"], stack["value"])
 }
 
-func (c *current) onSQLLimit2(count interface{}) (interface{}, error) {
-	return count, nil
+func (c *current) onCaseExpr29(e interface{}) (interface{}, error) {
+	return e, nil
 }
 
-func (p *parser) callonSQLLimit2() (interface{}, error) {
+func (p *parser) callonCaseExpr29() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLLimit2(stack["count"])
+	return p.cur.onCaseExpr29(stack["e"])
 }
 
-func (c *current) onSQLLimit9() (interface{}, error) {
-	return 0, nil
+func (c *current) onCaseExpr1(subject, whens, otherwise interface{}) (interface{}, error) {
+	return makeCaseExpr(subject, whens, otherwise), nil
+
 }
 
-func (p *parser) callonSQLLimit9() (interface{}, error) {
+func (p *parser) callonCaseExpr1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLLimit9()
+	return p.cur.onCaseExpr1(stack["subject"], stack["whens"], stack["otherwise"])
 }
 
 func (c *current) onSELECT1() (interface{}, error) {
@@ -14184,6 +17447,286 @@ func (p *parser) callonINNER1() (interface{}, error) {
 	return p.cur.onINNER1()
 }
 
+func (c *current) onWITH1() (interface{}, error) {
+	return "with", nil
+}
+
+func (p *parser) callonWITH1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWITH1()
+}
+
+func (c *current) onUNION1() (interface{}, error) {
+	return "union", nil
+}
+
+func (p *parser) callonUNION1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUNION1()
+}
+
+func (c *current) onALL1() (interface{}, error) {
+	return "all", nil
+}
+
+func (p *parser) callonALL1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onALL1()
+}
+
+func (c *current) onIN1() (interface{}, error) {
+	return "in", nil
+}
+
+func (p *parser) callonIN1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIN1()
+}
+
+func (c *current) onDISTINCT1() (interface{}, error) {
+	return "distinct", nil
+}
+
+func (p *parser) callonDISTINCT1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDISTINCT1()
+}
+
+func (c *current) onCASE1() (interface{}, error) {
+	return "case", nil
+}
+
+func (p *parser) callonCASE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCASE1()
+}
+
+func (c *current) onWHEN1() (interface{}, error) {
+	return "when", nil
+}
+
+func (p *parser) callonWHEN1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWHEN1()
+}
+
+func (c *current) onTHEN1() (interface{}, error) {
+	return "then", nil
+}
+
+func (p *parser) callonTHEN1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTHEN1()
+}
+
+func (c *current) onELSE1() (interface{}, error) {
+	return "else", nil
+}
+
+func (p *parser) callonELSE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onELSE1()
+}
+
+func (c *current) onEND1() (interface{}, error) {
+	return "end", nil
+}
+
+func (p *parser) callonEND1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEND1()
+}
+
+func (c *current) onOVER1() (interface{}, error) {
+	return "over", nil
+}
+
+func (p *parser) callonOVER1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOVER1()
+}
+
+func (c *current) onWINDOW1() (interface{}, error) {
+	return "window", nil
+}
+
+func (p *parser) callonWINDOW1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWINDOW1()
+}
+
+func (c *current) onPARTITION1() (interface{}, error) {
+	return "partition", nil
+}
+
+func (p *parser) callonPARTITION1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPARTITION1()
+}
+
+func (c *current) onROWS1() (interface{}, error) {
+	return "rows", nil
+}
+
+func (p *parser) callonROWS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onROWS1()
+}
+
+func (c *current) onRANGE1() (interface{}, error) {
+	return "range", nil
+}
+
+func (p *parser) callonRANGE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRANGE1()
+}
+
+func (c *current) onBETWEEN1() (interface{}, error) {
+	return "between", nil
+}
+
+func (p *parser) callonBETWEEN1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBETWEEN1()
+}
+
+func (c *current) onUNBOUNDED1() (interface{}, error) {
+	return "unbounded", nil
+}
+
+func (p *parser) callonUNBOUNDED1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUNBOUNDED1()
+}
+
+func (c *current) onPRECEDING1() (interface{}, error) {
+	return "preceding", nil
+}
+
+func (p *parser) callonPRECEDING1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPRECEDING1()
+}
+
+func (c *current) onFOLLOWING1() (interface{}, error) {
+	return "following", nil
+}
+
+func (p *parser) callonFOLLOWING1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFOLLOWING1()
+}
+
+func (c *current) onCURRENT1() (interface{}, error) {
+	return "current", nil
+}
+
+func (p *parser) callonCURRENT1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCURRENT1()
+}
+
+func (c *current) onROW1() (interface{}, error) {
+	return "row", nil
+}
+
+func (p *parser) callonROW1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onROW1()
+}
+
+func (c *current) onCREATE1() (interface{}, error) {
+	return "create", nil
+}
+
+func (p *parser) callonCREATE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCREATE1()
+}
+
+func (c *current) onDROP1() (interface{}, error) {
+	return "drop", nil
+}
+
+func (p *parser) callonDROP1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDROP1()
+}
+
+func (c *current) onPOOL1() (interface{}, error) {
+	return "pool", nil
+}
+
+func (p *parser) callonPOOL1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPOOL1()
+}
+
+func (c *current) onBRANCH1() (interface{}, error) {
+	return "branch", nil
+}
+
+func (p *parser) callonBRANCH1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBRANCH1()
+}
+
+func (c *current) onINSERT1() (interface{}, error) {
+	return "insert", nil
+}
+
+func (p *parser) callonINSERT1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onINSERT1()
+}
+
+func (c *current) onINTO1() (interface{}, error) {
+	return "into", nil
+}
+
+func (p *parser) callonINTO1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onINTO1()
+}
+
+func (c *current) onDELETE1() (interface{}, error) {
+	return "delete", nil
+}
+
+func (p *parser) callonDELETE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDELETE1()
+}
+
 func (c *current) onSubnetLiteral2(v interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Primitive", "type": "net", "text": v}, nil
 
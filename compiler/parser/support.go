@@ -20,6 +20,20 @@ func makeBinaryExprChain(first, rest interface{}) interface{} {
 	return ret
 }
 
+// loc sets the "loc" field of the map v, which is the AST node of an operator,
+// declaration, or branch, to the offsets of the first and last bytes of the
+// current match if the Locations option was given to the parser.  Otherwise,
+// v is unchanged so the AST matches the output of the JavaScript parser.
+func (c *current) loc(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok && c.globalStore[locationsKey] == true {
+		m["loc"] = map[string]interface{}{
+			"first": c.pos.offset,
+			"last":  c.pos.offset + len(c.text) - 1,
+		}
+	}
+	return v
+}
+
 func makeArgMap(args interface{}) (interface{}, error) {
 	m := make(map[string]interface{})
 	for _, a := range args.([]interface{}) {
@@ -98,3 +112,34 @@ func makeUnicodeChar(chars interface{}) string {
 
 	return string(r)
 }
+
+// makeCaseExpr returns the chain of Conditional expressions for a SQL CASE
+// expression.  whens is an array of [condition, value] pairs and, if subject
+// is not nil, each condition is a value compared to subject.  Without an
+// ELSE, the result is null when no condition holds.
+func makeCaseExpr(subject, whens, otherwise interface{}) interface{} {
+	ret := otherwise
+	if ret == nil {
+		ret = map[string]interface{}{"kind": "Primitive", "type": "null", "text": ""}
+	}
+	w := whens.([]interface{})
+	for k := len(w) - 1; k >= 0; k-- {
+		when := w[k].([]interface{})
+		cond := when[0]
+		if subject != nil {
+			cond = map[string]interface{}{
+				"kind": "BinaryExpr",
+				"op":   "==",
+				"lhs":  subject,
+				"rhs":  cond,
+			}
+		}
+		ret = map[string]interface{}{
+			"kind": "Conditional",
+			"cond": cond,
+			"then": when[1],
+			"else": ret,
+		}
+	}
+	return ret
+}
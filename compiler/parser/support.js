@@ -19,6 +19,21 @@ function makeBinaryExprChain(first, rest) {
   return ret
 }
 
+function makeCaseExpr(subject, whens, otherwise) {
+  let ret = otherwise
+  if (!ret) {
+    ret = { kind: "Primitive", type: "null", text: "" }
+  }
+  for (let k = whens.length - 1; k >= 0; k--) {
+    let cond = whens[k][0]
+    if (subject) {
+      cond = { kind: "BinaryExpr", op: "==", lhs: subject, rhs: cond }
+    }
+    ret = { kind: "Conditional", cond: cond, then: whens[k][1], else: ret }
+  }
+  return ret
+}
+
 function makeTemplateExprChain(args) {
   let ret = args[0]
   for (let part of args.slice(1)) {
@@ -7,6 +7,7 @@ import (
 	"github.com/brimdata/zed/compiler/ast/dag"
 	"github.com/brimdata/zed/compiler/data"
 	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/order"
 )
 
 // Analyze performs a semantic analysis of the AST, translating it from AST
@@ -15,3 +16,8 @@ import (
 func Analyze(ctx context.Context, seq *ast.Sequential, source *data.Source, head *lakeparse.Commitish) (*dag.Sequential, error) {
 	return semSequential(ctx, NewScope(), seq, source, head)
 }
+
+// Layout returns the pool layout described by the keys and order of p.
+func Layout(p *ast.Layout) (order.Layout, error) {
+	return semLayout(p)
+}
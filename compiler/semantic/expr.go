@@ -141,7 +141,7 @@ func semExpr(scope *Scope, e ast.Expr) (dag.Expr, error) {
 		if err != nil {
 			return nil, err
 		}
-		if expr == nil && e.Name != "count" {
+		if expr == nil && (e.Name != "count" || e.Distinct) {
 			return nil, fmt.Errorf("aggregator '%s' requires argument", e.Name)
 		}
 		where, err := semExprNullable(scope, e.Where)
@@ -149,10 +149,11 @@ func semExpr(scope *Scope, e ast.Expr) (dag.Expr, error) {
 			return nil, err
 		}
 		return &dag.Agg{
-			Kind:  "Agg",
-			Name:  e.Name,
-			Expr:  expr,
-			Where: where,
+			Kind:     "Agg",
+			Name:     e.Name,
+			Distinct: e.Distinct,
+			Expr:     expr,
+			Where:    where,
 		}, nil
 	case *ast.RecordExpr:
 		var out []dag.RecordElem
@@ -248,6 +249,10 @@ func semExpr(scope *Scope, e ast.Expr) (dag.Expr, error) {
 			Exprs: exprs,
 			Scope: seq,
 		}, nil
+	case *ast.SQLExpr:
+		return nil, errors.New("IN subquery must be a condition of a WHERE clause and not part of an OR or NOT expression")
+	case *ast.SQLOver:
+		return nil, errors.New("window functions may be used only in the SELECT clause")
 	}
 	return nil, fmt.Errorf("invalid expression type %T", e)
 }
@@ -14,11 +14,15 @@ import (
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/reglob"
+	"github.com/brimdata/zed/runtime/expr/agg"
 	"github.com/brimdata/zed/runtime/expr/function"
 	"github.com/segmentio/ksuid"
 	"golang.org/x/exp/slices"
 )
 
+// ErrNoLake is returned when a query reads from a pool but there is no lake.
+var ErrNoLake = errors.New("semantic analyzer: from pool cannot be used without a lake")
+
 func semFrom(ctx context.Context, scope *Scope, from *ast.From, source *data.Source, head *lakeparse.Commitish) (*dag.From, error) {
 	var trunks []dag.Trunk
 	for _, in := range from.Trunks {
@@ -92,7 +96,7 @@ func semSource(ctx context.Context, scope *Scope, source ast.Source, ds *data.So
 		}, nil
 	case *ast.Pool:
 		if !ds.IsLake() {
-			return nil, errors.New("semantic analyzer: from pool cannot be used without a lake")
+			return nil, ErrNoLake
 		}
 		return semPool(ctx, scope, p, ds, head)
 	case *ast.Pass:
@@ -244,6 +248,10 @@ func semPoolWithName(ctx context.Context, scope *Scope, p *ast.Pool, poolName st
 			return nil, err
 		}
 	}
+	partition, err := ds.Partition(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
 	return &dag.Pool{
 		Kind:      "Pool",
 		ID:        poolID,
@@ -252,6 +260,7 @@ func semPoolWithName(ctx context.Context, scope *Scope, p *ast.Pool, poolName st
 		ScanLower: lower,
 		ScanUpper: upper,
 		ScanOrder: p.ScanOrder,
+		Partition: partition,
 	}, nil
 }
 
@@ -350,6 +359,25 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 		}, nil
 	case *ast.Sequential:
 		return semSequential(ctx, scope, o, ds, head)
+	case *ast.Output:
+		return &dag.Output{
+			Kind: "Output",
+			Name: o.Name,
+		}, nil
+	case *ast.Try:
+		body, err := semSequential(ctx, scope, o.Body, ds, head)
+		if err != nil {
+			return nil, err
+		}
+		catch, err := semSequential(ctx, scope, o.Catch, ds, head)
+		if err != nil {
+			return nil, err
+		}
+		return &dag.Try{
+			Kind:  "Try",
+			Body:  body,
+			Catch: catch,
+		}, nil
 	case *ast.Switch:
 		var expr dag.Expr
 		if o.Expr != nil {
@@ -426,18 +454,42 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 		if limit == 0 {
 			limit = 1
 		}
+		keys, err := semExprs(scope, o.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("head: %w", err)
+		}
 		return &dag.Head{
 			Kind:  "Head",
 			Count: limit,
+			Keys:  keys,
 		}, nil
 	case *ast.Tail:
 		limit := o.Count
 		if limit == 0 {
 			limit = 1
 		}
+		keys, err := semExprs(scope, o.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("tail: %w", err)
+		}
 		return &dag.Tail{
 			Kind:  "Tail",
 			Count: limit,
+			Keys:  keys,
+		}, nil
+	case *ast.Sample:
+		if o.Limit == 0 {
+			return nil, errors.New("sample: limit must be greater than zero")
+		}
+		keys, err := semExprs(scope, o.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("sample: %w", err)
+		}
+		return &dag.Sample{
+			Kind:  "Sample",
+			Limit: o.Limit,
+			Seed:  o.Seed,
+			Keys:  keys,
 		}, nil
 	case *ast.Uniq:
 		return &dag.Uniq{
@@ -474,11 +526,19 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 		if len(args) == 0 {
 			return nil, errors.New("top: no arguments given")
 		}
+		keys, err := semExprs(scope, o.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("top: %w", err)
+		}
+		if len(keys) != 0 && o.Flush {
+			return nil, errors.New("top: -flush cannot be used with by")
+		}
 		return &dag.Top{
 			Kind:  "Top",
 			Args:  args,
 			Flush: o.Flush,
 			Limit: o.Limit,
+			Keys:  keys,
 		}, nil
 	case *ast.Put:
 		assignments, err := semAssignments(scope, o.Args, false)
@@ -585,7 +645,7 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 		}
 		return &dag.Merge{
 			Kind:  "Merge",
-			Expr:  expr,
+			Exprs: []dag.Expr{expr},
 			Order: order.Asc, //XXX
 		}, nil
 	case *ast.Over:
@@ -621,10 +681,84 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 			Kind:  "Yield",
 			Exprs: exprs,
 		}, nil
+	case *ast.Pivot:
+		return semPivot(scope, o)
+	case *ast.Unpivot:
+		return semUnpivot(scope, o)
 	}
 	return nil, fmt.Errorf("semantic transform: unknown AST operator type: %T", o)
 }
 
+func semPivot(scope *Scope, p *ast.Pivot) (*dag.Pivot, error) {
+	if p.Agg == nil {
+		return nil, errors.New("pivot: no aggregation given")
+	}
+	if _, err := agg.NewPattern(p.Agg.Name, p.Agg.Expr != nil); err != nil {
+		return nil, fmt.Errorf("pivot: %w", err)
+	}
+	e, err := semExpr(scope, p.Agg)
+	if err != nil {
+		return nil, fmt.Errorf("pivot: %w", err)
+	}
+	column, err := semExpr(scope, p.Column)
+	if err != nil {
+		return nil, fmt.Errorf("pivot: %w", err)
+	}
+	keys, err := semAssignments(scope, p.Keys, true)
+	if err != nil {
+		return nil, fmt.Errorf("pivot: %w", err)
+	}
+	return &dag.Pivot{
+		Kind:   "Pivot",
+		Agg:    e.(*dag.Agg),
+		Column: column,
+		Keys:   keys,
+	}, nil
+}
+
+func semUnpivot(scope *Scope, p *ast.Unpivot) (*dag.Unpivot, error) {
+	args, err := semFields(scope, p.Args)
+	if err != nil {
+		return nil, fmt.Errorf("unpivot: %w", err)
+	}
+	if len(args) == 0 {
+		return nil, errors.New("unpivot: no fields given")
+	}
+	name, err := semUnpivotField(scope, p.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+	value, err := semUnpivotField(scope, p.Value, "value")
+	if err != nil {
+		return nil, err
+	}
+	if name.Path[0] == value.Path[0] {
+		return nil, fmt.Errorf("unpivot: name and value fields are both %q", name.Path[0])
+	}
+	return &dag.Unpivot{
+		Kind:  "Unpivot",
+		Args:  args,
+		Name:  name,
+		Value: value,
+	}, nil
+}
+
+// semUnpivotField returns the top-level output field given by e or,
+// if e is nil, a field with the default name def.
+func semUnpivotField(scope *Scope, e ast.Expr, def string) (*dag.This, error) {
+	if e == nil {
+		return &dag.This{Kind: "This", Path: field.New(def)}, nil
+	}
+	f, err := semField(scope, e)
+	if err != nil {
+		return nil, fmt.Errorf("unpivot: %w", err)
+	}
+	if len(f.Path) != 1 {
+		return nil, fmt.Errorf("unpivot: %s must be a top-level field", field.Path(f.Path))
+	}
+	return f, nil
+}
+
 func semOver(ctx context.Context, scope *Scope, in *ast.Over, ds *data.Source, head *lakeparse.Commitish) (*dag.Over, error) {
 	exprs, err := semExprs(scope, in.Exprs)
 	if err != nil {
@@ -1,8 +1,10 @@
 package semantic
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/compiler/ast/dag"
@@ -11,14 +13,94 @@ import (
 	"github.com/brimdata/zed/runtime/expr/agg"
 )
 
+// sqlTables maps the name of each common table expression in the WITH
+// clauses enclosing a query to its definition.  A table's query is converted
+// wherever the table is used so each use has its own copy of the DAG.
+type sqlTables map[string]sqlTable
+
+type sqlTable struct {
+	query *ast.SQLExpr
+	// tables are the tables visible to query, i.e., those defined before it.
+	tables sqlTables
+}
+
+func (t sqlTables) with(ctes []ast.SQLCTE) sqlTables {
+	for _, cte := range ctes {
+		out := make(sqlTables, len(t)+1)
+		for name, table := range t {
+			out[name] = table
+		}
+		out[cte.Name] = sqlTable{cte.Query, t}
+		t = out
+	}
+	return t
+}
+
 func convertSQLOp(scope *Scope, sql *ast.SQLExpr) (dag.Op, error) {
-	selection, err := newSQLSelection(scope, sql.Select)
-	if err != err {
+	ops, err := convertSQLQuery(scope, nil, sql)
+	if err != nil {
+		return nil, err
+	}
+	return wrapOrPass(ops), nil
+}
+
+func convertSQLQuery(scope *Scope, tables sqlTables, sql *ast.SQLExpr) ([]dag.Op, error) {
+	tables = tables.with(sql.With)
+	ops, err := convertSQLSelectCore(scope, tables, sql)
+	if err != nil {
 		return nil, err
 	}
+	if len(sql.Union) > 0 {
+		// UNION ALL runs each query on the input and merges the results.
+		branches := []dag.Op{wrapOrPass(ops)}
+		for _, u := range sql.Union {
+			branch, err := convertSQLSelectCore(scope, tables, u)
+			if err != nil {
+				return nil, err
+			}
+			branches = append(branches, wrapOrPass(branch))
+		}
+		ops = []dag.Op{&dag.Parallel{
+			Kind: "Parallel",
+			Ops:  branches,
+		}}
+	}
+	if sql.OrderBy != nil {
+		keys, err := semExprs(scope, sql.OrderBy.Keys)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, sortByMulti(keys, sql.OrderBy.Order))
+	}
+	if sql.Limit != 0 {
+		p := &dag.Head{
+			Kind:  "Head",
+			Count: sql.Limit,
+		}
+		ops = append(ops, p)
+	}
+	return ops, nil
+}
+
+// convertSQLSelectCore converts the clauses of sql from SELECT through
+// HAVING.  The WITH, UNION ALL, ORDER BY, and LIMIT clauses are handled
+// by convertSQLQuery.
+func convertSQLSelectCore(scope *Scope, tables sqlTables, sql *ast.SQLExpr) ([]dag.Op, error) {
+	selectAST, windowFuncs, err := splitSQLWindowFuncs(sql)
+	if err != nil {
+		return nil, err
+	}
+	selection, err := newSQLSelection(scope, selectAST)
+	if err != nil {
+		return nil, err
+	}
+	if len(windowFuncs) > 0 && (sql.GroupBy != nil || len(selection.aggs()) > 0) {
+		return nil, errors.New("window functions cannot be used with GROUP BY or aggregations")
+	}
+	astWhere, inQueries := splitSQLInQueries(sql.Where)
 	var where dag.Expr
-	if sql.Where != nil {
-		where, err = semExpr(scope, sql.Where)
+	if astWhere != nil {
+		where, err = semExpr(scope, astWhere)
 		if err != nil {
 			return nil, err
 		}
@@ -29,11 +111,11 @@ func convertSQLOp(scope *Scope, sql *ast.SQLExpr) (dag.Op, error) {
 		if err != nil {
 			return nil, err
 		}
-		tableFilter, err := convertSQLTableRef(scope, sql.From.Table)
+		table, err := convertSQLTableRef(scope, tables, sql.From.Table)
 		if err != nil {
 			return nil, err
 		}
-		ops = append(ops, tableFilter)
+		ops = append(ops, table...)
 		if aliasID != "" {
 			// If the FROM table has been aliased and all join clauses, if any,
 			// are also aliased, then we can lift any where claused that is dependent
@@ -54,7 +136,13 @@ func convertSQLOp(scope *Scope, sql *ast.SQLExpr) (dag.Op, error) {
 		if len(ops) == 0 {
 			return nil, errors.New("cannot JOIN without a FROM")
 		}
-		ops, err = convertSQLJoins(scope, ops, sql.Joins)
+		ops, err = convertSQLJoins(scope, tables, ops, sql.Joins)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, in := range inQueries {
+		ops, err = convertSQLInQuery(scope, tables, ops, in)
 		if err != nil {
 			return nil, err
 		}
@@ -87,6 +175,11 @@ func convertSQLOp(scope *Scope, sql *ast.SQLExpr) (dag.Op, error) {
 		if sql.Having != nil {
 			return nil, errors.New("HAVING clause used without GROUP BY")
 		}
+		windows, err := convertSQLWindowFuncs(scope, windowFuncs)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, windows...)
 		// GroupBy will do the cutting but if there's no GroupBy,
 		// then we need a cut for the select expressions.
 		// For SELECT *, cutter is nil.
@@ -98,24 +191,396 @@ func convertSQLOp(scope *Scope, sql *ast.SQLExpr) (dag.Op, error) {
 			ops = append(ops, selector)
 		}
 	}
-	if sql.OrderBy != nil {
-		keys, err := semExprs(scope, sql.OrderBy.Keys)
+	return ops, nil
+}
+
+// A sqlWindowFunc is a window function in the SELECT clause.  Its value is
+// computed into the temporary field tmp, which replaces the function in the
+// selection.
+type sqlWindowFunc struct {
+	tmp    string
+	call   *ast.Call
+	window *ast.SQLWindow
+}
+
+// splitSQLWindowFuncs returns the SELECT clause of sql with each window
+// function replaced by a reference to its temporary field along with the
+// replaced functions, whose windows are resolved against the WINDOW clause.
+func splitSQLWindowFuncs(sql *ast.SQLExpr) ([]ast.Assignment, []sqlWindowFunc, error) {
+	windows := make(map[string]*ast.SQLWindow)
+	for k := range sql.Windows {
+		w := &sql.Windows[k]
+		if _, ok := windows[w.Name]; ok {
+			return nil, nil, fmt.Errorf("window %q defined more than once", w.Name)
+		}
+		resolved, err := resolveSQLWindow(windows, w)
+		if err != nil {
+			return nil, nil, err
+		}
+		windows[w.Name] = resolved
+	}
+	var funcs []sqlWindowFunc
+	var replace func(ast.Expr) (ast.Expr, error)
+	replace = func(e ast.Expr) (ast.Expr, error) {
+		var err error
+		switch e := e.(type) {
+		case *ast.SQLOver:
+			w, err := resolveSQLWindow(windows, e.Window)
+			if err != nil {
+				return nil, err
+			}
+			tmp := fmt.Sprintf("_window%d", len(funcs))
+			funcs = append(funcs, sqlWindowFunc{tmp, e.Func, w})
+			return &ast.ID{Kind: "ID", Name: tmp}, nil
+		case *ast.UnaryExpr:
+			out := *e
+			out.Operand, err = replace(e.Operand)
+			return &out, err
+		case *ast.BinaryExpr:
+			out := *e
+			if out.LHS, err = replace(e.LHS); err != nil {
+				return nil, err
+			}
+			out.RHS, err = replace(e.RHS)
+			return &out, err
+		case *ast.Conditional:
+			out := *e
+			if out.Cond, err = replace(e.Cond); err != nil {
+				return nil, err
+			}
+			if out.Then, err = replace(e.Then); err != nil {
+				return nil, err
+			}
+			out.Else, err = replace(e.Else)
+			return &out, err
+		case *ast.Cast:
+			out := *e
+			out.Expr, err = replace(e.Expr)
+			return &out, err
+		case *ast.Call:
+			out := *e
+			out.Args = make([]ast.Expr, len(e.Args))
+			for k, arg := range e.Args {
+				if out.Args[k], err = replace(arg); err != nil {
+					return nil, err
+				}
+			}
+			return &out, nil
+		}
+		return e, nil
+	}
+	var assignments []ast.Assignment
+	for _, a := range sql.Select {
+		if over, ok := a.RHS.(*ast.SQLOver); ok && a.LHS == nil {
+			// Name the column after the function as for an aggregation.
+			a.LHS = &ast.ID{Kind: "ID", Name: strings.ToLower(over.Func.Name)}
+		}
+		rhs, err := replace(a.RHS)
+		if err != nil {
+			return nil, nil, err
+		}
+		a.RHS = rhs
+		assignments = append(assignments, a)
+	}
+	return assignments, funcs, nil
+}
+
+// resolveSQLWindow returns w combined with the window it refines, if any.
+// A refining window may add to but not change its base's PARTITION BY and
+// ORDER BY clauses and its frame, if any, replaces the base's frame.
+func resolveSQLWindow(windows map[string]*ast.SQLWindow, w *ast.SQLWindow) (*ast.SQLWindow, error) {
+	if w.Base == "" {
+		return w, nil
+	}
+	base, ok := windows[w.Base]
+	if !ok {
+		return nil, fmt.Errorf("window %q is not defined", w.Base)
+	}
+	out := *base
+	out.Name = w.Name
+	out.Base = ""
+	if w.PartitionBy != nil {
+		if base.PartitionBy != nil {
+			return nil, fmt.Errorf("cannot override PARTITION BY of window %q", w.Base)
+		}
+		out.PartitionBy = w.PartitionBy
+	}
+	if w.OrderBy != nil {
+		if base.OrderBy != nil {
+			return nil, fmt.Errorf("cannot override ORDER BY of window %q", w.Base)
+		}
+		out.OrderBy = w.OrderBy
+	}
+	if w.Frame != nil {
+		out.Frame = w.Frame
+	}
+	return &out, nil
+}
+
+// convertSQLWindowFuncs returns a window operator, preceded by a sort of
+// its input, for each distinct window of funcs.
+func convertSQLWindowFuncs(scope *Scope, funcs []sqlWindowFunc) ([]dag.Op, error) {
+	var windows []*dag.Window
+	index := make(map[string]*dag.Window)
+	for _, f := range funcs {
+		partitionBy, err := semExprs(scope, f.window.PartitionBy)
 		if err != nil {
 			return nil, err
 		}
-		ops = append(ops, sortByMulti(keys, sql.OrderBy.Order))
+		var orderBy []dag.Expr
+		o := order.Asc
+		if f.window.OrderBy != nil {
+			orderBy, err = semExprs(scope, f.window.OrderBy.Keys)
+			if err != nil {
+				return nil, err
+			}
+			o = f.window.OrderBy.Order
+		}
+		fn, err := convertSQLWindowFunc(scope, f, len(orderBy))
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal([]interface{}{partitionBy, orderBy, o})
+		if err != nil {
+			return nil, err
+		}
+		w, ok := index[string(b)]
+		if !ok {
+			w = &dag.Window{
+				Kind:        "Window",
+				PartitionBy: partitionBy,
+				OrderBy:     orderBy,
+				Order:       o,
+			}
+			index[string(b)] = w
+			windows = append(windows, w)
+		}
+		w.Funcs = append(w.Funcs, fn)
 	}
-	if sql.Limit != 0 {
-		p := &dag.Head{
-			Kind:  "Head",
-			Count: sql.Limit,
+	var ops []dag.Op
+	for _, w := range windows {
+		if keys := append(append([]dag.Expr{}, w.PartitionBy...), w.OrderBy...); len(keys) > 0 {
+			ops = append(ops, sortByMulti(keys, w.Order))
 		}
-		ops = append(ops, p)
+		ops = append(ops, w)
 	}
-	if len(ops) == 0 {
-		ops = []dag.Op{&dag.Pass{Kind: "Pass"}}
+	return ops, nil
+}
+
+func convertSQLWindowFunc(scope *Scope, f sqlWindowFunc, norder int) (dag.WindowFunc, error) {
+	name := strings.ToLower(f.call.Name)
+	args, err := semExprs(scope, f.call.Args)
+	if err != nil {
+		return dag.WindowFunc{}, err
+	}
+	nargs := len(args)
+	switch name {
+	case "row_number", "rank", "dense_rank":
+		if nargs != 0 {
+			return dag.WindowFunc{}, fmt.Errorf("%s: window function takes no arguments", name)
+		}
+	case "lag", "lead":
+		if nargs < 1 || nargs > 3 {
+			return dag.WindowFunc{}, fmt.Errorf("%s: window function takes one to three arguments", name)
+		}
+	case "first_value", "last_value":
+		if nargs != 1 {
+			return dag.WindowFunc{}, fmt.Errorf("%s: window function takes one argument", name)
+		}
+	default:
+		if _, err := agg.NewPattern(name, nargs > 0); err != nil {
+			return dag.WindowFunc{}, fmt.Errorf("unknown window function: %s", f.call.Name)
+		}
+		if nargs > 1 {
+			return dag.WindowFunc{}, fmt.Errorf("%s: wrong number of arguments", name)
+		}
+	}
+	frame, err := convertSQLFrame(f.window.Frame, norder)
+	if err != nil {
+		return dag.WindowFunc{}, err
+	}
+	return dag.WindowFunc{
+		LHS:   &dag.This{Kind: "This", Path: field.Path{f.tmp}},
+		Name:  name,
+		Args:  args,
+		Frame: frame,
+	}, nil
+}
+
+// convertSQLFrame converts a window frame.  Absent a frame, the frame is the
+// values from the start of the partition through the peers of the current
+// value if the window is ordered and is the whole partition otherwise.
+func convertSQLFrame(f *ast.SQLFrame, norder int) (dag.WindowFrame, error) {
+	if f == nil {
+		if norder > 0 {
+			return dag.WindowFrame{
+				Units: "range",
+				Start: dag.WindowBound{Type: "unbounded_preceding"},
+				End:   dag.WindowBound{Type: "current_row"},
+			}, nil
+		}
+		return dag.WindowFrame{
+			Units: "rows",
+			Start: dag.WindowBound{Type: "unbounded_preceding"},
+			End:   dag.WindowBound{Type: "unbounded_following"},
+		}, nil
+	}
+	if f.Start.Type == "unbounded_following" || f.End.Type == "unbounded_preceding" {
+		return dag.WindowFrame{}, errors.New("frame cannot start at UNBOUNDED FOLLOWING or end at UNBOUNDED PRECEDING")
+	}
+	if f.Units == "range" && norder != 1 && (hasOffset(f.Start) || hasOffset(f.End)) {
+		return dag.WindowFrame{}, errors.New("RANGE frame with an offset requires exactly one ORDER BY key")
+	}
+	return dag.WindowFrame{
+		Units: f.Units,
+		Start: dag.WindowBound{Type: f.Start.Type, Offset: f.Start.Offset},
+		End:   dag.WindowBound{Type: f.End.Type, Offset: f.End.Offset},
+	}, nil
+}
+
+func hasOffset(b ast.SQLFrameBound) bool {
+	return b.Type == "preceding" || b.Type == "following"
+}
+
+// A sqlInQuery is a condition of the form "expr IN (SELECT ...)" or
+// "expr NOT IN (SELECT ...)" in a WHERE clause.
+type sqlInQuery struct {
+	expr  ast.Expr
+	query *ast.SQLExpr
+	not   bool
+}
+
+// splitSQLInQueries removes the IN and NOT IN subquery conditions from the
+// top-level conjunction of the WHERE clause e and returns what is left of e
+// along with the removed conditions, which are converted to joins.
+func splitSQLInQueries(e ast.Expr) (ast.Expr, []sqlInQuery) {
+	switch e := e.(type) {
+	case *ast.BinaryExpr:
+		if e.Op == "and" {
+			lhs, lhsQueries := splitSQLInQueries(e.LHS)
+			rhs, rhsQueries := splitSQLInQueries(e.RHS)
+			queries := append(lhsQueries, rhsQueries...)
+			if lhs == nil {
+				return rhs, queries
+			}
+			if rhs == nil {
+				return lhs, queries
+			}
+			if len(queries) == 0 {
+				return e, nil
+			}
+			return &ast.BinaryExpr{
+				Kind: "BinaryExpr",
+				Op:   "and",
+				LHS:  lhs,
+				RHS:  rhs,
+			}, queries
+		}
+		if query, ok := e.RHS.(*ast.SQLExpr); ok && e.Op == "in" {
+			return nil, []sqlInQuery{{expr: e.LHS, query: query}}
+		}
+	case *ast.UnaryExpr:
+		if operand, ok := e.Operand.(*ast.BinaryExpr); ok && e.Op == "!" && operand.Op == "in" {
+			if query, ok := operand.RHS.(*ast.SQLExpr); ok {
+				return nil, []sqlInQuery{{expr: operand.LHS, query: query, not: true}}
+			}
+		}
+	}
+	return e, nil
+}
+
+// convertSQLInQuery converts an IN subquery to an inner join (or an anti
+// join for NOT IN) of leftPath with the distinct values of the subquery
+// so that each value on the left is joined at most once.  As in SQL, a null
+// matches nothing, so IN drops null values from both sides, while NOT IN
+// yields nothing if the subquery yields a null and drops a null value on the
+// left unless the subquery is empty.
+func convertSQLInQuery(scope *Scope, tables sqlTables, leftPath []dag.Op, in sqlInQuery) ([]dag.Op, error) {
+	if len(in.query.Select) != 1 {
+		return nil, errors.New("IN subquery must select exactly one column")
+	}
+	leftKey, err := semExpr(scope, in.expr)
+	if err != nil {
+		return nil, err
+	}
+	rightPath, err := convertSQLQuery(scope, tables, in.query)
+	if err != nil {
+		return nil, err
+	}
+	name, err := deriveAs(scope, in.query.Select[0])
+	if err != nil {
+		return nil, err
+	}
+	rightKey := &dag.This{Kind: "This", Path: name}
+	distinct := &dag.Summarize{
+		Kind: "Summarize",
+		Keys: []dag.Assignment{{
+			Kind: "Assignment",
+			LHS:  rightKey,
+			RHS:  rightKey,
+		}},
+	}
+	rightPath = append(rightPath, notNull(rightKey), distinct, sortBy(rightKey))
+	if !in.not {
+		leftPath = append(leftPath, notNull(leftKey), sortBy(leftKey))
+		return joinPaths("inner", leftPath, rightPath, leftKey, rightKey), nil
+	}
+	leftPath = append(leftPath, sortBy(leftKey))
+	ops := joinPaths("anti", leftPath, rightPath, leftKey, rightKey)
+	// Each value of the subquery contributes true to the keys of the
+	// right side of this join and a null value also contributes false.
+	// Joining these with whether each key on the left is null thus drops
+	// every value if the subquery yields a null and drops the null keys
+	// on the left if the subquery yields anything.
+	nullsPath, err := convertSQLQuery(scope, tables, in.query)
+	if err != nil {
+		return nil, err
+	}
+	this := &dag.This{Kind: "This"}
+	over := &dag.Over{
+		Kind: "Over",
+		Exprs: []dag.Expr{&dag.ArrayExpr{
+			Kind: "ArrayExpr",
+			Elems: []dag.VectorElem{
+				&dag.VectorValue{Kind: "VectorValue", Expr: &dag.Literal{Kind: "Literal", Value: "true"}},
+				&dag.VectorValue{Kind: "VectorValue", Expr: compareNull("!=", rightKey)},
+			},
+		}},
+	}
+	nullsPath = append(nullsPath, over, sortBy(this), &dag.Uniq{Kind: "Uniq"})
+	isNull := compareNull("==", leftKey)
+	ops = append(ops, sortBy(isNull))
+	return joinPaths("anti", ops, nullsPath, isNull, this), nil
+}
+
+func joinPaths(style string, leftPath, rightPath []dag.Op, leftKey, rightKey dag.Expr) []dag.Op {
+	fork := &dag.Parallel{
+		Kind: "Parallel",
+		Ops:  []dag.Op{wrap(leftPath), wrap(rightPath)},
+	}
+	join := &dag.Join{
+		Kind:     "Join",
+		Style:    style,
+		LeftKey:  leftKey,
+		RightKey: rightKey,
+	}
+	return []dag.Op{fork, join}
+}
+
+func notNull(e dag.Expr) *dag.Filter {
+	return &dag.Filter{
+		Kind: "Filter",
+		Expr: compareNull("!=", e),
+	}
+}
+
+func compareNull(op string, e dag.Expr) dag.Expr {
+	return &dag.BinaryExpr{
+		Kind: "BinaryExpr",
+		Op:   op,
+		LHS:  e,
+		RHS:  &dag.Literal{Kind: "Literal", Value: "null"},
 	}
-	return wrap(ops), nil
 }
 
 func isID(e ast.Expr) (string, bool) {
@@ -211,7 +676,12 @@ func eligibleFieldRef(aliasID string, e *dag.Dot) dag.Expr {
 	return nil
 }
 
-func convertSQLTableRef(scope *Scope, e ast.Expr) (dag.Op, error) {
+func convertSQLTableRef(scope *Scope, tables sqlTables, e ast.Expr) ([]dag.Op, error) {
+	if id, ok := e.(*ast.ID); ok {
+		if table, ok := tables[id.Name]; ok {
+			return convertSQLQuery(scope, table.tables, table.query)
+		}
+	}
 	converted, err := semExpr(scope, e)
 	if err != nil {
 		return nil, err
@@ -224,14 +694,14 @@ func convertSQLTableRef(scope *Scope, e ast.Expr) (dag.Op, error) {
 			converted = dynamicTypeName(id)
 		}
 	}
-	return &dag.Filter{
+	return []dag.Op{&dag.Filter{
 		Kind: "Filter",
 		Expr: &dag.Call{
 			Kind: "Call",
 			Name: "is",
 			Args: []dag.Expr{converted},
 		},
-	}, nil
+	}}, nil
 }
 
 func convertSQLAlias(scope *Scope, e ast.Expr) (*dag.Cut, string, error) {
@@ -270,11 +740,18 @@ func wrap(ops []dag.Op) dag.Op {
 	}
 }
 
-func convertSQLJoins(scope *Scope, fromPath []dag.Op, joins []ast.SQLJoin) ([]dag.Op, error) {
+func wrapOrPass(ops []dag.Op) dag.Op {
+	if len(ops) == 0 {
+		return &dag.Pass{Kind: "Pass"}
+	}
+	return wrap(ops)
+}
+
+func convertSQLJoins(scope *Scope, tables sqlTables, fromPath []dag.Op, joins []ast.SQLJoin) ([]dag.Op, error) {
 	left := fromPath
 	for _, right := range joins {
 		var err error
-		left, err = convertSQLJoin(scope, left, right)
+		left, err = convertSQLJoin(scope, tables, left, right)
 		if err != nil {
 			return nil, err
 		}
@@ -284,7 +761,7 @@ func convertSQLJoins(scope *Scope, fromPath []dag.Op, joins []ast.SQLJoin) ([]da
 
 // For now, each joining table is on the right...
 // We don't have logic to not care about the side of the JOIN ON keys...
-func convertSQLJoin(scope *Scope, leftPath []dag.Op, sqlJoin ast.SQLJoin) ([]dag.Op, error) {
+func convertSQLJoin(scope *Scope, tables sqlTables, leftPath []dag.Op, sqlJoin ast.SQLJoin) ([]dag.Op, error) {
 	if sqlJoin.Alias == nil {
 		return nil, errors.New("JOIN currently requires alias, e.g., JOIN <type> <alias> (will be fixed soon)")
 	}
@@ -293,11 +770,10 @@ func convertSQLJoin(scope *Scope, leftPath []dag.Op, sqlJoin ast.SQLJoin) ([]dag
 		return nil, err
 	}
 	leftPath = append(leftPath, sortBy(leftKey))
-	joinFilter, err := convertSQLTableRef(scope, sqlJoin.Table)
+	rightPath, err := convertSQLTableRef(scope, tables, sqlJoin.Table)
 	if err != nil {
 		return nil, err
 	}
-	rightPath := []dag.Op{joinFilter}
 	cut, aliasID, err := convertSQLAlias(scope, sqlJoin.Alias)
 	if err != nil {
 		return nil, errors.New("JOIN alias must be a name")
@@ -508,11 +984,24 @@ func (s sqlSelection) cut() *dag.Cut {
 }
 
 func isAgg(scope *Scope, e ast.Expr) (*dag.Agg, error) {
+	if a, ok := e.(*ast.Agg); ok {
+		// An aggregation with DISTINCT, e.g., COUNT(DISTINCT x), is
+		// parsed as an Agg rather than a Call.
+		converted, err := semExpr(scope, a)
+		if err != nil {
+			return nil, err
+		}
+		agg := converted.(*dag.Agg)
+		agg.Name = strings.ToLower(agg.Name)
+		return agg, nil
+	}
 	call, ok := e.(*ast.Call)
 	if !ok {
 		return nil, nil
 	}
-	if _, err := agg.NewPattern(call.Name, true); err != nil {
+	// SQL function names are case insensitive, e.g., COUNT is count.
+	name := strings.ToLower(call.Name)
+	if _, err := agg.NewPattern(name, true); err != nil {
 		return nil, nil
 	}
 	var arg ast.Expr
@@ -532,7 +1021,7 @@ func isAgg(scope *Scope, e ast.Expr) (*dag.Agg, error) {
 	}
 	return &dag.Agg{
 		Kind: "Agg",
-		Name: call.Name,
+		Name: name,
 		Expr: dagArg,
 	}, nil
 }
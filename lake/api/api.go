@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/api"
@@ -14,6 +15,7 @@ import (
 	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zson"
@@ -24,19 +26,29 @@ type Interface interface {
 	Root() *lake.Root
 	Query(ctx context.Context, head *lakeparse.Commitish, src string, srcfiles ...string) (zio.ReadCloser, error)
 	QueryWithControl(ctx context.Context, head *lakeparse.Commitish, src string, srcfiles ...string) (zbuf.ProgressReadCloser, error)
+	Lint(ctx context.Context, head *lakeparse.Commitish, src string, types []zed.Type) ([]string, error)
 	PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error)
 	CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error)
 	CreatePool(context.Context, string, order.Layout, int, int64) (ksuid.KSUID, error)
+	CreatePartitionedPool(context.Context, string, order.Layout, pools.Partition, int, int64) (ksuid.KSUID, error)
 	RemovePool(context.Context, ksuid.KSUID) error
 	RenamePool(context.Context, ksuid.KSUID, string) error
 	CreateBranch(ctx context.Context, pool ksuid.KSUID, name string, parent ksuid.KSUID) error
 	RemoveBranch(ctx context.Context, pool ksuid.KSUID, branchName string) error
+	CreateTag(ctx context.Context, pool ksuid.KSUID, name string, commit ksuid.KSUID) error
+	RemoveTag(ctx context.Context, pool ksuid.KSUID, tagName string) error
 	MergeBranch(ctx context.Context, pool ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error)
+	Diff(ctx context.Context, pool ksuid.KSUID, from, to string, values bool) (zio.ReadCloser, error)
 	Compact(ctx context.Context, pool ksuid.KSUID, branch string, objects []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	Load(ctx context.Context, zctx *zed.Context, pool ksuid.KSUID, branch string, r zio.Reader, message api.CommitMessage) (ksuid.KSUID, error)
+	Upsert(ctx context.Context, zctx *zed.Context, pool ksuid.KSUID, branch string, r zio.Reader, key field.Path, message api.CommitMessage) (ksuid.KSUID, error)
 	Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, tags []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error)
+	Update(ctx context.Context, poolID ksuid.KSUID, branchName, where, transform string, commit api.CommitMessage) (ksuid.KSUID, error)
 	Revert(ctx context.Context, poolID ksuid.KSUID, branch string, commitID ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error)
+	CherryPick(ctx context.Context, poolID ksuid.KSUID, branch string, commitID ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error)
+	Rebase(ctx context.Context, poolID ksuid.KSUID, branch, onto string) (ksuid.KSUID, error)
+	GC(ctx context.Context, poolID ksuid.KSUID, grace time.Duration, dryRun bool) (api.GCResponse, error)
 	AddIndexRules(context.Context, []index.Rule) error
 	DeleteIndexRules(context.Context, []ksuid.KSUID) ([]index.Rule, error)
 	ApplyIndexRules(ctx context.Context, rules []string, pool ksuid.KSUID, branchName string, ids []ksuid.KSUID) (ksuid.KSUID, error)
@@ -119,6 +131,23 @@ func GetPools(ctx context.Context, api Interface) ([]*pools.Config, error) {
 	return pls, nil
 }
 
+func GetBranches(ctx context.Context, api Interface) ([]*lake.BranchMeta, error) {
+	b := newBuffer(lake.BranchMeta{})
+	q, err := api.Query(ctx, nil, "from :branches")
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+	if err := zio.Copy(b, zbuf.NoControl(q)); err != nil {
+		return nil, err
+	}
+	var branches []*lake.BranchMeta
+	for _, r := range b.results {
+		branches = append(branches, r.(*lake.BranchMeta))
+	}
+	return branches, nil
+}
+
 func LookupPoolByID(ctx context.Context, api Interface, id ksuid.KSUID) (*pools.Config, error) {
 	b := newBuffer(pools.Config{})
 	zed := fmt.Sprintf("from :pools | id == hex('%s')", idToHex(id))
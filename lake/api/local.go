@@ -3,14 +3,18 @@ package api
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/api"
 	"github.com/brimdata/zed/compiler"
+	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/lake"
 	"github.com/brimdata/zed/lake/index"
+	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime"
 	"github.com/brimdata/zed/runtime/exec"
@@ -44,6 +48,16 @@ func OpenLocalLake(ctx context.Context, lakePath string) (Interface, error) {
 	}, nil
 }
 
+// NewLocalLake returns an Interface to the lake root, which is stored
+// with engine.
+func NewLocalLake(root *lake.Root, engine storage.Engine) Interface {
+	return &local{
+		root:     root,
+		compiler: compiler.NewLakeCompiler(root),
+		engine:   engine,
+	}
+}
+
 func CreateLocalLake(ctx context.Context, lakePath string) (Interface, error) {
 	uri, err := storage.ParseURI(lakePath)
 	if err != nil {
@@ -55,8 +69,9 @@ func CreateLocalLake(ctx context.Context, lakePath string) (Interface, error) {
 		return nil, err
 	}
 	return &local{
-		root:   root,
-		engine: engine,
+		root:     root,
+		compiler: compiler.NewLakeCompiler(root),
+		engine:   engine,
 	}, nil
 }
 
@@ -65,10 +80,14 @@ func (l *local) Root() *lake.Root {
 }
 
 func (l *local) CreatePool(ctx context.Context, name string, layout order.Layout, seekStride int, thresh int64) (ksuid.KSUID, error) {
+	return l.CreatePartitionedPool(ctx, name, layout, pools.Partition{}, seekStride, thresh)
+}
+
+func (l *local) CreatePartitionedPool(ctx context.Context, name string, layout order.Layout, partition pools.Partition, seekStride int, thresh int64) (ksuid.KSUID, error) {
 	if name == "" {
 		return ksuid.Nil, errors.New("no pool name provided")
 	}
-	pool, err := l.root.CreatePool(ctx, name, layout, seekStride, thresh)
+	pool, err := l.root.CreatePool(ctx, name, layout, partition, seekStride, thresh)
 	if err != nil {
 		return ksuid.Nil, err
 	}
@@ -96,10 +115,46 @@ func (l *local) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, branchName
 	return l.root.RemoveBranch(ctx, poolID, branchName)
 }
 
+func (l *local) CreateTag(ctx context.Context, poolID ksuid.KSUID, name string, commit ksuid.KSUID) error {
+	_, err := l.root.CreateTag(ctx, poolID, name, commit)
+	return err
+}
+
+func (l *local) RemoveTag(ctx context.Context, poolID ksuid.KSUID, tagName string) error {
+	return l.root.RemoveTag(ctx, poolID, tagName)
+}
+
 func (l *local) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error) {
 	return l.root.MergeBranch(ctx, poolID, childBranch, parentBranch, message.Author, message.Body)
 }
 
+func (l *local) Diff(ctx context.Context, poolID ksuid.KSUID, from, to string, values bool) (zio.ReadCloser, error) {
+	pool, err := l.root.OpenPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	fromID, err := resolveCommit(ctx, pool, from)
+	if err != nil {
+		return nil, err
+	}
+	toID, err := resolveCommit(ctx, pool, to)
+	if err != nil {
+		return nil, err
+	}
+	r, err := exec.Diff(ctx, zed.NewContext(), l.root, pool, fromID, toID, values)
+	if err != nil {
+		return nil, err
+	}
+	return zio.NopReadCloser(r), nil
+}
+
+func resolveCommit(ctx context.Context, pool *lake.Pool, ref string) (ksuid.KSUID, error) {
+	if id, err := lakeparse.ParseID(ref); err == nil {
+		return id, nil
+	}
+	return pool.ResolveCommit(ctx, ref)
+}
+
 func (l *local) Compact(ctx context.Context, poolID ksuid.KSUID, branchName string, objects []ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error) {
 	pool, err := l.root.OpenPool(ctx, poolID)
 	if err != nil {
@@ -129,6 +184,13 @@ func (l *local) QueryWithControl(ctx context.Context, head *lakeparse.Commitish,
 	if err != nil {
 		return nil, err
 	}
+	if stmt, ok := flowgraph.(ast.Statement); ok {
+		r, err := ExecStatement(ctx, l, stmt, head)
+		if err != nil {
+			return nil, err
+		}
+		return zbuf.MeterReadCloser(r), nil
+	}
 	q, err := runtime.CompileLakeQuery(ctx, zed.NewContext(), l.compiler, flowgraph, head, nil)
 	if err != nil {
 		return nil, err
@@ -136,6 +198,25 @@ func (l *local) QueryWithControl(ctx context.Context, head *lakeparse.Commitish,
 	return q.AsProgressReadCloser(), nil
 }
 
+func (l *local) Lint(ctx context.Context, head *lakeparse.Commitish, src string, types []zed.Type) ([]string, error) {
+	program, err := l.compiler.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	// The types given belong to the caller's context so they are
+	// copied into the context of the linter.
+	zctx := zed.NewContext()
+	var input []zed.Type
+	for _, typ := range types {
+		t, err := zctx.TranslateType(typ)
+		if err != nil {
+			return nil, err
+		}
+		input = append(input, t)
+	}
+	return compiler.Lint(ctx, zctx, program, l.root, head, input)
+}
+
 func (l *local) PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error) {
 	if poolName == "" {
 		return ksuid.Nil, errors.New("no pool name provided")
@@ -167,6 +248,14 @@ func (l *local) Load(ctx context.Context, ztcx *zed.Context, poolID ksuid.KSUID,
 	return branch.Load(ctx, ztcx, r, message.Author, message.Body, message.Meta)
 }
 
+func (l *local) Upsert(ctx context.Context, zctx *zed.Context, poolID ksuid.KSUID, branchName string, r zio.Reader, key field.Path, message api.CommitMessage) (ksuid.KSUID, error) {
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return branch.Upsert(ctx, zctx, r, key, message.Author, message.Body, message.Meta)
+}
+
 func (l *local) Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, ids []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
 	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
 	if err != nil {
@@ -191,10 +280,47 @@ func (l *local) DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName,
 	return branch.DeleteWhere(ctx, l.compiler, op, commit.Author, commit.Body, commit.Meta)
 }
 
+func (l *local) Update(ctx context.Context, poolID ksuid.KSUID, branchName, where, transform string, commit api.CommitMessage) (ksuid.KSUID, error) {
+	whereOp, err := l.compiler.Parse(where)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	transformOp, err := l.compiler.Parse(transform)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return branch.Update(ctx, l.compiler, whereOp, transformOp, commit.Author, commit.Body, commit.Meta)
+}
+
 func (l *local) Revert(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
 	return l.root.Revert(ctx, poolID, branchName, commitID, message.Author, message.Body)
 }
 
+func (l *local) CherryPick(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
+	return l.root.CherryPick(ctx, poolID, branchName, commitID, message.Author, message.Body, message.Meta)
+}
+
+func (l *local) Rebase(ctx context.Context, poolID ksuid.KSUID, branchName, onto string) (ksuid.KSUID, error) {
+	pool, err := l.root.OpenPool(ctx, poolID)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	ontoID, err := resolveCommit(ctx, pool, onto)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return l.root.Rebase(ctx, poolID, branchName, ontoID)
+}
+
+func (l *local) GC(ctx context.Context, poolID ksuid.KSUID, grace time.Duration, dryRun bool) (api.GCResponse, error) {
+	stats, err := l.root.GC(ctx, poolID, grace, dryRun)
+	return api.GCResponse{Objects: stats.Objects, Bytes: stats.Bytes}, err
+}
+
 func (l *local) ApplyIndexRules(ctx context.Context, ruleRefs []string, poolID ksuid.KSUID, branchName string, inTags []ksuid.KSUID) (ksuid.KSUID, error) {
 	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
 	if err != nil {
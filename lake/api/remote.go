@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"time"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/api"
@@ -11,11 +12,15 @@ import (
 	"github.com/brimdata/zed/api/queryio"
 	"github.com/brimdata/zed/lake"
 	"github.com/brimdata/zed/lake/index"
+	"github.com/brimdata/zed/lake/pools"
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/zngio"
+	"github.com/brimdata/zed/zson"
 	"github.com/segmentio/ksuid"
 )
 
@@ -47,9 +52,14 @@ func (r *remote) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchNam
 }
 
 func (r *remote) CreatePool(ctx context.Context, name string, layout order.Layout, seekStride int, thresh int64) (ksuid.KSUID, error) {
+	return r.CreatePartitionedPool(ctx, name, layout, pools.Partition{}, seekStride, thresh)
+}
+
+func (r *remote) CreatePartitionedPool(ctx context.Context, name string, layout order.Layout, partition pools.Partition, seekStride int, thresh int64) (ksuid.KSUID, error) {
 	res, err := r.conn.CreatePool(ctx, api.PoolPostRequest{
 		Name:       name,
 		Layout:     layout,
+		Partition:  partition,
 		SeekStride: seekStride,
 		Thresh:     thresh,
 	})
@@ -71,11 +81,31 @@ func (r *remote) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, branchNam
 	return errors.New("TBD remote.RemoveBranch")
 }
 
+func (r *remote) CreateTag(ctx context.Context, poolID ksuid.KSUID, name string, commit ksuid.KSUID) error {
+	_, err := r.conn.CreateTag(ctx, poolID, api.TagPostRequest{
+		Name:   name,
+		Commit: commit.String(),
+	})
+	return err
+}
+
+func (r *remote) RemoveTag(ctx context.Context, poolID ksuid.KSUID, tagName string) error {
+	return r.conn.RemoveTag(ctx, poolID, tagName)
+}
+
 func (r *remote) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error) {
 	res, err := r.conn.MergeBranch(ctx, poolID, childBranch, parentBranch, message)
 	return res.Commit, err
 }
 
+func (r *remote) Diff(ctx context.Context, poolID ksuid.KSUID, from, to string, values bool) (zio.ReadCloser, error) {
+	res, err := r.conn.Diff(ctx, poolID, from, to, values)
+	if err != nil {
+		return nil, err
+	}
+	return zio.NewReadCloser(zngio.NewReader(zed.NewContext(), res.Body), res.Body), nil
+}
+
 func (r *remote) Compact(ctx context.Context, poolID ksuid.KSUID, branch string, objects []ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error) {
 	res, err := r.conn.Compact(ctx, poolID, branch, objects, commit)
 	return res.Commit, err
@@ -93,6 +123,17 @@ func (r *remote) RenamePool(ctx context.Context, pool ksuid.KSUID, name string)
 }
 
 func (r *remote) Load(ctx context.Context, _ *zed.Context, poolID ksuid.KSUID, branchName string, reader zio.Reader, commit api.CommitMessage) (ksuid.KSUID, error) {
+	res, err := r.conn.Load(ctx, poolID, branchName, api.MediaTypeZNG, zngPipe(ctx, reader), commit)
+	return res.Commit, err
+}
+
+func (r *remote) Upsert(ctx context.Context, _ *zed.Context, poolID ksuid.KSUID, branchName string, reader zio.Reader, key field.Path, commit api.CommitMessage) (ksuid.KSUID, error) {
+	res, err := r.conn.Upsert(ctx, poolID, branchName, key.String(), api.MediaTypeZNG, zngPipe(ctx, reader), commit)
+	return res.Commit, err
+}
+
+// zngPipe returns a reader of the values read from reader encoded as ZNG.
+func zngPipe(ctx context.Context, reader zio.Reader) io.Reader {
 	pr, pw := io.Pipe()
 	go func() {
 		w := zngio.NewWriter(zio.NopCloser(pw))
@@ -102,8 +143,7 @@ func (r *remote) Load(ctx context.Context, _ *zed.Context, poolID ksuid.KSUID, b
 		}
 		pw.CloseWithError(err)
 	}()
-	res, err := r.conn.Load(ctx, poolID, branchName, api.MediaTypeZNG, pr, commit)
-	return res.Commit, err
+	return pr
 }
 
 func (r *remote) Revert(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
@@ -111,6 +151,21 @@ func (r *remote) Revert(ctx context.Context, poolID ksuid.KSUID, branchName stri
 	return res.Commit, err
 }
 
+func (r *remote) CherryPick(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
+	res, err := r.conn.CherryPick(ctx, poolID, branchName, commitID, message)
+	return res.Commit, err
+}
+
+func (r *remote) Rebase(ctx context.Context, poolID ksuid.KSUID, branchName, onto string) (ksuid.KSUID, error) {
+	res, err := r.conn.Rebase(ctx, poolID, branchName, onto)
+	return res.Commit, err
+}
+
+func (r *remote) GC(ctx context.Context, poolID ksuid.KSUID, grace time.Duration, dryRun bool) (api.GCResponse, error) {
+	d := nano.Duration(grace)
+	return r.conn.GC(ctx, poolID, api.GCRequest{Grace: &d, DryRun: dryRun})
+}
+
 func (r *remote) Query(ctx context.Context, head *lakeparse.Commitish, src string, srcfiles ...string) (zio.ReadCloser, error) {
 	q, err := r.QueryWithControl(ctx, head, src, srcfiles...)
 	if err != nil {
@@ -131,6 +186,15 @@ func (r *remote) QueryWithControl(ctx context.Context, head *lakeparse.Commitish
 	return zbuf.MeterReadCloser(q), nil
 }
 
+func (r *remote) Lint(ctx context.Context, head *lakeparse.Commitish, src string, types []zed.Type) ([]string, error) {
+	var input []string
+	for _, typ := range types {
+		input = append(input, zson.FormatType(typ))
+	}
+	res, err := r.conn.Lint(ctx, head, src, input)
+	return res.Warnings, err
+}
+
 func (r *remote) Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, tags []ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error) {
 	res, err := r.conn.Delete(ctx, poolID, branchName, tags, commit)
 	return res.Commit, err
@@ -141,6 +205,11 @@ func (r *remote) DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName
 	return res.Commit, err
 }
 
+func (r *remote) Update(ctx context.Context, poolID ksuid.KSUID, branchName, where, transform string, commit api.CommitMessage) (ksuid.KSUID, error) {
+	res, err := r.conn.Update(ctx, poolID, branchName, where, transform, commit)
+	return res.Commit, err
+}
+
 func (r *remote) AddIndexRules(ctx context.Context, rules []index.Rule) error {
 	return r.conn.AddIndexRules(ctx, rules)
 }
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/api"
+	"github.com/brimdata/zed/compiler/ast"
+	"github.com/brimdata/zed/compiler/semantic"
+	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zfmt"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zson"
+	"github.com/segmentio/ksuid"
+)
+
+// PoolResult is the result of a CREATE POOL or DROP POOL statement.
+type PoolResult struct {
+	Pool string      `zed:"pool"`
+	ID   ksuid.KSUID `zed:"id"`
+}
+
+// CommitResult is the result of a statement that changes a branch.  For
+// CREATE BRANCH, Commit is the commit at which the branch was created.
+type CommitResult struct {
+	Pool   string      `zed:"pool"`
+	Branch string      `zed:"branch"`
+	Commit ksuid.KSUID `zed:"commit"`
+}
+
+// ExecStatement runs stmt as RunStatement does and returns a reader of its
+// result.
+func ExecStatement(ctx context.Context, lake Interface, stmt ast.Statement, head *lakeparse.Commitish) (zio.ReadCloser, error) {
+	result, err := RunStatement(ctx, lake, stmt, head)
+	if err != nil {
+		return nil, err
+	}
+	val, err := zson.MarshalZNG(result)
+	if err != nil {
+		return nil, err
+	}
+	return zio.NopReadCloser(zbuf.NewArray([]zed.Value{*val})), nil
+}
+
+// RunStatement runs stmt using the methods of lake and returns its result,
+// which is a PoolResult or a CommitResult.  The query of an INSERT INTO
+// statement is run at head.  When a statement does not name a branch, it
+// refers to the main branch.
+func RunStatement(ctx context.Context, lake Interface, stmt ast.Statement, head *lakeparse.Commitish) (interface{}, error) {
+	var result interface{}
+	switch stmt := stmt.(type) {
+	case *ast.CreatePool:
+		layout := order.NewLayout(order.Desc, field.DottedList("ts"))
+		if stmt.Layout != nil {
+			var err error
+			layout, err = semantic.Layout(stmt.Layout)
+			if err != nil {
+				return nil, err
+			}
+		}
+		id, err := lake.CreatePool(ctx, stmt.Name, layout, data.DefaultSeekStride, data.DefaultThreshold)
+		if err != nil {
+			return nil, err
+		}
+		result = PoolResult{Pool: stmt.Name, ID: id}
+	case *ast.DropPool:
+		id, err := lookupPoolID(ctx, lake, stmt.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := lake.RemovePool(ctx, id); err != nil {
+			return nil, err
+		}
+		result = PoolResult{Pool: stmt.Name, ID: id}
+	case *ast.CreateBranch:
+		poolID, err := lookupPoolID(ctx, lake, stmt.Pool)
+		if err != nil {
+			return nil, err
+		}
+		parent, err := lakeparse.ParseID(stmt.From)
+		if err != nil {
+			parent, err = lake.CommitObject(ctx, poolID, branchOrMain(stmt.From))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := lake.CreateBranch(ctx, poolID, stmt.Name, parent); err != nil {
+			return nil, err
+		}
+		result = CommitResult{Pool: stmt.Pool, Branch: stmt.Name, Commit: parent}
+	case *ast.InsertInto:
+		poolID, err := lookupPoolID(ctx, lake, stmt.Pool)
+		if err != nil {
+			return nil, err
+		}
+		q, err := lake.Query(ctx, head, zfmt.AST(stmt.Query))
+		if err != nil {
+			return nil, err
+		}
+		defer q.Close()
+		branch := branchOrMain(stmt.Branch)
+		commit, err := lake.Load(ctx, zed.NewContext(), poolID, branch, q, api.CommitMessage{})
+		if err != nil {
+			return nil, err
+		}
+		result = CommitResult{Pool: stmt.Pool, Branch: branch, Commit: commit}
+	case *ast.DeleteFrom:
+		poolID, err := lookupPoolID(ctx, lake, stmt.Pool)
+		if err != nil {
+			return nil, err
+		}
+		src := zfmt.AST(&ast.Sequential{
+			Kind: "Sequential",
+			Ops:  []ast.Op{&ast.Where{Kind: "Where", Expr: stmt.Where}},
+		})
+		branch := branchOrMain(stmt.Branch)
+		commit, err := lake.DeleteWhere(ctx, poolID, branch, src, api.CommitMessage{})
+		if err != nil {
+			return nil, err
+		}
+		result = CommitResult{Pool: stmt.Pool, Branch: branch, Commit: commit}
+	default:
+		return nil, fmt.Errorf("internal error: unknown statement type %T", stmt)
+	}
+	return result, nil
+}
+
+func lookupPoolID(ctx context.Context, lake Interface, name string) (ksuid.KSUID, error) {
+	if id, err := lakeparse.ParseID(name); err == nil {
+		return id, nil
+	}
+	return lake.PoolID(ctx, name)
+}
+
+func branchOrMain(branch string) string {
+	if branch == "" {
+		return "main"
+	}
+	return branch
+}
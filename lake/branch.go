@@ -2,6 +2,7 @@ package lake
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime"
+	"github.com/brimdata/zed/runtime/expr/agg"
 	"github.com/brimdata/zed/runtime/op"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/zngio"
@@ -49,6 +51,7 @@ func OpenBranch(ctx context.Context, config *branches.Config, engine storage.Eng
 }
 
 func (b *Branch) Load(ctx context.Context, zctx *zed.Context, r zio.Reader, author, message, meta string) (ksuid.KSUID, error) {
+	defer b.pool.BeginWrite()()
 	w, err := NewWriter(ctx, zctx, b.pool)
 	if err != nil {
 		return ksuid.Nil, err
@@ -135,68 +138,259 @@ func (b *Branch) Delete(ctx context.Context, ids []ksuid.KSUID, author, message
 }
 
 func (b *Branch) DeleteWhere(ctx context.Context, c runtime.Compiler, program ast.Op, author, message, meta string) (ksuid.KSUID, error) {
+	defer b.pool.BeginWrite()()
 	zctx := zed.NewContext()
 	appMeta, err := loadMeta(zctx, meta)
 	if err != nil {
 		return ksuid.Nil, err
 	}
 	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
-		pctx := op.NewContext(ctx, zctx, nil)
-		defer pctx.Cancel()
-		// XXX It would be great to not do this since and just pass the snapshot
-		// into c.NewLakeDeleteQuery since we have to load the snapshot later
-		// anyways. Unfortunately there's quite a few layers of plumbing needed
-		// to get this working in compiler.
-		commitish := &lakeparse.Commitish{
-			Pool:   b.pool.Name,
-			Branch: parent.Commit.String(),
-		}
-		query, err := c.NewLakeDeleteQuery(pctx, program, commitish)
+		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
 		if err != nil {
 			return nil, err
 		}
-		defer query.Pull(true)
-		w, err := NewWriter(ctx, zctx, b.pool)
+		deleted, added, err := b.rewriteWhere(ctx, c, zctx, program, parent.Commit, base)
 		if err != nil {
 			return nil, err
 		}
-		err = zio.CopyWithContext(ctx, w, query.AsReader())
-		if closeErr := w.Close(); err == nil {
-			err = closeErr
+		if len(deleted) == 0 {
+			return nil, commits.ErrEmptyTransaction
+		}
+		patch := commits.NewPatch(base)
+		for _, o := range deleted {
+			patch.DeleteObject(o.ID)
+		}
+		for _, o := range added {
+			patch.AddDataObject(o)
+		}
+		if message == "" {
+			message = deleteWhereMessage(deleted, added)
+		}
+		return patch.NewCommitObject(parent.Commit, retries, author, message, *appMeta), nil
+	})
+}
+
+// rewriteWhere runs the delete query program against commit, whose snapshot
+// is base, and returns the objects holding values deleted by program along
+// with the new objects holding the values of those objects that are kept.
+func (b *Branch) rewriteWhere(ctx context.Context, c runtime.Compiler, zctx *zed.Context, program ast.Op, commit ksuid.KSUID, base commits.View) ([]*data.Object, []*data.Object, error) {
+	pctx := op.NewContext(ctx, zctx, nil)
+	defer pctx.Cancel()
+	// XXX It would be great to not do this since and just pass the snapshot
+	// into c.NewLakeDeleteQuery since we have to load the snapshot later
+	// anyways. Unfortunately there's quite a few layers of plumbing needed
+	// to get this working in compiler.
+	commitish := &lakeparse.Commitish{
+		Pool:   b.pool.Name,
+		Branch: commit.String(),
+	}
+	query, err := c.NewLakeDeleteQuery(pctx, program, commitish)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer query.Pull(true)
+	w, err := NewWriter(ctx, zctx, b.pool)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = zio.CopyWithContext(ctx, w, query.AsReader())
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var deleted []*data.Object
+	for _, id := range query.DeletionSet() {
+		o, err := base.Lookup(id)
+		if err != nil {
+			return nil, nil, err
 		}
+		deleted = append(deleted, o)
+	}
+	var added []*data.Object
+	for _, o := range w.Objects() {
+		obj := o
+		added = append(added, &obj)
+	}
+	return deleted, added, nil
+}
+
+// Update rewrites the values in the branch for which the filter where is true
+// by passing them through the pipeline transform, committing the objects
+// holding those values as deleted and the rewritten objects as added in a
+// single commit.  Objects holding both updated and untouched values are
+// rewritten as for DeleteWhere.
+func (b *Branch) Update(ctx context.Context, c runtime.Compiler, where, transform ast.Op, author, message, meta string) (ksuid.KSUID, error) {
+	defer b.pool.BeginWrite()()
+	program, err := updateProgram(where, transform)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	zctx := zed.NewContext()
+	appMeta, err := loadMeta(zctx, meta)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
 		if err != nil {
 			return nil, err
 		}
-		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		deleted, added, err := b.rewriteWhere(ctx, c, zctx, where, parent.Commit, base)
 		if err != nil {
 			return nil, err
 		}
-		deleted := query.DeletionSet()
 		if len(deleted) == 0 {
 			return nil, commits.ErrEmptyTransaction
 		}
+		updated, err := b.updateWhere(ctx, c, zctx, program, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		added = append(added, updated...)
 		patch := commits.NewPatch(base)
-		for _, oid := range deleted {
-			patch.DeleteObject(oid)
+		for _, o := range deleted {
+			patch.DeleteObject(o.ID)
 		}
-		for _, o := range w.Objects() {
-			obj := o
-			patch.AddDataObject(&obj)
+		for _, o := range added {
+			patch.AddDataObject(o)
 		}
-		if message == "" {
-			var deletedObjs []*data.Object
-			for _, id := range deleted {
-				o, _ := base.Lookup(id)
-				deletedObjs = append(deletedObjs, o)
+		msg := message
+		if msg == "" {
+			msg = "updated values\n\n" + deleteWhereMessage(deleted, added)
+		}
+		return patch.NewCommitObject(parent.Commit, retries, author, msg, *appMeta), nil
+	})
+}
+
+// updateProgram returns a query reading the values of the branch head for
+// which the filter where is true and passing them through transform.
+func updateProgram(where, transform ast.Op) (ast.Op, error) {
+	filter, ok := ast.Copy(where).(*ast.Sequential)
+	if !ok || len(filter.Ops) == 0 {
+		return nil, errors.New("update requires a filter")
+	}
+	if _, ok := transform.(ast.Statement); ok {
+		return nil, errors.New("update transform must be a pipeline")
+	}
+	seq, ok := ast.Copy(transform).(*ast.Sequential)
+	if !ok || len(seq.Ops) == 0 {
+		return nil, errors.New("update transform must be a pipeline")
+	}
+	if err := checkUpdateTransform(seq); err != nil {
+		return nil, err
+	}
+	seq.Ops = append(filter.Ops, seq.Ops...)
+	seq.Prepend(&ast.From{
+		Kind: "From",
+		Trunks: []ast.Trunk{{
+			Kind: "Trunk",
+			Source: &ast.Pool{
+				Kind: "Pool",
+				Spec: ast.PoolSpec{
+					Pool: &ast.String{
+						Kind: "String",
+						Text: "HEAD",
+					},
+				},
+			},
+		}},
+	})
+	seq.Decls = append(filter.Decls, seq.Decls...)
+	return seq, nil
+}
+
+// checkUpdateTransform ensures that each operator of the transform seq maps
+// one input value to one output value, since update replaces each matching
+// value with the transform's output.  Operators that drop, reorder, or
+// aggregate values and expressions that aggregate or iterate over values
+// are rejected.
+func checkUpdateTransform(seq *ast.Sequential) error {
+	for _, o := range seq.Ops {
+		switch o := o.(type) {
+		case *ast.Cut, *ast.Drop, *ast.OpAssignment, *ast.Put, *ast.Rename:
+		case *ast.Yield:
+			if len(o.Exprs) != 1 {
+				return errors.New("update: yield in an update transform must have exactly one expression")
+			}
+		default:
+			return errors.New("update: only cut, drop, put, rename, and single-expression yield may appear in an update transform")
+		}
+	}
+	b, err := json.Marshal(seq)
+	if err != nil {
+		return err
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	return checkUpdateExpr(v)
+}
+
+// checkUpdateExpr walks the JSON form of an AST node and returns an error
+// for any aggregation or over expression it contains.
+func checkUpdateExpr(v any) error {
+	switch v := v.(type) {
+	case map[string]any:
+		switch v["kind"] {
+		case "Agg":
+			return errors.New("update: aggregations may not appear in an update transform")
+		case "Call":
+			name, _ := v["name"].(string)
+			if _, err := agg.NewPattern(name, true); err == nil {
+				return fmt.Errorf("update: aggregate function %q may not appear in an update transform", name)
 			}
-			var added []*data.Object
-			for _, o := range w.Objects() {
-				added = append(added, &o)
+		case "OverExpr":
+			return errors.New("update: over expressions may not appear in an update transform")
+		}
+		for _, child := range v {
+			if err := checkUpdateExpr(child); err != nil {
+				return err
 			}
-			message = deleteWhereMessage(deletedObjs, added)
 		}
-		return patch.NewCommitObject(parent.Commit, retries, author, message, *appMeta), nil
-	})
+	case []any:
+		for _, child := range v {
+			if err := checkUpdateExpr(child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateWhere runs the update query program against commit and writes its
+// output to new objects.
+func (b *Branch) updateWhere(ctx context.Context, c runtime.Compiler, zctx *zed.Context, program ast.Op, commit ksuid.KSUID) ([]*data.Object, error) {
+	pctx := op.NewContext(ctx, zctx, nil)
+	defer pctx.Cancel()
+	commitish := &lakeparse.Commitish{
+		Pool:   b.pool.Name,
+		Branch: commit.String(),
+	}
+	query, err := c.NewLakeQuery(pctx, program, 0, commitish)
+	if err != nil {
+		return nil, err
+	}
+	defer query.Pull(true)
+	w, err := NewWriter(ctx, zctx, b.pool)
+	if err != nil {
+		return nil, err
+	}
+	err = zio.CopyWithContext(ctx, w, query.AsReader())
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	var objects []*data.Object
+	for _, o := range w.Objects() {
+		obj := o
+		objects = append(objects, &obj)
+	}
+	return objects, nil
 }
 
 func deleteWhereMessage(deleted, added []*data.Object) string {
@@ -247,6 +441,175 @@ func (b *Branch) Revert(ctx context.Context, commit ksuid.KSUID, author, message
 	})
 }
 
+// CherryPick applies the changes in commit, which is typically on another
+// branch, to the tip of the branch in a new commit.  An empty author or
+// meta is taken from the original commit.
+func (b *Branch) CherryPick(ctx context.Context, commit ksuid.KSUID, author, message, meta string) (ksuid.KSUID, error) {
+	patch, err := b.pool.commits.PatchOfCommit(ctx, commit)
+	if err != nil {
+		return ksuid.Nil, fmt.Errorf("commit not found: %s", commit)
+	}
+	object, err := b.pool.commits.Get(ctx, commit)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	if author == "" {
+		author = object.Author()
+	}
+	if message == "" {
+		message = provenance("cherry-picked", commit, object.Message())
+	}
+	appMeta := object.Meta()
+	if meta != "" {
+		zv, err := loadMeta(zed.NewContext(), meta)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		appMeta = *zv
+	}
+	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		tip, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := patch.Apply(tip)
+		if err != nil {
+			if err == commits.ErrEmptyDiff {
+				return nil, fmt.Errorf("cherry-pick of %s is empty", commit)
+			}
+			return nil, fmt.Errorf("error cherry-picking %s onto %q: %w", commit, b.Name, err)
+		}
+		return diff.NewCommitObject(parent.Commit, retries, author, message, appMeta), nil
+	})
+}
+
+// Rebase replays the commits made on the branch since its most recent common
+// ancestor with onto as new commits on top of onto, then moves the branch
+// to the last of these commits.  Each new commit keeps the author of the
+// commit it replays and notes that commit in its message.  Commits whose
+// changes are already present in onto, e.g., because the branch was merged
+// into onto, are dropped.  Rebase returns the new tip of the branch.
+func (b *Branch) Rebase(ctx context.Context, onto ksuid.KSUID) (ksuid.KSUID, error) {
+	if onto == ksuid.Nil {
+		return ksuid.Nil, errors.New("cannot rebase onto an empty branch")
+	}
+	ontoPath, err := b.pool.commits.Path(ctx, onto)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	for retries := 0; retries < maxCommitRetries; retries++ {
+		config, err := b.pool.branches.LookupByName(ctx, b.Name)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		tip, objects, err := b.buildRebaseObjects(ctx, config.Commit, onto, ontoPath, retries)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		if tip == config.Commit {
+			return tip, nil
+		}
+		err = b.movePointer(ctx, config, tip)
+		if err == nil {
+			return tip, nil
+		}
+		b.removeObjects(ctx, objects)
+		if err != journal.ErrConstraint {
+			return ksuid.Nil, err
+		}
+	}
+	return ksuid.Nil, fmt.Errorf("branch %q: %w", b.Name, ErrCommitFailed)
+}
+
+// buildRebaseObjects writes the commit objects that replay the commits on
+// the path from head back to its common ancestor with onto and returns
+// the new tip along with the objects written.
+func (b *Branch) buildRebaseObjects(ctx context.Context, head, onto ksuid.KSUID, ontoPath []ksuid.KSUID, retries int) (ksuid.KSUID, []*commits.Object, error) {
+	var headPath []ksuid.KSUID
+	if head != ksuid.Nil {
+		var err error
+		headPath, err = b.pool.commits.Path(ctx, head)
+		if err != nil {
+			return ksuid.Nil, nil, err
+		}
+	}
+	baseID := commonAncestor(ontoPath, headPath)
+	if baseID == onto {
+		// The branch already contains onto.
+		return head, nil, nil
+	}
+	replay := headPath
+	for k, id := range headPath {
+		if id == baseID {
+			replay = headPath[:k]
+			break
+		}
+	}
+	snap, err := b.pool.commits.Snapshot(ctx, onto)
+	if err != nil {
+		return ksuid.Nil, nil, err
+	}
+	// Snapshots are cached by the commits store so we play the new
+	// commits into a copy.
+	tip := snap.Copy()
+	parent := onto
+	var objects []*commits.Object
+	// Replay commits in forward order.
+	for k := len(replay) - 1; k >= 0; k-- {
+		id := replay[k]
+		patch, err := b.pool.commits.PatchOfCommit(ctx, id)
+		if err != nil {
+			b.removeObjects(ctx, objects)
+			return ksuid.Nil, nil, err
+		}
+		if patch.AppliedTo(tip) {
+			continue
+		}
+		diff, err := patch.Apply(tip)
+		if err != nil {
+			if err == commits.ErrEmptyDiff {
+				continue
+			}
+			b.removeObjects(ctx, objects)
+			return ksuid.Nil, nil, fmt.Errorf("error rebasing %q onto %s: commit %s: %w", b.Name, onto, id, err)
+		}
+		original, err := b.pool.commits.Get(ctx, id)
+		if err != nil {
+			b.removeObjects(ctx, objects)
+			return ksuid.Nil, nil, err
+		}
+		message := provenance("rebased", id, original.Message())
+		object := diff.NewCommitObject(parent, retries, original.Author(), message, original.Meta())
+		if err := b.pool.commits.Put(ctx, object); err != nil {
+			b.removeObjects(ctx, objects)
+			return ksuid.Nil, nil, fmt.Errorf("branch %q failed to write commit object: %w", b.Name, err)
+		}
+		objects = append(objects, object)
+		if err := commits.Play(tip, object); err != nil {
+			b.removeObjects(ctx, objects)
+			return ksuid.Nil, nil, err
+		}
+		parent = object.Commit
+	}
+	return parent, objects, nil
+}
+
+func (b *Branch) removeObjects(ctx context.Context, objects []*commits.Object) {
+	for _, o := range objects {
+		b.pool.commits.Remove(ctx, o)
+	}
+}
+
+// provenance returns the message of a commit that reproduces the changes
+// in commit along with that commit's original message.
+func provenance(verb string, commit ksuid.KSUID, message string) string {
+	s := fmt.Sprintf("%s commit %s", verb, commit)
+	if message != "" {
+		s += "\n\n" + message
+	}
+	return s
+}
+
 func (b *Branch) CommitCompact(ctx context.Context, src, rollup []*data.Object, author, message, meta string) (ksuid.KSUID, error) {
 	if len(rollup) < 1 {
 		return ksuid.Nil, errors.New("compact: one or more rollup objects required")
@@ -292,11 +655,9 @@ func (b *Branch) mergeInto(ctx context.Context, parent *Branch, author, message
 	return parent.commit(ctx, func(head *branches.Config, retries int) (*commits.Object, error) {
 		return b.buildMergeObject(ctx, head, retries, author, message, parent.Name)
 	})
-	//XXX we should follow parent commit with a child rebase... do this
-	// next... we want to fast forward the child to any pending commits
-	// that happened on the child branch while we were merging into the parent.
-	// and rebase the child branch to point at the parent where we grafted on
-	// it's ok if new commits are arriving past the parent graft on point...
+	// The child is not rebased onto the merge commit here.  Rebasing it
+	// with Rebase drops the commits that were merged and replays any
+	// commits that arrived on the child while we were merging.
 }
 
 func (b *Branch) buildMergeObject(ctx context.Context, parent *branches.Config, retries int, author, message, parentName string) (*commits.Object, error) {
@@ -385,18 +746,7 @@ func (b *Branch) commit(ctx context.Context, create constructor) (ksuid.KSUID, e
 		if err := b.pool.commits.Put(ctx, object); err != nil {
 			return ksuid.Nil, fmt.Errorf("branch %q failed to write commit object: %w", b.Name, err)
 		}
-		// Set the branch pointer to point to this commit object
-		// and stash the current commit (that will become the parent)
-		// in a local for the constraint check closure.
-		parent := config.Commit
-		config.Commit = object.Commit
-		parentCheck := func(e journal.Entry) bool {
-			if entry, ok := e.(*branches.Config); ok {
-				return entry.Commit == parent
-			}
-			return false
-		}
-		if err := b.pool.branches.Update(ctx, config, parentCheck); err != nil {
+		if err := b.movePointer(ctx, config, object.Commit); err != nil {
 			// Branch update failed so remove commit.
 			rmerr := b.pool.commits.Remove(ctx, object)
 			if err == journal.ErrConstraint {
@@ -413,6 +763,22 @@ func (b *Branch) commit(ctx context.Context, create constructor) (ksuid.KSUID, e
 	return ksuid.Nil, fmt.Errorf("branch %q: %w", b.Name, ErrCommitFailed)
 }
 
+// movePointer sets the branch pointer to commit provided the branch still
+// points at config.Commit, returning journal.ErrConstraint if it does not.
+func (b *Branch) movePointer(ctx context.Context, config *branches.Config, commit ksuid.KSUID) error {
+	// Stash the current commit (that will become the parent)
+	// in a local for the constraint check closure.
+	parent := config.Commit
+	config.Commit = commit
+	parentCheck := func(e journal.Entry) bool {
+		if entry, ok := e.(*branches.Config); ok {
+			return entry.Commit == parent
+		}
+		return false
+	}
+	return b.pool.branches.Update(ctx, config, parentCheck)
+}
+
 func (b *Branch) LookupTags(ctx context.Context, tags []ksuid.KSUID) ([]ksuid.KSUID, error) {
 	var ids []ksuid.KSUID
 	for _, tag := range tags {
@@ -438,6 +804,7 @@ func (b *Branch) Pool() *Pool {
 }
 
 func (b *Branch) ApplyIndexRules(ctx context.Context, c runtime.Compiler, rules []index.Rule, ids []ksuid.KSUID) (ksuid.KSUID, error) {
+	defer b.pool.BeginWrite()()
 	idxrefs := make([]*index.Object, 0, len(rules)*len(ids))
 	for _, id := range ids {
 		//XXX make issue for this.
@@ -456,6 +823,7 @@ func (b *Branch) ApplyIndexRules(ctx context.Context, c runtime.Compiler, rules
 }
 
 func (b *Branch) UpdateIndex(ctx context.Context, c runtime.Compiler, rules []index.Rule) (ksuid.KSUID, error) {
+	defer b.pool.BeginWrite()()
 	snap, err := b.pool.commits.Snapshot(ctx, b.Commit)
 	if err != nil {
 		return ksuid.Nil, err
@@ -519,6 +887,7 @@ func (b *Branch) indexObject(ctx context.Context, c runtime.Compiler, rules []in
 }
 
 func (b *Branch) AddVectors(ctx context.Context, ids []ksuid.KSUID, author, message string) (ksuid.KSUID, error) {
+	defer b.pool.BeginWrite()()
 	if message == "" {
 		message = vectorMessage("add", ids)
 	}
@@ -14,7 +14,10 @@ import (
 	"github.com/segmentio/ksuid"
 )
 
-var ErrEmptyTransaction = errors.New("empty transaction")
+var (
+	ErrEmptyTransaction = errors.New("empty transaction")
+	ErrEmptyDiff        = errors.New("difference is empty")
+)
 
 type Object struct {
 	Commit  ksuid.KSUID `zed:"commit"`
@@ -80,6 +83,46 @@ func NewDeleteVectorsObject(parent ksuid.KSUID, author, message string, ids []ks
 	return o
 }
 
+// Date returns the time at which o was committed.
+func (o *Object) Date() nano.Ts {
+	if len(o.Actions) > 0 {
+		if first, ok := o.Actions[0].(*Commit); ok {
+			return first.Date
+		}
+	}
+	return 0
+}
+
+// Author returns the author of o.
+func (o *Object) Author() string {
+	if len(o.Actions) > 0 {
+		if first, ok := o.Actions[0].(*Commit); ok {
+			return first.Author
+		}
+	}
+	return ""
+}
+
+// Message returns the commit message of o.
+func (o *Object) Message() string {
+	if len(o.Actions) > 0 {
+		if first, ok := o.Actions[0].(*Commit); ok {
+			return first.Message
+		}
+	}
+	return ""
+}
+
+// Meta returns the application metadata of o.
+func (o *Object) Meta() zed.Value {
+	if len(o.Actions) > 0 {
+		if first, ok := o.Actions[0].(*Commit); ok {
+			return first.Meta
+		}
+	}
+	return *zed.Null
+}
+
 func (o *Object) append(action Action) {
 	o.Actions = append(o.Actions, action)
 }
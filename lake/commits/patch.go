@@ -232,6 +232,39 @@ func (p *Patch) Revert(tip *Snapshot, commit, parent ksuid.KSUID, retries int, a
 	return object, nil
 }
 
+// ObjectChanges returns the data objects added to and deleted from the base
+// of the patch.
+func (p *Patch) ObjectChanges() ([]*data.Object, []*data.Object, error) {
+	added := p.diff.SelectAll()
+	deleted := make([]*data.Object, 0, len(p.deletedObjects))
+	for _, id := range p.deletedObjects {
+		o, err := p.base.Lookup(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		deleted = append(deleted, o)
+	}
+	return added, deleted, nil
+}
+
+// IndexChanges returns the index objects added to and deleted from the base
+// of the patch.
+func (p *Patch) IndexChanges() ([]*index.Object, []*index.Object, error) {
+	added := p.diff.SelectAllIndexes()
+	deleted := make([]*index.Object, 0, len(p.deletedIndexes))
+	for _, ref := range p.deletedIndexes {
+		o, err := p.base.LookupIndex(ref.ruleID, ref.id)
+		if err != nil {
+			return nil, nil, err
+		}
+		deleted = append(deleted, o)
+	}
+	return added, deleted, nil
+}
+
+// Diff returns a patch to parent that applies the changes in child, i.e.,
+// the changes made by merging child into parent.  Both patches must have
+// the same base.  If there are no such changes, ErrEmptyDiff is returned.
 func Diff(parent, child *Patch) (*Patch, error) {
 	var dirty bool
 	p := NewPatch(parent)
@@ -295,7 +328,104 @@ func Diff(parent, child *Patch) (*Patch, error) {
 		}
 	}
 	if !dirty {
-		return nil, errors.New("difference is empty")
+		return nil, ErrEmptyDiff
 	}
 	return p, nil
 }
+
+// Apply returns a patch to tip that makes the changes in p, i.e., the changes
+// made by cherry-picking the commit that p represents onto tip.  Unlike Diff,
+// p and tip need not share a base, so any change that cannot be made to tip
+// is a conflict: an added object must not be in tip and a deleted object
+// must be.  If there are no changes, ErrEmptyDiff is returned.
+func (p *Patch) Apply(tip View) (*Patch, error) {
+	patch := NewPatch(tip)
+	for _, o := range p.diff.SelectAll() {
+		if Exists(tip, o.ID) {
+			return nil, fmt.Errorf("add conflict: %s", o.ID)
+		}
+		if err := patch.AddDataObject(o); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range p.deletedObjects {
+		if !Exists(tip, id) {
+			return nil, fmt.Errorf("delete conflict: %s", id)
+		}
+		if err := patch.DeleteObject(id); err != nil {
+			return nil, err
+		}
+	}
+	for _, idx := range p.diff.SelectAllIndexes() {
+		if IndexExists(tip, idx.Rule.RuleID(), idx.ID) {
+			return nil, fmt.Errorf("add conflict on index object: %s:%s", idx.Rule.RuleID(), idx.ID)
+		}
+		if err := patch.AddIndexObject(idx); err != nil {
+			return nil, err
+		}
+	}
+	for _, idx := range p.deletedIndexes {
+		if !IndexExists(tip, idx.ruleID, idx.id) {
+			return nil, fmt.Errorf("delete conflict on index object: %s:%s", idx.ruleID, idx.id)
+		}
+		if err := patch.DeleteIndexObject(idx.ruleID, idx.id); err != nil {
+			return nil, err
+		}
+	}
+	for id := range p.diff.vectors {
+		if err := patch.AddVector(id); err != nil {
+			return nil, fmt.Errorf("add conflict on vector: %s", id)
+		}
+	}
+	for _, id := range p.deletedVectors {
+		if err := patch.DeleteVector(id); err != nil {
+			return nil, fmt.Errorf("delete conflict on vector: %s", id)
+		}
+	}
+	if patch.empty() {
+		return nil, ErrEmptyDiff
+	}
+	return patch, nil
+}
+
+// AppliedTo returns true if every change in p is already reflected in view,
+// e.g., because the commit that p represents was merged into view.
+func (p *Patch) AppliedTo(view View) bool {
+	for _, o := range p.diff.SelectAll() {
+		if !Exists(view, o.ID) {
+			return false
+		}
+	}
+	for _, id := range p.deletedObjects {
+		if Exists(view, id) {
+			return false
+		}
+	}
+	for _, idx := range p.diff.SelectAllIndexes() {
+		if !IndexExists(view, idx.Rule.RuleID(), idx.ID) {
+			return false
+		}
+	}
+	for _, idx := range p.deletedIndexes {
+		if IndexExists(view, idx.ruleID, idx.id) {
+			return false
+		}
+	}
+	for id := range p.diff.vectors {
+		if !view.HasVector(id) {
+			return false
+		}
+	}
+	for _, id := range p.deletedVectors {
+		if view.HasVector(id) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Patch) empty() bool {
+	return len(p.diff.objects) == 0 && len(p.deletedObjects) == 0 &&
+		len(p.diff.SelectAllIndexes()) == 0 && len(p.deletedIndexes) == 0 &&
+		len(p.diff.vectors) == 0 && len(p.deletedVectors) == 0
+}
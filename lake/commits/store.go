@@ -8,8 +8,10 @@ import (
 	"io"
 	"io/fs"
 	"sync"
+	"time"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/zngio"
@@ -219,6 +221,22 @@ func (s *Store) PathRange(ctx context.Context, from, to ksuid.KSUID) ([]ksuid.KS
 	return path, nil
 }
 
+// CommitAt returns the ID of the last commit in the path ending at leaf whose
+// date is at or before ts.
+func (s *Store) CommitAt(ctx context.Context, leaf ksuid.KSUID, ts nano.Ts) (ksuid.KSUID, error) {
+	for at := leaf; at != ksuid.Nil; {
+		o, err := s.Get(ctx, at)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		if o.Date() <= ts {
+			return at, nil
+		}
+		at = o.Parent
+	}
+	return ksuid.Nil, fmt.Errorf("no commit at or before %s: %w", ts.Time().Format(time.RFC3339Nano), ErrNotFound)
+}
+
 func (s *Store) GetBytes(ctx context.Context, commit ksuid.KSUID) ([]byte, *Commit, error) {
 	b, err := storage.Get(ctx, s.engine, s.pathOf(commit))
 	if err != nil {
@@ -317,13 +335,12 @@ func (s *Store) PatchOfPath(ctx context.Context, base *Snapshot, baseID, commit
 		return nil, err
 	}
 	patch := NewPatch(base)
-	if len(path) < 2 {
-		// There are no changes past the base.  Return the empty patch.
-		return patch, nil
+	if n := len(path); n > 0 && path[n-1] == baseID {
+		// Skip over the base as the difference is relative to it.
+		path = path[:n-1]
 	}
-	// Play objects in forward order skipping over the last path element
-	// as that is the base and the difference is relative to it.
-	for k := len(path) - 2; k >= 0; k-- {
+	// Play objects in forward order.
+	for k := len(path) - 1; k >= 0; k-- {
 		o, err := s.Get(ctx, path[k])
 		if err != nil {
 			return nil, err
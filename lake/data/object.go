@@ -91,6 +91,9 @@ type Meta struct {
 type Object struct {
 	ID   ksuid.KSUID `zed:"id"`
 	Meta `zed:"meta"`
+	// Partition is the partition value shared by all values in the
+	// object or the zero value if the pool is not partitioned.
+	Partition zed.Value `zed:"partition,omitempty"`
 }
 
 func (o Object) IsZero() bool {
@@ -19,7 +19,6 @@ func LookupSeekRange(ctx context.Context, engine storage.Engine, path *storage.U
 	defer r.Close()
 	rg := &seekindex.Range{Start: -1}
 	reader := seekindex.NewSectionReader(r, obj.Last, obj.Count, obj.Size, cmp)
-	swapper := expr.NewValueCompareFn(order.Asc, o == order.Asc)
 	for {
 		s, err := reader.Next()
 		if s == nil || err != nil {
@@ -27,7 +26,9 @@ func LookupSeekRange(ctx context.Context, engine storage.Engine, path *storage.U
 		}
 		first := s.Keys.First()
 		last := s.Keys.Last()
-		if swapper(first, last) > 0 {
+		// cmp compares in the order o, so the keys of a section in
+		// descending order are swapped to make its span ascending.
+		if c := cmp(first, last); o == order.Asc && c > 0 || o == order.Desc && c < 0 {
 			first, last = last, first
 		}
 		if filter != nil && filter.Eval(first, last) {
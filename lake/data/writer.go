@@ -11,6 +11,7 @@ import (
 	"github.com/brimdata/zed/pkg/bufwriter"
 	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zcode"
 	"github.com/brimdata/zed/zio/zngio"
 )
 
@@ -28,15 +29,19 @@ type Writer struct {
 	seekIndexStride  int
 	seekIndexTrigger int
 	first            bool
-	poolKey          field.Path
+	poolKeys         field.List
+	keyBuilder       zcode.Builder
+	keyFields        []zed.Field
+	zctx             *zed.Context
 }
 
 // NewWriter returns a writer for writing the data of a zng-row storage object as
 // well as optionally creating a seek index for the row object when the
 // seekIndexStride is non-zero.  We assume all records are non-volatile until
 // Close as zed.Values from the various record bodies are referenced across
-// calls to Write.
-func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *storage.URI, order order.Which, poolKey field.Path, seekIndexStride int) (*Writer, error) {
+// calls to Write.  If there is more than one pool key, the key of each record
+// is a record of the values of poolKeys, whose fields are named by the keys.
+func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *storage.URI, order order.Which, poolKeys field.List, seekIndexStride int) (*Writer, error) {
 	out, err := engine.Put(ctx, o.SequenceURI(path))
 	if err != nil {
 		return nil, err
@@ -48,7 +53,11 @@ func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *sto
 		writer:      zngio.NewWriter(counter),
 		order:       order,
 		first:       true,
-		poolKey:     poolKey,
+		poolKeys:    poolKeys,
+		zctx:        zed.NewContext(),
+	}
+	for _, key := range poolKeys {
+		w.keyFields = append(w.keyFields, zed.Field{Name: key.String()})
 	}
 	if seekIndexStride == 0 {
 		seekIndexStride = DefaultSeekStride
@@ -64,7 +73,7 @@ func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *sto
 }
 
 func (w *Writer) Write(rec *zed.Value) error {
-	key := rec.DerefPath(w.poolKey).MissingAsNull()
+	key := w.poolKey(rec)
 	if w.seekIndex != nil {
 		if err := w.writeIndex(*key); err != nil {
 			return err
@@ -78,6 +87,19 @@ func (w *Writer) Write(rec *zed.Value) error {
 	return nil
 }
 
+func (w *Writer) poolKey(rec *zed.Value) *zed.Value {
+	if len(w.poolKeys) == 1 {
+		return rec.DerefPath(w.poolKeys[0]).MissingAsNull()
+	}
+	w.keyBuilder.Reset()
+	for k, key := range w.poolKeys {
+		val := rec.DerefPath(key).MissingAsNull()
+		w.keyFields[k].Type = val.Type
+		w.keyBuilder.Append(val.Bytes)
+	}
+	return zed.NewValue(w.zctx.MustLookupTypeRecord(w.keyFields), w.keyBuilder.Bytes())
+}
+
 func (w *Writer) writeIndex(key zed.Value) error {
 	w.seekIndexTrigger += len(key.Bytes)
 	if w.first {
@@ -0,0 +1,70 @@
+package lake
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brimdata/zed/lake/commits"
+	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/lake/index"
+	"github.com/segmentio/ksuid"
+)
+
+// ObjectChange is a data object added or deleted by a diff.  Op is either
+// "add" or "delete".
+type ObjectChange struct {
+	Op     string      `zed:"op"`
+	Object data.Object `zed:"object"`
+}
+
+// IndexChange is an index object added or deleted by a diff.  Op is either
+// "add" or "delete".
+type IndexChange struct {
+	Op    string       `zed:"op"`
+	Index index.Object `zed:"index"`
+}
+
+// Diff returns the changes that merging commit to into commit from would
+// make as a patch to the snapshot at from.  As for a merge, the changes are
+// those made along the path from the common ancestor of from and to
+// through to, and an error is returned if they conflict with the changes
+// along the path through from.
+func (p *Pool) Diff(ctx context.Context, from, to ksuid.KSUID) (*commits.Patch, error) {
+	fromPath, err := p.commitPath(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toPath, err := p.commitPath(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	baseID := commonAncestor(fromPath, toPath)
+	base := commits.NewSnapshot()
+	if baseID != ksuid.Nil {
+		base, err = p.commits.Snapshot(ctx, baseID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fromPatch, err := p.commits.PatchOfPath(ctx, base, baseID, from)
+	if err != nil {
+		return nil, err
+	}
+	toPatch, err := p.commits.PatchOfPath(ctx, base, baseID, to)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := commits.Diff(fromPatch, toPatch)
+	if errors.Is(err, commits.ErrEmptyDiff) {
+		return commits.NewPatch(fromPatch), nil
+	}
+	return diff, err
+}
+
+func (p *Pool) commitPath(ctx context.Context, commit ksuid.KSUID) ([]ksuid.KSUID, error) {
+	if commit == ksuid.Nil {
+		// The commit of an empty branch has no path.
+		return nil, nil
+	}
+	return p.commits.Path(ctx, commit)
+}
@@ -0,0 +1,375 @@
+package lake
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/brimdata/zed/lake/commits"
+	"github.com/brimdata/zed/lake/index"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/segmentio/ksuid"
+)
+
+// DefaultGCGrace is the default grace period for GC.
+const DefaultGCGrace = time.Hour
+
+// GCStats summarizes the storage objects removed by a garbage collection or,
+// for a dry run, the objects that would be removed.
+type GCStats struct {
+	Objects int   `zed:"objects"`
+	Bytes   int64 `zed:"bytes"`
+}
+
+// GC removes the data, seek index, vector, and search index objects in the
+// pool's storage that are not referenced by the snapshot of any branch or
+// tag and that are older than grace.  Objects deleted by a commit made
+// within grace of now are also retained so that queries of recent
+// snapshots continue to work.  Since objects are removed even if older
+// commits reference them, time travel and revert to a commit older than
+// grace may fail after a GC unless the commit is tagged.  If dryRun is
+// true, nothing is removed and the returned stats describe what would be.
+//
+// Objects written for a commit that has not yet been made are unreferenced,
+// so GC also retains the objects written after the latest commit of any
+// branch or tag and, for writes in this process, after the start of the
+// oldest write in progress (see BeginWrite).  A write in another process
+// that commits objects written before both must finish within grace.
+func (p *Pool) GC(ctx context.Context, grace time.Duration, dryRun bool) (GCStats, error) {
+	var files []gcFile
+	for retries := 0; ; retries++ {
+		if retries == maxCommitRetries {
+			return GCStats{}, errors.New("pool changed too often during garbage collection")
+		}
+		heads, err := p.heads(ctx)
+		if err != nil {
+			return GCStats{}, err
+		}
+		cutoff := time.Now().Add(-grace)
+		live, err := p.liveObjects(ctx, heads, cutoff)
+		if err != nil {
+			return GCStats{}, err
+		}
+		written, err := p.writtenCutoff(ctx, cutoff, heads)
+		if err != nil {
+			return GCStats{}, err
+		}
+		if files, err = p.unreferencedFiles(ctx, live, written); err != nil {
+			return GCStats{}, err
+		}
+		// A commit made while listing may reference a file just listed,
+		// so start over if any branch or tag has moved.
+		after, err := p.heads(ctx)
+		if err != nil {
+			return GCStats{}, err
+		}
+		if sameHeads(heads, after) {
+			break
+		}
+	}
+	var stats GCStats
+	for _, f := range files {
+		if !dryRun {
+			if err := p.engine.Delete(ctx, f.uri); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return GCStats{}, err
+			}
+		}
+		stats.Objects++
+		stats.Bytes += f.size
+	}
+	return stats, nil
+}
+
+type gcFile struct {
+	uri  *storage.URI
+	size int64
+}
+
+// unreferencedFiles returns the files in the pool's storage that are not
+// live and were written before cutoff.
+func (p *Pool) unreferencedFiles(ctx context.Context, live *liveObjects, cutoff time.Time) ([]gcFile, error) {
+	var files []gcFile
+	infos, err := listIfExists(ctx, p.engine, p.DataPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		id, vector, ok := parseDataFile(info.Name)
+		if !ok || !isOlder(info, id, cutoff) {
+			continue
+		}
+		if vector {
+			_, ok = live.vectors[id]
+		} else {
+			_, ok = live.objects[id]
+		}
+		if ok {
+			continue
+		}
+		files = append(files, gcFile{p.DataPath.JoinPath(info.Name), info.Size})
+	}
+	rules, err := listIfExists(ctx, p.engine, p.IndexPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		ruleID, err := ksuid.Parse(rule.Name)
+		if err != nil {
+			continue
+		}
+		rulePath := p.IndexPath.JoinPath(rule.Name)
+		infos, err := listIfExists(ctx, p.engine, rulePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			id, ok := parseIndexFile(info.Name)
+			if !ok || !isOlder(info, id, cutoff) {
+				continue
+			}
+			if live.hasIndex(ruleID, id) {
+				continue
+			}
+			files = append(files, gcFile{rulePath.JoinPath(info.Name), info.Size})
+		}
+	}
+	return files, nil
+}
+
+// heads returns the commits of the pool's branches and tags.
+func (p *Pool) heads(ctx context.Context) (map[ksuid.KSUID]struct{}, error) {
+	branches, err := p.ListBranches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := p.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	heads := make(map[ksuid.KSUID]struct{})
+	for _, b := range branches {
+		heads[b.Commit] = struct{}{}
+	}
+	for _, t := range tags {
+		heads[t.Commit] = struct{}{}
+	}
+	delete(heads, ksuid.Nil)
+	return heads, nil
+}
+
+func sameHeads(a, b map[ksuid.KSUID]struct{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id := range a {
+		if _, ok := b[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// writtenCutoff returns the time before which an unreferenced file must have
+// been written to be collected: cutoff but no later than the latest of the
+// head commits or the start of the oldest write to the pool in progress.
+func (p *Pool) writtenCutoff(ctx context.Context, cutoff time.Time, heads map[ksuid.KSUID]struct{}) (time.Time, error) {
+	var latest time.Time
+	for head := range heads {
+		o, err := p.commits.Get(ctx, head)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if date := o.Date().Time(); date.After(latest) {
+			latest = date
+		}
+	}
+	if latest.Before(cutoff) {
+		cutoff = latest
+	}
+	if start, ok := oldestWrite(p.Path); ok && start.Before(cutoff) {
+		cutoff = start
+	}
+	return cutoff, nil
+}
+
+// writes holds the start times of the writes in progress in this process,
+// keyed by pool path.
+var writes = struct {
+	sync.Mutex
+	pools map[string]map[*time.Time]struct{}
+}{pools: make(map[string]map[*time.Time]struct{})}
+
+// writeSlack backdates the start of a write to allow for file systems that
+// record modification times with a coarse clock.
+const writeSlack = time.Second
+
+// BeginWrite records the start of a write of objects to the pool that a
+// commit will reference and returns a function to call once the commit is
+// made or abandoned.  Until then, GC retains the objects written to the
+// pool after the start.
+func (p *Pool) BeginWrite() func() {
+	start := time.Now().Add(-writeSlack)
+	key := p.Path.String()
+	writes.Lock()
+	defer writes.Unlock()
+	if writes.pools[key] == nil {
+		writes.pools[key] = make(map[*time.Time]struct{})
+	}
+	writes.pools[key][&start] = struct{}{}
+	return func() {
+		writes.Lock()
+		defer writes.Unlock()
+		delete(writes.pools[key], &start)
+		if len(writes.pools[key]) == 0 {
+			delete(writes.pools, key)
+		}
+	}
+}
+
+func oldestWrite(path *storage.URI) (time.Time, bool) {
+	writes.Lock()
+	defer writes.Unlock()
+	var oldest time.Time
+	for start := range writes.pools[path.String()] {
+		if oldest.IsZero() || start.Before(oldest) {
+			oldest = *start
+		}
+	}
+	return oldest, !oldest.IsZero()
+}
+
+type liveObjects struct {
+	objects map[ksuid.KSUID]struct{}
+	vectors map[ksuid.KSUID]struct{}
+	indexes map[string]struct{}
+}
+
+// hasIndex returns true if the index object for rule ruleID of data object id
+// is live.  Since deleting a data object does not delete its index objects
+// from a snapshot, the data object must be live too.
+func (l *liveObjects) hasIndex(ruleID, id ksuid.KSUID) bool {
+	if _, ok := l.objects[id]; !ok {
+		return false
+	}
+	_, ok := l.indexes[index.ObjectName(ruleID, id)]
+	return ok
+}
+
+// liveObjects returns the objects referenced by the snapshots of heads along
+// with the objects deleted by commits made after cutoff in the history of
+// each.  A vector is live only if its data object is.
+func (p *Pool) liveObjects(ctx context.Context, heads map[ksuid.KSUID]struct{}, cutoff time.Time) (*liveObjects, error) {
+	live := &liveObjects{
+		objects: make(map[ksuid.KSUID]struct{}),
+		vectors: make(map[ksuid.KSUID]struct{}),
+		indexes: make(map[string]struct{}),
+	}
+	visited := make(map[ksuid.KSUID]struct{})
+	var snaps []*commits.Snapshot
+	for head := range heads {
+		snap, err := p.commits.Snapshot(ctx, head)
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+		for _, o := range snap.SelectAll() {
+			live.objects[o.ID] = struct{}{}
+		}
+		for _, o := range snap.SelectAllIndexes() {
+			live.indexes[index.ObjectName(o.Rule.RuleID(), o.ID)] = struct{}{}
+		}
+		if err := p.addRecentDeletes(ctx, live, head, cutoff, visited); err != nil {
+			return nil, err
+		}
+	}
+	for id := range live.objects {
+		for _, snap := range snaps {
+			if snap.HasVector(id) {
+				live.vectors[id] = struct{}{}
+				break
+			}
+		}
+	}
+	return live, nil
+}
+
+// addRecentDeletes adds to live the objects deleted by the commits made after
+// cutoff on the path from head.
+func (p *Pool) addRecentDeletes(ctx context.Context, live *liveObjects, head ksuid.KSUID, cutoff time.Time, visited map[ksuid.KSUID]struct{}) error {
+	for at := head; at != ksuid.Nil; {
+		if _, ok := visited[at]; ok {
+			return nil
+		}
+		visited[at] = struct{}{}
+		o, err := p.commits.Get(ctx, at)
+		if err != nil {
+			return err
+		}
+		if o.Date().Time().Before(cutoff) {
+			return nil
+		}
+		for _, action := range o.Actions {
+			switch action := action.(type) {
+			case *commits.Delete:
+				live.objects[action.ID] = struct{}{}
+			case *commits.DeleteVector:
+				live.vectors[action.ID] = struct{}{}
+			case *commits.DeleteIndex:
+				live.indexes[index.ObjectName(action.RuleID, action.ID)] = struct{}{}
+			}
+		}
+		at = o.Parent
+	}
+	return nil
+}
+
+func listIfExists(ctx context.Context, engine storage.Engine, u *storage.URI) ([]storage.Info, error) {
+	infos, err := engine.List(ctx, u)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return infos, err
+}
+
+// isOlder returns true if the storage object described by info was written
+// before cutoff.  If the storage engine does not provide a modification
+// time, the time in the ID of the object is used.
+func isOlder(info storage.Info, id ksuid.KSUID, cutoff time.Time) bool {
+	if !info.ModTime.IsZero() {
+		return info.ModTime.Before(cutoff)
+	}
+	return id.Time().Before(cutoff)
+}
+
+var dataFileRegex = regexp.MustCompile(`^([0-9A-Za-z]{27})(\.zng|-seek\.zng|\.vng)$`)
+
+// parseDataFile returns the ID of the data object a file in a pool's data
+// directory belongs to and whether the file is the object's vector.
+func parseDataFile(name string) (ksuid.KSUID, bool, bool) {
+	match := dataFileRegex.FindStringSubmatch(name)
+	if match == nil {
+		return ksuid.Nil, false, false
+	}
+	id, err := ksuid.Parse(match[1])
+	if err != nil {
+		return ksuid.Nil, false, false
+	}
+	return id, match[2] == ".vng", true
+}
+
+var indexFileRegex = regexp.MustCompile(`^([0-9A-Za-z]{27})\.zng$`)
+
+func parseIndexFile(name string) (ksuid.KSUID, bool) {
+	match := indexFileRegex.FindStringSubmatch(name)
+	if match == nil {
+		return ksuid.Nil, false
+	}
+	id, err := ksuid.Parse(match[1])
+	if err != nil {
+		return ksuid.Nil, false
+	}
+	return id, true
+}
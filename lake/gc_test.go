@@ -0,0 +1,62 @@
+package lake_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/pools"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/zsonio"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCRetainsUncommittedObjects(t *testing.T) {
+	ctx := context.Background()
+	root, err := lake.Create(ctx, storage.NewLocalEngine(), storage.MustParseURI(t.TempDir()))
+	require.NoError(t, err)
+	layout := order.NewLayout(order.Asc, field.DottedList("ts"))
+	pool, err := root.CreatePool(ctx, "test", layout, pools.Partition{}, 0, 0)
+	require.NoError(t, err)
+	branch, err := pool.OpenBranchByName(ctx, "main")
+	require.NoError(t, err)
+	zctx := zed.NewContext()
+	load := func(s string) {
+		_, err := branch.Load(ctx, zctx, zsonio.NewReader(zctx, strings.NewReader(s)), "", "", "")
+		require.NoError(t, err)
+	}
+	// write writes an object that is never committed as if by a slow load.
+	write := func() {
+		w, err := lake.NewWriter(ctx, zctx, pool)
+		require.NoError(t, err)
+		require.NoError(t, zio.Copy(w, zsonio.NewReader(zctx, strings.NewReader("{ts:0}"))))
+		require.NoError(t, w.Close())
+	}
+	gc := func() int {
+		stats, err := pool.GC(ctx, 0, false)
+		require.NoError(t, err)
+		return stats.Objects
+	}
+	load("{ts:1}")
+	// Allow for a file system clock coarser than the commit's.
+	time.Sleep(50 * time.Millisecond)
+	write()
+	// The object is newer than the latest commit.
+	require.Equal(t, 0, gc())
+	load("{ts:2}")
+	done := pool.BeginWrite()
+	write()
+	load("{ts:3}")
+	// Both objects were written after the start of a write in progress
+	// and the second before the latest commit.
+	require.Equal(t, 0, gc())
+	done()
+	// Each object and its seek index are collected.
+	require.Equal(t, 4, gc())
+}
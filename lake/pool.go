@@ -2,6 +2,7 @@ package lake
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/brimdata/zed"
@@ -9,6 +10,8 @@ import (
 	"github.com/brimdata/zed/lake/commits"
 	"github.com/brimdata/zed/lake/data"
 	"github.com/brimdata/zed/lake/pools"
+	"github.com/brimdata/zed/lake/tags"
+	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/zio"
@@ -22,6 +25,7 @@ const (
 	IndexTag    = "index"
 	BranchesTag = "branches"
 	CommitsTag  = "commits"
+	TagsTag     = "tags"
 )
 
 type Pool struct {
@@ -32,6 +36,7 @@ type Pool struct {
 	IndexPath *storage.URI
 	branches  *branches.Store
 	commits   *commits.Store
+	tagsPath  *storage.URI
 }
 
 func CreatePool(ctx context.Context, config *pools.Config, engine storage.Engine, root *storage.URI) error {
@@ -87,6 +92,7 @@ func OpenPool(ctx context.Context, config *pools.Config, engine storage.Engine,
 		IndexPath: IndexPath(path),
 		branches:  branches,
 		commits:   commits,
+		tagsPath:  path.JoinPath(TagsTag),
 	}, nil
 }
 
@@ -126,6 +132,107 @@ func (p *Pool) LookupBranchByName(ctx context.Context, name string) (*branches.C
 	return p.branches.LookupByName(ctx, name)
 }
 
+// ResolveCommit returns the ID of the commit referred to by ref, which is
+// a branch or tag name optionally followed by a time as accepted by
+// lakeparse.SplitTime.  A time refers to the last commit at or before that
+// time in the history of the branch or tag.  A branch takes precedence over
+// a tag of the same name.
+func (p *Pool) ResolveCommit(ctx context.Context, ref string) (ksuid.KSUID, error) {
+	name, ts, ok := lakeparse.SplitTime(ref)
+	var commit ksuid.KSUID
+	branch, err := p.LookupBranchByName(ctx, name)
+	if err == nil {
+		commit = branch.Commit
+	} else {
+		if !errors.Is(err, branches.ErrNotFound) {
+			return ksuid.Nil, err
+		}
+		tag, tagErr := p.LookupTagByName(ctx, name)
+		if tagErr != nil {
+			if errors.Is(tagErr, tags.ErrNotFound) {
+				return ksuid.Nil, err
+			}
+			return ksuid.Nil, tagErr
+		}
+		commit = tag.Commit
+	}
+	if !ok {
+		return commit, nil
+	}
+	return p.commits.CommitAt(ctx, commit, ts)
+}
+
+// openTags opens the pool's tags journal.  The journal is created by the
+// pool's first CreateTag, so openTags returns a nil store if there is none.
+func (p *Pool) openTags(ctx context.Context) (*tags.Store, error) {
+	exists, err := p.engine.Exists(ctx, p.tagsPath.JoinPath("HEAD"))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return tags.OpenStore(ctx, p.engine, p.tagsPath)
+}
+
+func (p *Pool) ListTags(ctx context.Context) ([]tags.Config, error) {
+	store, err := p.openTags(ctx)
+	if store == nil {
+		return nil, err
+	}
+	return store.All(ctx)
+}
+
+func (p *Pool) LookupTagByName(ctx context.Context, name string) (*tags.Config, error) {
+	store, err := p.openTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, fmt.Errorf("%q: %w", name, tags.ErrNotFound)
+	}
+	return store.LookupByName(ctx, name)
+}
+
+// CreateTag creates a tag named name that refers to commit.  A tag may not
+// share its name with a branch.
+func (p *Pool) CreateTag(ctx context.Context, name string, commit ksuid.KSUID) (*tags.Config, error) {
+	if _, err := lakeparse.ParseID(name); err == nil {
+		return nil, fmt.Errorf("tag name cannot be a commit ID: %s", name)
+	}
+	if _, err := p.LookupBranchByName(ctx, name); err == nil {
+		return nil, fmt.Errorf("%s/%s: a branch with this name exists: %w", p.Name, name, tags.ErrExists)
+	}
+	if commit == ksuid.Nil {
+		return nil, fmt.Errorf("%s/%s: cannot tag an empty branch", p.Name, name)
+	}
+	if _, err := p.commits.Get(ctx, commit); err != nil {
+		return nil, err
+	}
+	store, err := p.openTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		if store, err = tags.CreateStore(ctx, p.engine, p.tagsPath); err != nil {
+			return nil, err
+		}
+	}
+	config := tags.NewConfig(name, commit)
+	if err := store.Add(ctx, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (p *Pool) RemoveTag(ctx context.Context, name string) error {
+	store, err := p.openTags(ctx)
+	if err != nil {
+		return err
+	}
+	if store == nil {
+		return fmt.Errorf("%q: %w", name, tags.ErrNotFound)
+	}
+	return store.Remove(ctx, name)
+}
+
 func (p *Pool) openBranch(ctx context.Context, config *branches.Config) (*Branch, error) {
 	return OpenBranch(ctx, config, p.engine, p.Path, p)
 }
@@ -157,6 +264,25 @@ func (p *Pool) BatchifyBranches(ctx context.Context, zctx *zed.Context, recs []z
 	return recs, nil
 }
 
+func (p *Pool) BatchifyTags(ctx context.Context, zctx *zed.Context, recs []zed.Value, m *zson.MarshalZNGContext, f expr.Evaluator) ([]zed.Value, error) {
+	tags, err := p.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ectx := expr.NewContext()
+	for _, tagRef := range tags {
+		meta := TagMeta{p.Config, tagRef}
+		rec, err := m.Marshal(&meta)
+		if err != nil {
+			return nil, err
+		}
+		if filter(zctx, ectx, rec, f) {
+			recs = append(recs, *rec)
+		}
+	}
+	return recs, nil
+}
+
 func filter(zctx *zed.Context, ectx expr.Context, this *zed.Value, e expr.Evaluator) bool {
 	if e == nil {
 		return true
@@ -14,13 +14,14 @@ type Config struct {
 	Name       string       `zed:"name"`
 	ID         ksuid.KSUID  `zed:"id"`
 	Layout     order.Layout `zed:"layout"`
+	Partition  Partition    `zed:"partition,omitempty"`
 	SeekStride int          `zed:"seek_stride"`
 	Threshold  int64        `zed:"threshold"`
 }
 
 var _ journal.Entry = (*Config)(nil)
 
-func NewConfig(name string, layout order.Layout, thresh int64, seekStride int) *Config {
+func NewConfig(name string, layout order.Layout, partition Partition, thresh int64, seekStride int) *Config {
 	if thresh == 0 {
 		thresh = data.DefaultThreshold
 	}
@@ -32,6 +33,7 @@ func NewConfig(name string, layout order.Layout, thresh int64, seekStride int) *
 		Name:       name,
 		ID:         ksuid.New(),
 		Layout:     layout,
+		Partition:  partition,
 		SeekStride: seekStride,
 		Threshold:  thresh,
 	}
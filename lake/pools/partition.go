@@ -0,0 +1,61 @@
+package pools
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zson"
+)
+
+// Partition describes how the data objects of a pool are partitioned.  Each
+// data object holds values from a single partition.  Key is a field path,
+// not an expression, so a pool cannot be partitioned by a computed value.
+// If Key is nil, the pool is not partitioned.  If Buckets is zero, values are partitioned by the
+// value of Key.  Otherwise, values are partitioned by the hash of the value of
+// Key into Buckets buckets.
+type Partition struct {
+	Key     field.Path `zed:"key" json:"key"`
+	Buckets int        `zed:"buckets" json:"buckets"`
+}
+
+func (p Partition) Enabled() bool {
+	return len(p.Key) > 0
+}
+
+func (p Partition) Validate() error {
+	if p.Buckets < 0 {
+		return fmt.Errorf("partition buckets must be positive: %d", p.Buckets)
+	}
+	if p.Buckets > 0 && !p.Enabled() {
+		return errors.New("partition buckets require a partition key")
+	}
+	for _, name := range p.Key {
+		if !zson.IsIdentifier(name) {
+			return fmt.Errorf("partition key must be a field path: %q", p.Key.String())
+		}
+	}
+	return nil
+}
+
+// Value returns the partition value of val, which is the value of Key in val
+// (or null if val has no such value) or, if Buckets is nonzero, the uint64
+// bucket of that value.
+func (p Partition) Value(val *zed.Value) *zed.Value {
+	key := val.DerefPath(p.Key)
+	if key == nil || key.IsMissing() {
+		key = zed.Null
+	}
+	if p.Buckets == 0 {
+		return key
+	}
+	return zed.NewUint64(p.Bucket(key))
+}
+
+// Bucket returns the hash bucket of val.  Values that compare equal hash to
+// the same bucket regardless of their numeric types.
+func (p Partition) Bucket(val *zed.Value) uint64 {
+	return expr.Bucket(val, p.Buckets)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/fs"
 	"sort"
+	"time"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/compiler/ast/dag"
@@ -14,6 +15,7 @@ import (
 	"github.com/brimdata/zed/lake/data"
 	"github.com/brimdata/zed/lake/index"
 	"github.com/brimdata/zed/lake/pools"
+	"github.com/brimdata/zed/lake/tags"
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime/expr"
@@ -224,6 +226,35 @@ type BranchMeta struct {
 	Branch branches.Config `zed:"branch"`
 }
 
+func (r *Root) BatchifyTags(ctx context.Context, zctx *zed.Context, f expr.Evaluator) ([]zed.Value, error) {
+	m := zson.NewZNGMarshalerWithContext(zctx)
+	m.Decorate(zson.StylePackage)
+	poolRefs, err := r.ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var vals []zed.Value
+	for k := range poolRefs {
+		pool, err := r.openPool(ctx, &poolRefs[k])
+		if err != nil {
+			if errors.Is(err, pools.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		vals, err = pool.BatchifyTags(ctx, zctx, vals, m, f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+type TagMeta struct {
+	Pool pools.Config `zed:"pool"`
+	Tag  tags.Config  `zed:"tag"`
+}
+
 func (r *Root) ListPools(ctx context.Context) ([]pools.Config, error) {
 	return r.pools.All(ctx)
 }
@@ -249,11 +280,7 @@ func (r *Root) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	branchRef, err := pool.LookupBranchByName(ctx, branchName)
-	if err != nil {
-		return ksuid.Nil, err
-	}
-	return branchRef.Commit, nil
+	return pool.ResolveCommit(ctx, branchName)
 }
 
 func (r *Root) Layout(ctx context.Context, src dag.Source) order.Layout {
@@ -268,6 +295,22 @@ func (r *Root) Layout(ctx context.Context, src dag.Source) order.Layout {
 	return config.Layout
 }
 
+// Partition returns the partitioning of the data objects of the pool with
+// the given ID or nil if the pool is not partitioned.
+func (r *Root) Partition(ctx context.Context, id ksuid.KSUID) (*dag.Partition, error) {
+	config, err := r.pools.LookupByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !config.Partition.Enabled() {
+		return nil, nil
+	}
+	return &dag.Partition{
+		Key:     config.Partition.Key,
+		Buckets: config.Partition.Buckets,
+	}, nil
+}
+
 func (r *Root) OpenPool(ctx context.Context, id ksuid.KSUID) (*Pool, error) {
 	config, err := r.pools.LookupByID(ctx, id)
 	if err != nil {
@@ -297,17 +340,20 @@ func (r *Root) RenamePool(ctx context.Context, id ksuid.KSUID, newName string) e
 	return r.pools.Rename(ctx, id, newName)
 }
 
-func (r *Root) CreatePool(ctx context.Context, name string, layout order.Layout, seekStride int, thresh int64) (*Pool, error) {
+func (r *Root) CreatePool(ctx context.Context, name string, layout order.Layout, partition pools.Partition, seekStride int, thresh int64) (*Pool, error) {
 	if name == "HEAD" {
 		return nil, fmt.Errorf("pool cannot be named %q", name)
 	}
 	if r.pools.LookupByName(ctx, name) != nil {
 		return nil, fmt.Errorf("%s: %w", name, pools.ErrExists)
 	}
+	if err := partition.Validate(); err != nil {
+		return nil, err
+	}
 	if thresh == 0 {
 		thresh = data.DefaultThreshold
 	}
-	config := pools.NewConfig(name, layout, thresh, seekStride)
+	config := pools.NewConfig(name, layout, partition, thresh, seekStride)
 	if err := CreatePool(ctx, config, r.engine, r.path); err != nil {
 		return nil, err
 	}
@@ -345,9 +391,32 @@ func (r *Root) CreateBranch(ctx context.Context, poolID ksuid.KSUID, name string
 	if err != nil {
 		return nil, err
 	}
+	pool, err := r.openPool(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pool.LookupTagByName(ctx, name); err == nil {
+		return nil, fmt.Errorf("%s/%s: a tag with this name exists: %w", config.Name, name, branches.ErrExists)
+	}
 	return CreateBranch(ctx, config, r.engine, r.path, name, parent)
 }
 
+func (r *Root) CreateTag(ctx context.Context, poolID ksuid.KSUID, name string, commit ksuid.KSUID) (*tags.Config, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return pool.CreateTag(ctx, name, commit)
+}
+
+func (r *Root) RemoveTag(ctx context.Context, poolID ksuid.KSUID, name string) error {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	return pool.RemoveTag(ctx, name)
+}
+
 func (r *Root) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, name string) error {
 	pool, err := r.OpenPool(ctx, poolID)
 	if err != nil {
@@ -386,6 +455,42 @@ func (r *Root) Revert(ctx context.Context, poolID ksuid.KSUID, branchName string
 	return branch.Revert(ctx, commitID, author, message)
 }
 
+func (r *Root) CherryPick(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, author, message, meta string) (ksuid.KSUID, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	branch, err := pool.OpenBranchByName(ctx, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return branch.CherryPick(ctx, commitID, author, message, meta)
+}
+
+// Rebase replays the commits on the indicated branch since its common
+// ancestor with onto on top of onto, returning the new tip of the branch.
+func (r *Root) Rebase(ctx context.Context, poolID ksuid.KSUID, branchName string, onto ksuid.KSUID) (ksuid.KSUID, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	branch, err := pool.OpenBranchByName(ctx, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return branch.Rebase(ctx, onto)
+}
+
+// GC removes the unreferenced objects older than grace from the storage
+// of the indicated pool.  See Pool.GC.
+func (r *Root) GC(ctx context.Context, poolID ksuid.KSUID, grace time.Duration, dryRun bool) (GCStats, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return GCStats{}, err
+	}
+	return pool.GC(ctx, grace, dryRun)
+}
+
 func (r *Root) AddIndexRules(ctx context.Context, rules []index.Rule) error {
 	//XXX should change this to do a single commit for all of the rules
 	// and abort all if one fails.  (change Add() semantics)
@@ -0,0 +1,25 @@
+package tags
+
+import (
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/segmentio/ksuid"
+)
+
+// Config is a named, immutable reference to a commit object in a pool.
+type Config struct {
+	Ts     nano.Ts     `zed:"ts"`
+	Name   string      `zed:"name"`
+	Commit ksuid.KSUID `zed:"commit"`
+}
+
+func NewConfig(name string, commit ksuid.KSUID) *Config {
+	return &Config{
+		Ts:     nano.Now(),
+		Name:   name,
+		Commit: commit,
+	}
+}
+
+func (c *Config) Key() string {
+	return c.Name
+}
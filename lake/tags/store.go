@@ -0,0 +1,84 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/zed/lake/journal"
+	"github.com/brimdata/zed/pkg/storage"
+)
+
+var (
+	ErrExists   = errors.New("tag already exists")
+	ErrNotFound = errors.New("tag not found")
+)
+
+// Store is the journal of a pool's tags.  Unlike a branch, a tag never
+// moves once created, so the store has no update method.
+type Store struct {
+	store *journal.Store
+}
+
+func CreateStore(ctx context.Context, engine storage.Engine, path *storage.URI) (*Store, error) {
+	store, err := journal.CreateStore(ctx, engine, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func OpenStore(ctx context.Context, engine storage.Engine, path *storage.URI) (*Store, error) {
+	store, err := journal.OpenStore(ctx, engine, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func (s *Store) All(ctx context.Context) ([]Config, error) {
+	entries, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Config, 0, len(entries))
+	for _, entry := range entries {
+		tag, ok := entry.(*Config)
+		if !ok {
+			return nil, errors.New("corrupt tag config journal")
+		}
+		list = append(list, *tag)
+	}
+	return list, nil
+}
+
+func (s *Store) LookupByName(ctx context.Context, name string) (*Config, error) {
+	entry, err := s.store.Lookup(ctx, name)
+	if err != nil {
+		if err == journal.ErrNoSuchKey {
+			return nil, fmt.Errorf("%q: %w", name, ErrNotFound)
+		}
+		return nil, err
+	}
+	tag, ok := entry.(*Config)
+	if !ok {
+		return nil, errors.New("corrupt tag config journal")
+	}
+	return tag, nil
+}
+
+func (s *Store) Add(ctx context.Context, config *Config) error {
+	err := s.store.Insert(ctx, config)
+	if err == journal.ErrKeyExists {
+		return fmt.Errorf("%q: %w", config.Name, ErrExists)
+	}
+	return err
+}
+
+func (s *Store) Remove(ctx context.Context, name string) error {
+	err := s.store.Delete(ctx, name, nil)
+	if err == journal.ErrNoSuchKey {
+		return fmt.Errorf("%q: %w", name, ErrNotFound)
+	}
+	return err
+}
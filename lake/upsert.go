@@ -0,0 +1,443 @@
+package lake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/lake/branches"
+	"github.com/brimdata/zed/lake/commits"
+	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/lake/seekindex"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/expr/extent"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/zngio"
+	"github.com/segmentio/ksuid"
+)
+
+// UpsertMaxKeys is the maximum number of distinct key values held in memory
+// by an upsert.  Larger loads are upserted in chunks of this many keys, each
+// of which replaces the values of the chunks before it.
+var UpsertMaxKeys = 100_000
+
+// Upsert loads the values read from r as Load does and, in the same commit,
+// deletes the values in the branch whose value of key equals that of a loaded
+// value, so the loaded values replace them.  If several loaded values have
+// the same key, the last one read from r wins.  Objects holding both deleted
+// and kept values are rewritten as for DeleteWhere.  Loaded values lacking
+// key or with a null key are simply added.  If key is the pool key, only the
+// objects and seek index sections spanning loaded keys are read.  Otherwise,
+// every object in the branch is read.
+func (b *Branch) Upsert(ctx context.Context, zctx *zed.Context, r zio.Reader, key field.Path, author, message, meta string) (ksuid.KSUID, error) {
+	if len(key) == 0 {
+		return ksuid.Nil, errors.New("upsert requires a key")
+	}
+	defer b.pool.BeginWrite()()
+	appMeta, err := loadMeta(zctx, meta)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	w := &upsertWriter{
+		ctx:  ctx,
+		zctx: zctx,
+		pool: b.pool,
+		key:  key,
+		keys: make(map[string]int),
+	}
+	err = zio.CopyWithContext(ctx, w, r)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	u := &upserter{
+		pool:     b.pool,
+		zctx:     zctx,
+		key:      key,
+		chunks:   w.chunks,
+		examined: make(map[ksuid.KSUID]struct{}),
+	}
+	// The values of each chunk replace those of earlier chunks.
+	var loaded []*data.Object
+	for k, chunk := range w.chunks {
+		deleted, added, err := u.rewrite(ctx, chunk.objects, w.chunks[k+1:])
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		for _, o := range chunk.objects {
+			if !containsObject(deleted, o) {
+				loaded = append(loaded, o)
+			}
+		}
+		loaded = append(loaded, added...)
+		for _, o := range deleted {
+			if err := o.Remove(ctx, b.pool.engine, b.pool.DataPath); err != nil {
+				return ksuid.Nil, err
+			}
+		}
+	}
+	if len(loaded) == 0 {
+		return ksuid.Nil, commits.ErrEmptyTransaction
+	}
+	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.update(ctx, base); err != nil {
+			return nil, err
+		}
+		patch := commits.NewPatch(base)
+		for _, o := range u.deleted {
+			if err := patch.DeleteObject(o.ID); err != nil {
+				return nil, err
+			}
+		}
+		added := append(append([]*data.Object{}, loaded...), u.added...)
+		for _, o := range added {
+			if err := patch.AddDataObject(o); err != nil {
+				return nil, err
+			}
+		}
+		msg := message
+		if msg == "" {
+			msg = fmt.Sprintf("upserted by key %s\n\n%s", key, deleteWhereMessage(u.deleted, added))
+		}
+		return patch.NewCommitObject(parent.Commit, retries, author, msg, *appMeta), nil
+	})
+}
+
+// upserter deletes the values with the keys of an upsert's chunks from the
+// objects of a branch.  Since a retried commit sees a new branch head, the
+// rewrite accumulates across attempts so that each object is read and
+// rewritten only once.
+type upserter struct {
+	pool     *Pool
+	zctx     *zed.Context
+	key      field.Path
+	chunks   []*upsertChunk
+	examined map[ksuid.KSUID]struct{}
+	deleted  []*data.Object
+	added    []*data.Object
+}
+
+// update rewrites the objects of base not examined by an earlier attempt to
+// commit the upsert, i.e., every object on the first attempt and the objects
+// added by intervening commits on a retry.
+func (u *upserter) update(ctx context.Context, base commits.View) error {
+	for _, o := range u.deleted {
+		if !commits.Exists(base, o.ID) {
+			return fmt.Errorf("upsert: data object %s was deleted by a concurrent commit", o.ID)
+		}
+	}
+	span, ok := u.span()
+	if !ok {
+		// No loaded value has a key.
+		return nil
+	}
+	var objects []*data.Object
+	for _, o := range base.Select(span, u.pool.Layout.Order) {
+		if _, ok := u.examined[o.ID]; !ok {
+			u.examined[o.ID] = struct{}{}
+			objects = append(objects, o)
+		}
+	}
+	deleted, added, err := u.rewrite(ctx, objects, u.chunks)
+	if err != nil {
+		return err
+	}
+	u.deleted = append(u.deleted, deleted...)
+	u.added = append(u.added, added...)
+	return nil
+}
+
+// prunable returns true if objects and their seek index sections may be
+// skipped by comparing their spans with those of the chunks, i.e., if the
+// upsert key is the pool key.
+func (u *upserter) prunable() bool {
+	keys := poolKeys(u.pool.Layout)
+	return len(keys) == 1 && u.key.Equal(keys[0])
+}
+
+// span returns the span of the keys of all chunks, which is nil if the
+// objects of the branch cannot be pruned, and false if there are no keys.
+func (u *upserter) span() (extent.Span, bool) {
+	var span *extent.Generic
+	for _, chunk := range u.chunks {
+		if chunk.span == nil {
+			continue
+		}
+		if span == nil {
+			span = extent.NewGenericFromOrder(*chunk.span.First(), *chunk.span.Last(), u.pool.Layout.Order)
+		} else {
+			span.Extend(chunk.span.First())
+			span.Extend(chunk.span.Last())
+		}
+	}
+	if span == nil {
+		return nil, false
+	}
+	if !u.prunable() {
+		return nil, true
+	}
+	return span, true
+}
+
+// rewrite deletes from objects the values with the keys of chunks.  It
+// returns the objects holding deleted values and the new objects holding the
+// values of those objects that are kept.  Each object is read up to its first
+// deleted value and, only if there is one, read again in full to rewrite it.
+func (u *upserter) rewrite(ctx context.Context, objects []*data.Object, chunks []*upsertChunk) ([]*data.Object, []*data.Object, error) {
+	var deleted []*data.Object
+	for _, o := range objects {
+		chunks := u.overlapping(o, chunks)
+		if len(chunks) == 0 {
+			continue
+		}
+		rg, err := u.seekRange(ctx, o, chunks)
+		if err != nil {
+			return nil, nil, err
+		}
+		var found bool
+		err = u.scan(ctx, o, rg, func(val *zed.Value) (bool, error) {
+			found = hasKey(chunks, u.key, val)
+			return !found, nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if found {
+			deleted = append(deleted, o)
+		}
+	}
+	if len(deleted) == 0 {
+		return nil, nil, nil
+	}
+	w, err := NewWriter(ctx, u.zctx, u.pool)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, o := range deleted {
+		chunks := u.overlapping(o, chunks)
+		err := u.scan(ctx, o, seekindex.Range{End: o.Size}, func(val *zed.Value) (bool, error) {
+			if hasKey(chunks, u.key, val) {
+				return true, nil
+			}
+			return true, w.Write(val)
+		})
+		if err != nil {
+			w.Close()
+			return nil, nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+	var added []*data.Object
+	for _, o := range w.Objects() {
+		obj := o
+		added = append(added, &obj)
+	}
+	return deleted, added, nil
+}
+
+// overlapping returns the chunks that may hold the key of a value in o.
+func (u *upserter) overlapping(o *data.Object, chunks []*upsertChunk) []*upsertChunk {
+	var out []*upsertChunk
+	for _, chunk := range chunks {
+		if chunk.span == nil {
+			continue
+		}
+		if u.prunable() && !extent.Overlaps(chunk.span, o.Span(u.pool.Layout.Order)) {
+			continue
+		}
+		out = append(out, chunk)
+	}
+	return out
+}
+
+// seekRange returns the range of o spanning the seek index sections that may
+// hold the keys of chunks.
+func (u *upserter) seekRange(ctx context.Context, o *data.Object, chunks []*upsertChunk) (seekindex.Range, error) {
+	rg := seekindex.Range{End: o.Size}
+	if !u.prunable() {
+		return rg, nil
+	}
+	r, err := u.pool.engine.Get(ctx, o.SeekIndexURI(u.pool.DataPath))
+	if err != nil {
+		return rg, err
+	}
+	defer r.Close()
+	which := u.pool.Layout.Order
+	sections := seekindex.NewSectionReader(r, o.Last, o.Count, o.Size, expr.NewValueCompareFn(which, which == order.Asc))
+	rg.Start = -1
+	for {
+		s, err := sections.Next()
+		if err != nil {
+			return rg, err
+		}
+		if s == nil {
+			break
+		}
+		for _, chunk := range chunks {
+			if chunk.span.Overlaps(s.Keys.First(), s.Keys.Last()) {
+				if rg.Start == -1 {
+					rg.Start = s.Range.Start
+				}
+				rg.End = s.Range.End
+				break
+			}
+		}
+	}
+	if rg.Start == -1 {
+		return seekindex.Range{}, nil
+	}
+	return rg, nil
+}
+
+// scan calls fn for each value in range rg of object o until fn returns false
+// or an error.
+func (u *upserter) scan(ctx context.Context, o *data.Object, rg seekindex.Range, fn func(*zed.Value) (bool, error)) error {
+	if rg.Size() == 0 {
+		return nil
+	}
+	r, err := o.NewReader(ctx, u.pool.engine, u.pool.DataPath, rg)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	zr := zngio.NewReader(u.zctx, r)
+	defer zr.Close()
+	for {
+		val, err := zr.Read()
+		if val == nil || err != nil {
+			return err
+		}
+		if ok, err := fn(val); !ok || err != nil {
+			return err
+		}
+	}
+}
+
+func hasKey(chunks []*upsertChunk, key field.Path, val *zed.Value) bool {
+	for _, chunk := range chunks {
+		if chunk.has(key, val) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsObject(objects []*data.Object, o *data.Object) bool {
+	for _, obj := range objects {
+		if obj.ID == o.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// upsertChunk holds the data objects written for a chunk of an upsert load
+// along with the set of key values in the chunk and their span.
+type upsertChunk struct {
+	objects []*data.Object
+	keys    map[string]struct{}
+	span    *extent.Generic
+}
+
+// has returns true if the value of key in val is one of the chunk's keys.
+func (u *upsertChunk) has(key field.Path, val *zed.Value) bool {
+	k := val.DerefPath(key)
+	if k == nil || k.IsMissing() || k.IsNull() {
+		return false
+	}
+	_, ok := u.keys[expr.Canonical(k)]
+	return ok
+}
+
+// upsertWriter writes the values of an upsert load to data objects in chunks
+// of at most UpsertMaxKeys distinct key values.  Within a chunk, a value
+// replaces any earlier value with the same key.
+type upsertWriter struct {
+	ctx    context.Context
+	zctx   *zed.Context
+	pool   *Pool
+	key    field.Path
+	writer *Writer
+	keys   map[string]int
+	vals   []zed.Value
+	chunks []*upsertChunk
+}
+
+func (u *upsertWriter) Write(val *zed.Value) error {
+	if u.writer == nil {
+		w, err := NewWriter(u.ctx, u.zctx, u.pool)
+		if err != nil {
+			return err
+		}
+		u.writer = w
+	}
+	key := val.DerefPath(u.key)
+	if key == nil || key.IsMissing() || key.IsNull() {
+		return u.writer.Write(val)
+	}
+	hash := expr.Canonical(key)
+	if k, ok := u.keys[hash]; ok {
+		u.vals[k] = *val.Copy()
+		return nil
+	}
+	if len(u.keys) >= UpsertMaxKeys {
+		if err := u.flush(); err != nil {
+			return err
+		}
+		return u.Write(val)
+	}
+	u.keys[hash] = len(u.vals)
+	u.vals = append(u.vals, *val.Copy())
+	return nil
+}
+
+func (u *upsertWriter) flush() error {
+	if u.writer == nil {
+		return nil
+	}
+	chunk := &upsertChunk{keys: make(map[string]struct{}, len(u.keys))}
+	for hash := range u.keys {
+		chunk.keys[hash] = struct{}{}
+	}
+	for i := range u.vals {
+		val := &u.vals[i]
+		if err := u.writer.Write(val); err != nil {
+			u.writer.Close()
+			return err
+		}
+		key := val.DerefPath(u.key)
+		if chunk.span == nil {
+			chunk.span = extent.NewGenericFromOrder(*key.Copy(), *key.Copy(), u.pool.Layout.Order)
+		} else {
+			chunk.span.Extend(key)
+		}
+	}
+	w := u.writer
+	u.writer = nil
+	u.keys = make(map[string]int)
+	u.vals = nil
+	if err := w.Close(); err != nil {
+		return err
+	}
+	for _, o := range w.Objects() {
+		obj := o
+		chunk.objects = append(chunk.objects, &obj)
+	}
+	u.chunks = append(u.chunks, chunk)
+	return nil
+}
+
+func (u *upsertWriter) Close() error {
+	return u.flush()
+}
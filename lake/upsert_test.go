@@ -0,0 +1,81 @@
+package lake_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/zed"
+	apitypes "github.com/brimdata/zed/api"
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/api"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/zsonio"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertChunks(t *testing.T) {
+	const existing = `
+{ts:1,id:"a",v:0}
+{ts:2,id:"b",v:0}
+{ts:3,id:"c",v:0}
+`
+	// Keys repeat both within and across chunks of two keys, so the last
+	// value for each key must win regardless of chunking.
+	const upsert = `
+{ts:4,id:"a",v:1}
+{ts:5,id:"a",v:2}
+{ts:6,id:"b",v:1}
+{ts:7,id:"d",v:1}
+{ts:8,id:"a",v:3}
+{ts:9,v:1}
+{ts:10,id:"d",v:2}
+`
+	const expected = `{ts:3,id:"c",v:0}
+{ts:6,id:"b",v:1}
+{ts:8,id:"a",v:3}
+{ts:9,v:1}
+{ts:10,id:"d",v:2}
+`
+	// With pool key id, objects and seek index sections are pruned by the
+	// span of the keys of each chunk.
+	for _, c := range []struct {
+		poolKey string
+		maxKeys int
+	}{
+		{"ts", 2},
+		{"ts", 100},
+		{"id", 2},
+		{"id", 100},
+	} {
+		poolKey, maxKeys := c.poolKey, c.maxKeys
+		t.Run(fmt.Sprintf("%s/%d", poolKey, maxKeys), func(t *testing.T) {
+			saved := lake.UpsertMaxKeys
+			lake.UpsertMaxKeys = maxKeys
+			defer func() {
+				lake.UpsertMaxKeys = saved
+			}()
+			ctx := context.Background()
+			lk, err := api.CreateLocalLake(ctx, t.TempDir())
+			require.NoError(t, err)
+			layout := order.NewLayout(order.Asc, field.DottedList(poolKey))
+			poolID, err := lk.CreatePool(ctx, "test", layout, 1, 0)
+			require.NoError(t, err)
+			zctx := zed.NewContext()
+			_, err = lk.Load(ctx, zctx, poolID, "main", zsonio.NewReader(zctx, strings.NewReader(existing)), apitypes.CommitMessage{})
+			require.NoError(t, err)
+			_, err = lk.Upsert(ctx, zctx, poolID, "main", zsonio.NewReader(zctx, strings.NewReader(upsert)), field.Path{"id"}, apitypes.CommitMessage{})
+			require.NoError(t, err)
+			q, err := lk.Query(ctx, nil, "from test | sort ts")
+			require.NoError(t, err)
+			var sb strings.Builder
+			w := zsonio.NewWriter(zio.NopCloser(&sb), zsonio.WriterOpts{})
+			require.NoError(t, zio.Copy(w, q))
+			require.NoError(t, q.Close())
+			require.Equal(t, expected, sb.String())
+		})
+	}
+}
@@ -11,13 +11,22 @@ import (
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 )
 
+// MemMaxBytes limits the total size of the values a Writer buffers across
+// the partitions of a pool.  When the limit is reached, the largest buffer
+// is written as an object even though it is smaller than the pool's
+// threshold.  A pool's threshold raises the limit for its Writers so that a
+// single buffer may always reach it.
+var MemMaxBytes int64 = data.DefaultThreshold
+
 // Writer is a zio.Writer that consumes records into memory according to
 // the pools data object threshold, sorts each resulting buffer, and writes
 // it as an immutable object to the storage system.  The presumption is that
-// each buffer's worth of data fits into memory.
+// each buffer's worth of data fits into memory.  If the pool is partitioned,
+// a buffer is kept for each partition value.
 type Writer struct {
 	pool        *Pool
 	objects     []data.Object
@@ -25,18 +34,30 @@ type Writer struct {
 	ctx         context.Context
 	zctx        *zed.Context
 	//defs          index.Definitions
-	errgroup *errgroup.Group
-	vals     []zed.Value
+	errgroup    *errgroup.Group
+	partitions  map[partitionKey]*partitionBuffer
+	memBuffered int64
 	// XXX this is a simple double buffering model so the cloud-object
 	// writer can run in parallel with the reader filling the records
 	// buffer.  This can be later extended to pass a big bytes buffer
 	// back and forth where the bytes buffer holds all of the record
 	// data efficiently in one big backing store.
-	buffer      chan []zed.Value
-	comparator  *expr.Comparator
-	sorter      expr.Sorter
+	buffer     chan []zed.Value
+	comparator *expr.Comparator
+	sorter     expr.Sorter
+	stats      ImportStats
+}
+
+type partitionBuffer struct {
+	partition   zed.Value
+	vals        []zed.Value
 	memBuffered int64
-	stats       ImportStats
+}
+
+// partitionKey identifies a partition value.
+type partitionKey struct {
+	typ   zed.Type
+	bytes string
 }
 
 //XXX NOTE: we removed the flusher logic as the callee should just put
@@ -60,6 +81,7 @@ func NewWriter(ctx context.Context, zctx *zed.Context, pool *Pool) (*Writer, err
 		ctx:        ctx,
 		zctx:       zctx,
 		errgroup:   g,
+		partitions: make(map[partitionKey]*partitionBuffer),
 		buffer:     ch,
 		comparator: ImportComparator(zctx, pool),
 	}, nil
@@ -69,11 +91,29 @@ func (w *Writer) Objects() []data.Object {
 	return w.objects
 }
 
-func (w *Writer) newObject() *data.Object {
-	w.objects = append(w.objects, data.NewObject())
+func (w *Writer) newObject(partition zed.Value) *data.Object {
+	o := data.NewObject()
+	if w.pool.Partition.Enabled() {
+		o.Partition = partition
+	}
+	w.objects = append(w.objects, o)
 	return &w.objects[len(w.objects)-1]
 }
 
+func (w *Writer) partitionBuffer(rec *zed.Value) *partitionBuffer {
+	partition := zed.Null
+	if w.pool.Partition.Enabled() {
+		partition = w.pool.Partition.Value(rec)
+	}
+	key := partitionKey{partition.Type, string(partition.Bytes)}
+	b, ok := w.partitions[key]
+	if !ok {
+		b = &partitionBuffer{partition: *partition.Copy()}
+		w.partitions[key] = b
+	}
+	return b
+}
+
 func (w *Writer) Write(rec *zed.Value) error {
 	if w.ctx.Err() != nil {
 		if err := w.errgroup.Wait(); err != nil {
@@ -85,26 +125,41 @@ func (w *Writer) Write(rec *zed.Value) error {
 	// and slow down import. We should instead copy the raw record bytes into a
 	// recycled buffer and keep around an array of ts + byte-slice structs for
 	// sorting.
-	w.vals = append(w.vals, *rec.Copy())
+	b := w.partitionBuffer(rec)
+	b.vals = append(b.vals, *rec.Copy())
+	b.memBuffered += int64(len(rec.Bytes))
 	w.memBuffered += int64(len(rec.Bytes))
 	//XXX change name LogSizeThreshold
 	// XXX the previous logic estimated the object size with divide by 2...?!
-	if w.memBuffered >= w.pool.Threshold {
-		w.flipBuffers()
+	if b.memBuffered >= w.pool.Threshold {
+		w.flipBuffers(b)
+	} else if w.memBuffered >= MemMaxBytes && w.memBuffered >= w.pool.Threshold {
+		w.flipBuffers(w.largestBuffer())
 	}
 	return nil
 }
 
-func (w *Writer) flipBuffers() {
+func (w *Writer) largestBuffer() *partitionBuffer {
+	var largest *partitionBuffer
+	for _, b := range w.partitions {
+		if largest == nil || b.memBuffered > largest.memBuffered {
+			largest = b
+		}
+	}
+	return largest
+}
+
+func (w *Writer) flipBuffers(b *partitionBuffer) {
 	oldvals, ok := <-w.buffer
 	if !ok {
 		return
 	}
-	recs := w.vals
-	w.vals = oldvals[:0]
-	w.memBuffered = 0
+	recs := b.vals
+	b.vals = oldvals[:0]
+	w.memBuffered -= b.memBuffered
+	b.memBuffered = 0
 	w.errgroup.Go(func() error {
-		err := w.writeObject(w.newObject(), recs)
+		err := w.writeObject(w.newObject(b.partition), recs)
 		if err != nil {
 			close(w.buffer)
 			return err
@@ -115,10 +170,21 @@ func (w *Writer) flipBuffers() {
 }
 
 func (w *Writer) Close() error {
-	// Send the last write (Note: we could reorder things so we do the
-	// record sort in this thread while waiting for the write to complete.)
-	if len(w.vals) > 0 {
-		w.flipBuffers()
+	// Send the last write of each partition (Note: we could reorder things
+	// so we do the record sort in this thread while waiting for the write
+	// to complete.)
+	cmp := expr.NewValueCompareFn(order.Asc, true)
+	var buffers []*partitionBuffer
+	for _, b := range w.partitions {
+		buffers = append(buffers, b)
+	}
+	slices.SortFunc(buffers, func(a, b *partitionBuffer) bool {
+		return cmp(&a.partition, &b.partition) < 0
+	})
+	for _, b := range buffers {
+		if len(b.vals) > 0 {
+			w.flipBuffers(b)
+		}
 	}
 	// Wait for any pending write to finish.
 	return w.errgroup.Wait()
@@ -137,7 +203,7 @@ func (w *Writer) writeObject(object *data.Object, recs []zed.Value) error {
 			return w.ctx.Err()
 		}
 	}
-	writer, err := object.NewWriter(w.ctx, w.pool.engine, w.pool.DataPath, w.pool.Layout.Order, poolKey(w.pool.Layout), w.pool.SeekStride)
+	writer, err := object.NewWriter(w.ctx, w.pool.engine, w.pool.DataPath, w.pool.Layout.Order, poolKeys(w.pool.Layout), w.pool.SeekStride)
 	if err != nil {
 		return err
 	}
@@ -161,42 +227,57 @@ func (w *Writer) Stats() ImportStats {
 	return w.stats.Copy()
 }
 
+// SortedWriter writes values sorted by pool key to data objects.  If the
+// pool is partitioned, an object is written for each partition value.
 type SortedWriter struct {
 	ctx     context.Context
 	pool    *Pool
-	writer  *data.Writer
+	writers map[partitionKey]*data.Writer
 	objects []*data.Object
 }
 
 func NewSortedWriter(ctx context.Context, pool *Pool) *SortedWriter {
-	return &SortedWriter{ctx: ctx, pool: pool}
+	return &SortedWriter{
+		ctx:     ctx,
+		pool:    pool,
+		writers: make(map[partitionKey]*data.Writer),
+	}
 }
 
 func (w *SortedWriter) Write(val *zed.Value) error {
-	if w.writer == nil {
+	partition := zed.Null
+	if w.pool.Partition.Enabled() {
+		partition = w.pool.Partition.Value(val)
+	}
+	key := partitionKey{partition.Type, string(partition.Bytes)}
+	writer := w.writers[key]
+	if writer == nil {
 		o := data.NewObject()
+		if w.pool.Partition.Enabled() {
+			o.Partition = *partition.Copy()
+		}
 		w.objects = append(w.objects, &o)
 		var err error
-		w.writer, err = o.NewWriter(w.ctx, w.pool.engine, w.pool.DataPath, w.pool.Layout.Order, poolKey(w.pool.Layout), w.pool.SeekStride)
+		writer, err = o.NewWriter(w.ctx, w.pool.engine, w.pool.DataPath, w.pool.Layout.Order, poolKeys(w.pool.Layout), w.pool.SeekStride)
 		if err != nil {
 			return err
 		}
+		w.writers[key] = writer
 	}
-	if err := w.writer.Write(val); err != nil {
+	if err := writer.Write(val); err != nil {
 		return err
 	}
-	if w.writer.BytesWritten() >= w.pool.Threshold {
-		writer := w.writer
-		w.writer = nil
+	if writer.BytesWritten() >= w.pool.Threshold {
+		delete(w.writers, key)
 		return writer.Close(w.ctx)
 	}
 	return nil
 }
 
 func (w *SortedWriter) Abort() {
-	if w.writer != nil {
-		w.writer.Abort()
-		w.writer = nil
+	for key, writer := range w.writers {
+		writer.Abort()
+		delete(w.writers, key)
 	}
 	// Delete all created objects.
 	for _, o := range w.objects {
@@ -209,10 +290,13 @@ func (w *SortedWriter) Objects() []*data.Object {
 }
 
 func (w *SortedWriter) Close() error {
-	if w.writer == nil {
-		return nil
+	for key, writer := range w.writers {
+		delete(w.writers, key)
+		if err := writer.Close(w.ctx); err != nil {
+			return err
+		}
 	}
-	return w.writer.Close(w.ctx)
+	return nil
 }
 
 type ImportStats struct {
@@ -237,14 +321,15 @@ func (s *ImportStats) Copy() ImportStats {
 
 func ImportComparator(zctx *zed.Context, pool *Pool) *expr.Comparator {
 	layout := pool.Layout
-	layout.Keys = field.List{poolKey(layout)}
+	layout.Keys = poolKeys(layout)
 	return zbuf.NewComparator(zctx, layout)
 }
 
-func poolKey(layout order.Layout) field.Path {
+// poolKeys returns the keys of layout, by which values are sorted
+// lexicographically, defaulting to ts.
+func poolKeys(layout order.Layout) field.List {
 	if len(layout.Keys) != 0 {
-		// XXX We don't yet handle multiple pool keys.
-		return layout.Keys[0]
+		return layout.Keys
 	}
-	return field.New("ts")
+	return field.List{field.New("ts")}
 }
@@ -0,0 +1,74 @@
+package lake_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/pools"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/zio/zsonio"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterMemMaxBytes(t *testing.T) {
+	const threshold = 1000
+	// Each partition buffers less than the threshold, so only the limit
+	// on the total buffered across partitions causes objects to be
+	// written before the writer is closed.
+	var sb strings.Builder
+	for i := 0; i < 30; i++ {
+		fmt.Fprintf(&sb, "{ts:%d,tenant:%q,s:%q}\n", i, "abc"[i%3:i%3+1], strings.Repeat("x", 40))
+	}
+	for _, c := range []struct {
+		memMaxBytes int64
+		split       bool
+	}{
+		{memMaxBytes: 1 << 20, split: false},
+		{memMaxBytes: threshold, split: true},
+	} {
+		t.Run(fmt.Sprint(c.memMaxBytes), func(t *testing.T) {
+			saved := lake.MemMaxBytes
+			lake.MemMaxBytes = c.memMaxBytes
+			defer func() {
+				lake.MemMaxBytes = saved
+			}()
+			ctx := context.Background()
+			root, err := lake.Create(ctx, storage.NewLocalEngine(), storage.MustParseURI(t.TempDir()))
+			require.NoError(t, err)
+			layout := order.NewLayout(order.Asc, field.DottedList("ts"))
+			pool, err := root.CreatePool(ctx, "test", layout, pools.Partition{Key: field.Path{"tenant"}}, 0, threshold)
+			require.NoError(t, err)
+			zctx := zed.NewContext()
+			w, err := lake.NewWriter(ctx, zctx, pool)
+			require.NoError(t, err)
+			r := zsonio.NewReader(zctx, strings.NewReader(sb.String()))
+			for {
+				val, err := r.Read()
+				require.NoError(t, err)
+				if val == nil {
+					break
+				}
+				require.NoError(t, w.Write(val))
+			}
+			require.NoError(t, w.Close())
+			// Without splits, an object is written for each of the
+			// three partitions.
+			if c.split {
+				require.Greater(t, len(w.Objects()), 3)
+			} else {
+				require.Len(t, w.Objects(), 3)
+			}
+			var count uint64
+			for _, o := range w.Objects() {
+				count += o.Count
+			}
+			require.EqualValues(t, 30, count)
+		})
+	}
+}
@@ -19,7 +19,14 @@ func ParseCommitish(commitish string) (*Commitish, error) {
 		return nil, errors.New("pool and branch names may not contain single quote characters")
 	}
 	if i := strings.LastIndexByte(commitish, '@'); i > -1 {
-		return &Commitish{Pool: commitish[:i], Branch: commitish[i+1:]}, nil
+		pool, branch := commitish[:i], commitish[i+1:]
+		// A time may follow a branch name, as in "logs@main@-24h".
+		if _, ok := ParseTime(branch); ok {
+			if j := strings.LastIndexByte(pool, '@'); j > -1 {
+				pool, branch = pool[:j], pool[j+1:]+"@"+branch
+			}
+		}
+		return &Commitish{Pool: pool, Branch: branch}, nil
 	}
 	return &Commitish{Pool: commitish}, nil
 }
@@ -0,0 +1,40 @@
+package lakeparse
+
+import (
+	"strings"
+
+	"github.com/brimdata/zed/pkg/nano"
+)
+
+// ParseTime parses s as the time in a commitish, which is either an RFC 3339
+// timestamp, e.g., "2022-06-01T09:00:00Z", or a negative duration relative
+// to the current time, e.g., "-24h".
+func ParseTime(s string) (nano.Ts, bool) {
+	if strings.HasPrefix(s, "-") {
+		d, err := nano.ParseDuration(s)
+		if err != nil {
+			return 0, false
+		}
+		return nano.Now().Add(d), true
+	}
+	ts, err := nano.ParseRFC3339Nano([]byte(s))
+	if err != nil {
+		return 0, false
+	}
+	return ts, true
+}
+
+// SplitTime splits the branch part of a commitish into a branch name and a
+// time when it has the form "<branch>@<time>" or "<time>", in which case the
+// branch is "main".  Otherwise, SplitTime returns ref and false.
+func SplitTime(ref string) (string, nano.Ts, bool) {
+	branch, at := "main", ref
+	if i := strings.LastIndexByte(ref, '@'); i > -1 {
+		branch, at = ref[:i], ref[i+1:]
+	}
+	ts, ok := ParseTime(at)
+	if !ok {
+		return ref, 0, false
+	}
+	return branch, ts, true
+}
@@ -0,0 +1,57 @@
+package lakeparse
+
+import (
+	"testing"
+
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	ts, ok := ParseTime("2022-06-01T09:00:00Z")
+	assert.True(t, ok)
+	assert.Equal(t, nano.Date(2022, 6, 1, 9, 0, 0, 0), ts)
+
+	before := nano.Now()
+	ts, ok = ParseTime("-24h")
+	assert.True(t, ok)
+	assert.LessOrEqual(t, before.Add(-24*nano.Hour), ts)
+	assert.GreaterOrEqual(t, nano.Now().Add(-24*nano.Hour), ts)
+
+	// A duration must be negative and a timestamp must be complete.
+	for _, s := range []string{"24h", "main", "2022-06-01", "-", "-24x"} {
+		_, ok := ParseTime(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestSplitTime(t *testing.T) {
+	branch, ts, ok := SplitTime("2022-06-01T09:00:00Z")
+	assert.True(t, ok)
+	assert.Equal(t, "main", branch)
+	assert.Equal(t, nano.Date(2022, 6, 1, 9, 0, 0, 0), ts)
+
+	branch, _, ok = SplitTime("dev@-1h")
+	assert.True(t, ok)
+	assert.Equal(t, "dev", branch)
+
+	branch, _, ok = SplitTime("dev")
+	assert.False(t, ok)
+	assert.Equal(t, "dev", branch)
+}
+
+func TestParseCommitishTime(t *testing.T) {
+	c, err := ParseCommitish("logs@-24h")
+	require.NoError(t, err)
+	assert.Equal(t, &Commitish{Pool: "logs", Branch: "-24h"}, c)
+
+	c, err = ParseCommitish("logs@dev@2022-06-01T09:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, &Commitish{Pool: "logs", Branch: "dev@2022-06-01T09:00:00Z"}, c)
+
+	// The last @ still separates a pool name containing @ from a branch.
+	c, err = ParseCommitish("a@b@dev")
+	require.NoError(t, err)
+	assert.Equal(t, &Commitish{Pool: "a@b", Branch: "dev"}, c)
+}
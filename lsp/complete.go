@@ -0,0 +1,88 @@
+package lsp
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/api"
+	"github.com/brimdata/zed/runtime/expr/agg"
+	"github.com/brimdata/zed/runtime/expr/function"
+)
+
+// operators are the keywords that begin an operator.
+var operators = []string{
+	"assert", "const", "cut", "drop", "explode", "file", "fork", "from",
+	"func", "fuse", "get", "head", "join", "merge", "output", "over",
+	"pass", "pivot", "pool", "put", "rename", "sample", "search", "shape",
+	"sort", "summarize", "switch", "tail", "top", "try", "type", "uniq",
+	"unpivot", "where", "yield",
+}
+
+// complete returns the completions at pos in text.  A word containing "@"
+// is completed with the names of the branches of the pool named before
+// the "@".  Any other word is completed with the names of operators,
+// functions, aggregate functions, and pools.
+func (s *Server) complete(ctx context.Context, text string, pos Position) *CompletionList {
+	word := wordAt(text, offset(text, pos))
+	items := []CompletionItem{}
+	if i := strings.LastIndexByte(word, '@'); i >= 0 {
+		pool := strings.Trim(word[:i], `"'`)
+		for _, branch := range s.branches(ctx) {
+			if branch.Pool.Name == pool {
+				items = append(items, CompletionItem{
+					Label:  branch.Branch.Name,
+					Kind:   CompletionModule,
+					Detail: "branch",
+				})
+			}
+		}
+		return &CompletionList{Items: items}
+	}
+	for _, name := range operators {
+		items = append(items, CompletionItem{Label: name, Kind: CompletionKeyword, Detail: "operator"})
+	}
+	for _, names := range [][]string{function.Names, shapers} {
+		for _, name := range names {
+			items = append(items, CompletionItem{Label: name, Kind: CompletionFunction, Detail: "function"})
+		}
+	}
+	for _, name := range agg.Names {
+		items = append(items, CompletionItem{Label: name, Kind: CompletionFunction, Detail: "aggregate function"})
+	}
+	if s.lake != nil {
+		// An unreachable lake service leaves pool names uncompleted.
+		pools, _ := api.GetPools(ctx, s.lake)
+		for _, p := range pools {
+			items = append(items, CompletionItem{Label: p.Name, Kind: CompletionModule, Detail: "pool"})
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+	return &CompletionList{Items: items}
+}
+
+func (s *Server) branches(ctx context.Context) []*lake.BranchMeta {
+	if s.lake == nil {
+		return nil
+	}
+	branches, _ := api.GetBranches(ctx, s.lake)
+	return branches
+}
+
+// wordAt returns the word ending at off in text.
+func wordAt(text string, off int) string {
+	start := off
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:start])
+		if unicode.IsSpace(r) || strings.ContainsRune("|(),=:", r) {
+			break
+		}
+		start -= size
+	}
+	return text[start:off]
+}
@@ -0,0 +1,193 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler"
+	"github.com/brimdata/zed/compiler/ast"
+	"github.com/brimdata/zed/compiler/data"
+	"github.com/brimdata/zed/compiler/parser"
+	"github.com/brimdata/zed/compiler/semantic"
+	"github.com/brimdata/zed/runtime/expr/function"
+	"github.com/brimdata/zed/runtime/op"
+)
+
+// shapers are the functions implemented by the compiler instead of
+// function.New.
+var shapers = []string{"cast", "crop", "fill", "fit", "order", "shape"}
+
+// diagnose returns the syntax error in text or, if there is none, the error
+// found by the semantic pass and any calls to undefined functions.
+func (s *Server) diagnose(ctx context.Context, text string) []Diagnostic {
+	diags := []Diagnostic{}
+	program, err := compiler.Parse(text)
+	if err != nil {
+		r := wholeRange(text)
+		var perr *parser.Error
+		if errors.As(err, &perr) {
+			start := position(text, perr.Offset)
+			r = Range{Start: start, End: position(text, nextOffset(text, perr.Offset))}
+		}
+		return append(diags, newDiagnostic(r, SeverityError, "syntax error"))
+	}
+	if _, ok := program.(ast.Statement); ok {
+		// Statements are run against a lake rather than compiled.
+		return diags
+	}
+	pctx := op.NewContext(ctx, zed.NewContext(), nil)
+	defer pctx.Cancel()
+	job, err := compiler.NewJob(pctx, program, data.NewSource(nil, s.root), nil)
+	if err != nil {
+		if s.root == nil && errors.Is(err, semantic.ErrNoLake) {
+			// Pool names are resolved only with a local lake.
+			return diags
+		}
+		return append(diags, newDiagnostic(wholeRange(text), SeverityError, err.Error()))
+	}
+	// Walk the JSON encoding of the DAG instead of each type of operator
+	// and expression to find its user-defined functions and calls.
+	b, err := json.Marshal(job.Entry())
+	if err != nil {
+		return diags
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return diags
+	}
+	funcs := make(map[string]bool)
+	for _, name := range shapers {
+		funcs[name] = true
+	}
+	var calls []call
+	walk(v, funcs, &calls)
+	seen := make(map[string]int)
+	for _, c := range calls {
+		if funcs[c.name] {
+			continue
+		}
+		_, _, err := function.New(zed.NewContext(), c.name, c.nargs)
+		var msg string
+		switch {
+		case errors.Is(err, function.ErrNoSuchFunction):
+			msg = fmt.Sprintf("undefined function %s", c.name)
+		case errors.Is(err, function.ErrTooFewArgs), errors.Is(err, function.ErrTooManyArgs):
+			msg = fmt.Sprintf("%s(): %s", c.name, err)
+		default:
+			continue
+		}
+		// The DAG has no positions so the nth call of a function
+		// is taken to be its nth occurrence in text.
+		r := wholeRange(text)
+		n := seen[c.name]
+		seen[c.name]++
+		re := regexp.MustCompile(`\b` + regexp.QuoteMeta(c.name) + `\s*\(`)
+		if locs := re.FindAllStringIndex(text, -1); n < len(locs) {
+			start := locs[n][0]
+			r = Range{Start: position(text, start), End: position(text, start+len(c.name))}
+		}
+		diags = append(diags, newDiagnostic(r, SeverityError, msg))
+	}
+	sort.SliceStable(diags, func(i, j int) bool {
+		a, b := diags[i].Range.Start, diags[j].Range.Start
+		return a.Line < b.Line || a.Line == b.Line && a.Character < b.Character
+	})
+	return diags
+}
+
+type call struct {
+	name  string
+	nargs int
+}
+
+// walk adds the names of the user-defined functions in the JSON value v
+// to funcs and appends its calls to calls.
+func walk(v any, funcs map[string]bool, calls *[]call) {
+	switch v := v.(type) {
+	case map[string]any:
+		if v["kind"] == "Call" {
+			name, _ := v["name"].(string)
+			args, _ := v["args"].([]any)
+			*calls = append(*calls, call{name, len(args)})
+		}
+		// The functions of a Sequential are in its "funcs" array.
+		if list, ok := v["funcs"].([]any); ok {
+			for _, f := range list {
+				if f, ok := f.(map[string]any); ok {
+					name, _ := f["name"].(string)
+					funcs[name] = true
+				}
+			}
+		}
+		for _, child := range v {
+			walk(child, funcs, calls)
+		}
+	case []any:
+		for _, child := range v {
+			walk(child, funcs, calls)
+		}
+	}
+}
+
+func newDiagnostic(r Range, severity int, msg string) Diagnostic {
+	return Diagnostic{
+		Range:    r,
+		Severity: severity,
+		Source:   "zed",
+		Message:  msg,
+	}
+}
+
+func wholeRange(text string) Range {
+	return Range{End: position(text, len(text))}
+}
+
+// position returns the position of offset in text, whose characters are
+// counted in UTF-16 code units as the protocol requires.
+func position(text string, offset int) Position {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	prefix := text[:offset]
+	line := strings.Count(prefix, "\n")
+	if i := strings.LastIndexByte(prefix, '\n'); i >= 0 {
+		prefix = prefix[i+1:]
+	}
+	return Position{Line: line, Character: len(utf16.Encode([]rune(prefix)))}
+}
+
+// offset returns the offset in text of pos.
+func offset(text string, pos Position) int {
+	var off int
+	for line := 0; line < pos.Line; line++ {
+		i := strings.IndexByte(text[off:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		off += i + 1
+	}
+	for n := 0; n < pos.Character && off < len(text) && text[off] != '\n'; {
+		r, size := utf8.DecodeRuneInString(text[off:])
+		n += utf16.RuneLen(r)
+		off += size
+	}
+	return off
+}
+
+// nextOffset returns the offset of the character after the one at off in
+// text or off if there is none.
+func nextOffset(text string, off int) int {
+	if off >= len(text) {
+		return off
+	}
+	_, size := utf8.DecodeRuneInString(text[off:])
+	return off + size
+}
@@ -0,0 +1,146 @@
+// Package lsp implements a language server for the Zed language that
+// communicates with an editor using the Language Server Protocol.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/api"
+)
+
+// Server is a language server that reports the syntax and semantic errors
+// in Zed documents and completes operators, functions, aggregate functions,
+// and, if it has a lake, pool and branch names.
+type Server struct {
+	lake api.Interface
+	root *lake.Root
+	conn *conn
+	docs map[string]string
+}
+
+// NewServer returns a Server that completes the names of the pools and
+// branches of lk, which may be nil.
+func NewServer(lk api.Interface) *Server {
+	s := &Server{
+		lake: lk,
+		docs: make(map[string]string),
+	}
+	if lk != nil {
+		// Root is nil for a lake service, in which case the semantic
+		// pass cannot resolve pool names.
+		s.root = lk.Root()
+	}
+	return s
+}
+
+// Serve reads messages from r and writes messages to w until it reads
+// an exit notification or the end of r.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		msg, err := s.conn.read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			var rpcErr *rpcError
+			if !errors.As(err, &rpcErr) {
+				return err
+			}
+			if err := s.conn.write(&message{ID: json.RawMessage("null"), Error: rpcErr}); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+		result, err := s.handle(ctx, msg)
+		if msg.ID == nil {
+			// Notifications have no response.
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		res := &message{ID: msg.ID}
+		if err != nil {
+			if !errors.As(err, &res.Error) {
+				res.Error = &rpcError{Code: codeInvalidParams, Message: err.Error()}
+			}
+		} else if res.Result, err = json.Marshal(result); err != nil {
+			return err
+		}
+		if err := s.conn.write(res); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, msg *message) (any, error) {
+	switch msg.Method {
+	case "initialize":
+		return &InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync: TextDocumentSyncFull,
+				CompletionProvider: &CompletionOptions{
+					TriggerCharacters: []string{"@"},
+				},
+			},
+			ServerInfo: ServerInfo{Name: "zed"},
+		}, nil
+	case "shutdown":
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.update(ctx, params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			// With full synchronization, the last change holds the
+			// text of the document.
+			return nil, s.update(ctx, params.TextDocument.URI, params.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.publish(params.TextDocument.URI, []Diagnostic{})
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.complete(ctx, s.docs[params.TextDocument.URI], params.Position), nil
+	}
+	if msg.ID != nil {
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+	// Ignore other notifications, e.g., "initialized" and "$/cancelRequest".
+	return nil, nil
+}
+
+func (s *Server) update(ctx context.Context, uri, text string) error {
+	s.docs[uri] = text
+	return s.publish(uri, s.diagnose(ctx, text))
+}
+
+func (s *Server) publish(uri string, diags []Diagnostic) error {
+	return s.conn.notify("textDocument/publishDiagnostics", &PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diags,
+	})
+}
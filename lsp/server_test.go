@@ -0,0 +1,130 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/brimdata/zed/lake/api"
+	"github.com/brimdata/zed/order"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type client struct {
+	t    *testing.T
+	conn *conn
+	id   int
+}
+
+func newClient(t *testing.T, lk api.Interface) *client {
+	serverIn, clientOut := io.Pipe()
+	clientIn, serverOut := io.Pipe()
+	done := make(chan error)
+	go func() {
+		done <- NewServer(lk).Serve(context.Background(), serverIn, serverOut)
+		serverOut.Close()
+	}()
+	t.Cleanup(func() {
+		clientOut.Close()
+		require.NoError(t, <-done)
+	})
+	return &client{t: t, conn: newConn(clientIn, clientOut)}
+}
+
+func (c *client) call(method string, params, result any) {
+	c.id++
+	id := json.RawMessage(fmt.Sprint(c.id))
+	b, err := json.Marshal(params)
+	require.NoError(c.t, err)
+	require.NoError(c.t, c.conn.write(&message{ID: id, Method: method, Params: b}))
+	msg, err := c.conn.read()
+	require.NoError(c.t, err)
+	require.Equal(c.t, string(id), string(msg.ID))
+	require.Nil(c.t, msg.Error)
+	require.NoError(c.t, json.Unmarshal(msg.Result, result))
+}
+
+// open sends text as the document uri and returns the diagnostics published
+// for it.
+func (c *client) open(uri, text string) []Diagnostic {
+	b, err := json.Marshal(&DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "zed", Text: text},
+	})
+	require.NoError(c.t, err)
+	require.NoError(c.t, c.conn.write(&message{Method: "textDocument/didOpen", Params: b}))
+	msg, err := c.conn.read()
+	require.NoError(c.t, err)
+	require.Equal(c.t, "textDocument/publishDiagnostics", msg.Method)
+	var params PublishDiagnosticsParams
+	require.NoError(c.t, json.Unmarshal(msg.Params, &params))
+	require.Equal(c.t, uri, params.URI)
+	return params.Diagnostics
+}
+
+func (c *client) complete(uri string, line, char int) []string {
+	var list CompletionList
+	c.call("textDocument/completion", &TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: line, Character: char},
+	}, &list)
+	var labels []string
+	for _, item := range list.Items {
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
+
+func TestDiagnostics(t *testing.T) {
+	c := newClient(t, nil)
+	var res InitializeResult
+	c.call("initialize", map[string]any{}, &res)
+	assert.Equal(t, TextDocumentSyncFull, res.Capabilities.TextDocumentSync)
+
+	assert.Empty(t, c.open("file:///ok.zed", "x==1 | count() by y"))
+
+	diags := c.open("file:///syntax.zed", "count() by x\n| sort -r )")
+	require.Len(t, diags, 1)
+	assert.Equal(t, "syntax error", diags[0].Message)
+	assert.Equal(t, Position{Line: 1, Character: 10}, diags[0].Range.Start)
+
+	diags = c.open("file:///call.zed", "func f(x): (x+1)\nyield f(a), len(b), lenn(c), lenn(d), upper()")
+	require.Len(t, diags, 3)
+	assert.Equal(t, "undefined function lenn", diags[0].Message)
+	assert.Equal(t, Range{Start: Position{1, 20}, End: Position{1, 24}}, diags[0].Range)
+	assert.Equal(t, Range{Start: Position{1, 29}, End: Position{1, 33}}, diags[1].Range)
+	assert.Equal(t, "upper(): too few arguments", diags[2].Message)
+
+	diags = c.open("file:///semantic.zed", "const x=1\nconst x=2\nyield x")
+	require.Len(t, diags, 1)
+	assert.Equal(t, `symbol "x" redefined`, diags[0].Message)
+	assert.Equal(t, Position{Line: 2, Character: 7}, diags[0].Range.End)
+
+	// There is no lake so pools are not resolved.
+	assert.Empty(t, c.open("file:///pool.zed", "from p | count()"))
+}
+
+func TestCompletion(t *testing.T) {
+	ctx := context.Background()
+	lk, err := api.CreateLocalLake(ctx, t.TempDir())
+	require.NoError(t, err)
+	poolID, err := lk.CreatePool(ctx, "logs", order.Nil, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, lk.CreateBranch(ctx, poolID, "dev", ksuid.Nil))
+	c := newClient(t, lk)
+
+	c.open("file:///a.zed", "from logs@ | summ")
+	labels := c.complete("file:///a.zed", 0, 16)
+	for _, name := range []string{"summarize", "len", "cast", "count", "logs"} {
+		assert.Contains(t, labels, name)
+	}
+	assert.ElementsMatch(t, []string{"dev", "main"}, c.complete("file:///a.zed", 0, 10))
+
+	// The pool is resolved by the semantic pass of a local lake.
+	diags := c.open("file:///b.zed", "from nopool | count()")
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Message, "nopool")
+}
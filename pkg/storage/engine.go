@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"time"
 )
 
 type Reader interface {
@@ -32,8 +33,9 @@ type Engine interface {
 }
 
 type Info struct {
-	Name string
-	Size int64
+	Name    string
+	Size    int64
+	ModTime time.Time
 }
 
 func NewRemoteEngine() *Router {
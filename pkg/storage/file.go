@@ -100,8 +100,9 @@ func (f *FileSystem) List(ctx context.Context, u *URI) ([]Info, error) {
 			return nil, err
 		}
 		infos[i] = Info{
-			Name: e.Name(),
-			Size: info.Size(),
+			Name:    e.Name(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
 		}
 	}
 	return infos, nil
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"net/http"
@@ -32,7 +33,58 @@ func (*HTTPEngine) Get(ctx context.Context, u *URI) (Reader, error) {
 		}
 		return nil, errors.New(resp.Status)
 	}
-	return &notSupportedReaderAt{resp.Body}, nil
+	if resp.Header.Get("Accept-Ranges") != "bytes" || resp.ContentLength < 0 {
+		return &notSupportedReaderAt{resp.Body}, nil
+	}
+	return &httpReader{
+		ReadCloser: resp.Body,
+		ctx:        ctx,
+		url:        u.String(),
+		size:       resp.ContentLength,
+	}, nil
+}
+
+// httpReader implements io.ReaderAt and Sizer for a server that accepts
+// range requests.  Each call to ReadAt issues a request for the range.
+type httpReader struct {
+	io.ReadCloser
+	ctx  context.Context
+	url  string
+	size int64
+}
+
+var _ Sizer = (*httpReader)(nil)
+
+func (h *httpReader) ReadAt(b []byte, off int64) (int, error) {
+	if off >= h.size {
+		return 0, io.EOF
+	}
+	end := off + int64(len(b))
+	if end > h.size {
+		end = h.size
+	}
+	req, err := http.NewRequestWithContext(h.ctx, http.MethodGet, h.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, end-1))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		return 0, fmt.Errorf("%s: range request: %s", h.url, resp.Status)
+	}
+	n, err := io.ReadFull(resp.Body, b[:end-off])
+	if err == nil && end-off < int64(len(b)) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (h *httpReader) Size() (int64, error) {
+	return h.size, nil
 }
 
 type notSupportedReaderAt struct{ io.ReadCloser }
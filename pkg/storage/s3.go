@@ -65,8 +65,9 @@ func (s *S3Engine) List(ctx context.Context, uri *URI) ([]Info, error) {
 	infos := make([]Info, 0, len(entries))
 	for _, e := range entries {
 		infos = append(infos, Info{
-			Name: e.Name,
-			Size: e.Size,
+			Name:    e.Name,
+			Size:    e.Size,
+			ModTime: e.ModTime,
 		})
 	}
 	return infos, nil
@@ -50,3 +50,8 @@ func (b Bytes) Abbrev() string {
 		return format(v, "B", 1)
 	}
 }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	return b.Set(string(text))
+}
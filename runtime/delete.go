@@ -15,7 +15,7 @@ type DeleteQuery struct {
 
 func NewDeleteQuery(pctx *op.Context, puller zbuf.Puller, deletes *sync.Map) *DeleteQuery {
 	return &DeleteQuery{
-		Query:   NewQuery(pctx, puller, nil),
+		Query:   NewQuery(pctx, puller, nil, nil, nil),
 		deletes: deletes,
 	}
 }
@@ -17,6 +17,7 @@ func Compact(ctx context.Context, lk *lake.Root, pool *lake.Pool, branchName str
 	if len(objectIDs) < 2 {
 		return ksuid.Nil, errors.New("compact: two or more source objects required")
 	}
+	defer pool.BeginWrite()()
 	branch, err := pool.OpenBranchByName(ctx, branchName)
 	if err != nil {
 		return ksuid.Nil, err
@@ -36,7 +37,7 @@ func Compact(ctx context.Context, lk *lake.Root, pool *lake.Pool, branchName str
 	zctx := zed.NewContext()
 	lister := meta.NewSortedListerFromSnap(ctx, zed.NewContext(), lk, pool, compact, nil)
 	octx := op.NewContext(ctx, zctx, nil)
-	slicer := meta.NewSlicer(lister, zctx, pool.Layout.Order)
+	slicer := meta.NewSlicer(lister, zctx, pool.Layout)
 	puller := meta.NewSequenceScanner(octx, slicer, pool, lister.Snapshot(), nil, nil)
 	w := lake.NewSortedWriter(ctx, pool)
 	if err := zbuf.CopyPuller(w, puller); err != nil {
@@ -0,0 +1,165 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/commits"
+	"github.com/brimdata/zed/lake/data"
+	"github.com/brimdata/zed/lake/index"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/runtime/op/meta"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zson"
+	"github.com/segmentio/ksuid"
+)
+
+// Diff returns a reader of the changes that merging commit to into commit
+// from of pool would make.  Unless values is true, the reader yields a
+// lake.ObjectChange for each data object deleted or added followed by a
+// lake.IndexChange for each index object deleted or added.  If values is
+// true, the reader instead yields a record {op:"delete",value:<value>} for
+// each value in the deleted data objects followed by a record
+// {op:"add",value:<value>} for each value in the added data objects.
+func Diff(ctx context.Context, zctx *zed.Context, lk *lake.Root, pool *lake.Pool, from, to ksuid.KSUID, values bool) (zio.Reader, error) {
+	patch, err := pool.Diff(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	added, deleted, err := patch.ObjectChanges()
+	if err != nil {
+		return nil, err
+	}
+	sortObjects(added, pool.Layout)
+	sortObjects(deleted, pool.Layout)
+	if values {
+		return zbuf.PullerReader(&diffValues{
+			zctx: zctx,
+			ops:  []string{"delete", "add"},
+			pullers: []zbuf.Puller{
+				scanObjects(ctx, zctx, lk, pool, deleted),
+				scanObjects(ctx, zctx, lk, pool, added),
+			},
+		}), nil
+	}
+	addedIndexes, deletedIndexes, err := patch.IndexChanges()
+	if err != nil {
+		return nil, err
+	}
+	sortIndexes(addedIndexes)
+	sortIndexes(deletedIndexes)
+	m := zson.NewZNGMarshalerWithContext(zctx)
+	m.Decorate(zson.StylePackage)
+	var vals []zed.Value
+	for _, o := range deleted {
+		val, err := m.Marshal(&lake.ObjectChange{Op: "delete", Object: *o})
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	for _, o := range added {
+		val, err := m.Marshal(&lake.ObjectChange{Op: "add", Object: *o})
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	for _, o := range deletedIndexes {
+		val, err := m.Marshal(&lake.IndexChange{Op: "delete", Index: *o})
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	for _, o := range addedIndexes {
+		val, err := m.Marshal(&lake.IndexChange{Op: "add", Index: *o})
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return zbuf.NewArray(vals), nil
+}
+
+func sortObjects(objects []*data.Object, layout order.Layout) {
+	cmp := expr.NewKeyCompareFn(layout, layout.Order == order.Asc)
+	sort.SliceStable(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		if c := cmp(&a.First, &b.First); c != 0 {
+			return c < 0
+		}
+		if c := cmp(&a.Last, &b.Last); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(a.ID.Bytes(), b.ID.Bytes()) < 0
+	})
+}
+
+func sortIndexes(objects []*index.Object) {
+	sort.Slice(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		if c := bytes.Compare(a.ID.Bytes(), b.ID.Bytes()); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(a.Rule.RuleID().Bytes(), b.Rule.RuleID().Bytes()) < 0
+	})
+}
+
+func scanObjects(ctx context.Context, zctx *zed.Context, lk *lake.Root, pool *lake.Pool, objects []*data.Object) zbuf.Puller {
+	snap := commits.NewSnapshot()
+	for _, o := range objects {
+		snap.AddDataObject(o)
+	}
+	lister := meta.NewSortedListerFromSnap(ctx, zctx, lk, pool, snap, nil)
+	slicer := meta.NewSlicer(lister, zctx, pool.Layout)
+	return meta.NewSequenceScanner(op.NewContext(ctx, zctx, nil), slicer, pool, snap, nil, nil)
+}
+
+// diffValues wraps each value pulled from pullers[k] in a record with
+// op ops[k].
+type diffValues struct {
+	zctx    *zed.Context
+	ops     []string
+	pullers []zbuf.Puller
+	builder zcode.Builder
+}
+
+func (d *diffValues) Pull(done bool) (zbuf.Batch, error) {
+	for len(d.pullers) > 0 {
+		batch, err := d.pullers[0].Pull(done)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			d.ops, d.pullers = d.ops[1:], d.pullers[1:]
+			continue
+		}
+		vals := batch.Values()
+		out := make([]zed.Value, 0, len(vals))
+		for _, val := range vals {
+			typ, err := d.zctx.LookupTypeRecord([]zed.Field{
+				zed.NewField("op", zed.TypeString),
+				zed.NewField("value", val.Type),
+			})
+			if err != nil {
+				batch.Unref()
+				return nil, err
+			}
+			d.builder.Reset()
+			d.builder.Append(zed.EncodeString(d.ops[0]))
+			d.builder.Append(val.Bytes)
+			out = append(out, *zed.NewValue(typ, d.builder.Bytes()))
+		}
+		batch.Unref()
+		return zbuf.NewArray(out), nil
+	}
+	return nil, nil
+}
@@ -1,6 +1,8 @@
 package expr
 
 import (
+	"fmt"
+
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/runtime/expr/agg"
 )
@@ -11,11 +13,17 @@ type Aggregator struct {
 	where   Evaluator
 }
 
-func NewAggregator(op string, expr Evaluator, where Evaluator) (*Aggregator, error) {
+func NewAggregator(op string, distinct bool, expr Evaluator, where Evaluator) (*Aggregator, error) {
 	pattern, err := agg.NewPattern(op, expr != nil)
 	if err != nil {
 		return nil, err
 	}
+	if distinct {
+		if expr == nil {
+			return nil, fmt.Errorf("%s: distinct requires an argument", op)
+		}
+		pattern = agg.NewDistinct(pattern)
+	}
 	if expr == nil {
 		// Count is the only that has no argument so we just return
 		// true so it counts each value encountered.
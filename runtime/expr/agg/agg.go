@@ -23,6 +23,12 @@ type Function interface {
 	ResultAsPartial(*zed.Context) *zed.Value
 }
 
+// Names lists the names of the aggregate functions created by NewPattern.
+var Names = []string{
+	"and", "any", "avg", "collect", "count", "dcount", "fuse", "map", "max",
+	"min", "or", "sum", "union",
+}
+
 func NewPattern(op string, hasarg bool) (Pattern, error) {
 	needarg := true
 	var pattern Pattern
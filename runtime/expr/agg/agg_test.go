@@ -0,0 +1,14 @@
+package agg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNames(t *testing.T) {
+	for _, name := range Names {
+		_, err := NewPattern(name, true)
+		assert.NoError(t, err, name)
+	}
+}
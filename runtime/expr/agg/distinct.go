@@ -0,0 +1,71 @@
+package agg
+
+import (
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zcode"
+)
+
+// Distinct wraps a Function so that it consumes each distinct non-null
+// value only once, as for "count(distinct x)".  The partial result of a
+// Distinct is the set of values seen, so partials from different
+// aggregators can be merged without consuming a value twice.
+type Distinct struct {
+	fn    Function
+	types map[zed.Type]map[string]struct{}
+}
+
+var _ Function = (*Distinct)(nil)
+
+func NewDistinct(pattern Pattern) Pattern {
+	return func() Function {
+		return &Distinct{
+			fn:    pattern(),
+			types: make(map[zed.Type]map[string]struct{}),
+		}
+	}
+}
+
+func (d *Distinct) Consume(val *zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	d.update(val.Type, val.Bytes)
+}
+
+func (d *Distinct) update(typ zed.Type, b zcode.Bytes) {
+	m, ok := d.types[typ]
+	if !ok {
+		m = make(map[string]struct{})
+		d.types[typ] = m
+	}
+	if _, ok := m[string(b)]; !ok {
+		m[string(b)] = struct{}{}
+		d.fn.Consume(zed.NewValue(typ, b))
+	}
+}
+
+func (d *Distinct) Result(zctx *zed.Context) *zed.Value {
+	return d.fn.Result(zctx)
+}
+
+func (d *Distinct) ConsumeAsPartial(val *zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	styp, ok := val.Type.(*zed.TypeSet)
+	if !ok {
+		panic("distinct: partial not a set type")
+	}
+	for it := val.Iter(); !it.Done(); {
+		typ := styp.Type
+		b := it.Next()
+		if union, ok := zed.TypeUnder(typ).(*zed.TypeUnion); ok {
+			typ, b = union.Untag(b)
+		}
+		d.update(typ, b)
+	}
+}
+
+func (d *Distinct) ResultAsPartial(zctx *zed.Context) *zed.Value {
+	return newSet(zctx, d.types)
+}
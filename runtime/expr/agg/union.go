@@ -57,26 +57,32 @@ func (u *Union) deleteOne() {
 }
 
 func (u *Union) Result(zctx *zed.Context) *zed.Value {
-	if len(u.types) == 0 {
+	return newSet(zctx, u.types)
+}
+
+// newSet returns a set of the values in types, using a union type for
+// the set elements when there is more than one type.
+func newSet(zctx *zed.Context, types map[zed.Type]map[string]struct{}) *zed.Value {
+	if len(types) == 0 {
 		return zed.Null
 	}
-	types := make([]zed.Type, 0, len(u.types))
-	for typ := range u.types {
-		types = append(types, typ)
+	typs := make([]zed.Type, 0, len(types))
+	for typ := range types {
+		typs = append(typs, typ)
 	}
 	var inner zed.Type
 	var b zcode.Builder
-	if len(types) > 1 {
-		union := zctx.LookupTypeUnion(types)
+	if len(typs) > 1 {
+		union := zctx.LookupTypeUnion(typs)
 		inner = union
-		for typ, m := range u.types {
+		for typ, m := range types {
 			for v := range m {
 				zed.BuildUnion(&b, union.TagOf(typ), []byte(v))
 			}
 		}
 	} else {
-		inner = types[0]
-		for v := range u.types[inner] {
+		inner = typs[0]
+		for v := range types[inner] {
 			b.Append([]byte(v))
 		}
 	}
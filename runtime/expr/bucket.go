@@ -0,0 +1,41 @@
+package expr
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zson"
+)
+
+// Bucket returns the hash of val into one of buckets buckets.  Values that
+// compare equal hash to the same bucket regardless of their numeric types.
+func Bucket(val *zed.Value, buckets int) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(Canonical(val)))
+	return h.Sum64() % uint64(buckets)
+}
+
+// Canonical returns a string representation of val that is the same for
+// values that compare equal regardless of their numeric types.
+func Canonical(val *zed.Value) string {
+	typ := zed.TypeUnder(val.Type)
+	if val.Bytes == nil {
+		return "null"
+	}
+	id := typ.ID()
+	switch {
+	case zed.IsFloat(id):
+		f := zed.DecodeFloat(val.Bytes)
+		if f == math.Trunc(f) && math.Abs(f) < math.MaxInt64 {
+			return strconv.FormatInt(int64(f), 10)
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	case zed.IsSigned(id):
+		return strconv.FormatInt(zed.DecodeInt(val.Bytes), 10)
+	case zed.IsInteger(id):
+		return strconv.FormatUint(zed.DecodeUint(val.Bytes), 10)
+	}
+	return zson.String(&zed.Value{Type: typ, Bytes: val.Bytes})
+}
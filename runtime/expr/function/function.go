@@ -18,6 +18,17 @@ var (
 	ErrTooManyArgs    = errors.New("too many arguments")
 )
 
+// Names lists the names of the functions created by New.
+var Names = []string{
+	"abs", "base64", "bucket", "ceil", "cidr_match", "coalesce", "compare",
+	"error", "every", "fields", "flatten", "floor", "has", "has_error",
+	"hex", "is", "is_error", "join", "kind", "ksuid", "len", "levenshtein",
+	"log", "lower", "max", "min", "missing", "nameof", "nest_dotted",
+	"network_of", "now", "parse_uri", "parse_zson", "pow", "quiet",
+	"regexp", "replace", "round", "rune_len", "split", "sqrt", "trim",
+	"typename", "typeof", "typeunder", "under", "unflatten", "upper",
+}
+
 func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, error) {
 	argmin := 1
 	argmax := 1
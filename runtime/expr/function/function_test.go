@@ -0,0 +1,15 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNames(t *testing.T) {
+	for _, name := range Names {
+		_, _, err := New(zed.NewContext(), name, 1)
+		assert.NotErrorIs(t, err, ErrNoSuchFunction, name)
+	}
+}
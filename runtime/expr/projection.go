@@ -0,0 +1,83 @@
+package expr
+
+import (
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zcode"
+	"golang.org/x/exp/slices"
+)
+
+// Projection is an Evaluator that keeps only the named top-level fields of
+// record values.  Unlike cut, it keeps a record that has none of the fields
+// as an empty record and returns values that are not records unchanged so
+// that only data that could not be referenced downstream is removed.
+type Projection struct {
+	zctx    *zed.Context
+	fields  []string
+	builder zcode.Builder
+	types   map[zed.Type]*projection
+}
+
+type projection struct {
+	typ     *zed.TypeRecord
+	indexes []int
+}
+
+var _ Evaluator = (*Projection)(nil)
+
+func NewProjection(zctx *zed.Context, fields []string) *Projection {
+	return &Projection{
+		zctx:   zctx,
+		fields: fields,
+		types:  make(map[zed.Type]*projection),
+	}
+}
+
+// Fields returns the names of the fields kept by p.
+func (p *Projection) Fields() []string {
+	return p.fields
+}
+
+func (p *Projection) Eval(ectx Context, this *zed.Value) *zed.Value {
+	typ, ok := this.Type.(*zed.TypeRecord)
+	if !ok || this.IsNull() {
+		return this
+	}
+	proj, ok := p.types[typ]
+	if !ok {
+		proj = p.lookup(typ)
+		p.types[typ] = proj
+	}
+	if proj == nil {
+		return this
+	}
+	p.builder.Reset()
+	it := this.Bytes.Iter()
+	for k, next := 0, 0; !it.Done(); k++ {
+		bytes := it.Next()
+		if next < len(proj.indexes) && proj.indexes[next] == k {
+			p.builder.Append(bytes)
+			next++
+		}
+	}
+	return ectx.NewValue(proj.typ, p.builder.Bytes())
+}
+
+// lookup returns the projection of records of type typ or nil if
+// every field of typ is kept.
+func (p *Projection) lookup(typ *zed.TypeRecord) *projection {
+	var fields []zed.Field
+	var indexes []int
+	for k, f := range typ.Fields {
+		if slices.Contains(p.fields, f.Name) {
+			fields = append(fields, f)
+			indexes = append(indexes, k)
+		}
+	}
+	if len(fields) == len(typ.Fields) {
+		return nil
+	}
+	return &projection{
+		typ:     p.zctx.MustLookupTypeRecord(fields),
+		indexes: indexes,
+	}
+}
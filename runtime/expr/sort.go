@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/order"
@@ -115,10 +116,62 @@ func NewValueCompareFn(o order.Which, nullsMax bool) CompareFn {
 	return NewComparator(nullsMax, o == order.Desc, &This{}).Compare
 }
 
+// NewKeyCompareFn returns a function that compares values of the pool key of
+// layout in the order of layout.  If layout has more than one key, these
+// values are records of the key values, which compare lexicographically by
+// field, while a non-record value, as held by the objects of a pool written
+// before it had compound keys, compares with the first field of a record.
+// Otherwise, NewKeyCompareFn is the same as NewValueCompareFn.
+func NewKeyCompareFn(layout order.Layout, nullsMax bool) CompareFn {
+	if len(layout.Keys) < 2 {
+		return NewValueCompareFn(layout.Order, nullsMax)
+	}
+	c := NewComparator(nullsMax, layout.Order == order.Desc)
+	return func(a, b *zed.Value) int {
+		if c.reverse {
+			a, b = b, a
+		}
+		return c.compareKeys(a, b)
+	}
+}
+
+func (c *Comparator) compareKeys(a, b *zed.Value) int {
+	if a.IsNull() || b.IsNull() {
+		return compareValues(a, b, c.comparefns, &c.pair, c.nullsMax)
+	}
+	recA, recB := zed.TypeRecordOf(a.Type), zed.TypeRecordOf(b.Type)
+	switch {
+	case recA != nil && recB != nil:
+		if len(recA.Fields) != len(recB.Fields) {
+			break
+		}
+		itA, itB := a.Bytes.Iter(), b.Bytes.Iter()
+		for k := range recA.Fields {
+			valA := zed.NewValue(recA.Fields[k].Type, itA.Next())
+			valB := zed.NewValue(recB.Fields[k].Type, itB.Next())
+			if v := compareValues(valA, valB, c.comparefns, &c.pair, c.nullsMax); v != 0 {
+				return v
+			}
+		}
+		return 0
+	case recA != nil && len(recA.Fields) > 0:
+		return compareValues(firstField(recA, a.Bytes), b, c.comparefns, &c.pair, c.nullsMax)
+	case recB != nil && len(recB.Fields) > 0:
+		return compareValues(a, firstField(recB, b.Bytes), c.comparefns, &c.pair, c.nullsMax)
+	}
+	return compareValues(a, b, c.comparefns, &c.pair, c.nullsMax)
+}
+
+func firstField(typ *zed.TypeRecord, bytes zcode.Bytes) *zed.Value {
+	it := bytes.Iter()
+	return zed.NewValue(typ.Fields[0].Type, it.Next())
+}
+
 type Comparator struct {
-	exprs    []Evaluator
-	nullsMax bool
-	reverse  bool
+	exprs     []Evaluator
+	nullsMax  bool
+	reverse   bool
+	typeOrder bool
 
 	comparefns map[zed.Type]comparefn
 	ectx       Context
@@ -151,6 +204,14 @@ func (c *Comparator) WithMissingAsNull() *Comparator {
 	return c
 }
 
+// WithTypeOrder returns the receiver after modifying it to order values that
+// compare equal but differ in type by their types so that only values of the
+// same type compare equal.
+func (c *Comparator) WithTypeOrder() *Comparator {
+	c.typeOrder = true
+	return c
+}
+
 type missingAsNull struct{ Evaluator }
 
 func (m *missingAsNull) Eval(ectx Context, val *zed.Value) *zed.Value {
@@ -173,10 +234,35 @@ func (c *Comparator) Compare(a, b *zed.Value) int {
 		if v := compareValues(aval, bval, c.comparefns, &c.pair, c.nullsMax); v != 0 {
 			return v
 		}
+		if c.typeOrder {
+			if v := compareTypes(aval.Type, bval.Type); v != 0 {
+				return v
+			}
+		}
 	}
 	return 0
 }
 
+// compareTypes orders types by zed.CompareTypes, which ignores type names, and
+// then by type name.  Unlike type IDs, this ordering holds for types from
+// different contexts.
+func compareTypes(a, b zed.Type) int {
+	if a == b {
+		return 0
+	}
+	if v := zed.CompareTypes(a, b); v != 0 {
+		return v
+	}
+	return strings.Compare(typeName(a), typeName(b))
+}
+
+func typeName(typ zed.Type) string {
+	if named, ok := typ.(*zed.TypeNamed); ok {
+		return named.Name
+	}
+	return ""
+}
+
 func compareValues(a, b *zed.Value, comparefns map[zed.Type]comparefn, pair *coerce.Pair, nullsMax bool) int {
 	// Handle nulls according to nullsMax
 	nullA := a.IsNull()
@@ -33,6 +33,7 @@ type Proc struct {
 // ("every") group-by operations.  Records are generated in a
 // deterministic but undefined total order.
 type Aggregator struct {
+	op.Resources
 	ctx  context.Context
 	zctx *zed.Context
 	// The keyTypes and outTypes tables map a vector of types resulting
@@ -51,6 +52,7 @@ type Aggregator struct {
 	builder        *zed.RecordBuilder
 	recordTypes    map[int]*zed.TypeRecord
 	table          map[string]*Row
+	tableBytes     int // size of the keys of table
 	limit          int
 	valueCompare   expr.CompareFn   // to compare primary group keys for early key output
 	keyCompare     expr.CompareFn   // compare the first key (used when input sorted)
@@ -166,6 +168,14 @@ func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
 	return nil, p.pctx.Err()
 }
 
+func (p *Proc) BytesSpilled() int64 {
+	return p.agg.BytesSpilled()
+}
+
+func (p *Proc) PeakMemory() int64 {
+	return p.agg.PeakMemory()
+}
+
 func (p *Proc) run() {
 	defer func() {
 		if p.agg.spiller != nil {
@@ -282,6 +292,7 @@ func (p *Proc) reset() {
 		p.agg.spiller = nil
 	}
 	p.agg.table = make(map[string]*Row)
+	p.agg.tableBytes = 0
 	if p.batch != nil {
 		p.batch.Unref()
 		p.batch = nil
@@ -347,6 +358,8 @@ func (a *Aggregator) Consume(batch zbuf.Batch, this *zed.Value) error {
 			reducers: newValRow(a.aggs),
 		}
 		a.table[string(keyBytes)] = row
+		a.tableBytes += len(keyBytes)
+		a.Track(a.tableBytes)
 	}
 
 	if a.partialsIn {
@@ -370,7 +383,7 @@ func (a *Aggregator) spillTable(eof bool, ref zbuf.Batch) error {
 	}
 	recs := batch.Values()
 	// Note that this will sort recs according to g.keysComparator.
-	if err := a.spiller.Spill(a.ctx, recs); err != nil {
+	if err := a.Spill(a.ctx, a.spiller, recs); err != nil {
 		return err
 	}
 	if !eof && a.inputDir != 0 {
@@ -561,6 +574,7 @@ func (a *Aggregator) readTable(flush, partialsOut bool, batch zbuf.Batch) (zbuf.
 		// unnecessarily by holding back the table entries from GC
 		// until this loop finished.
 		delete(a.table, key)
+		a.tableBytes -= len(key)
 	}
 	if len(recs) == 0 {
 		return nil, nil
@@ -11,6 +11,7 @@ import (
 	"github.com/brimdata/zed/lake/commits"
 	"github.com/brimdata/zed/lake/data"
 	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zson"
@@ -32,6 +33,10 @@ type Lister struct {
 	mu        sync.Mutex
 	objects   []*data.Object
 	err       error
+	// total and pruned count the objects in the snapshot and those
+	// excluded from the scan by the filter.
+	total  int
+	pruned int
 }
 
 var _ zbuf.Puller = (*Lister)(nil)
@@ -69,14 +74,23 @@ func (l *Lister) Snapshot() commits.View {
 	return l.snap
 }
 
+// Pruned returns the number of objects in the snapshot and the number of
+// those excluded from the scan by the filter.
+func (l *Lister) Pruned() (int, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.total, l.pruned
+}
+
 func (l *Lister) Pull(done bool) (zbuf.Batch, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	if l.objects == nil {
-		l.objects, l.err = initObjectScan(l.snap, l.pool.Layout, l.filter)
+		l.objects, l.total, l.err = initObjectScan(l.snap, l.pool.Layout, l.filter)
 		if l.err != nil {
 			return nil, l.err
 		}
+		l.pruned = l.total - len(l.objects)
 	}
 	// End after the last object.  XXX we could change this so a scan can appear
 	// inside of a subgraph and be restarted after each time done is called
@@ -100,39 +114,84 @@ type Slice struct {
 	Object *data.Object
 }
 
-func initObjectScan(snap commits.View, layout order.Layout, filter zbuf.Filter) ([]*data.Object, error) {
+func initObjectScan(snap commits.View, layout order.Layout, filter zbuf.Filter) ([]*data.Object, int, error) {
 	objects := snap.Select(nil, layout.Order)
-	var f *expr.SpanFilter //XXX get rid of this
+	total := len(objects)
 	if filter != nil {
-		var err error
 		// Order is passed here just to handle nullsmax in the comparison.
 		// So we still need to swap first/last when descending order.
-		f, err = filter.AsKeySpanFilter(layout.Primary(), layout.Order)
-		if err != nil {
-			return nil, err
+		var filters [2]*expr.SpanFilter //XXX get rid of this
+		for k, keys := range []field.List{layout.Keys, {layout.Primary()}} {
+			f, err := filter.AsKeySpanFilter(keys, layout.Order)
+			if err != nil {
+				return nil, 0, err
+			}
+			filters[k] = f
 		}
-		if f != nil {
-			var filtered []*data.Object
-			for _, obj := range objects {
-				first := &obj.First
-				last := &obj.Last
-				if layout.Order == order.Desc {
-					first, last = last, first
-				}
-				if !f.Eval(first, last) {
-					filtered = append(filtered, obj)
-				}
+		var filtered []*data.Object
+		for _, obj := range objects {
+			f := filters[0]
+			if !hasCompoundKey(layout, obj) {
+				f = filters[1]
+			}
+			first := &obj.First
+			last := &obj.Last
+			if layout.Order == order.Desc {
+				first, last = last, first
+			}
+			if f == nil || !f.Eval(first, last) {
+				filtered = append(filtered, obj)
 			}
-			objects = filtered
+		}
+		var err error
+		objects, err = filterPartitions(filtered, filter)
+		if err != nil {
+			return nil, 0, err
 		}
 	}
 	//XXX at some point sorting should be optional.
-	sortObjects(objects, layout.Order)
-	return objects, nil
+	sortObjects(objects, layout)
+	return objects, total, nil
+}
+
+// hasCompoundKey returns true if the span of o is bounded by compound key
+// values, i.e., records of the values of the keys of layout.  This is false
+// for a layout with a single key and for the objects of a pool with compound
+// keys that were written before pool keys were compound, whose span is bounded
+// by values of the primary key.
+func hasCompoundKey(layout order.Layout, o *data.Object) bool {
+	return len(layout.Keys) > 1 && zed.TypeRecordOf(o.First.Type) != nil
+}
+
+// objectKeys returns the keys of layout that bound the span of o.
+func objectKeys(layout order.Layout, o *data.Object) field.List {
+	if len(layout.Keys) > 1 && !hasCompoundKey(layout, o) {
+		return field.List{layout.Primary()}
+	}
+	return layout.Keys
+}
+
+// filterPartitions returns the objects whose partition values may match filter.
+func filterPartitions(objects []*data.Object, filter zbuf.Filter) ([]*data.Object, error) {
+	f, err := filter.AsPartitionFilter()
+	if f == nil || err != nil {
+		return objects, err
+	}
+	var filtered []*data.Object
+	for _, obj := range objects {
+		partition := &obj.Partition
+		if partition.Type == nil {
+			partition = zed.Null
+		}
+		if !f.Eval(partition, partition) {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered, nil
 }
 
-func sortObjects(objects []*data.Object, o order.Which) {
-	cmp := expr.NewValueCompareFn(o, o == order.Asc) //XXX is nullsMax correct here?
+func sortObjects(objects []*data.Object, layout order.Layout) {
+	cmp := expr.NewKeyCompareFn(layout, layout.Order == order.Asc) //XXX is nullsMax correct here?
 	lessFunc := func(a, b *data.Object) bool {
 		if cmp(&a.First, &b.First) < 0 {
 			return true
@@ -25,6 +25,8 @@ func NewLakeMetaScanner(ctx context.Context, zctx *zed.Context, r *lake.Root, me
 		vals, err = r.BatchifyPools(ctx, zctx, f)
 	case "branches":
 		vals, err = r.BatchifyBranches(ctx, zctx, f)
+	case "tags":
+		vals, err = r.BatchifyTags(ctx, zctx, f)
 	case "index_rules":
 		vals, err = r.BatchifyIndexRules(ctx, zctx, f)
 	default:
@@ -54,6 +56,13 @@ func NewPoolMetaScanner(ctx context.Context, zctx *zed.Context, r *lake.Root, po
 		if err != nil {
 			return nil, err
 		}
+	case "tags":
+		m := zson.NewZNGMarshalerWithContext(zctx)
+		m.Decorate(zson.StylePackage)
+		vals, err = p.BatchifyTags(ctx, zctx, nil, m, f)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unknown pool metadata type: %q", meta)
 	}
@@ -83,7 +92,7 @@ func NewCommitMetaScanner(ctx context.Context, zctx *zed.Context, r *lake.Root,
 		if err != nil {
 			return nil, err
 		}
-		return NewSlicer(lister, zctx, p.Layout.Order), nil
+		return NewSlicer(lister, zctx, p.Layout), nil
 	case "log":
 		tips, err := p.BatchifyBranchTips(ctx, zctx, nil)
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync/atomic"
 
 	"github.com/brimdata/zed/lake"
 	"github.com/brimdata/zed/lake/commits"
@@ -31,10 +32,20 @@ type SequenceScanner struct {
 	progress    *zbuf.Progress
 	snap        commits.View
 	unmarshaler *zson.UnmarshalZNGContext
+	stats       ScanStats
 	done        bool
 	err         error
 }
 
+// ScanStats describes the objects read by a SequenceScanner.  Seeks counts
+// the objects read over a range narrowed by a seek index and BytesSkipped
+// counts the bytes those seeks avoided reading.
+type ScanStats struct {
+	Objects      int64 `zed:"objects" json:"objects"`
+	Seeks        int64 `zed:"seeks" json:"seeks"`
+	BytesSkipped int64 `zed:"bytes_skipped" json:"bytes_skipped"`
+}
+
 func NewSequenceScanner(pctx *op.Context, parent zbuf.Puller, pool *lake.Pool, snap commits.View, filter zbuf.Filter, progress *zbuf.Progress) *SequenceScanner {
 	return &SequenceScanner{
 		pctx:        pctx,
@@ -91,6 +102,15 @@ func (s *SequenceScanner) Pull(done bool) (zbuf.Batch, error) {
 	}
 }
 
+// ScanStats returns the statistics of the objects scanned so far.
+func (s *SequenceScanner) ScanStats() ScanStats {
+	return ScanStats{
+		Objects:      atomic.LoadInt64(&s.stats.Objects),
+		Seeks:        atomic.LoadInt64(&s.stats.Seeks),
+		BytesSkipped: atomic.LoadInt64(&s.stats.BytesSkipped),
+	}
+}
+
 func (s *SequenceScanner) close(err error) {
 	s.err = err
 	s.done = true
@@ -121,6 +141,11 @@ func newSortedPartitionScanner(p *SequenceScanner, part Partition) (zbuf.Puller,
 		if err != nil {
 			return nil, err
 		}
+		atomic.AddInt64(&p.stats.Objects, 1)
+		if size := rg.End - rg.Start; size < o.Size {
+			atomic.AddInt64(&p.stats.Seeks, 1)
+			atomic.AddInt64(&p.stats.BytesSkipped, o.Size-size)
+		}
 		rc, err := o.NewReader(p.pctx.Context, p.pool.Storage(), p.pool.DataPath, *rg)
 		if err != nil {
 			pullersDone()
@@ -189,12 +214,12 @@ func seekRange(ctx context.Context, pool *lake.Pool, snap commits.View, filter z
 			}
 		}
 		var err error
-		cropped, err = filter.AsKeyCroppedByFilter(pool.Layout.Primary(), pool.Layout.Order)
+		cropped, err = filter.AsKeyCroppedByFilter(objectKeys(pool.Layout, o), pool.Layout.Order)
 		if err != nil {
 			return nil, err
 		}
 	}
-	cmp := expr.NewValueCompareFn(pool.Layout.Order, pool.Layout.Order == order.Asc)
+	cmp := expr.NewKeyCompareFn(pool.Layout, pool.Layout.Order == order.Asc)
 	first := &o.First
 	last := &o.Last
 	if pool.Layout.Order == order.Desc {
@@ -203,7 +228,7 @@ func seekRange(ctx context.Context, pool *lake.Pool, snap commits.View, filter z
 	if indexSpan != nil || cropped != nil && cropped.Eval(first, last) {
 		// There's an index available or the object's span is cropped by
 		// p.filter, so use the seek index to find the range to scan.
-		spanFilter, err := filter.AsKeySpanFilter(pool.Layout.Primary(), pool.Layout.Order)
+		spanFilter, err := filter.AsKeySpanFilter(objectKeys(pool.Layout, o), pool.Layout.Order)
 		if err != nil {
 			return nil, err
 		}
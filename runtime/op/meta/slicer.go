@@ -27,7 +27,7 @@ type Slicer struct {
 	mu          sync.Mutex
 }
 
-func NewSlicer(parent zbuf.Puller, zctx *zed.Context, o order.Which) *Slicer {
+func NewSlicer(parent zbuf.Puller, zctx *zed.Context, layout order.Layout) *Slicer {
 	m := zson.NewZNGMarshalerWithContext(zctx)
 	m.Decorate(zson.StylePackage)
 	return &Slicer{
@@ -35,7 +35,7 @@ func NewSlicer(parent zbuf.Puller, zctx *zed.Context, o order.Which) *Slicer {
 		marshaler:   m,
 		unmarshaler: zson.NewZNGUnmarshaler(),
 		//XXX check nullsmax is consistent for both dirs in lake ops
-		cmp: expr.NewValueCompareFn(o, o == order.Asc),
+		cmp: expr.NewKeyCompareFn(layout, layout.Order == order.Asc),
 	}
 }
 
@@ -47,6 +47,21 @@ func DefaultContext() *Context {
 	return NewContext(context.Background(), zed.NewContext(), nil)
 }
 
+type explainKey struct{}
+
+// WithExplain returns a copy of ctx requesting that a flowgraph run with it
+// collect the runtime statistics of its operators for Query.Explain.  Since
+// these statistics have a cost, they are collected only when requested.
+func WithExplain(ctx context.Context) context.Context {
+	return context.WithValue(ctx, explainKey{}, true)
+}
+
+// ExplainRequested returns true if ctx was returned by WithExplain.
+func ExplainRequested(ctx context.Context) bool {
+	ok, _ := ctx.Value(explainKey{}).(bool)
+	return ok
+}
+
 // Cancel cancels the context.  Cancel must be called to ensure that operators
 // complete cleanup work (e.g., removing temporary files).
 func (c *Context) Cancel() {
@@ -0,0 +1,207 @@
+package pivot
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/expr/agg"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zson"
+)
+
+// Proc groups its input by a set of keys and, within each group, applies
+// an aggregation separately for each distinct value of a column expression.
+// At end of input, it emits one record per group comprising the group keys
+// followed by one field per column value, where the field name is the
+// column value (or its ZSON representation when the value is not a string).
+// Every output record has the same set of fields in the order in which
+// column values were first encountered, and a column that has no values
+// in a group is null.  Groups are emitted in the order in which they
+// were first encountered.
+type Proc struct {
+	pctx     *op.Context
+	parent   zbuf.Puller
+	keys     []expr.Assignment
+	column   expr.Evaluator
+	agg      *expr.Aggregator
+	keyTypes *zed.TypeVectorTable
+	keyCache zcode.Bytes
+	types    []zed.Type
+	table    map[string]*row
+	rows     []*row
+	columns  map[string]int
+	names    []string
+	eos      bool
+}
+
+type row struct {
+	keys []zed.Value
+	aggs map[string]agg.Function
+}
+
+func New(pctx *op.Context, parent zbuf.Puller, keys []expr.Assignment, column expr.Evaluator, agg *expr.Aggregator) *Proc {
+	return &Proc{
+		pctx:     pctx,
+		parent:   parent,
+		keys:     keys,
+		column:   column,
+		agg:      agg,
+		keyTypes: zed.NewTypeVectorTable(),
+		table:    make(map[string]*row),
+		columns:  make(map[string]int),
+	}
+}
+
+func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
+	if p.eos {
+		p.eos = false
+		return nil, nil
+	}
+	if done {
+		p.reset()
+		return p.parent.Pull(true)
+	}
+	for {
+		batch, err := p.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			batch, err := p.results()
+			p.reset()
+			if batch != nil {
+				p.eos = true
+			}
+			return batch, err
+		}
+		vals := batch.Values()
+		for i := range vals {
+			p.consume(batch, &vals[i])
+		}
+		batch.Unref()
+	}
+}
+
+func (p *Proc) consume(ectx expr.Context, this *zed.Value) {
+	col := p.column.Eval(ectx, this)
+	if col.IsMissing() || col.IsQuiet() {
+		return
+	}
+	name := columnName(col)
+	types := p.types[:0]
+	keyBytes := p.keyCache[:0]
+	for _, key := range p.keys {
+		val := key.RHS.Eval(ectx, this)
+		if val.IsQuiet() {
+			return
+		}
+		types = append(types, val.Type)
+		keyBytes = zcode.Append(keyBytes, val.Bytes)
+	}
+	keyBytes = binary.AppendUvarint(keyBytes, uint64(p.keyTypes.Lookup(types)))
+	p.types = types
+	p.keyCache = keyBytes
+	r, ok := p.table[string(keyBytes)]
+	if !ok {
+		r = &row{aggs: make(map[string]agg.Function)}
+		for _, key := range p.keys {
+			r.keys = append(r.keys, *key.RHS.Eval(ectx, this).Copy())
+		}
+		p.table[string(keyBytes)] = r
+		p.rows = append(p.rows, r)
+	}
+	fn, ok := r.aggs[name]
+	if !ok {
+		if _, ok := p.columns[name]; !ok {
+			p.columns[name] = len(p.names)
+			p.names = append(p.names, name)
+		}
+		fn = p.agg.NewFunction()
+		r.aggs[name] = fn
+	}
+	p.agg.Apply(p.pctx.Zctx, ectx, fn, this)
+}
+
+func columnName(val *zed.Value) string {
+	if val.IsString() {
+		return val.AsString()
+	}
+	return zson.MustFormatValue(val)
+}
+
+func (p *Proc) results() (zbuf.Batch, error) {
+	if len(p.rows) == 0 {
+		return nil, nil
+	}
+	names := make(field.List, 0, len(p.keys)+len(p.names))
+	for _, key := range p.keys {
+		names = append(names, key.LHS)
+	}
+	for _, name := range p.names {
+		names = append(names, field.Path{name})
+	}
+	builder, err := zed.NewRecordBuilder(p.pctx.Zctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("pivot: %w", err)
+	}
+	// Compute the results first so we know the type of each column
+	// and can fill in nulls of that type where a group lacks a column.
+	results := make([][]*zed.Value, 0, len(p.rows))
+	colTypes := make([]zed.Type, len(p.names))
+	for _, r := range p.rows {
+		vals := make([]*zed.Value, len(p.names))
+		for name, fn := range r.aggs {
+			k := p.columns[name]
+			vals[k] = fn.Result(p.pctx.Zctx).Copy()
+			if colTypes[k] == nil || colTypes[k] == zed.TypeNull {
+				colTypes[k] = vals[k].Type
+			}
+		}
+		results = append(results, vals)
+	}
+	outTypes := zed.NewTypeVectorTable()
+	recordTypes := make(map[int]*zed.TypeRecord)
+	out := make([]zed.Value, 0, len(p.rows))
+	types := make([]zed.Type, 0, len(names))
+	for i, r := range p.rows {
+		builder.Reset()
+		types = types[:0]
+		for _, key := range r.keys {
+			builder.Append(key.Bytes)
+			types = append(types, key.Type)
+		}
+		for k, val := range results[i] {
+			if val == nil {
+				builder.Append(nil)
+				types = append(types, colTypes[k])
+				continue
+			}
+			builder.Append(val.Bytes)
+			types = append(types, val.Type)
+		}
+		id := outTypes.Lookup(types)
+		typ, ok := recordTypes[id]
+		if !ok {
+			typ = builder.Type(types)
+			recordTypes[id] = typ
+		}
+		bytes, err := builder.Encode()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *zed.NewValue(typ, bytes))
+	}
+	return zbuf.NewArray(out), nil
+}
+
+func (p *Proc) reset() {
+	p.table = make(map[string]*row)
+	p.rows = nil
+	p.columns = make(map[string]int)
+	p.names = nil
+}
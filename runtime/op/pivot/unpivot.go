@@ -0,0 +1,88 @@
+package pivot
+
+import (
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zbuf"
+)
+
+// Unpivot is the inverse of Proc.  For each input record and each of
+// a list of fields present in that record, it emits a record comprising
+// the record's other fields followed by a string field holding the field's
+// name and a field holding its value.  Listed fields that are missing from
+// an input record are skipped and non-record values are passed through
+// as errors.
+type Unpivot struct {
+	zctx    *zed.Context
+	parent  zbuf.Puller
+	names   []*zed.Value
+	fields  []expr.Evaluator
+	dropper *expr.Dropper
+	out     []zed.Field
+	empty   *zed.Value
+}
+
+func NewUnpivot(zctx *zed.Context, parent zbuf.Puller, fields field.List, name, value string) *Unpivot {
+	var names []*zed.Value
+	var evals []expr.Evaluator
+	for _, f := range fields {
+		names = append(names, zed.NewString(f.String()))
+		evals = append(evals, expr.NewDottedExpr(zctx, f))
+	}
+	return &Unpivot{
+		zctx:    zctx,
+		parent:  parent,
+		names:   names,
+		fields:  evals,
+		dropper: expr.NewDropper(zctx, fields),
+		out: []zed.Field{
+			zed.NewField(name, zed.TypeString),
+			zed.NewField(value, nil),
+		},
+		empty: zed.NewValue(zctx.MustLookupTypeRecord(nil), nil),
+	}
+}
+
+func (u *Unpivot) Pull(done bool) (zbuf.Batch, error) {
+	for {
+		batch, err := u.parent.Pull(done)
+		if batch == nil || err != nil {
+			return nil, err
+		}
+		vals := batch.Values()
+		out := make([]zed.Value, 0, len(vals)*len(u.fields))
+		for i := range vals {
+			out = u.unpivot(batch, &vals[i], out)
+		}
+		if len(out) > 0 {
+			defer batch.Unref()
+			return zbuf.NewBatch(batch, out), nil
+		}
+		batch.Unref()
+	}
+}
+
+func (u *Unpivot) unpivot(ectx expr.Context, this *zed.Value, out []zed.Value) []zed.Value {
+	if !zed.IsRecordType(this.Type) {
+		return append(out, *u.zctx.WrapError("unpivot: not a record", this))
+	}
+	rest := u.dropper.Eval(ectx, this)
+	if rest.IsQuiet() {
+		rest = u.empty
+	}
+	for k, f := range u.fields {
+		val := f.Eval(ectx, this)
+		if val.IsMissing() {
+			continue
+		}
+		u.out[1].Type = val.Type
+		rec, err := u.zctx.AddFields(rest, u.out, []zed.Value{*u.names[k], *val})
+		if err != nil {
+			out = append(out, *u.zctx.WrapError("unpivot: "+err.Error(), this))
+			continue
+		}
+		out = append(out, *rec)
+	}
+	return out
+}
@@ -17,6 +17,7 @@ import (
 var MemMaxBytes = 128 * 1024 * 1024
 
 type Proc struct {
+	op.Resources
 	pctx       *op.Context
 	parent     zbuf.Puller
 	order      order.Which
@@ -97,7 +98,7 @@ func (p *Proc) run() {
 				continue
 			}
 			if len(out) > 0 {
-				if err := spiller.Spill(p.pctx.Context, out); err != nil {
+				if err := p.Spill(p.pctx.Context, spiller, out); err != nil {
 					if ok := p.sendResult(nil, err); !ok {
 						return
 					}
@@ -124,6 +125,7 @@ func (p *Proc) run() {
 			p.setComparator(&out[0])
 		}
 		nbytes += delta
+		p.Track(nbytes)
 		if nbytes < MemMaxBytes {
 			continue
 		}
@@ -138,7 +140,7 @@ func (p *Proc) run() {
 				continue
 			}
 		}
-		if err := spiller.Spill(p.pctx.Context, out); err != nil {
+		if err := p.Spill(p.pctx.Context, spiller, out); err != nil {
 			if ok := p.sendResult(nil, err); !ok {
 				return
 			}
@@ -0,0 +1,114 @@
+package op
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zbuf"
+)
+
+// Stats holds the runtime statistics of an operator.  Wall is the time
+// spent in calls to the operator's Pull method, which includes time spent
+// waiting on upstream operators.  Its fields are updated atomically so
+// that a copy may be taken while a flowgraph runs.
+type Stats struct {
+	ValuesIn     int64         `zed:"values_in" json:"values_in"`
+	ValuesOut    int64         `zed:"values_out" json:"values_out"`
+	Wall         time.Duration `zed:"wall" json:"wall"`
+	BytesSpilled int64         `zed:"bytes_spilled" json:"bytes_spilled"`
+	PeakMemory   int64         `zed:"peak_memory" json:"peak_memory"`
+}
+
+func (s *Stats) Copy() Stats {
+	return Stats{
+		ValuesIn:     atomic.LoadInt64(&s.ValuesIn),
+		ValuesOut:    atomic.LoadInt64(&s.ValuesOut),
+		Wall:         time.Duration(atomic.LoadInt64((*int64)(&s.Wall))),
+		BytesSpilled: atomic.LoadInt64(&s.BytesSpilled),
+		PeakMemory:   atomic.LoadInt64(&s.PeakMemory),
+	}
+}
+
+// A Resourcer is an operator that reports the memory it buffered and
+// the bytes it spilled to disk.
+type Resourcer interface {
+	BytesSpilled() int64
+	PeakMemory() int64
+}
+
+// Resources implements Resourcer for operators that embed it.  Its methods
+// may be called concurrently.
+type Resources struct {
+	spilled int64
+	peak    int64
+}
+
+var _ Resourcer = (*Resources)(nil)
+
+// Track records that the operator currently holds nbytes in memory.
+func (r *Resources) Track(nbytes int) {
+	for {
+		peak := atomic.LoadInt64(&r.peak)
+		if int64(nbytes) <= peak || atomic.CompareAndSwapInt64(&r.peak, peak, int64(nbytes)) {
+			return
+		}
+	}
+}
+
+// A Spiller writes values to temporary files, as spill.MergeSort does.
+type Spiller interface {
+	Spill(context.Context, []zed.Value) error
+	SpillSize() int64
+}
+
+// Spill spills vals to spiller and records the bytes written.
+func (r *Resources) Spill(ctx context.Context, spiller Spiller, vals []zed.Value) error {
+	size := spiller.SpillSize()
+	err := spiller.Spill(ctx, vals)
+	atomic.AddInt64(&r.spilled, spiller.SpillSize()-size)
+	return err
+}
+
+func (r *Resources) BytesSpilled() int64 {
+	return atomic.LoadInt64(&r.spilled)
+}
+
+func (r *Resources) PeakMemory() int64 {
+	return atomic.LoadInt64(&r.peak)
+}
+
+// Measure returns a puller that counts the values pulled through parent
+// as the ValuesOut of stats and accumulates the time spent in parent's
+// Pull method as the Wall of stats.
+func Measure(parent zbuf.Puller, stats *Stats) zbuf.Puller {
+	return &measure{parent: parent, count: &stats.ValuesOut, wall: &stats.Wall}
+}
+
+// MeasureIn returns a puller that counts the values pulled through parent
+// as the ValuesIn of stats.
+func MeasureIn(parent zbuf.Puller, stats *Stats) zbuf.Puller {
+	return &measure{parent: parent, count: &stats.ValuesIn}
+}
+
+type measure struct {
+	parent zbuf.Puller
+	count  *int64
+	wall   *time.Duration
+}
+
+func (m *measure) Pull(done bool) (zbuf.Batch, error) {
+	var start time.Time
+	if m.wall != nil {
+		start = time.Now()
+	}
+	batch, err := m.parent.Pull(done)
+	if m.wall != nil {
+		atomic.AddInt64((*int64)(m.wall), int64(time.Since(start)))
+	}
+	if batch != nil {
+		atomic.AddInt64(m.count, int64(len(batch.Values())))
+	}
+	return batch, err
+}
@@ -0,0 +1,432 @@
+package top
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/runtime/op/spill"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zcode"
+)
+
+// DefaultGroupedLimit is the number of values a Grouped operator retains
+// in memory before it spills its per-group state to disk.
+var DefaultGroupedLimit = 1000000
+
+// Grouped implements the per-group forms of head, tail, top, and sample.  It
+// partitions its input by a list of key expressions and retains a bounded
+// heap of values for each partition.  At end of input, it emits the values
+// retained for each group, one group after another.  Groups are emitted in
+// the order in which they were first encountered unless the operator
+// spilled to disk, in which case they are emitted in key order.
+//
+// Each retained value is wrapped in a record of the form
+// {k0:<key>,...,rank:<int64>,val:<value>} so that its group and its rank
+// survive a round trip through a spill file.  The rank is the value's input
+// position except for sample, where it is random.
+type Grouped struct {
+	op.Resources
+	pctx    *op.Context
+	parent  zbuf.Puller
+	keys    []expr.Evaluator
+	limit   int
+	compare expr.CompareFn
+	reverse bool
+	// nextRank, if non-nil, assigns ranks in place of input positions.
+	nextRank func() int64
+	// If partialsIn is true, the input comprises wrapped values
+	// emitted by other Grouped operators for which partialsOut is true.
+	partialsIn  bool
+	partialsOut bool
+
+	once          sync.Once
+	resultCh      chan op.Result
+	keyTypes      *zed.TypeVectorTable
+	keyCache      zcode.Bytes
+	typeCache     []zed.Type
+	wrapTypes     *zed.TypeVectorTable
+	recordTypes   map[int]*zed.TypeRecord
+	builder       zcode.Builder
+	table         map[string]*bounded
+	groups        []*bounded
+	nvals         int
+	nbytes        int
+	rank          int64
+	keyComparator *expr.Comparator
+}
+
+// NewGroupedHead returns a Grouped operator that emits the first limit
+// values of each group in input order.
+func NewGroupedHead(pctx *op.Context, parent zbuf.Puller, keys []expr.Evaluator, limit int) *Grouped {
+	g := newGrouped(pctx, parent, keys, limit)
+	g.compare = func(a, b *zed.Value) int {
+		return compareInts(g.rankOf(b), g.rankOf(a))
+	}
+	return g
+}
+
+// NewGroupedTail returns a Grouped operator that emits the last limit
+// values of each group in input order.
+func NewGroupedTail(pctx *op.Context, parent zbuf.Puller, keys []expr.Evaluator, limit int) *Grouped {
+	g := newGrouped(pctx, parent, keys, limit)
+	g.compare = func(a, b *zed.Value) int {
+		return compareInts(g.rankOf(a), g.rankOf(b))
+	}
+	g.reverse = true
+	return g
+}
+
+// NewGroupedTop returns a Grouped operator that emits the limit largest
+// values of each group according to fields in descending order.  Ties are
+// broken in favor of values that appear earlier in the input.
+func NewGroupedTop(pctx *op.Context, parent zbuf.Puller, keys []expr.Evaluator, limit int, fields []expr.Evaluator) *Grouped {
+	if limit == 0 {
+		limit = defaultTopLimit
+	}
+	g := newGrouped(pctx, parent, keys, limit)
+	compare := expr.NewCompareFn(false, fields...)
+	g.compare = func(a, b *zed.Value) int {
+		if c := compare(g.valOf(a), g.valOf(b)); c != 0 {
+			return c
+		}
+		return compareInts(g.rankOf(b), g.rankOf(a))
+	}
+	return g
+}
+
+// NewGroupedSample returns a Grouped operator that emits a uniform random
+// sample of up to limit values from each group.  Each value is assigned a
+// random rank drawn from a source seeded with seed, and the values with
+// the smallest ranks are retained.  Since ranks are carried with the
+// values, samples computed over disjoint parts of the input by operators
+// with partialsOut may be combined by a single operator with partialsIn.
+func NewGroupedSample(pctx *op.Context, parent zbuf.Puller, keys []expr.Evaluator, limit int, seed int64, partialsIn, partialsOut bool) *Grouped {
+	g := newGrouped(pctx, parent, keys, limit)
+	g.nextRank = rand.New(rand.NewSource(seed)).Int63
+	g.compare = func(a, b *zed.Value) int {
+		return compareInts(g.rankOf(b), g.rankOf(a))
+	}
+	g.partialsIn = partialsIn
+	g.partialsOut = partialsOut
+	return g
+}
+
+func newGrouped(pctx *op.Context, parent zbuf.Puller, keys []expr.Evaluator, limit int) *Grouped {
+	keyRefs := make([]expr.Evaluator, 0, len(keys))
+	for k := range keys {
+		keyRefs = append(keyRefs, expr.NewDottedExpr(pctx.Zctx, keyField(k)))
+	}
+	return &Grouped{
+		pctx:          pctx,
+		parent:        parent,
+		keys:          keys,
+		limit:         limit,
+		resultCh:      make(chan op.Result),
+		keyTypes:      zed.NewTypeVectorTable(),
+		typeCache:     make([]zed.Type, 0, len(keys)+2),
+		wrapTypes:     zed.NewTypeVectorTable(),
+		recordTypes:   make(map[int]*zed.TypeRecord),
+		table:         make(map[string]*bounded),
+		keyComparator: expr.NewComparator(true, false, keyRefs...).WithMissingAsNull().WithTypeOrder(),
+	}
+}
+
+func keyField(k int) []string {
+	return []string{fmt.Sprintf("k%d", k)}
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func (g *Grouped) rankOf(wrapped *zed.Value) int64 {
+	return wrapped.DerefByColumn(len(g.keys)).AsInt()
+}
+
+func (g *Grouped) valOf(wrapped *zed.Value) *zed.Value {
+	return wrapped.DerefByColumn(len(g.keys) + 1)
+}
+
+func (g *Grouped) Pull(done bool) (zbuf.Batch, error) {
+	g.once.Do(func() {
+		// Block g.pctx's cancel function until g.run finishes its
+		// cleanup.
+		g.pctx.WaitGroup.Add(1)
+		go g.run()
+	})
+	for {
+		r, ok := <-g.resultCh
+		if !ok {
+			return nil, g.pctx.Err()
+		}
+		if !done || r.Batch == nil || r.Err != nil {
+			return r.Batch, r.Err
+		}
+		r.Batch.Unref()
+	}
+}
+
+func (g *Grouped) run() {
+	defer close(g.resultCh)
+	var spiller *spill.MergeSort
+	defer func() {
+		if spiller != nil {
+			spiller.Cleanup()
+		}
+		// Tell g.pctx's cancel function that we've finished our cleanup.
+		g.pctx.WaitGroup.Done()
+	}()
+	for {
+		batch, err := g.parent.Pull(false)
+		if err != nil {
+			if ok := g.sendResult(nil, err); !ok {
+				return
+			}
+			continue
+		}
+		if batch == nil {
+			if spiller == nil {
+				if ok := g.sendTable(); !ok {
+					return
+				}
+			} else {
+				var err error
+				if len(g.groups) != 0 {
+					err = g.Spill(g.pctx.Context, spiller, g.flushTable())
+				}
+				if err == nil {
+					err = g.sendSpills(spiller)
+				}
+				if err != nil {
+					if ok := g.sendResult(nil, err); !ok {
+						return
+					}
+				}
+				spiller.Cleanup()
+				spiller = nil
+			}
+			if ok := g.sendResult(nil, nil); !ok {
+				return
+			}
+			g.rank = 0
+			continue
+		}
+		vals := batch.Values()
+		for i := range vals {
+			g.consume(batch, &vals[i])
+		}
+		batch.Unref()
+		if g.nvals < DefaultGroupedLimit {
+			continue
+		}
+		if spiller == nil {
+			spiller, err = spill.NewMergeSort(g.keyComparator)
+			if err != nil {
+				g.flushTable()
+				if ok := g.sendResult(nil, err); !ok {
+					return
+				}
+				continue
+			}
+		}
+		if err := g.Spill(g.pctx.Context, spiller, g.flushTable()); err != nil {
+			if ok := g.sendResult(nil, err); !ok {
+				return
+			}
+		}
+	}
+}
+
+func (g *Grouped) consume(ectx expr.Context, this *zed.Value) {
+	if g.partialsIn {
+		g.consumeWrapped(this)
+		return
+	}
+	types := g.typeCache[:0]
+	keyBytes := g.keyCache[:0]
+	g.builder.Reset()
+	for _, e := range g.keys {
+		key := e.Eval(ectx, this)
+		if key.IsQuiet() {
+			return
+		}
+		types = append(types, key.Type)
+		keyBytes = zcode.Append(keyBytes, key.Bytes)
+		g.builder.Append(key.Bytes)
+	}
+	keyBytes = binary.AppendUvarint(keyBytes, uint64(g.keyTypes.Lookup(types)))
+	g.keyCache = keyBytes
+	g.builder.Append(zed.EncodeInt(g.newRank()))
+	g.builder.Append(this.Bytes)
+	types = append(types, zed.TypeInt64, this.Type)
+	g.typeCache = types
+	g.insert(keyBytes, zed.NewValue(g.wrapType(types), g.builder.Bytes()))
+}
+
+// consumeWrapped inserts a value wrapped by an upstream Grouped operator,
+// preserving its rank.
+func (g *Grouped) consumeWrapped(wrapped *zed.Value) {
+	typ := zed.TypeRecordOf(wrapped.Type)
+	if typ == nil || len(typ.Fields) != len(g.keys)+2 {
+		return
+	}
+	types := g.typeCache[:0]
+	keyBytes := g.keyCache[:0]
+	for k := range g.keys {
+		key := wrapped.DerefByColumn(k)
+		types = append(types, key.Type)
+		keyBytes = zcode.Append(keyBytes, key.Bytes)
+	}
+	keyBytes = binary.AppendUvarint(keyBytes, uint64(g.keyTypes.Lookup(types)))
+	g.keyCache = keyBytes
+	g.typeCache = types
+	g.insert(keyBytes, wrapped)
+}
+
+func (g *Grouped) newRank() int64 {
+	if g.nextRank != nil {
+		return g.nextRank()
+	}
+	rank := g.rank
+	g.rank++
+	return rank
+}
+
+func (g *Grouped) insert(keyBytes zcode.Bytes, wrapped *zed.Value) {
+	b, ok := g.table[string(keyBytes)]
+	if !ok {
+		b = newBounded(g.limit, g.compare)
+		g.table[string(keyBytes)] = b
+		g.groups = append(g.groups, b)
+	}
+	if !b.accepts(wrapped) {
+		return
+	}
+	if b.len() == b.limit {
+		// The push will evict the smallest value, which is at the
+		// root of the heap.
+		g.nbytes -= len(b.vals.Index(0).Bytes)
+	}
+	g.nvals += b.push(wrapped.Copy())
+	g.nbytes += len(wrapped.Bytes)
+	g.Track(g.nbytes)
+}
+
+func (g *Grouped) wrapType(types []zed.Type) *zed.TypeRecord {
+	id := g.wrapTypes.Lookup(types)
+	typ, ok := g.recordTypes[id]
+	if !ok {
+		fields := make([]zed.Field, 0, len(types))
+		for k := range g.keys {
+			fields = append(fields, zed.NewField(keyField(k)[0], types[k]))
+		}
+		fields = append(fields, zed.NewField("rank", zed.TypeInt64))
+		fields = append(fields, zed.NewField("val", types[len(types)-1]))
+		typ = g.pctx.Zctx.MustLookupTypeRecord(fields)
+		g.recordTypes[id] = typ
+	}
+	return typ
+}
+
+// flushTable empties the in-memory table and returns all of the wrapped
+// values it retained.
+func (g *Grouped) flushTable() []zed.Value {
+	out := make([]zed.Value, 0, g.nvals)
+	for _, b := range g.groups {
+		for i := 0; i < b.len(); i++ {
+			out = append(out, *b.vals.Index(i))
+		}
+	}
+	g.table = make(map[string]*bounded)
+	g.groups = nil
+	g.nvals = 0
+	g.nbytes = 0
+	return out
+}
+
+func (g *Grouped) sendTable() bool {
+	if len(g.groups) == 0 {
+		return true
+	}
+	out := make([]zed.Value, 0, g.nvals)
+	for _, b := range g.groups {
+		out = g.appendGroup(out, b)
+	}
+	g.flushTable()
+	return g.sendResult(zbuf.NewArray(out), nil)
+}
+
+// appendGroup appends the unwrapped values retained by b to out in
+// output order.  If g.partialsOut is true, the values are left wrapped.
+func (g *Grouped) appendGroup(out []zed.Value, b *bounded) []zed.Value {
+	vals := b.sorted()
+	if g.partialsOut {
+		return append(out, vals...)
+	}
+	if g.reverse {
+		for i := len(vals) - 1; i >= 0; i-- {
+			out = append(out, *g.valOf(&vals[i]))
+		}
+		return out
+	}
+	for i := range vals {
+		out = append(out, *g.valOf(&vals[i]))
+	}
+	return out
+}
+
+// sendSpills merges the spilled runs, which are sorted by group key, and
+// re-applies the per-group bound to the values of each group.
+func (g *Grouped) sendSpills(spiller *spill.MergeSort) error {
+	var first *zed.Value
+	var b *bounded
+	out := make([]zed.Value, 0, op.BatchLen)
+	for {
+		if err := g.pctx.Err(); err != nil {
+			return err
+		}
+		val, err := spiller.Read()
+		if err != nil {
+			return err
+		}
+		if val == nil || first == nil || g.keyComparator.Compare(first, val) != 0 {
+			if b != nil {
+				out = g.appendGroup(out, b)
+			}
+			if len(out) >= op.BatchLen || (val == nil && len(out) > 0) {
+				if ok := g.sendResult(zbuf.NewArray(out), nil); !ok {
+					return g.pctx.Err()
+				}
+				out = make([]zed.Value, 0, op.BatchLen)
+			}
+			if val == nil {
+				return nil
+			}
+			first = val.Copy()
+			b = newBounded(g.limit, g.compare)
+		}
+		if b.accepts(val) {
+			b.push(val.Copy())
+		}
+	}
+}
+
+func (g *Grouped) sendResult(b zbuf.Batch, err error) bool {
+	select {
+	case g.resultCh <- op.Result{Batch: b, Err: err}:
+		return true
+	case <-g.pctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,98 @@
+package top_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/zed/runtime/op/top"
+	"github.com/brimdata/zed/ztest"
+)
+
+const groupedIn = `
+{src:"a",dst:1,bytes:10}
+{src:"b",dst:2,bytes:5}
+{src:"a",dst:3,bytes:30}
+{src:"c",dst:4,bytes:1}
+{src:"a",dst:5,bytes:20}
+{src:"b",dst:6,bytes:50}
+{src:"a",dst:7,bytes:30}
+`
+
+const groupedMixedIn = `
+{k:1,v:1}
+{k:1.,v:2}
+{k:"1",v:3}
+{k:1,v:4}
+{k:1.,v:5}
+{k:"1",v:6}
+{k:1,v:7}
+`
+
+var groupedCases = []struct {
+	name   string
+	zed    string
+	input  string
+	output string
+}{
+	{"head", "head 2 by src", groupedIn, `
+{src:"a",dst:1,bytes:10}
+{src:"a",dst:3,bytes:30}
+{src:"b",dst:2,bytes:5}
+{src:"b",dst:6,bytes:50}
+{src:"c",dst:4,bytes:1}
+`},
+	{"tail", "tail 2 by src", groupedIn, `
+{src:"a",dst:5,bytes:20}
+{src:"a",dst:7,bytes:30}
+{src:"b",dst:2,bytes:5}
+{src:"b",dst:6,bytes:50}
+{src:"c",dst:4,bytes:1}
+`},
+	{"top", "top 2 bytes by src", groupedIn, `
+{src:"a",dst:3,bytes:30}
+{src:"a",dst:7,bytes:30}
+{src:"b",dst:6,bytes:50}
+{src:"b",dst:2,bytes:5}
+{src:"c",dst:4,bytes:1}
+`},
+	{"sample", "sample 2 -seed 3 by src", groupedIn, `
+{src:"a",dst:7,bytes:30}
+{src:"a",dst:1,bytes:10}
+{src:"b",dst:6,bytes:50}
+{src:"b",dst:2,bytes:5}
+{src:"c",dst:4,bytes:1}
+`},
+	// Keys that are equal in value but differ in type form distinct
+	// groups whether or not the operator spills.
+	{"mixed", "head 2 by k", groupedMixedIn, `
+{k:1,v:1}
+{k:1,v:4}
+{k:1.,v:2}
+{k:1.,v:5}
+{k:"1",v:3}
+{k:"1",v:6}
+`},
+}
+
+func TestGrouped(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		runGroupedCases(t)
+	})
+	t.Run("spill", func(t *testing.T) {
+		saved := top.DefaultGroupedLimit
+		top.DefaultGroupedLimit = 1
+		defer func() {
+			top.DefaultGroupedLimit = saved
+		}()
+		runGroupedCases(t)
+	})
+}
+
+func runGroupedCases(t *testing.T) {
+	t.Helper()
+	for _, c := range groupedCases {
+		zt := ztest.ZTest{Zed: c.zed, Input: c.input[1:], Output: c.output[1:]}
+		t.Run(c.name, func(t *testing.T) {
+			zt.Run(t, "", "")
+		})
+	}
+}
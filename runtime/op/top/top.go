@@ -21,8 +21,7 @@ type Proc struct {
 	zctx       *zed.Context
 	limit      int
 	fields     []expr.Evaluator
-	records    *expr.RecordSlice
-	compare    expr.CompareFn
+	records    *bounded
 	flushEvery bool
 }
 
@@ -65,15 +64,10 @@ func (p *Proc) consume(rec *zed.Value) {
 		p.fields = []expr.Evaluator{accessor}
 	}
 	if p.records == nil {
-		p.compare = expr.NewCompareFn(false, p.fields...)
-		p.records = expr.NewRecordSlice(p.compare)
-		heap.Init(p.records)
+		p.records = newBounded(p.limit, expr.NewCompareFn(false, p.fields...))
 	}
-	if p.records.Len() < p.limit || p.compare(p.records.Index(0), rec) < 0 {
-		heap.Push(p.records, rec.Copy())
-	}
-	if p.records.Len() > p.limit {
-		heap.Pop(p.records)
+	if p.records.accepts(rec) {
+		p.records.push(rec.Copy())
 	}
 }
 
@@ -81,11 +75,58 @@ func (t *Proc) sorted() zbuf.Batch {
 	if t.records == nil {
 		return nil
 	}
-	out := make([]zed.Value, t.records.Len())
-	for i := t.records.Len() - 1; i >= 0; i-- {
-		out[i] = *heap.Pop(t.records).(*zed.Value)
-	}
+	out := t.records.sorted()
 	// clear records
 	t.records = nil
 	return zbuf.NewArray(out)
 }
+
+// bounded is a min-heap that retains the limit largest values pushed into
+// it according to compare.
+type bounded struct {
+	limit   int
+	compare expr.CompareFn
+	vals    *expr.RecordSlice
+}
+
+func newBounded(limit int, compare expr.CompareFn) *bounded {
+	vals := expr.NewRecordSlice(compare)
+	heap.Init(vals)
+	return &bounded{
+		limit:   limit,
+		compare: compare,
+		vals:    vals,
+	}
+}
+
+func (b *bounded) len() int {
+	return b.vals.Len()
+}
+
+// accepts returns true if val would be retained by push.
+func (b *bounded) accepts(val *zed.Value) bool {
+	return b.vals.Len() < b.limit || b.compare(b.vals.Index(0), val) < 0
+}
+
+// push adds val, which must not be modified by the caller thereafter, and
+// returns the resulting change in the number of retained values.
+func (b *bounded) push(val *zed.Value) int {
+	if !b.accepts(val) {
+		return 0
+	}
+	heap.Push(b.vals, val)
+	if b.vals.Len() > b.limit {
+		heap.Pop(b.vals)
+		return 0
+	}
+	return 1
+}
+
+// sorted empties the heap and returns its values in descending order.
+func (b *bounded) sorted() []zed.Value {
+	out := make([]zed.Value, b.vals.Len())
+	for i := b.vals.Len() - 1; i >= 0; i-- {
+		out[i] = *heap.Pop(b.vals).(*zed.Value)
+	}
+	return out
+}
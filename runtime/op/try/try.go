@@ -0,0 +1,174 @@
+package try
+
+import (
+	"errors"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zcode"
+)
+
+// Selector applies a sequence of per-value stages to each input value and
+// routes the results to one of two outputs.  If every stage succeeds, the
+// values produced by the final stage are sent to the main output.  If any
+// stage produces a value that is or contains an error, the values produced
+// from that input are discarded and a record of the form
+// {input:<value>,error:<error>} holding the original input value and the
+// first error found is sent to the catch output instead.
+//
+// Each stage is a list of evaluators, each of which is applied to every
+// value produced by the previous stage.  As with op.NewApplier, quiet
+// and missing results are dropped.
+type Selector struct {
+	*op.Router
+	zctx        *zed.Context
+	stages      [][]expr.Evaluator
+	main        zbuf.Puller
+	catch       zbuf.Puller
+	hasError    map[zed.Type]bool
+	recordTypes map[[2]zed.Type]*zed.TypeRecord
+	builder     zcode.Builder
+}
+
+var _ op.Selector = (*Selector)(nil)
+
+func New(pctx *op.Context, parent zbuf.Puller, stages [][]expr.Evaluator) *Selector {
+	router := op.NewRouter(pctx, parent)
+	s := &Selector{
+		Router:      router,
+		zctx:        pctx.Zctx,
+		stages:      stages,
+		hasError:    make(map[zed.Type]bool),
+		recordTypes: make(map[[2]zed.Type]*zed.TypeRecord),
+	}
+	s.main = router.AddRoute()
+	s.catch = router.AddRoute()
+	router.Link(s)
+	return s
+}
+
+// Main returns the output for values that were transformed without error.
+func (s *Selector) Main() zbuf.Puller {
+	return s.main
+}
+
+// Catch returns the output for values whose transformation produced an error.
+func (s *Selector) Catch() zbuf.Puller {
+	return s.catch
+}
+
+func (s *Selector) Forward(router *op.Router, batch zbuf.Batch) bool {
+	var out, caught []zed.Value
+	vals := batch.Values()
+	for i := range vals {
+		out, caught = s.apply(batch, &vals[i], out, caught)
+	}
+	if len(out) > 0 {
+		if ok := router.Send(s.main, zbuf.NewArray(out), nil); !ok {
+			return false
+		}
+	}
+	if len(caught) > 0 {
+		if ok := router.Send(s.catch, zbuf.NewArray(caught), nil); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Selector) apply(ectx expr.Context, in *zed.Value, out, caught []zed.Value) ([]zed.Value, []zed.Value) {
+	vals := []zed.Value{*in}
+	for _, stage := range s.stages {
+		var next []zed.Value
+		for i := range vals {
+			for _, e := range stage {
+				val := e.Eval(ectx, &vals[i])
+				if val.IsQuiet() || val.IsMissing() {
+					continue
+				}
+				if err := s.findError(val); err != nil {
+					return out, append(caught, s.wrap(in, err))
+				}
+				// Copy is necessary because Eval can return
+				// its argument or reuse its result buffer.
+				next = append(next, *val.Copy())
+			}
+		}
+		vals = next
+	}
+	return append(out, vals...), caught
+}
+
+var errFound = errors.New("error found")
+
+// findError returns the first non-null error value found in a depth-first
+// traversal of val or nil if there is none.
+func (s *Selector) findError(val *zed.Value) *zed.Value {
+	if !s.typeHasError(val.Type) {
+		return nil
+	}
+	var found *zed.Value
+	val.Walk(func(typ zed.Type, body zcode.Bytes) error {
+		if _, ok := typ.(*zed.TypeError); ok && body != nil {
+			found = zed.NewValue(typ, body)
+			return errFound
+		}
+		return nil
+	})
+	return found
+}
+
+func (s *Selector) typeHasError(typ zed.Type) bool {
+	has, ok := s.hasError[typ]
+	if !ok {
+		has = typeHasError(typ)
+		s.hasError[typ] = has
+	}
+	return has
+}
+
+func typeHasError(typ zed.Type) bool {
+	switch typ := typ.(type) {
+	case *zed.TypeError:
+		return true
+	case *zed.TypeNamed:
+		return typeHasError(typ.Type)
+	case *zed.TypeRecord:
+		for _, f := range typ.Fields {
+			if typeHasError(f.Type) {
+				return true
+			}
+		}
+	case *zed.TypeArray:
+		return typeHasError(typ.Type)
+	case *zed.TypeSet:
+		return typeHasError(typ.Type)
+	case *zed.TypeMap:
+		return typeHasError(typ.KeyType) || typeHasError(typ.ValType)
+	case *zed.TypeUnion:
+		for _, t := range typ.Types {
+			if typeHasError(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Selector) wrap(in, err *zed.Value) zed.Value {
+	key := [2]zed.Type{in.Type, err.Type}
+	typ, ok := s.recordTypes[key]
+	if !ok {
+		typ = s.zctx.MustLookupTypeRecord([]zed.Field{
+			zed.NewField("input", in.Type),
+			zed.NewField("error", err.Type),
+		})
+		s.recordTypes[key] = typ
+	}
+	s.builder.Reset()
+	s.builder.Append(in.Bytes)
+	s.builder.Append(err.Bytes)
+	return *zed.NewValue(typ, s.builder.Bytes()).Copy()
+}
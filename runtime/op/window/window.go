@@ -0,0 +1,433 @@
+package window
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/expr/coerce"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zcode"
+)
+
+// BoundType is the type of the start or end of a Frame.
+type BoundType int
+
+const (
+	UnboundedPreceding BoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+// A Bound is the start or end of a Frame relative to the current value.
+// Offset is a number of values for a Frame of rows or a difference between
+// order keys for a Frame of range.
+type Bound struct {
+	Type   BoundType
+	Offset int
+}
+
+// A Frame is the range of values of a partition over which a Func is
+// computed.  If Range is false, the bounds are counted in values.
+// Otherwise, they are relative to the order key of the current value and
+// the current row includes all of its peers, i.e., the values with an
+// equal order key.
+type Frame struct {
+	Range bool
+	Start Bound
+	End   Bound
+}
+
+// A Func is a window function whose value is assigned to the field LHS.
+// If Agg is not nil, the function is the aggregation Agg computed over
+// Frame.  Otherwise, Name is one of row_number, rank, dense_rank, lag,
+// lead, first_value, or last_value and Args are its arguments.
+type Func struct {
+	LHS   field.Path
+	Name  string
+	Args  []expr.Evaluator
+	Agg   *expr.Aggregator
+	Frame Frame
+}
+
+// Proc computes window functions over the partitions of its input, which
+// must be sorted by the partition keys and then by the order keys.  Each
+// partition is buffered until the partition keys change, and then its
+// values are emitted, in order, each with the value of each Func assigned
+// to its field.
+type Proc struct {
+	pctx      *op.Context
+	parent    zbuf.Puller
+	partition []expr.Evaluator
+	orderBy   []expr.Evaluator
+	desc      bool
+	funcs     []Func
+	slots     []slot
+	putter    *expr.Putter
+	ectx      expr.Context
+	key       zcode.Bytes
+	keyCache  zcode.Bytes
+	rows      []zed.Value
+	eos       bool
+}
+
+// A slot is an Evaluator for the value of a Func at the current row.
+type slot struct {
+	val *zed.Value
+}
+
+func (s *slot) Eval(expr.Context, *zed.Value) *zed.Value {
+	return s.val
+}
+
+func New(pctx *op.Context, parent zbuf.Puller, partition, orderBy []expr.Evaluator, o order.Which, funcs []Func) (*Proc, error) {
+	slots := make([]slot, len(funcs))
+	var clauses []expr.Assignment
+	for k, f := range funcs {
+		clauses = append(clauses, expr.Assignment{LHS: f.LHS, RHS: &slots[k]})
+	}
+	putter, err := expr.NewPutter(pctx.Zctx, clauses)
+	if err != nil {
+		return nil, fmt.Errorf("window: %w", err)
+	}
+	return &Proc{
+		pctx:      pctx,
+		parent:    parent,
+		partition: partition,
+		orderBy:   orderBy,
+		desc:      o == order.Desc,
+		funcs:     funcs,
+		slots:     slots,
+		putter:    putter,
+		ectx:      expr.NewContext(),
+	}, nil
+}
+
+func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
+	if p.eos {
+		p.eos = false
+		return nil, nil
+	}
+	if done {
+		p.reset()
+		return p.parent.Pull(true)
+	}
+	for {
+		batch, err := p.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			out := p.flush(nil)
+			p.reset()
+			if len(out) == 0 {
+				return nil, nil
+			}
+			p.eos = true
+			return zbuf.NewArray(out), nil
+		}
+		var out []zed.Value
+		vals := batch.Values()
+		for i := range vals {
+			key := p.partitionKey(batch, &vals[i])
+			if len(p.rows) > 0 && !bytes.Equal(key, p.key) {
+				out = p.flush(out)
+			}
+			if len(p.rows) == 0 {
+				p.key = append(p.key[:0], key...)
+			}
+			p.rows = append(p.rows, *vals[i].Copy())
+		}
+		batch.Unref()
+		if len(out) > 0 {
+			return zbuf.NewArray(out), nil
+		}
+	}
+}
+
+func (p *Proc) reset() {
+	p.rows = nil
+	p.key = p.key[:0]
+}
+
+func (p *Proc) partitionKey(ectx expr.Context, this *zed.Value) zcode.Bytes {
+	p.keyCache = p.keyCache[:0]
+	for _, e := range p.partition {
+		val := e.Eval(ectx, this)
+		p.keyCache = zcode.Append(p.keyCache, zed.EncodeTypeValue(val.Type))
+		p.keyCache = zcode.Append(p.keyCache, val.Bytes)
+	}
+	return p.keyCache
+}
+
+// flush computes the window functions over the buffered partition and
+// appends its values to out.
+func (p *Proc) flush(out []zed.Value) []zed.Value {
+	if len(p.rows) == 0 {
+		return out
+	}
+	part := p.newPartition()
+	results := make([][]*zed.Value, len(p.funcs))
+	for k := range p.funcs {
+		results[k] = part.eval(&p.funcs[k])
+	}
+	for i := range p.rows {
+		for k := range p.slots {
+			p.slots[k].val = results[k][i]
+		}
+		out = append(out, *p.putter.Eval(p.ectx, &p.rows[i]).Copy())
+	}
+	p.rows = p.rows[:0]
+	return out
+}
+
+type partition struct {
+	*Proc
+	// peers[i] is the index of the first value of the peer group of value i.
+	peers []int
+	// ranks[i] is the dense rank of value i.
+	ranks []int
+	// nums are the numeric values of the first order key, if any.
+	nums  []float64
+	isNum []bool
+}
+
+func (p *Proc) newPartition() *partition {
+	n := len(p.rows)
+	part := &partition{
+		Proc:  p,
+		peers: make([]int, n),
+		ranks: make([]int, n),
+		nums:  make([]float64, n),
+		isNum: make([]bool, n),
+	}
+	var prev, key zcode.Bytes
+	for i := range p.rows {
+		key = key[:0]
+		for k, e := range p.orderBy {
+			val := e.Eval(p.ectx, &p.rows[i])
+			key = zcode.Append(key, zed.EncodeTypeValue(val.Type))
+			key = zcode.Append(key, val.Bytes)
+			if k == 0 {
+				part.nums[i], part.isNum[i] = coerce.ToFloat(val)
+			}
+		}
+		if i > 0 && bytes.Equal(key, prev) {
+			part.peers[i] = part.peers[i-1]
+			part.ranks[i] = part.ranks[i-1]
+		} else {
+			part.peers[i] = i
+			if i > 0 {
+				part.ranks[i] = part.ranks[i-1] + 1
+			}
+		}
+		prev = append(prev[:0], key...)
+	}
+	return part
+}
+
+// lastPeer returns the index of the last value of the peer group of value i.
+func (p *partition) lastPeer(i int) int {
+	for i+1 < len(p.rows) && p.peers[i+1] == p.peers[i] {
+		i++
+	}
+	return i
+}
+
+// pos returns the position of value j relative to value i in a range
+// frame, i.e., the difference of their order keys in the sort direction.
+func (p *partition) pos(i, j int) float64 {
+	if p.desc {
+		return p.nums[i] - p.nums[j]
+	}
+	return p.nums[j] - p.nums[i]
+}
+
+// bounds returns the indexes of the first and last values of the frame of
+// value i.  The frame is empty if the first is greater than the last.
+func (p *partition) bounds(f Frame, i int) (int, int) {
+	n := len(p.rows)
+	if !f.Range {
+		return clamp(rowBound(f.Start, i), n), clamp(rowBound(f.End, i), n)
+	}
+	return p.rangeStart(f.Start, i), p.rangeEnd(f.End, i)
+}
+
+func rowBound(b Bound, i int) int {
+	switch b.Type {
+	case UnboundedPreceding:
+		return -1 << 31
+	case Preceding:
+		return i - b.Offset
+	case Following:
+		return i + b.Offset
+	case UnboundedFollowing:
+		return 1 << 31
+	}
+	return i
+}
+
+func clamp(i, n int) int {
+	if i < -1 {
+		return -1
+	}
+	if i > n {
+		return n
+	}
+	return i
+}
+
+func (p *partition) rangeStart(b Bound, i int) int {
+	n := len(p.rows)
+	switch b.Type {
+	case UnboundedPreceding:
+		return 0
+	case UnboundedFollowing:
+		return n
+	case Preceding, Following:
+		if p.isNum[i] {
+			off := float64(b.Offset)
+			if b.Type == Preceding {
+				off = -off
+			}
+			return sort.Search(n, func(j int) bool {
+				return p.isNum[j] && p.pos(i, j) >= off
+			})
+		}
+	}
+	return p.peers[i]
+}
+
+func (p *partition) rangeEnd(b Bound, i int) int {
+	n := len(p.rows)
+	switch b.Type {
+	case UnboundedPreceding:
+		return -1
+	case UnboundedFollowing:
+		return n - 1
+	case Preceding, Following:
+		if p.isNum[i] {
+			off := float64(b.Offset)
+			if b.Type == Preceding {
+				off = -off
+			}
+			return sort.Search(n, func(j int) bool {
+				return !p.isNum[j] || p.pos(i, j) > off
+			}) - 1
+		}
+	}
+	return p.lastPeer(i)
+}
+
+// eval returns the value of f for each value of the partition.
+func (p *partition) eval(f *Func) []*zed.Value {
+	n := len(p.rows)
+	out := make([]*zed.Value, n)
+	if f.Agg != nil {
+		p.evalAgg(f, out)
+		return out
+	}
+	for i := range p.rows {
+		var val *zed.Value
+		switch f.Name {
+		case "row_number":
+			val = zed.NewUint64(uint64(i + 1))
+		case "rank":
+			val = zed.NewUint64(uint64(p.peers[i] + 1))
+		case "dense_rank":
+			val = zed.NewUint64(uint64(p.ranks[i] + 1))
+		case "lag", "lead":
+			offset := 1
+			if len(f.Args) > 1 {
+				off := f.Args[1].Eval(p.ectx, &p.rows[i])
+				if !zed.IsInteger(off.Type.ID()) {
+					val = p.pctx.Zctx.NewErrorf("%s: offset is not an integer", f.Name)
+					break
+				}
+				offset = int(coerceInt(off))
+			}
+			if f.Name == "lag" {
+				offset = -offset
+			}
+			if j := i + offset; j >= 0 && j < n {
+				val = f.Args[0].Eval(p.ectx, &p.rows[j])
+			} else if len(f.Args) > 2 {
+				val = f.Args[2].Eval(p.ectx, &p.rows[i])
+			} else {
+				val = zed.Null
+			}
+		case "first_value", "last_value":
+			first, last := p.bounds(f.Frame, i)
+			first, last = max(first, 0), min(last, n-1)
+			switch {
+			case first > last:
+				val = zed.Null
+			case f.Name == "first_value":
+				val = f.Args[0].Eval(p.ectx, &p.rows[first])
+			default:
+				val = f.Args[0].Eval(p.ectx, &p.rows[last])
+			}
+		default:
+			val = p.pctx.Zctx.NewErrorf("unknown window function: %s", f.Name)
+		}
+		out[i] = val.Copy()
+	}
+	return out
+}
+
+// evalAgg computes the aggregation of f over the frame of each value.
+// When the frames all start at the beginning of the partition, the
+// aggregation is computed incrementally since each frame is a prefix of
+// the next.  Otherwise, it is computed anew for each frame.
+func (p *partition) evalAgg(f *Func, out []*zed.Value) {
+	n := len(p.rows)
+	zctx := p.pctx.Zctx
+	if f.Frame.Start.Type == UnboundedPreceding {
+		fn := f.Agg.NewFunction()
+		next := 0
+		for i := range p.rows {
+			_, last := p.bounds(f.Frame, i)
+			for ; next <= last && next < n; next++ {
+				f.Agg.Apply(zctx, p.ectx, fn, &p.rows[next])
+			}
+			out[i] = fn.Result(zctx).Copy()
+		}
+		return
+	}
+	for i := range p.rows {
+		first, last := p.bounds(f.Frame, i)
+		fn := f.Agg.NewFunction()
+		for j := max(first, 0); j <= last && j < n; j++ {
+			f.Agg.Apply(zctx, p.ectx, fn, &p.rows[j])
+		}
+		out[i] = fn.Result(zctx).Copy()
+	}
+}
+
+func coerceInt(val *zed.Value) int64 {
+	if zed.IsSigned(val.Type.ID()) {
+		return zed.DecodeInt(val.Bytes)
+	}
+	return int64(zed.DecodeUint(val.Bytes))
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
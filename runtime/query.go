@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/brimdata/zed"
@@ -11,6 +12,7 @@ import (
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 // Query runs a flowgraph as a zbuf.Puller and implements a Close() method
@@ -18,17 +20,21 @@ import (
 // methods provide a convenient means to run a flowgraph as zio.Reader.
 type Query struct {
 	zbuf.Puller
-	pctx  *op.Context
-	meter zbuf.Meter
+	pctx     *op.Context
+	meter    zbuf.Meter
+	channels []string
+	explain  func() string
 }
 
 var _ zbuf.Puller = (*Query)(nil)
 
-func NewQuery(pctx *op.Context, puller zbuf.Puller, meter zbuf.Meter) *Query {
+func NewQuery(pctx *op.Context, puller zbuf.Puller, meter zbuf.Meter, channels []string, explain func() string) *Query {
 	return &Query{
-		Puller: puller,
-		pctx:   pctx,
-		meter:  meter,
+		Puller:   puller,
+		pctx:     pctx,
+		meter:    meter,
+		channels: channels,
+		explain:  explain,
 	}
 }
 
@@ -63,12 +69,16 @@ func (q *Query) AsReader() zio.Reader {
 	return zbuf.PullerReader(q)
 }
 
+// AsProgressReadCloser returns a reader for q that, like the reader of a
+// remote query, returns a *zbuf.Control holding a zbuf.SetChannel error
+// before each run of values from a different output channel.
 func (q *Query) AsProgressReadCloser() zbuf.ProgressReadCloser {
 	return struct {
 		zio.Reader
 		io.Closer
 		zbuf.Meter
-	}{q.AsReader(), q, q}
+		zbuf.Explainer
+	}{&channelReader{q: q, cid: -1}, q, q, q}
 }
 
 func (q *Query) Progress() zbuf.Progress {
@@ -79,6 +89,55 @@ func (q *Query) Meter() zbuf.Meter {
 	return q.meter
 }
 
+// Explain returns the optimized DAG of the query annotated with the runtime
+// statistics of its operators, or an empty string if the query was not
+// compiled from a DAG.
+func (q *Query) Explain() string {
+	if q.explain == nil {
+		return ""
+	}
+	return q.explain()
+}
+
+// Channels returns the name of each output channel of the query, where
+// the name of a channel not terminated by an output operator is empty.
+func (q *Query) Channels() []string {
+	return q.channels
+}
+
+// CopyChannels copies the output of q to w except that the values of each
+// named output channel in named are written to the corresponding writer.
+func (q *Query) CopyChannels(w zio.Writer, named map[string]zio.WriteCloser) error {
+	writers := make([]zio.Writer, 0, len(q.channels))
+	for _, name := range q.channels {
+		if nw, ok := named[name]; ok {
+			writers = append(writers, nw)
+		} else {
+			writers = append(writers, w)
+		}
+	}
+	for name := range named {
+		if !slices.Contains(q.channels, name) {
+			return fmt.Errorf("no output named %q in query", name)
+		}
+	}
+	for {
+		batch, err := q.Pull(false)
+		if batch == nil || err != nil {
+			return err
+		}
+		batch, label := op.Unwrap(batch)
+		out := w
+		if label < len(writers) {
+			out = writers[label]
+		}
+		if err := zbuf.WriteBatch(out, batch); err != nil {
+			return err
+		}
+		batch.Unref()
+	}
+}
+
 func (q *Query) Close() error {
 	q.pctx.Cancel()
 	return nil
@@ -90,3 +149,36 @@ func (q *Query) Pull(done bool) (zbuf.Batch, error) {
 	}
 	return q.Puller.Pull(done)
 }
+
+type channelReader struct {
+	q     *Query
+	cid   int
+	batch zbuf.Batch
+	vals  []zed.Value
+}
+
+func (r *channelReader) Read() (*zed.Value, error) {
+	for len(r.vals) == 0 {
+		if r.batch != nil {
+			r.batch.Unref()
+			r.batch = nil
+		}
+		batch, err := r.q.Pull(false)
+		if batch == nil || err != nil {
+			return nil, err
+		}
+		r.batch = batch
+		r.vals = batch.Values()
+		if _, cid := op.Unwrap(batch); len(r.vals) != 0 && cid != r.cid {
+			r.cid = cid
+			var name string
+			if cid < len(r.q.channels) {
+				name = r.q.channels[cid]
+			}
+			return nil, &zbuf.Control{Message: zbuf.SetChannel{ID: cid, Name: name}}
+		}
+	}
+	val := &r.vals[0]
+	r.vals = r.vals[1:]
+	return val, nil
+}
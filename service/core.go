@@ -168,12 +168,20 @@ func (c *Core) addAPIServerRoutes() {
 	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchLoad).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/compact", handleCompact).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/delete", handleDelete).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/update", handleUpdate).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/index", branchHandle(handleIndexApply)).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/index/update", branchHandle(handleIndexUpdate)).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/merge/{child}", handleBranchMerge).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/revert/{commit}", handleRevertPost).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/cherrypick/{commit}", handleCherryPickPost).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/rebase", handleRebasePost).Methods("POST")
+	c.authhandle("/pool/{pool}/diff", handleDiff).Methods("GET")
+	c.authhandle("/pool/{pool}/gc", handleGCPost).Methods("POST")
 	c.authhandle("/pool/{pool}/stats", handlePoolStats).Methods("GET")
+	c.authhandle("/pool/{pool}/tag", handleTagPost).Methods("POST")
+	c.authhandle("/pool/{pool}/tag/{tag}", handleTagDelete).Methods("DELETE")
 	c.authhandle("/query", handleQuery).Methods("OPTIONS", "POST")
+	c.authhandle("/query/lint", handleQueryLint).Methods("POST")
 }
 
 func (c *Core) handler(f func(*Core, *ResponseWriter, *Request)) http.Handler {
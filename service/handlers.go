@@ -13,20 +13,25 @@ import (
 	"github.com/brimdata/zed/compiler"
 	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/lake"
+	lakeapi "github.com/brimdata/zed/lake/api"
 	"github.com/brimdata/zed/lake/commits"
 	"github.com/brimdata/zed/lake/index"
 	"github.com/brimdata/zed/lake/journal"
 	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/runtime"
 	"github.com/brimdata/zed/runtime/exec"
 	"github.com/brimdata/zed/runtime/op"
 	"github.com/brimdata/zed/service/auth"
 	"github.com/brimdata/zed/service/srverr"
+	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/anyio"
 	"github.com/brimdata/zed/zio/csvio"
 	"github.com/brimdata/zed/zio/zngio"
+	"github.com/brimdata/zed/zson"
 	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
 )
 
 func handleQuery(c *Core, w *ResponseWriter, r *Request) {
@@ -53,7 +58,15 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(srverr.ErrInvalid(err))
 		return
 	}
-	flowgraph, err := runtime.CompileLakeQuery(r.Context(), zed.NewContext(), c.compiler, query, &req.Head, r.Logger)
+	if stmt, ok := query.(ast.Statement); ok {
+		handleStatement(c, w, r, stmt, &req.Head, ctrl)
+		return
+	}
+	ctx := r.Context()
+	if req.Explain {
+		ctx = op.WithExplain(ctx)
+	}
+	flowgraph, err := runtime.CompileLakeQuery(ctx, zed.NewContext(), c.compiler, query, &req.Head, r.Logger)
 	if err != nil {
 		w.Error(err)
 		return
@@ -97,6 +110,12 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 				return
 			}
 			if batch == nil {
+				if req.Explain {
+					if err := writer.WriteExplanation(flowgraph.Explain()); err != nil {
+						writer.WriteError(err)
+						return
+					}
+				}
 				if err := writer.WriteProgress(meter.Progress()); err != nil {
 					writer.WriteError(err)
 					return
@@ -116,7 +135,11 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 			}
 			var cid int
 			batch, cid = op.Unwrap(batch)
-			if err := writer.WriteBatch(cid, batch); err != nil {
+			var name string
+			if channels := flowgraph.Channels(); cid < len(channels) {
+				name = channels[cid]
+			}
+			if err := writer.WriteBatch(cid, name, batch); err != nil {
 				writer.WriteError(err)
 				return
 			}
@@ -124,6 +147,96 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	}
 }
 
+// handleStatement runs a pool management statement sent to the query
+// endpoint and responds with its result as the query's only value.
+func handleStatement(c *Core, w *ResponseWriter, r *Request, stmt ast.Statement, head *lakeparse.Commitish, ctrl bool) {
+	result, err := lakeapi.RunStatement(r.Context(), lakeapi.NewLocalLake(c.root, c.engine), stmt, head)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	val, err := zson.MarshalZNG(result)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	flusher, _ := w.ResponseWriter.(http.Flusher)
+	writer, err := queryio.NewWriter(zio.NopCloser(w), w.Format, flusher, ctrl)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	defer writer.Close()
+	if err := writer.WriteBatch(0, "", zbuf.NewArray([]zed.Value{*val})); err != nil {
+		writer.WriteError(err)
+		return
+	}
+	if err := writer.WhiteChannelEnd(0); err != nil {
+		writer.WriteError(err)
+		return
+	}
+	switch result := result.(type) {
+	case lakeapi.PoolResult:
+		if _, ok := stmt.(*ast.DropPool); ok {
+			c.publishEvent(w, "pool-delete", api.EventPool{PoolID: result.ID})
+		} else {
+			c.publishEvent(w, "pool-new", api.EventPool{PoolID: result.ID})
+		}
+	case lakeapi.CommitResult:
+		poolID, err := lakeparse.ParseID(result.Pool)
+		if err != nil {
+			if poolID, err = c.root.PoolID(r.Context(), result.Pool); err != nil {
+				w.Logger.Error("Error looking up pool of statement", zap.Error(err))
+				return
+			}
+		}
+		if _, ok := stmt.(*ast.CreateBranch); ok {
+			c.publishEvent(w, "branch-update", api.EventBranch{PoolID: poolID, Branch: result.Branch})
+		} else {
+			c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+				CommitID: result.Commit,
+				PoolID:   poolID,
+				Branch:   result.Branch,
+			})
+		}
+	}
+}
+
+func handleQueryLint(c *Core, w *ResponseWriter, r *Request) {
+	var req api.LintRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	query, err := c.compiler.Parse(req.Query)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	zctx := zed.NewContext()
+	var types []zed.Type
+	for _, s := range req.Types {
+		typ, err := zson.ParseType(zctx, s)
+		if err != nil {
+			w.Error(srverr.ErrInvalid(err))
+			return
+		}
+		types = append(types, typ)
+	}
+	var head *lakeparse.Commitish
+	if req.Head.Pool != "" {
+		head = &req.Head
+	}
+	warnings, err := compiler.Lint(r.Context(), zctx, query, c.root, head, types)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	if warnings == nil {
+		warnings = []string{}
+	}
+	w.Respond(http.StatusOK, api.LintResponse{Warnings: warnings})
+}
+
 func handleBranchGet(c *Core, w *ResponseWriter, r *Request) {
 	branchName, ok := r.StringFromPath(w, "branch")
 	if !ok {
@@ -134,17 +247,77 @@ func handleBranchGet(c *Core, w *ResponseWriter, r *Request) {
 		return
 	}
 	if branchName != "" {
-		branch, err := pool.LookupBranchByName(r.Context(), branchName)
+		commit, err := pool.ResolveCommit(r.Context(), branchName)
 		if err != nil {
 			w.Error(err)
 			return
 		}
-		w.Respond(http.StatusOK, api.CommitResponse{Commit: branch.Commit})
+		w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
 		return
 	}
 	w.Respond(http.StatusOK, pool.Config)
 }
 
+func handleDiff(c *Core, w *ResponseWriter, r *Request) {
+	pool, ok := r.openPool(w, c.root)
+	if !ok {
+		return
+	}
+	values, ok := r.BoolFromQuery(w, "values")
+	if !ok {
+		return
+	}
+	var ids [2]ksuid.KSUID
+	for k, param := range []string{"from", "to"} {
+		ref := r.URL.Query().Get(param)
+		if ref == "" {
+			w.Error(srverr.ErrInvalid("missing query param %q", param))
+			return
+		}
+		if ids[k], ok = r.resolveCommit(w, pool, ref); !ok {
+			return
+		}
+	}
+	reader, err := exec.Diff(r.Context(), zed.NewContext(), c.root, pool, ids[0], ids[1], values)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	zw := w.ZioWriter()
+	if zw == nil {
+		return
+	}
+	defer zw.Close()
+	if err := zio.Copy(zw, reader); err != nil {
+		w.Logger.Warn("Error writing diff", zap.Error(err))
+	}
+}
+
+func handleGCPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.GCRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	poolID, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	grace := lake.DefaultGCGrace
+	if req.Grace != nil {
+		grace = time.Duration(*req.Grace)
+	}
+	if grace < 0 {
+		w.Error(srverr.ErrInvalid("grace period must not be negative"))
+		return
+	}
+	stats, err := c.root.GC(r.Context(), poolID, grace, req.DryRun)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.GCResponse{Objects: stats.Objects, Bytes: stats.Bytes})
+}
+
 func handlePoolStats(c *Core, w *ResponseWriter, r *Request) {
 	pool, ok := r.openPool(w, c.root)
 	if !ok {
@@ -180,7 +353,7 @@ func handlePoolPost(c *Core, w *ResponseWriter, r *Request) {
 	if !r.Unmarshal(w, &req) {
 		return
 	}
-	pool, err := c.root.CreatePool(r.Context(), req.Name, req.Layout, req.SeekStride, req.Thresh)
+	pool, err := c.root.CreatePool(r.Context(), req.Name, req.Layout, req.Partition, req.SeekStride, req.Thresh)
 	if err != nil {
 		w.Error(err)
 		return
@@ -234,6 +407,46 @@ func handleBranchPost(c *Core, w *ResponseWriter, r *Request) {
 	c.publishEvent(w, "branch-update", api.EventBranch{PoolID: poolID, Branch: branchRef.Name})
 }
 
+func handleTagPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.TagPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	poolID, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	commit, err := lakeparse.ParseID(req.Commit)
+	if err != nil {
+		w.Error(srverr.ErrInvalid("invalid commit object: %s", req.Commit))
+		return
+	}
+	tagRef, err := c.root.CreateTag(r.Context(), poolID, req.Name, commit)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, tagRef)
+	c.publishEvent(w, "tag-new", api.EventTag{PoolID: poolID, Tag: tagRef.Name})
+}
+
+func handleTagDelete(c *Core, w *ResponseWriter, r *Request) {
+	poolID, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	tagName, ok := r.StringFromPath(w, "tag")
+	if !ok {
+		return
+	}
+	if err := c.root.RemoveTag(r.Context(), poolID, tagName); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	c.publishEvent(w, "tag-delete", api.EventTag{PoolID: poolID, Tag: tagName})
+}
+
 func handleRevertPost(c *Core, w *ResponseWriter, r *Request) {
 	poolID, ok := r.PoolID(w, c.root)
 	if !ok {
@@ -264,6 +477,66 @@ func handleRevertPost(c *Core, w *ResponseWriter, r *Request) {
 	})
 }
 
+func handleCherryPickPost(c *Core, w *ResponseWriter, r *Request) {
+	poolID, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	branch, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	commit, ok := r.CommitID(w)
+	if !ok {
+		return
+	}
+	message, ok := r.decodeCommitMessage(w)
+	if !ok {
+		return
+	}
+	commit, err := c.root.CherryPick(r.Context(), poolID, branch, commit, message.Author, message.Body, message.Meta)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
+	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+		CommitID: commit,
+		PoolID:   poolID,
+		Branch:   branch,
+	})
+}
+
+func handleRebasePost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.RebaseRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	pool, ok := r.openPool(w, c.root)
+	if !ok {
+		return
+	}
+	branch, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	if req.Onto == "" {
+		w.Error(srverr.ErrInvalid("rebase target must be specified"))
+		return
+	}
+	onto, ok := r.resolveCommit(w, pool, req.Onto)
+	if !ok {
+		return
+	}
+	commit, err := c.root.Rebase(r.Context(), pool.ID, branch, onto)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
+	c.publishEvent(w, "branch-update", api.EventBranch{PoolID: pool.ID, Branch: branch})
+}
+
 func handleBranchMerge(c *Core, w *ResponseWriter, r *Request) {
 	poolID, ok := r.PoolID(w, c.root)
 	if !ok {
@@ -396,7 +669,12 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 	}
 	defer zrc.Close()
 	wr := &warningsReader{zrc, []string{}}
-	kommit, err := branch.Load(r.Context(), zctx, wr, message.Author, message.Body, message.Meta)
+	var kommit ksuid.KSUID
+	if upsertKey := r.URL.Query().Get("upsert_key"); upsertKey != "" {
+		kommit, err = branch.Upsert(r.Context(), zctx, wr, field.Dotted(upsertKey), message.Author, message.Body, message.Meta)
+	} else {
+		kommit, err = branch.Load(r.Context(), zctx, wr, message.Author, message.Body, message.Meta)
+	}
 	if err != nil {
 		if errors.Is(err, commits.ErrEmptyTransaction) {
 			err = srverr.ErrInvalid("no records in request")
@@ -524,6 +802,58 @@ func handleDelete(c *Core, w *ResponseWriter, r *Request) {
 	})
 }
 
+func handleUpdate(c *Core, w *ResponseWriter, r *Request) {
+	branchName, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	message, ok := r.decodeCommitMessage(w)
+	if !ok {
+		return
+	}
+	var payload api.UpdateRequest
+	if !r.Unmarshal(w, &payload) {
+		return
+	}
+	if payload.Where == "" || payload.Transform == "" {
+		w.Error(srverr.ErrInvalid("where and transform must be set"))
+		return
+	}
+	where, err := c.compiler.Parse(payload.Where)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	transform, err := c.compiler.Parse(payload.Transform)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	pool, ok := r.openPool(w, c.root)
+	if !ok {
+		return
+	}
+	branch, err := pool.OpenBranchByName(r.Context(), branchName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	commit, err := branch.Update(r.Context(), c.compiler, where, transform, message.Author, message.Body, message.Meta)
+	if errors.Is(err, &compiler.InvalidDeleteWhereQuery{}) {
+		err = srverr.ErrInvalid(err)
+	}
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Marshal(api.CommitResponse{Commit: commit})
+	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+		CommitID: commit,
+		PoolID:   pool.ID,
+		Branch:   branchName,
+	})
+}
+
 func handleIndexRulesPost(c *Core, w *ResponseWriter, r *Request) {
 	var body api.IndexRulesAddRequest
 	if !r.Unmarshal(w, &body, index.RuleTypes...) {
@@ -8,14 +8,18 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/api"
 	"github.com/brimdata/zed/api/client"
+	"github.com/brimdata/zed/lake"
+	"github.com/brimdata/zed/lake/data"
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/runtime/exec"
 	"github.com/brimdata/zed/service"
+	"github.com/brimdata/zed/zson"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/segmentio/ksuid"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +66,46 @@ func TestQueryGroupByReverse(t *testing.T) {
 	require.Equal(t, counts, "\n"+conn.TestQuery("from test | count() by every(1s)"))
 }
 
+func TestQueryCompoundKeyLegacyObject(t *testing.T) {
+	dir := t.TempDir()
+	_, conn := newCoreAtDir(t, dir)
+	layout := order.Layout{Order: order.Asc, Keys: field.DottedList("tenant,ts")}
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test", Layout: layout})
+	// Write an object as it was written before pool keys were compound,
+	// i.e., with a span and seek index of the primary key only.
+	ctx := context.Background()
+	root, err := lake.Open(ctx, storage.NewLocalEngine(), storage.MustParseURI(dir))
+	require.NoError(t, err)
+	pool, err := root.OpenPool(ctx, poolID)
+	require.NoError(t, err)
+	branch, err := pool.OpenBranchByName(ctx, "main")
+	require.NoError(t, err)
+	object := data.NewObject()
+	w, err := object.NewWriter(ctx, pool.Storage(), pool.DataPath, order.Asc, field.DottedList("tenant"), 0)
+	require.NoError(t, err)
+	zctx := zed.NewContext()
+	for _, s := range []string{`{tenant:"a",ts:1}`, `{tenant:"b",ts:2}`, `{tenant:"c",ts:3}`} {
+		require.NoError(t, w.Write(zson.MustParseValue(zctx, s)))
+	}
+	require.NoError(t, w.Close(ctx))
+	_, err = branch.CommitCompact(ctx, nil, []*data.Object{w.Object()}, "", "", "")
+	require.NoError(t, err)
+	conn.TestLoad(poolID, "main", strings.NewReader(`{tenant:"b",ts:1} {tenant:"b",ts:4} {tenant:"d",ts:0}`))
+	expected := `{tenant:"b",ts:1}
+{tenant:"b",ts:2}
+{tenant:"b",ts:4}
+`
+	assert.Equal(t, expected, conn.TestQuery(`from test | tenant=="b"`))
+	expected = `{tenant:"b",ts:2}
+{tenant:"b",ts:4}
+`
+	assert.Equal(t, expected, conn.TestQuery(`from test | tenant=="b" and ts>=2`))
+	expected = `{tenant:"c",ts:3}
+{tenant:"d",ts:0}
+`
+	assert.Equal(t, expected, conn.TestQuery(`from test | tenant>"b"`))
+}
+
 func TestPoolStats(t *testing.T) {
 	src := `
 {_path:"conn",ts:1970-01-01T00:00:01Z,uid:"CBrzd94qfowOqJwCHa"}
@@ -18,6 +18,7 @@ import (
 	"github.com/brimdata/zed/lake/commits"
 	"github.com/brimdata/zed/lake/journal"
 	"github.com/brimdata/zed/lake/pools"
+	"github.com/brimdata/zed/lake/tags"
 	"github.com/brimdata/zed/lakeparse"
 	"github.com/brimdata/zed/service/srverr"
 	"github.com/brimdata/zed/zio"
@@ -75,6 +76,20 @@ func (r *Request) openPool(w *ResponseWriter, root *lake.Root) (*lake.Pool, bool
 	return pool, true
 }
 
+// resolveCommit returns the commit named by ref, which is a commit ID or the
+// name of a branch or tag in pool.
+func (r *Request) resolveCommit(w *ResponseWriter, pool *lake.Pool, ref string) (ksuid.KSUID, bool) {
+	if commit, err := lakeparse.ParseID(ref); err == nil {
+		return commit, true
+	}
+	commit, err := pool.ResolveCommit(r.Context(), ref)
+	if err != nil {
+		w.Error(err)
+		return ksuid.Nil, false
+	}
+	return commit, true
+}
+
 func (r *Request) PoolID(w *ResponseWriter, root *lake.Root) (ksuid.KSUID, bool) {
 	s, ok := r.StringFromPath(w, "pool")
 	if !ok {
@@ -292,10 +307,12 @@ func errorResponse(e error) (status int, ae *api.Error) {
 	if !errors.As(e, &ze) {
 		var kind srverr.Kind
 		switch {
-		case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists):
+		case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists) ||
+			errors.Is(e, tags.ErrExists):
 			kind = srverr.Conflict
 		case errors.Is(e, branches.ErrNotFound) || errors.Is(e, commits.ErrNotFound) ||
-			errors.Is(e, pools.ErrNotFound) || errors.Is(e, fs.ErrNotExist):
+			errors.Is(e, pools.ErrNotFound) || errors.Is(e, tags.ErrNotFound) ||
+			errors.Is(e, fs.ErrNotExist):
 			kind = srverr.NotFound
 		default:
 			ae.Message = e.Error()
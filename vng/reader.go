@@ -1,22 +1,29 @@
 package vng
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/vng/vector"
+	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zio"
+	"golang.org/x/exp/slices"
 )
 
 // Reader implements zio.Reader for a VNG object.
 type Reader struct {
+	object  *Object
 	root    *vector.Int64Reader
 	readers []typedReader
 	builder zcode.Builder
 	val     zed.Value
 }
 
+var _ zbuf.ScannerAble = (*Reader)(nil)
+
 type typedReader struct {
 	typ    zed.Type
 	reader vector.Reader
@@ -24,20 +31,64 @@ type typedReader struct {
 
 // NewReader returns a Reader for o.
 func NewReader(o *Object) (*Reader, error) {
-	root := vector.NewInt64Reader(o.root, o.readerAt)
+	readers, err := newTypedReaders(o, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		object:  o,
+		root:    vector.NewInt64Reader(o.root, o.readerAt),
+		readers: readers,
+	}, nil
+
+}
+
+// newTypedReaders returns a reader for each type in o.  If fields is
+// non-nil, the readers of top-level records read only the vectors of the
+// named fields so the vectors of other fields are never loaded.
+func newTypedReaders(o *Object, fields []string) ([]typedReader, error) {
 	readers := make([]typedReader, 0, len(o.maps))
 	for _, m := range o.maps {
+		if record, ok := m.(*vector.Record); ok && fields != nil {
+			m = projectRecord(record, fields)
+		}
 		r, err := vector.NewReader(m, o.readerAt)
 		if err != nil {
 			return nil, err
 		}
 		readers = append(readers, typedReader{typ: m.Type(o.zctx), reader: r})
 	}
-	return &Reader{
-		root:    root,
-		readers: readers,
-	}, nil
+	return readers, nil
+}
+
+func projectRecord(record *vector.Record, fields []string) *vector.Record {
+	var projected []vector.Field
+	for _, f := range record.Fields {
+		if slices.Contains(fields, f.Name) {
+			projected = append(projected, f)
+		}
+	}
+	return &vector.Record{Fields: projected}
+}
 
+// NewScanner implements zbuf.ScannerAble.  If filter has a projection,
+// r reads only the vectors of the projected fields.  NewScanner must be
+// called before any values are read from r.
+func (r *Reader) NewScanner(ctx context.Context, filter zbuf.Filter) (zbuf.Scanner, error) {
+	if filter != nil {
+		projection, err := filter.AsProjection()
+		if err != nil {
+			return nil, err
+		}
+		if projection != nil {
+			r.readers, err = newTypedReaders(r.object, projection.Fields())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Hide r's NewScanner so zbuf.NewScanner returns its generic scanner.
+	return zbuf.NewScanner(ctx, struct{ zio.Reader }{r}, filter)
 }
 
 func (r *Reader) Read() (*zed.Value, error) {
@@ -15,7 +15,14 @@ func (c *Control) Error() string {
 	return "control"
 }
 
-type SetChannel int
+// SetChannel is a control message indicating that the values that follow
+// belong to the output channel with the given ID and name, where the name of
+// a channel not terminated by an output operator is empty.
+type SetChannel struct {
+	ID   int
+	Name string
+}
+
 type EndChannel int
 
 type noControl struct {
@@ -46,6 +53,16 @@ type ProgressReadCloser interface {
 	Progress() Progress
 }
 
+// An Explainer describes the flowgraph of a query annotated with the
+// runtime statistics of its operators.
+type Explainer interface {
+	Explain() string
+}
+
+// Explanation is a control message carrying the text returned by the
+// Explain method of a query.
+type Explanation string
+
 func MeterReadCloser(rc zio.ReadCloser) ProgressReadCloser {
 	return &meterReadCloser{ReadCloser: rc}
 }
@@ -53,6 +70,13 @@ func MeterReadCloser(rc zio.ReadCloser) ProgressReadCloser {
 type meterReadCloser struct {
 	zio.ReadCloser
 	progress Progress
+	explain  string
+}
+
+var _ Explainer = (*meterReadCloser)(nil)
+
+func (m *meterReadCloser) Explain() string {
+	return m.explain
 }
 
 func (m *meterReadCloser) Progress() Progress {
@@ -62,8 +86,11 @@ func (m *meterReadCloser) Progress() Progress {
 func (m *meterReadCloser) Read() (*zed.Value, error) {
 	val, err := m.ReadCloser.Read()
 	if ctrl, ok := err.(*Control); ok {
-		if progress, ok := ctrl.Message.(Progress); ok {
-			m.progress = progress
+		switch msg := ctrl.Message.(type) {
+		case Progress:
+			m.progress = msg
+		case Explanation:
+			m.explain = string(msg)
 		}
 	}
 	return val, err
@@ -16,8 +16,18 @@ import (
 type Filter interface {
 	AsEvaluator() (expr.Evaluator, error)
 	AsBufferFilter() (*expr.BufferFilter, error)
-	AsKeySpanFilter(field.Path, order.Which) (*expr.SpanFilter, error)
-	AsKeyCroppedByFilter(field.Path, order.Which) (*expr.SpanFilter, error)
+	// AsKeySpanFilter and AsKeyCroppedByFilter take the pool keys.  If there
+	// is more than one, the spans they filter have compound key bounds.
+	AsKeySpanFilter(field.List, order.Which) (*expr.SpanFilter, error)
+	AsKeyCroppedByFilter(field.List, order.Which) (*expr.SpanFilter, error)
+	// AsPartitionFilter returns a filter for the partition values of the
+	// objects of a partitioned pool, which are passed as both bounds of the
+	// span, or nil if the pool is not partitioned.
+	AsPartitionFilter() (*expr.SpanFilter, error)
+	// AsProjection returns nil if all fields are to be scanned.  Only
+	// columnar readers like VNG apply it since they can skip the columns
+	// of unneeded fields, while row formats must decode whole values.
+	AsProjection() (*expr.Projection, error)
 	//XXX This is here to break an import loop between lake and compiler.
 	// We will remove this in a subsequent PR when the runtime/sequence,vector
 	// packages will implement a pushdown language that is not based on dag.Expr
@@ -23,6 +23,8 @@ type canon struct {
 	canonZed
 	head  bool
 	first bool
+	// over, if not nil, formats over expressions in place of canon.
+	over func(*ast.OverExpr)
 }
 
 func (c *canon) open(args ...interface{}) {
@@ -89,6 +91,9 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		c.write("null")
 	case *ast.Agg:
 		c.write("%s(", e.Name)
+		if e.Distinct {
+			c.write("distinct ")
+		}
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
@@ -109,23 +114,45 @@ func (c *canon) expr(e ast.Expr, parent string) {
 	case *ast.BinaryExpr:
 		c.binary(e, parent)
 	case *ast.Conditional:
+		c.maybewrite("(", parent != "")
 		c.write("(")
 		c.expr(e.Cond, "")
 		c.write(") ? ")
 		c.expr(e.Then, "")
 		c.write(" : ")
 		c.expr(e.Else, "")
+		c.maybewrite(")", parent != "")
 	case *ast.Call:
 		c.write("%s(", e.Name)
 		c.exprs(e.Args)
 		c.write(")")
+		if e.Where != nil {
+			c.write(" where ")
+			c.expr(e.Where, "")
+		}
 	case *ast.Cast:
-		c.expr(e.Type, "")
+		if typ, ok := e.Type.(*astzed.TypeValue); ok && isPrimitive(typ.Value) {
+			c.typ(typ.Value)
+		} else {
+			c.expr(e.Type, "")
+		}
 		c.write("(")
 		c.expr(e.Expr, "")
 		c.write(")")
 	case *ast.SQLExpr:
-		c.sql(e)
+		c.write("(")
+		c.sql(e, c.space)
+		c.write(")")
+	case *ast.SQLOver:
+		c.expr(e.Func, "")
+		w := e.Window
+		if w.Base != "" && w.PartitionBy == nil && w.OrderBy == nil && w.Frame == nil {
+			c.write(" OVER %s", w.Base)
+		} else {
+			c.write(" OVER (")
+			c.sqlWindow(w)
+			c.write(")")
+		}
 	case *astzed.TypeValue:
 		c.write("<")
 		c.typ(e.Value)
@@ -133,14 +160,14 @@ func (c *canon) expr(e ast.Expr, parent string) {
 	case *ast.Regexp:
 		c.write("/%s/", e.Pattern)
 	case *ast.Glob:
-		c.write(e.Pattern)
+		c.write(globPattern(e.Pattern))
 	case *ast.Grep:
 		c.write("grep(")
 		switch p := e.Pattern.(type) {
 		case *ast.Regexp:
 			c.write("/%s/", p.Pattern)
 		case *ast.Glob:
-			c.write(p.Pattern)
+			c.write(globPattern(p.Pattern))
 		case *ast.String:
 			c.write(zson.QuotedString([]byte(p.Text)))
 		default:
@@ -194,6 +221,10 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		}
 		c.write("}|")
 	case *ast.OverExpr:
+		if c.over != nil {
+			c.over(e)
+			break
+		}
 		c.open("(")
 		c.ret()
 		c.write("over ")
@@ -230,10 +261,8 @@ func (c *canon) vectorElems(elems []ast.VectorElem) {
 func (c *canon) binary(e *ast.BinaryExpr, parent string) {
 	switch e.Op {
 	case ".":
-		if !isThis(e.LHS) {
-			c.expr(e.LHS, "")
-			c.write(".")
-		}
+		c.expr(e.LHS, "")
+		c.write(".")
 		c.expr(e.RHS, "")
 	case "[":
 		if isThis(e.LHS) {
@@ -244,24 +273,50 @@ func (c *canon) binary(e *ast.BinaryExpr, parent string) {
 		c.write("[")
 		c.expr(e.RHS, "")
 		c.write("]")
+	case ":":
+		// A slice, whose bounds are optional.
+		if e.LHS != nil {
+			c.expr(e.LHS, "")
+		}
+		c.write(":")
+		if e.RHS != nil {
+			c.expr(e.RHS, "")
+		}
 	case "and", "or", "in":
 		parens := needsparens(parent, e.Op)
 		c.maybewrite("(", parens)
 		c.expr(e.LHS, e.Op)
 		c.write(" %s ", e.Op)
-		c.expr(e.RHS, e.Op)
+		c.rhs(e)
 		c.maybewrite(")", parens)
 	default:
 		parens := needsparens(parent, e.Op)
 		c.maybewrite("(", parens)
 		// do need parens calc
 		c.expr(e.LHS, e.Op)
-		c.write("%s", e.Op)
-		c.expr(e.RHS, e.Op)
+		if e.Op == "~" {
+			c.write(" ~ ")
+		} else {
+			c.write("%s", e.Op)
+		}
+		c.rhs(e)
 		c.maybewrite(")", parens)
 	}
 }
 
+// rhs formats the right operand of e, which is parenthesized if it is
+// a binary expression of the same precedence since operators of the same
+// precedence associate to the left.
+func (c *canon) rhs(e *ast.BinaryExpr) {
+	if rhs, ok := e.RHS.(*ast.BinaryExpr); ok && precedence(rhs.Op) == precedence(e.Op) && precedence(e.Op) != 100 {
+		c.write("(")
+		c.expr(rhs, "")
+		c.write(")")
+		return
+	}
+	c.expr(e.RHS, e.Op)
+}
+
 func needsparens(parent, op string) bool {
 	return precedence(parent)-precedence(op) < 0
 }
@@ -287,15 +342,148 @@ func precedence(op string) int {
 	}
 }
 
-func (c *canon) sql(e *ast.SQLExpr) {
+// sql formats the SQL query e with the function sep called between clauses.
+func (c *canon) sql(e *ast.SQLExpr, sep func()) {
+	if len(e.With) > 0 {
+		c.write("WITH ")
+		for k, cte := range e.With {
+			if k > 0 {
+				c.write(", ")
+			}
+			c.write("%s AS (", cte.Name)
+			c.sql(cte.Query, c.space)
+			c.write(")")
+		}
+		sep()
+	}
+	c.sqlSelect(e, sep)
+	for _, u := range e.Union {
+		sep()
+		c.write("UNION ALL")
+		sep()
+		c.sqlSelect(u, sep)
+	}
+	if e.OrderBy != nil {
+		sep()
+		c.write("ORDER BY ")
+		c.exprs(e.OrderBy.Keys)
+		c.write(" ")
+		c.write(strings.ToUpper(e.OrderBy.Order.String()))
+	}
+	if e.Limit != 0 {
+		sep()
+		c.write("LIMIT %d", e.Limit)
+	}
+}
+
+func (c *canon) sqlSelect(e *ast.SQLExpr, sep func()) {
+	c.write("SELECT ")
 	if e.Select == nil {
-		c.write(" SELECT *")
-	} else {
-		c.write(" SELECT")
-		c.assignments(e.Select)
+		c.write("*")
+	}
+	for k, a := range e.Select {
+		if k > 0 {
+			c.write(",")
+		}
+		c.expr(a.RHS, "")
+		if a.LHS != nil {
+			c.write(" AS ")
+			c.expr(a.LHS, "")
+		}
+	}
+	if e.From != nil {
+		sep()
+		c.write("FROM ")
+		c.expr(e.From.Table, "")
+		if e.From.Alias != nil {
+			c.write(" AS ")
+			c.expr(e.From.Alias, "")
+		}
+	}
+	for _, join := range e.Joins {
+		sep()
+		switch join.Style {
+		case "left":
+			c.write("LEFT ")
+		case "right":
+			c.write("RIGHT ")
+		}
+		c.write("JOIN ")
+		c.expr(join.Table, "")
+		if join.Alias != nil {
+			c.write(" AS ")
+			c.expr(join.Alias, "")
+		}
+		c.write(" ON ")
+		c.expr(join.LeftKey, "")
+		c.write("=")
+		c.expr(join.RightKey, "")
+	}
+	if e.Where != nil {
+		sep()
+		c.write("WHERE ")
+		c.expr(e.Where, "")
+	}
+	if e.GroupBy != nil {
+		sep()
+		c.write("GROUP BY ")
+		c.exprs(e.GroupBy)
+	}
+	if e.Having != nil {
+		sep()
+		c.write("HAVING ")
+		c.expr(e.Having, "")
+	}
+	if len(e.Windows) > 0 {
+		sep()
+		c.write("WINDOW ")
+		for k := range e.Windows {
+			if k > 0 {
+				c.write(", ")
+			}
+			c.write("%s AS (", e.Windows[k].Name)
+			c.sqlWindow(&e.Windows[k])
+			c.write(")")
+		}
 	}
 }
 
+func (c *canon) sqlWindow(w *ast.SQLWindow) {
+	var sep string
+	if w.Base != "" {
+		c.write(w.Base)
+		sep = " "
+	}
+	if w.PartitionBy != nil {
+		c.write(sep + "PARTITION BY ")
+		c.exprs(w.PartitionBy)
+		sep = " "
+	}
+	if w.OrderBy != nil {
+		c.write(sep + "ORDER BY ")
+		c.exprs(w.OrderBy.Keys)
+		c.write(" %s", strings.ToUpper(w.OrderBy.Order.String()))
+		sep = " "
+	}
+	if f := w.Frame; f != nil {
+		c.write(sep+"%s BETWEEN %s AND %s", strings.ToUpper(f.Units), sqlFrameBound(f.Start), sqlFrameBound(f.End))
+	}
+}
+
+func sqlFrameBound(b ast.SQLFrameBound) string {
+	switch b.Type {
+	case "preceding":
+		return fmt.Sprintf("%d PRECEDING", b.Offset)
+	case "following":
+		return fmt.Sprintf("%d FOLLOWING", b.Offset)
+	}
+	return strings.ToUpper(strings.ReplaceAll(b.Type, "_", " "))
+}
+
+func (c *canon) space() {
+	c.write(" ")
+}
+
 func isThis(e ast.Expr) bool {
 	if id, ok := e.(*ast.ID); ok {
 		return id.Name == "this"
@@ -339,7 +527,7 @@ func (c *canon) decl(d ast.Decl) {
 		}
 		c.open("): (")
 		c.ret()
-		c.expr(d.Expr, d.Name)
+		c.expr(d.Expr, "")
 		c.close()
 		c.ret()
 		c.flush()
@@ -430,66 +618,28 @@ func (c *canon) proc(p ast.Op) {
 		c.write(")")
 	case *ast.SQLExpr:
 		c.next()
-		c.open("SELECT ")
-		if p.Select == nil {
-			c.write("*")
-		} else {
-			c.assignments(p.Select)
-		}
-		if p.From != nil {
-			c.ret()
-			c.write("FROM ")
-			c.expr(p.From.Table, "")
-			if p.From.Alias != nil {
-				c.write(" AS ")
-				c.expr(p.From.Alias, "")
-			}
-		}
-		for _, join := range p.Joins {
-			c.ret()
-			switch join.Style {
-			case "left":
-				c.write("LEFT ")
-			case "right":
-				c.write("RIGHT ")
-			}
-			c.write("JOIN ")
-			c.expr(join.Table, "")
-			if join.Alias != nil {
-				c.write(" AS ")
-				c.expr(join.Alias, "")
-			}
-			c.write(" ON ")
-			c.expr(join.LeftKey, "")
-			c.write("=")
-			c.expr(join.RightKey, "")
-		}
-		if p.Where != nil {
-			c.ret()
-			c.write("WHERE ")
-			c.expr(p.Where, "")
-		}
-		if p.GroupBy != nil {
-			c.ret()
-			c.write("GROUP BY ")
-			c.exprs(p.GroupBy)
-		}
-		if p.Having != nil {
-			c.ret()
-			c.write("HAVING ")
-			c.expr(p.Having, "")
-		}
-		if p.OrderBy != nil {
-			c.ret()
-			c.write("ORDER BY ")
-			c.exprs(p.OrderBy.Keys)
-			c.write(" ")
-			c.write(strings.ToUpper(p.OrderBy.Order.String()))
-		}
-		if p.Limit != 0 {
-			c.ret()
-			c.write("LIMIT %d", p.Limit)
-		}
+		c.open()
+		c.sql(p, c.ret)
+		c.close()
+	case *ast.CreatePool:
+		c.write("CREATE POOL %s", poolName(p.Name))
+		if p.Layout != nil {
+			c.write(" ORDER BY ")
+			c.exprs(p.Layout.Keys)
+			c.write(" %s", strings.ToUpper(p.Layout.Order))
+		}
+	case *ast.DropPool:
+		c.write("DROP POOL %s", poolName(p.Name))
+	case *ast.CreateBranch:
+		c.write("CREATE BRANCH %s FROM %s", poolName(p.Name), commitish(p.Pool, p.From))
+	case *ast.InsertInto:
+		c.write("INSERT INTO %s", commitish(p.Pool, p.Branch))
+		c.ret()
+		c.flush()
+		c.proc(p.Query)
+	case *ast.DeleteFrom:
+		c.write("DELETE FROM %s WHERE ", commitish(p.Pool, p.Branch))
+		c.expr(p.Where, "")
 	case *ast.Summarize:
 		c.next()
 		c.open("summarize")
@@ -529,9 +679,17 @@ func (c *canon) proc(p ast.Op) {
 	case *ast.Head:
 		c.next()
 		c.write("head %d", p.Count)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *ast.Tail:
 		c.next()
 		c.write("tail %d", p.Count)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *ast.Uniq:
 		c.next()
 		c.write("uniq")
@@ -573,6 +731,42 @@ func (c *canon) proc(p ast.Op) {
 		c.next()
 		c.write("top limit=%d flush=%t ", p.Limit, p.Flush)
 		c.exprs(p.Args)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+	case *ast.Output:
+		c.next()
+		c.write("output %s", p.Name)
+	case *ast.Try:
+		c.next()
+		c.open("try (")
+		c.open()
+		c.head = true
+		c.proc(p.Body)
+		c.close()
+		c.close()
+		c.ret()
+		c.flush()
+		c.open(") catch (")
+		c.open()
+		c.head = true
+		c.proc(p.Catch)
+		c.close()
+		c.close()
+		c.ret()
+		c.flush()
+		c.write(")")
+	case *ast.Sample:
+		c.next()
+		c.write("sample %d", p.Limit)
+		if p.Seed != nil {
+			c.write(" -seed %d", *p.Seed)
+		}
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *ast.Put:
 		c.next()
 		c.write("put ")
@@ -620,12 +814,40 @@ func (c *canon) proc(p ast.Op) {
 		c.next()
 		c.write("yield ")
 		c.exprs(p.Exprs)
+	case *ast.Pivot:
+		c.next()
+		c.write("pivot ")
+		c.expr(p.Agg, "")
+		c.write(" for ")
+		c.expr(p.Column, "")
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.assignments(p.Keys)
+		}
+	case *ast.Unpivot:
+		c.next()
+		c.write("unpivot ")
+		c.exprs(p.Args)
+		if p.Name != nil {
+			c.write(" as ")
+			c.expr(p.Name, "")
+			c.write(", ")
+			c.expr(p.Value, "")
+		}
 	default:
 		c.open("unknown proc: %T", p)
 		c.close()
 	}
 }
 
+// commitish formats a pool name followed by an optional branch or commit.
+func commitish(pool, branch string) string {
+	if branch != "" {
+		return poolName(pool) + "@" + poolCommit(branch)
+	}
+	return poolName(pool)
+}
+
 func (c *canon) pool(p *ast.Pool) {
 	//XXX TBD name, from, to, id etc
 	var s string
@@ -754,6 +976,24 @@ func (c *canon) source(src ast.Source) {
 	}
 }
 
+// globPattern returns the source form of a glob pattern, restoring the
+// escapes the parser removed.
+func globPattern(pattern string) string {
+	var b strings.Builder
+	for _, r := range pattern {
+		if strings.ContainsRune("=+-", r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isPrimitive(t astzed.Type) bool {
+	_, ok := t.(*astzed.TypePrimitive)
+	return ok
+}
+
 func isTrue(e ast.Expr) bool {
 	if p, ok := e.(*astzed.Primitive); ok {
 		return p.Type == "bool" && p.Text == "true"
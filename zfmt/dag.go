@@ -2,11 +2,15 @@ package zfmt
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/brimdata/zed/compiler/ast/dag"
 	astzed "github.com/brimdata/zed/compiler/ast/zed"
 	"github.com/brimdata/zed/compiler/kernel"
 	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zson"
 )
 
 func DAG(op dag.Op) string {
@@ -20,6 +24,20 @@ func DAG(op dag.Op) string {
 	return d.String()
 }
 
+// Explain is like DAG but annotates each operator and data source with
+// the runtime statistics collected in stats.
+func Explain(op dag.Op, stats *kernel.Stats) string {
+	d := &canonDAG{
+		canonZed: canonZed{formatter: formatter{tab: 2}},
+		head:     true,
+		first:    true,
+		stats:    stats,
+	}
+	d.op(op)
+	d.flush()
+	return d.String()
+}
+
 func DAGExpr(e dag.Expr) string {
 	d := &canonDAG{
 		canonZed: canonZed{formatter: formatter{tab: 2}},
@@ -35,6 +53,7 @@ type canonDAG struct {
 	canonZed
 	head  bool
 	first bool
+	stats *kernel.Stats
 }
 
 func (c *canonDAG) open(args ...interface{}) {
@@ -73,6 +92,9 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 		c.write("null")
 	case *dag.Agg:
 		c.write("%s(", e.Name)
+		if e.Distinct {
+			c.write("distinct ")
+		}
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
@@ -183,6 +205,15 @@ func (c *canonDAG) next() {
 }
 
 func (c *canonDAG) op(p dag.Op) {
+	c.opOnly(p)
+	if c.stats != nil {
+		if stats, ok := c.stats.Op(p); ok {
+			c.write("  // %s", formatStats(stats))
+		}
+	}
+}
+
+func (c *canonDAG) opOnly(p dag.Op) {
 	switch p := p.(type) {
 	case *dag.Sequential:
 		if p == nil {
@@ -256,7 +287,7 @@ func (c *canonDAG) op(p dag.Op) {
 	case *dag.Merge:
 		c.next()
 		c.write("merge ")
-		c.expr(p.Expr, "")
+		c.exprs(p.Exprs)
 		c.write(":" + p.Order.String())
 	case *dag.Summarize:
 		c.next()
@@ -306,9 +337,17 @@ func (c *canonDAG) op(p dag.Op) {
 	case *dag.Head:
 		c.next()
 		c.write("head %d", p.Count)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *dag.Tail:
 		c.next()
 		c.write("tail %d", p.Count)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *dag.Uniq:
 		c.next()
 		c.write("uniq")
@@ -331,6 +370,49 @@ func (c *canonDAG) op(p dag.Op) {
 		c.next()
 		c.write("top limit=%d flush=%t ", p.Limit, p.Flush)
 		c.exprs(p.Args)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+	case *dag.Output:
+		c.next()
+		c.write("output %s", p.Name)
+	case *dag.Try:
+		c.next()
+		c.open("try (")
+		c.open()
+		c.head = true
+		c.op(p.Body)
+		c.close()
+		c.close()
+		c.ret()
+		c.flush()
+		c.open(") catch (")
+		c.open()
+		c.head = true
+		c.op(p.Catch)
+		c.close()
+		c.close()
+		c.ret()
+		c.flush()
+		c.write(")")
+	case *dag.Sample:
+		c.next()
+		c.write("sample")
+		if p.PartialsIn {
+			c.write(" partials-in")
+		}
+		if p.PartialsOut {
+			c.write(" partials-out")
+		}
+		c.write(" %d", p.Limit)
+		if p.Seed != nil {
+			c.write(" -seed %d", *p.Seed)
+		}
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *dag.Put:
 		c.next()
 		c.write("put ")
@@ -357,7 +439,8 @@ func (c *canonDAG) op(p dag.Op) {
 		// XXX cleanup for single trunk
 		c.next()
 		c.open("from (")
-		for _, trunk := range p.Trunks {
+		for k := range p.Trunks {
+			trunk := &p.Trunks[k]
 			c.ret()
 			if trunk.Pushdown != nil {
 				c.head = true
@@ -367,7 +450,24 @@ func (c *canonDAG) op(p dag.Op) {
 				c.close()
 				c.ret()
 			}
+			if trunk.Projection != nil {
+				names := make([]string, 0, len(trunk.Projection))
+				for _, name := range trunk.Projection {
+					names = append(names, zson.QuotedName(name))
+				}
+				if len(names) == 0 {
+					c.write("(projection)")
+				} else {
+					c.write("(projection %s)", strings.Join(names, ","))
+				}
+				c.ret()
+			}
 			c.write("%s", source(trunk.Source))
+			if c.stats != nil {
+				if stats, ok := c.stats.Source(trunk); ok {
+					c.write("  // %s", formatSourceStats(stats))
+				}
+			}
 			if trunk.Seq != nil && len(trunk.Seq.Ops) != 0 {
 				c.open()
 				c.head = true
@@ -387,6 +487,47 @@ func (c *canonDAG) op(p dag.Op) {
 		c.next()
 		c.write("yield ")
 		c.exprs(p.Exprs)
+	case *dag.Pivot:
+		c.next()
+		c.write("pivot ")
+		c.expr(p.Agg, "")
+		c.write(" for ")
+		c.expr(p.Column, "")
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.assignments(p.Keys)
+		}
+	case *dag.Window:
+		c.next()
+		c.write("window ")
+		for k, f := range p.Funcs {
+			if k > 0 {
+				c.write(", ")
+			}
+			c.expr(f.LHS, "")
+			c.write(":=%s(", f.Name)
+			c.exprs(f.Args)
+			c.write(") over %s from %s to %s", f.Frame.Units, windowBound(f.Frame.Start), windowBound(f.Frame.End))
+		}
+		if len(p.PartitionBy) != 0 {
+			c.write(" partition by ")
+			c.exprs(p.PartitionBy)
+		}
+		if len(p.OrderBy) != 0 {
+			c.write(" order by ")
+			c.exprs(p.OrderBy)
+			c.write(" %s", p.Order)
+		}
+	case *dag.Unpivot:
+		c.next()
+		c.write("unpivot ")
+		c.exprs(p.Args)
+		if p.Name != nil {
+			c.write(" as ")
+			c.expr(p.Name, "")
+			c.write(", ")
+			c.expr(p.Value, "")
+		}
 	default:
 		c.open("unknown proc: %T", p)
 		c.close()
@@ -424,9 +565,39 @@ func source(src dag.Source) string {
 	}
 }
 
+func formatStats(stats op.Stats) string {
+	s := fmt.Sprintf("in=%d out=%d wall=%s", stats.ValuesIn, stats.ValuesOut, stats.Wall.Round(time.Microsecond))
+	if stats.BytesSpilled != 0 {
+		s += fmt.Sprintf(" spilled=%d", stats.BytesSpilled)
+	}
+	if stats.PeakMemory != 0 {
+		s += fmt.Sprintf(" peak=%d", stats.PeakMemory)
+	}
+	return s
+}
+
+func formatSourceStats(stats kernel.SourceStats) string {
+	s := []string{fmt.Sprintf("out=%d wall=%s", stats.ValuesOut, stats.Wall.Round(time.Microsecond))}
+	if stats.ObjectsTotal != 0 {
+		s = append(s, fmt.Sprintf("objects=%d pruned=%d", stats.ObjectsTotal, stats.ObjectsPruned))
+	}
+	if stats.Seeks != 0 {
+		s = append(s, fmt.Sprintf("seeks=%d skipped=%d", stats.Seeks, stats.BytesSkipped))
+	}
+	return strings.Join(s, " ")
+}
+
 func isDAGTrue(e dag.Expr) bool {
 	if p, ok := e.(*astzed.Primitive); ok {
 		return p.Type == "bool" && p.Text == "true"
 	}
 	return false
 }
+
+func windowBound(b dag.WindowBound) string {
+	switch b.Type {
+	case "preceding", "following":
+		return fmt.Sprintf("%d %s", b.Offset, b.Type)
+	}
+	return strings.ReplaceAll(b.Type, "_", " ")
+}
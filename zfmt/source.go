@@ -0,0 +1,846 @@
+package zfmt
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/brimdata/zed/compiler/ast"
+	astzed "github.com/brimdata/zed/compiler/ast/zed"
+	"github.com/brimdata/zed/compiler/parser"
+	"github.com/brimdata/zed/lakeparse"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/zson"
+)
+
+// Format returns the Zed source src reformatted with one operator per line,
+// the pipelines nested in an operator indented beneath it, the cases of
+// a switch aligned, and the comments of src kept.  Formatting the output of
+// Format leaves it unchanged.
+func Format(src string) (string, error) {
+	raw, err := parser.Parse("", []byte(src), parser.Locations())
+	if err != nil {
+		if comments, ok := onlyComments(src); ok {
+			return comments, nil
+		}
+		return "", parser.ImproveError(err, src, nil)
+	}
+	seq, err := ast.UnpackMapAsOp(raw)
+	if err != nil {
+		return "", err
+	}
+	f := &srcFormatter{canon: canon{canonZed: canonZed{formatter{tab: 2}}}}
+	f.over = f.overExpr
+	f.anchors = anchors(raw)
+	f.attach(findComments(src, raw))
+	switch p := seq.(type) {
+	case *ast.Sequential:
+		f.program(p)
+	case *ast.InsertInto:
+		f.write("INSERT INTO %s", commitish(p.Pool, p.Branch))
+		f.newline()
+		f.program(p.Query)
+	default:
+		f.proc(p)
+	}
+	f.finish()
+	return f.String(), nil
+}
+
+// srcFormatter formats the AST of a Zed program.  Comments are attached to anchors,
+// which are the operators, declarations, fork legs, switch cases, and from
+// trunks of the program in source order.  Since srcFormatter visits them in the
+// same order, the comments of each anchor are formatted with it.
+type srcFormatter struct {
+	canon
+	anchors []*anchor
+	next    int
+	// pending holds the comments that end the current line.
+	pending []string
+	// trailing holds the comments that follow the program.
+	trailing []string
+}
+
+type anchor struct {
+	first    int
+	last     int
+	kind     string
+	leading  []string
+	trailing []string
+}
+
+type comment struct {
+	offset  int
+	text    string
+	ownLine bool
+}
+
+// anchors returns the anchors of the raw AST v sorted by location.
+func anchors(v interface{}) []*anchor {
+	var out []*anchor
+	var walk func(interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if loc, ok := v["loc"].(map[string]interface{}); ok {
+				first, _ := loc["first"].(int)
+				last, _ := loc["last"].(int)
+				kind, _ := v["kind"].(string)
+				out = append(out, &anchor{first: first, last: last, kind: kind})
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(v)
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].first < out[j].first
+	})
+	return out
+}
+
+// findComments returns the comments in src, whose raw AST is v.  Since the
+// characters "//" may also appear in strings, regular expressions, and
+// paths, each candidate is a comment only if blanking it out leaves the AST
+// unchanged.
+func findComments(src string, v interface{}) []comment {
+	want, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var comments []comment
+	blanked := []byte(src)
+	for off := 0; off < len(src); {
+		k := strings.Index(src[off:], "//")
+		if k < 0 {
+			break
+		}
+		start := off + k
+		end := len(src)
+		if n := strings.IndexByte(src[start:], '\n'); n >= 0 {
+			end = start + n
+		}
+		candidate := append([]byte(nil), blanked...)
+		for i := start; i < end; i++ {
+			candidate[i] = ' '
+		}
+		if raw, err := parser.Parse("", candidate, parser.Locations()); err == nil {
+			if got, err := json.Marshal(raw); err == nil && string(got) == string(want) {
+				lineStart := strings.LastIndexByte(src[:start], '\n') + 1
+				comments = append(comments, comment{
+					offset:  start,
+					text:    strings.TrimRight(src[start:end], " \t\r"),
+					ownLine: strings.TrimSpace(src[lineStart:start]) == "",
+				})
+				blanked = candidate
+				off = end
+				continue
+			}
+		}
+		off = start + 2
+	}
+	return comments
+}
+
+var commentLine = regexp.MustCompile(`^\s*(//.*)?$`)
+
+// onlyComments returns the comments of src and true if src has nothing
+// but comments and white space.
+func onlyComments(src string) (string, bool) {
+	var b strings.Builder
+	for _, line := range strings.Split(src, "\n") {
+		if !commentLine.MatchString(line) {
+			return "", false
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String(), true
+}
+
+// attach attaches each comment that ends a line of code to the anchor that
+// precedes it and each comment on a line of its own to the anchor that
+// follows it.  A comment on a line of its own after the last anchor nested
+// in an anchor, e.g., between the assignments of a put operator, ends the
+// first line of that anchor.
+func (f *srcFormatter) attach(comments []comment) {
+	for _, c := range comments {
+		k := sort.Search(len(f.anchors), func(i int) bool {
+			return f.anchors[i].first > c.offset
+		})
+		var enclosing *anchor
+		for _, a := range f.anchors[:k] {
+			if a.last > c.offset {
+				enclosing = a
+			}
+		}
+		switch {
+		case !c.ownLine && k > 0:
+			f.anchors[k-1].trailing = append(f.anchors[k-1].trailing, c.text)
+		case enclosing != nil && (k == len(f.anchors) || f.anchors[k].first > enclosing.last):
+			enclosing.trailing = append(enclosing.trailing, c.text)
+		case k < len(f.anchors):
+			f.anchors[k].leading = append(f.anchors[k].leading, c.text)
+		default:
+			f.trailing = append(f.trailing, c.text)
+		}
+	}
+}
+
+// anchor formats the comments of the next anchor.  Its leading comments
+// precede it on lines of their own unless it begins in the middle of a line,
+// in which case they end the line along with its trailing comments.
+func (f *srcFormatter) anchor() {
+	if f.next >= len(f.anchors) {
+		return
+	}
+	a := f.anchors[f.next]
+	f.next++
+	if f.atLineStart() {
+		for _, c := range a.leading {
+			f.write(c)
+			f.newline()
+		}
+	} else {
+		f.pending = append(f.pending, a.leading...)
+	}
+	f.pending = append(f.pending, a.trailing...)
+}
+
+// peek returns the kind of the next anchor.
+func (f *srcFormatter) peek() string {
+	if f.next < len(f.anchors) {
+		return f.anchors[f.next].kind
+	}
+	return ""
+}
+
+func (f *srcFormatter) finish() {
+	f.flushPending()
+	f.WriteByte('\n')
+	// The comments of any anchors not visited follow the program.
+	for _, a := range f.anchors[f.next:] {
+		f.trailing = append(append(a.leading, a.trailing...), f.trailing...)
+	}
+	for _, c := range f.trailing {
+		f.WriteString(c)
+		f.WriteByte('\n')
+	}
+}
+
+// flushPending ends the current line with the pending comments.
+func (f *srcFormatter) flushPending() {
+	if len(f.pending) > 0 {
+		f.write(" " + strings.Join(f.pending, " "))
+		f.pending = nil
+	}
+}
+
+// newline ends the current line with any pending comments and begins a new
+// line at the current indentation.
+func (f *srcFormatter) newline() {
+	f.flushPending()
+	f.WriteByte('\n')
+	f.WriteString(strings.Repeat(" ", f.indent))
+}
+
+func (f *srcFormatter) line() string {
+	s := f.String()
+	return s[strings.LastIndexByte(s, '\n')+1:]
+}
+
+// column returns the column of the end of the output.
+func (f *srcFormatter) column() int {
+	return len(f.line())
+}
+
+func (f *srcFormatter) atLineStart() bool {
+	return strings.TrimLeft(f.line(), " ") == ""
+}
+
+// at calls fn with the indentation set to col.
+func (f *srcFormatter) at(col int, fn func()) {
+	indent := f.indent
+	f.indent = col
+	fn()
+	f.indent = indent
+}
+
+// close begins a new line at col with a closing parenthesis.
+func (f *srcFormatter) close(col int) {
+	f.at(col, f.newline)
+	f.write(")")
+}
+
+func (f *srcFormatter) program(seq *ast.Sequential) {
+	f.decls(seq.Decls)
+	if len(seq.Decls) > 0 {
+		f.newline()
+	}
+	f.ops(seq.Ops)
+}
+
+func (f *srcFormatter) decls(decls []ast.Decl) {
+	for _, d := range decls {
+		f.anchor()
+		switch d := d.(type) {
+		case *ast.ConstDecl:
+			if typ, ok := d.Expr.(*astzed.TypeValue); ok {
+				if def, ok := typ.Value.(*astzed.TypeDef); ok && def.Name == d.Name {
+					f.write("type %s = ", zson.QuotedName(d.Name))
+					f.typ(def.Type)
+					break
+				}
+			}
+			f.write("const %s = ", d.Name)
+			f.expr(d.Expr, "")
+		case *ast.FuncDecl:
+			f.write("func %s(%s): (", d.Name, strings.Join(d.Params, ", "))
+			f.expr(d.Expr, "")
+			f.write(")")
+		}
+		f.newline()
+	}
+}
+
+// seq formats a nested pipeline beginning at the current column.
+func (f *srcFormatter) seq(op ast.Op) {
+	seq, ok := op.(*ast.Sequential)
+	if !ok {
+		f.op(op)
+		return
+	}
+	f.at(f.column(), func() {
+		f.decls(seq.Decls)
+		f.ops(seq.Ops)
+	})
+}
+
+func (f *srcFormatter) ops(ops []ast.Op) {
+	for k, op := range ops {
+		if k > 0 {
+			f.newline()
+		}
+		f.anchor()
+		if k > 0 {
+			f.write("| ")
+		}
+		if seq, ok := op.(*ast.Sequential); ok {
+			// The parser expands some operators into a
+			// sequence of operators.
+			if e, ok := sampleExpr(seq); ok {
+				f.write("sample")
+				if !isThis(e) {
+					f.write(" ")
+					f.expr(e, "")
+				}
+				continue
+			}
+			for k, op := range seq.Ops {
+				if k > 0 {
+					f.newline()
+					f.write("| ")
+				}
+				f.op(op)
+			}
+			continue
+		}
+		f.op(op)
+	}
+}
+
+func (f *srcFormatter) op(op ast.Op) {
+	base := f.column()
+	switch op := op.(type) {
+	case *ast.Parallel:
+		f.write("fork (")
+		f.at(base+2, func() {
+			for _, leg := range op.Ops {
+				f.newline()
+				f.anchor()
+				f.write("=> ")
+				f.seq(leg)
+			}
+		})
+		f.close(base)
+	case *ast.Switch:
+		f.write("switch ")
+		if op.Expr != nil {
+			f.expr(op.Expr, "")
+			f.write(" ")
+		}
+		f.write("(")
+		var width int
+		labels := make([]string, 0, len(op.Cases))
+		for _, c := range op.Cases {
+			label := caseLabel(c.Expr)
+			labels = append(labels, label)
+			if len(label) > width {
+				width = len(label)
+			}
+		}
+		f.at(base+2, func() {
+			for k, c := range op.Cases {
+				f.newline()
+				f.anchor()
+				if c.Expr != nil {
+					f.write("case ")
+					f.expr(c.Expr, "")
+				} else {
+					f.write("default")
+				}
+				f.write("%s => ", strings.Repeat(" ", width-len(labels[k])))
+				f.seq(c.Op)
+			}
+		})
+		f.close(base)
+	case *ast.From:
+		if len(op.Trunks) == 1 && op.Trunks[0].Seq == nil && f.peek() != "Trunk" {
+			if f.shorthand(op.Trunks[0].Source) {
+				break
+			}
+		}
+		f.write("from (")
+		f.at(base+2, func() {
+			for _, trunk := range op.Trunks {
+				f.newline()
+				f.anchor()
+				f.source(trunk.Source)
+				if trunk.Seq != nil {
+					f.write(" => ")
+					f.seq(trunk.Seq)
+				}
+			}
+		})
+		f.close(base)
+	case *ast.Try:
+		f.write("try (")
+		f.block(base, op.Body)
+		f.write(" catch (")
+		f.block(base, op.Catch)
+	case *ast.Over:
+		f.write("over ")
+		f.exprs(op.Exprs)
+		f.scope(base, op.Scope)
+	case *ast.Let:
+		f.write("over ")
+		f.exprs(op.Over.Exprs)
+		f.write(" with ")
+		f.defs(op.Locals, ", ")
+		f.scope(base, op.Over.Scope)
+	case *ast.Summarize:
+		f.write("summarize")
+		if len(op.Aggs) > 0 {
+			f.write(" ")
+			f.assignments(op.Aggs)
+		}
+		if len(op.Keys) > 0 {
+			f.write(" by ")
+			f.assignments(op.Keys)
+		}
+		if op.Limit != 0 {
+			f.write(" with -limit %d", op.Limit)
+		}
+	case *ast.OpAssignment:
+		f.assignments(op.Assignments)
+	case *ast.OpExpr:
+		f.search(op.Expr)
+	case *ast.Search:
+		f.write("search ")
+		f.search(op.Expr)
+	case *ast.Where:
+		f.write("where ")
+		f.expr(op.Expr, "")
+	case *ast.Yield:
+		if text, ok := assertion(op); ok {
+			f.write("assert %s", text)
+			break
+		}
+		f.write("yield ")
+		f.exprs(op.Exprs)
+	case *ast.Cut:
+		f.write("cut ")
+		f.assignments(op.Args)
+	case *ast.Drop:
+		f.write("drop ")
+		f.exprs(op.Args)
+	case *ast.Put:
+		f.write("put ")
+		f.assignments(op.Args)
+	case *ast.Rename:
+		f.write("rename ")
+		f.assignments(op.Args)
+	case *ast.Sort:
+		f.write("sort")
+		if op.Order == order.Desc {
+			f.write(" -r")
+		}
+		if op.NullsFirst {
+			f.write(" -nulls first")
+		}
+		if len(op.Args) > 0 {
+			f.write(" ")
+			f.exprs(op.Args)
+		}
+	case *ast.Top:
+		f.write("top")
+		if op.Limit != 0 {
+			f.write(" %d", op.Limit)
+		}
+		if op.Flush {
+			f.write(" -flush")
+		}
+		if len(op.Args) > 0 {
+			f.write(" ")
+			f.exprs(op.Args)
+		}
+		f.by(op.Keys)
+	case *ast.Head:
+		f.write("head %d", op.Count)
+		f.by(op.Keys)
+	case *ast.Tail:
+		f.write("tail %d", op.Count)
+		f.by(op.Keys)
+	case *ast.Uniq:
+		f.write("uniq")
+		if op.Cflag {
+			f.write(" -c")
+		}
+	case *ast.Pass:
+		f.write("pass")
+	case *ast.Fuse:
+		f.write("fuse")
+	case *ast.Shape:
+		f.write("shape")
+	case *ast.Join:
+		if op.Style != "inner" {
+			f.write("%s ", op.Style)
+		}
+		f.write("join on ")
+		f.joinKey(op.LeftKey)
+		if !reflect.DeepEqual(op.LeftKey, op.RightKey) {
+			f.write("=")
+			f.joinKey(op.RightKey)
+		}
+		if len(op.Args) > 0 {
+			f.write(" ")
+			f.assignments(op.Args)
+		}
+	case *ast.Sample:
+		f.write("sample %d", op.Limit)
+		if op.Seed != nil {
+			f.write(" -seed %d", *op.Seed)
+		}
+		f.by(op.Keys)
+	case *ast.Explode:
+		f.write("explode ")
+		f.exprs(op.Args)
+		f.write(" by ")
+		f.typ(op.Type)
+		if op.As != nil {
+			f.write(" as ")
+			f.expr(op.As, "")
+		}
+	case *ast.Merge:
+		f.write("merge ")
+		f.expr(op.Expr, "")
+	case *ast.Pivot:
+		f.write("pivot ")
+		f.expr(op.Agg, "")
+		f.write(" for ")
+		f.expr(op.Column, "")
+		if len(op.Keys) > 0 {
+			f.write(" by ")
+			f.assignments(op.Keys)
+		}
+	case *ast.Unpivot:
+		f.write("unpivot ")
+		f.exprs(op.Args)
+		if op.Name != nil {
+			f.write(" as ")
+			f.expr(op.Name, "")
+			f.write(", ")
+			f.expr(op.Value, "")
+		}
+	case *ast.Output:
+		f.write("output %s", op.Name)
+	case *ast.SQLExpr:
+		f.sql(op, f.space)
+	default:
+		f.write("(unknown operator %T)", op)
+	}
+}
+
+// search formats a search expression, in which "*" matches everything.
+func (f *srcFormatter) search(e ast.Expr) {
+	if isTrue(e) {
+		f.write("*")
+		return
+	}
+	f.expr(e, "")
+}
+
+// block formats seq on the lines following an opening parenthesis indented
+// beyond the column base of its operator.
+func (f *srcFormatter) block(base int, seq *ast.Sequential) {
+	f.at(base+2, func() {
+		f.newline()
+		f.seq(seq)
+	})
+	f.close(base)
+}
+
+func (f *srcFormatter) scope(base int, seq *ast.Sequential) {
+	if seq != nil {
+		f.write(" => (")
+		f.block(base, seq)
+	}
+}
+
+func (f *srcFormatter) by(keys []ast.Expr) {
+	if len(keys) > 0 {
+		f.write(" by ")
+		f.exprs(keys)
+	}
+}
+
+func (f *srcFormatter) assignments(assignments []ast.Assignment) {
+	for k, a := range assignments {
+		if k > 0 {
+			f.write(", ")
+		}
+		f.assignment(a)
+	}
+}
+
+// joinKey formats a join key, which must be parenthesized unless it is
+// a field reference.
+func (f *srcFormatter) joinKey(e ast.Expr) {
+	parens := !isLval(e)
+	f.maybewrite("(", parens)
+	f.expr(e, "")
+	f.maybewrite(")", parens)
+}
+
+func isLval(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.ID:
+		return true
+	case *ast.BinaryExpr:
+		return (e.Op == "." || e.Op == "[") && isLval(e.LHS)
+	}
+	return false
+}
+
+// overExpr formats an over expression on a single line.
+func (f *srcFormatter) overExpr(e *ast.OverExpr) {
+	f.write("(over ")
+	f.exprs(e.Exprs)
+	if len(e.Locals) > 0 {
+		f.write(" with ")
+		f.defs(e.Locals, ", ")
+	}
+	for _, op := range e.Scope.Ops {
+		f.write(" | ")
+		f.anchor()
+		f.op(op)
+	}
+	f.write(")")
+}
+
+// shorthand formats a from operator with a single source using the syntax
+// without parentheses and returns true if there is such syntax for src.
+func (f *srcFormatter) shorthand(src ast.Source) bool {
+	switch src := src.(type) {
+	case *ast.Pool:
+		f.write("from ")
+		f.poolBody(src)
+	case *ast.File, *ast.HTTP:
+		f.source(src)
+	default:
+		return false
+	}
+	return true
+}
+
+func (f *srcFormatter) source(src ast.Source) {
+	switch src := src.(type) {
+	case *ast.Pool:
+		f.write("pool ")
+		f.poolBody(src)
+	case *ast.File:
+		f.write("file %s", path(src.Path))
+		f.formatArgs(src.Format, src.Layout)
+	case *ast.HTTP:
+		f.write("get %s", src.URL)
+		f.formatArgs(src.Format, src.Layout)
+	case *ast.Pass:
+		f.write("pass")
+	default:
+		f.write("(unknown source %T)", src)
+	}
+}
+
+func (f *srcFormatter) poolBody(p *ast.Pool) {
+	switch pool := p.Spec.Pool.(type) {
+	case *ast.Glob:
+		f.write(pool.Pattern)
+	case *ast.Regexp:
+		f.write("/%s/", pool.Pattern)
+	case *ast.String:
+		f.write(poolName(pool.Text))
+	}
+	if p.Spec.Commit != "" {
+		f.write("@%s", poolCommit(p.Spec.Commit))
+	}
+	if p.Spec.Meta != "" {
+		f.write(":%s", p.Spec.Meta)
+	}
+	if p.At != "" {
+		f.write(" at %s", p.At)
+	}
+	if p.Range != nil {
+		f.write(" range ")
+		f.expr(p.Range.Lower, "")
+		f.write(" to ")
+		f.expr(p.Range.Upper, "")
+	}
+	if p.ScanOrder != "" {
+		f.write(" order %s", p.ScanOrder)
+	}
+}
+
+func (f *srcFormatter) formatArgs(format string, layout *ast.Layout) {
+	if format != "" {
+		f.write(" format %s", format)
+	}
+	if layout != nil {
+		f.write(" order ")
+		f.exprs(layout.Keys)
+		if layout.Order == "desc" {
+			f.write(":desc")
+		}
+	}
+}
+
+// caseLabel returns the formatted label of a switch case whose expression
+// is e.
+func caseLabel(e ast.Expr) string {
+	if e == nil {
+		return "default"
+	}
+	f := &srcFormatter{canon: canon{canonZed: canonZed{formatter{tab: 2}}}}
+	f.over = f.overExpr
+	f.write("case ")
+	f.expr(e, "")
+	return f.String()
+}
+
+// sampleExpr returns the expression of a sample operator if seq is the
+// expansion of one.
+func sampleExpr(seq *ast.Sequential) (ast.Expr, bool) {
+	if len(seq.Ops) != 2 {
+		return nil, false
+	}
+	sum, ok := seq.Ops[0].(*ast.Summarize)
+	if !ok || len(sum.Keys) != 1 || len(sum.Aggs) != 1 || sum.Limit != 0 {
+		return nil, false
+	}
+	if !isID(sum.Aggs[0].LHS, "sample") || !isID(sum.Keys[0].LHS, "shape") {
+		return nil, false
+	}
+	if call, ok := sum.Keys[0].RHS.(*ast.Call); !ok || call.Name != "typeof" {
+		return nil, false
+	}
+	agg, ok := sum.Aggs[0].RHS.(*ast.Agg)
+	if !ok || agg.Name != "any" || agg.Where != nil {
+		return nil, false
+	}
+	y, ok := seq.Ops[1].(*ast.Yield)
+	if !ok || len(y.Exprs) != 1 || !isID(y.Exprs[0], "sample") {
+		return nil, false
+	}
+	return agg.Expr, true
+}
+
+func isID(e ast.Expr, name string) bool {
+	id, ok := e.(*ast.ID)
+	return ok && id.Name == name
+}
+
+// assertion returns the text of the expression of an assert operator if
+// y is the expansion of one.
+func assertion(y *ast.Yield) (string, bool) {
+	if len(y.Exprs) != 1 {
+		return "", false
+	}
+	cond, ok := y.Exprs[0].(*ast.Conditional)
+	if !ok {
+		return "", false
+	}
+	call, ok := cond.Else.(*ast.Call)
+	if !ok || call.Name != "error" || len(call.Args) != 1 {
+		return "", false
+	}
+	rec, ok := call.Args[0].(*ast.RecordExpr)
+	if !ok || len(rec.Elems) != 3 {
+		return "", false
+	}
+	message, ok := rec.Elems[0].(*ast.Field)
+	if !ok || message.Name != "message" {
+		return "", false
+	}
+	if s, ok := message.Value.(*astzed.Primitive); !ok || s.Text != "assertion failed" {
+		return "", false
+	}
+	expr, ok := rec.Elems[1].(*ast.Field)
+	if !ok || expr.Name != "expr" {
+		return "", false
+	}
+	text, ok := expr.Value.(*astzed.Primitive)
+	if !ok || text.Type != "string" {
+		return "", false
+	}
+	return text.Text, true
+}
+
+var unquotedPath = regexp.MustCompile(`^[0-9a-zA-Z!@$%^&*()_=<>,./?:[\]{}~|+-]+$`)
+
+func path(s string) string {
+	if unquotedPath.MatchString(s) {
+		return s
+	}
+	return zson.QuotedString([]byte(s))
+}
+
+var unquotedPoolName = regexp.MustCompile(`^([A-Za-z_$.][A-Za-z0-9_$.]*|[0-9A-Za-z]+)$`)
+
+// poolCommit formats the commit of a pool, which is a branch name, a commit
+// ID, or a time that may follow a branch name, e.g., "main@-24h".
+func poolCommit(s string) string {
+	if _, ok := lakeparse.ParseTime(s); ok {
+		return s
+	}
+	if i := strings.LastIndexByte(s, '@'); i > -1 {
+		if _, ok := lakeparse.ParseTime(s[i+1:]); ok {
+			return poolName(s[:i]) + "@" + s[i+1:]
+		}
+	}
+	return poolName(s)
+}
+
+func poolName(s string) string {
+	if unquotedPoolName.MatchString(s) {
+		return s
+	}
+	return zson.QuotedString([]byte(s))
+}
@@ -0,0 +1,104 @@
+package zfmt_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/zed/compiler"
+	"github.com/brimdata/zed/zfmt"
+	"github.com/brimdata/zed/ztest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchForZed(t *testing.T) []string {
+	var zed []string
+	re := regexp.MustCompile(fmt.Sprintf(`.*ztests\%c.*\.yaml$`, filepath.Separator))
+	err := filepath.Walk("..", func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() && re.MatchString(path) {
+			zt, err := ztest.FromYAMLFile(path)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			if zt.Zed != "" && zt.Zed != "*" {
+				zed = append(zed, zt.Zed)
+			}
+		}
+		return err
+	})
+	require.NoError(t, err)
+	f, err := os.Open("../compiler/parser/valid.zed")
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		zed = append(zed, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	return zed
+}
+
+func parse(t *testing.T, z string) string {
+	op, err := compiler.Parse(z)
+	require.NoError(t, err, "%q", z)
+	b, err := json.Marshal(op)
+	require.NoError(t, err)
+	return string(b)
+}
+
+// TestFormatPreservesAST checks that formatting each Zed query in the
+// ztests and in valid.zed leaves its AST unchanged and that formatting the
+// result leaves it unchanged.
+func TestFormatPreservesAST(t *testing.T) {
+	for _, z := range searchForZed(t) {
+		if _, err := compiler.Parse(z); err != nil {
+			// Some ztests check for parse errors.
+			continue
+		}
+		out, err := zfmt.Format(z)
+		require.NoError(t, err, "%q", z)
+		assert.Equal(t, parse(t, z), parse(t, out), "AST changed: %q formatted as %q", z, out)
+		again, err := zfmt.Format(out)
+		require.NoError(t, err)
+		assert.Equal(t, out, again, "not idempotent: %q", z)
+	}
+}
+
+func TestFormatComments(t *testing.T) {
+	const src = `
+// leading
+from logs // pool
+| put
+  // first
+  a:=1,
+  b:="http://example.com"
+| fork (
+  // count them
+  => count() // trailing
+  => head
+)
+// end
+`
+	const expected = `// leading
+from logs // pool
+| put a:=1, b:="http://example.com" // first
+| fork (
+    // count them
+    => count() // trailing
+    => head 1
+  )
+// end
+`
+	out, err := zfmt.Format(strings.TrimPrefix(src, "\n"))
+	require.NoError(t, err)
+	assert.Equal(t, expected, out)
+	again, err := zfmt.Format(out)
+	require.NoError(t, err)
+	assert.Equal(t, out, again)
+}
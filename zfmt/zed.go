@@ -1,6 +1,8 @@
 package zfmt
 
 import (
+	"strings"
+
 	astzed "github.com/brimdata/zed/compiler/ast/zed"
 	"github.com/brimdata/zed/zson"
 )
@@ -13,7 +15,10 @@ type canonZed struct {
 func (c *canonZed) literal(e astzed.Primitive) {
 	switch e.Type {
 	case "string", "error":
-		c.write("\"%s\"", e.Text)
+		// Escape "${" so it is not taken for a template expression.
+		c.write(strings.ReplaceAll(zson.QuotedString([]byte(e.Text)), "${", "\\${"))
+	case "null":
+		c.write("null")
 	case "regexp":
 		c.write("/%s/", e.Text)
 	default:
@@ -76,9 +81,8 @@ func (c *canonZed) typ(t astzed.Type) {
 	case *astzed.TypeNull:
 		c.write("null")
 	case *astzed.TypeDef:
-		c.write("%s=(", t.Name)
+		c.write("%s=", t.Name)
 		c.typ(t.Type)
-		c.write(")")
 	case *astzed.TypeName:
 		c.write(t.Name)
 	}
@@ -32,6 +32,9 @@ func init() {
 		pools.Config{},
 		lake.BranchMeta{},
 		lake.BranchTip{},
+		lake.TagMeta{},
+		lake.ObjectChange{},
+		lake.IndexChange{},
 		data.Object{},
 	)
 }
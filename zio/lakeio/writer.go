@@ -90,6 +90,12 @@ func (w *Writer) formatValue(t table, b *bytes.Buffer, v interface{}, width int,
 		formatPoolConfig(b, v)
 	case *lake.BranchMeta:
 		formatBranchMeta(b, v, width, w.headID, w.headName, colors)
+	case *lake.TagMeta:
+		formatTagMeta(b, v, colors)
+	case *lake.ObjectChange:
+		formatDataObject(b, &v.Object, changePrefix(v.Op), 0)
+	case *lake.IndexChange:
+		formatIndexObject(b, v.Index.Rule.RuleID(), v.Index.ID, changePrefix(v.Op), 0)
 	case data.Object:
 		formatDataObject(b, &v, "", 0)
 	case *data.Object:
@@ -150,6 +156,25 @@ func formatBranchMeta(b *bytes.Buffer, p *lake.BranchMeta, width int, headID ksu
 	b.WriteByte('\n')
 }
 
+func formatTagMeta(b *bytes.Buffer, t *lake.TagMeta, colors *color.Stack) {
+	b.WriteString(t.Pool.Name)
+	b.WriteByte('@')
+	b.WriteString(t.Tag.Name)
+	b.WriteByte(' ')
+	colors.Start(b, color.GrayYellow)
+	b.WriteString("commit ")
+	b.WriteString(t.Tag.Commit.String())
+	colors.End(b)
+	b.WriteByte('\n')
+}
+
+func changePrefix(op string) string {
+	if op == "delete" {
+		return "-"
+	}
+	return "+"
+}
+
 func tab(b *bytes.Buffer, indent int) {
 	for k := 0; k < indent; k++ {
 		b.WriteByte(' ')
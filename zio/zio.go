@@ -124,6 +124,14 @@ func (c *concatReader) Read() (*zed.Value, error) {
 	return nil, nil
 }
 
+// Discard is a Writer on which all Write calls succeed without doing
+// anything.
+var Discard Writer = discard{}
+
+type discard struct{}
+
+func (discard) Write(*zed.Value) error { return nil }
+
 func MultiWriter(writers ...Writer) Writer {
 	return &multiWriter{slices.Clone(writers)}
 }
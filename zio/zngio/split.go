@@ -0,0 +1,86 @@
+package zngio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"golang.org/x/exp/slices"
+)
+
+// Splitter divides a ZNG stream into splits at frame boundaries so that the
+// splits may be decoded concurrently.  Since the values of a frame refer to
+// the types defined by the preceding types frames of the same stream, i.e.,
+// those following the most recent EOS marker, each split is prefixed with a
+// copy of these types frames.  Only frame headers and types frames are read
+// to locate the splits.
+type Splitter struct {
+	r     io.ReaderAt
+	size  int64
+	off   int64
+	types []byte
+	hdr   [1 + binary.MaxVarintLen64]byte
+}
+
+func NewSplitter(r io.ReaderAt, size int64) *Splitter {
+	return &Splitter{
+		r:    r,
+		size: size,
+	}
+}
+
+// Next returns a reader for the next split, which begins where the previous
+// split ended and comprises the frames that begin in the next target bytes
+// of the stream.  Next returns nil at the end of the stream.
+func (s *Splitter) Next(target int64) (io.Reader, error) {
+	if s.off >= s.size {
+		return nil, nil
+	}
+	prefix := slices.Clone(s.types)
+	start := s.off
+	for s.off < s.size && s.off-start < target {
+		code, n, err := s.header()
+		if err != nil {
+			return nil, err
+		}
+		if code == EOS {
+			s.types = s.types[:0]
+		} else if (code>>4)&3 == TypesFrame {
+			b := make([]byte, n)
+			if _, err := s.r.ReadAt(b, s.off); err != nil {
+				return nil, err
+			}
+			s.types = append(s.types, b...)
+		}
+		s.off += n
+	}
+	return io.MultiReader(bytes.NewReader(prefix), io.NewSectionReader(s.r, start, s.off-start)), nil
+}
+
+// header returns the code and total length of the frame at s.off.
+func (s *Splitter) header() (byte, int64, error) {
+	n, err := s.r.ReadAt(s.hdr[:], s.off)
+	if n == 0 {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, 0, err
+	}
+	code := s.hdr[0]
+	if code == EOS {
+		return code, 1, nil
+	}
+	if (code & 0x80) != 0 {
+		return 0, 0, fmt.Errorf("zngio: encountered wrong version bit in framing at offset %d", s.off)
+	}
+	v, k := binary.Uvarint(s.hdr[1:n])
+	if k <= 0 {
+		return 0, 0, fmt.Errorf("zngio: bad frame header at offset %d", s.off)
+	}
+	size := int64(1+k) + int64(v<<4|uint64(code&0xf))
+	if s.off+size > s.size {
+		return 0, 0, fmt.Errorf("zngio: frame at offset %d extends past end of stream", s.off)
+	}
+	return code, size, nil
+}
@@ -0,0 +1,60 @@
+package zngio
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zio/zsonio"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitter(t *testing.T) {
+	t.Parallel()
+	const input = `
+{a:1}
+{a:2,b:"x"}
+{a:3}
+{c:[1,2]}
+{a:4,b:"y"}
+{c:[3]}
+4
+{a:5}
+`
+	var buf bytes.Buffer
+	w := NewWriterWithOpts(zio.NopCloser(&buf), WriterOpts{Compress: true, FrameThresh: 1})
+	r := zsonio.NewReader(zed.NewContext(), strings.NewReader(input))
+	for k := 0; ; k++ {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			break
+		}
+		require.NoError(t, w.Write(val))
+		if k == 3 {
+			require.NoError(t, w.EndStream())
+		}
+	}
+	require.NoError(t, w.Close())
+	for _, target := range []int64{1, 5, 20, 1 << 20} {
+		splitter := NewSplitter(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		var out strings.Builder
+		var n int
+		for {
+			split, err := splitter.Next(target)
+			require.NoError(t, err)
+			if split == nil {
+				break
+			}
+			n++
+			zw := zsonio.NewWriter(zio.NopCloser(&out), zsonio.WriterOpts{})
+			require.NoError(t, zio.Copy(zw, NewReader(zed.NewContext(), split)))
+		}
+		require.Equal(t, strings.TrimSpace(input)+"\n", out.String(), "target %d", target)
+		if target == 1 {
+			require.Greater(t, n, 8)
+		}
+	}
+}
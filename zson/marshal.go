@@ -174,10 +174,7 @@ const (
 )
 
 func fieldName(f reflect.StructField) string {
-	tag := f.Tag.Get(tagName)
-	if tag == "" {
-		tag = f.Tag.Get("json")
-	}
+	tag := fieldTag(f)
 	if tag != "" {
 		s := strings.SplitN(tag, tagSep, 2)
 		if len(s) > 0 && s[0] != "" {
@@ -187,6 +184,25 @@ func fieldName(f reflect.StructField) string {
 	return f.Name
 }
 
+// omitEmpty returns true if the tag of f has the "omitempty" option, in which
+// case the field is omitted from a marshaled record if its value is zero.
+func omitEmpty(f reflect.StructField) bool {
+	opts := strings.Split(fieldTag(f), tagSep)
+	for _, opt := range opts[1:] {
+		if opt == "omitempty" {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldTag(f reflect.StructField) string {
+	if tag := f.Tag.Get(tagName); tag != "" {
+		return tag
+	}
+	return f.Tag.Get("json")
+}
+
 func typeSimple(name, path string) string {
 	return name
 }
@@ -453,6 +469,9 @@ func (m *MarshalZNGContext) encodeRecord(sval reflect.Value) (zed.Type, error) {
 			// Ignore fields named "-".
 			continue
 		}
+		if omitEmpty(field) && sval.Field(i).IsZero() {
+			continue
+		}
 		typ, err := m.encodeValue(sval.Field(i))
 		if err != nil {
 			return nil, err
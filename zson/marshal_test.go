@@ -517,3 +517,20 @@ func TestSimpleUnionUnmarshal(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, i)
 }
+
+func TestMarshalOmitEmpty(t *testing.T) {
+	type S struct {
+		A int      `zed:"a"`
+		B []string `zed:"b,omitempty"`
+		C string   `json:"c,omitempty"`
+	}
+	z, err := zson.Marshal(S{A: 1})
+	require.NoError(t, err)
+	assert.Equal(t, `{a:1}`, z)
+	z, err = zson.Marshal(S{A: 1, B: []string{"x"}, C: "y"})
+	require.NoError(t, err)
+	assert.Equal(t, `{a:1,b:["x"],c:"y"}`, z)
+	var s S
+	require.NoError(t, zson.Unmarshal(`{a:1}`, &s))
+	assert.Equal(t, S{A: 1}, s)
+}